@@ -2,20 +2,34 @@ package adapter
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
 	"github.com/rzfd/mediashar/internal/service"
 	"github.com/rzfd/mediashar/pkg/pb"
 )
 
 type DonationServiceAdapter struct {
 	donationClient pb.DonationServiceClient
+	currencyRepo   repository.CurrencyRepository
+	languageRepo   repository.LanguageRepository
 }
 
-func NewDonationServiceAdapter(donationClient pb.DonationServiceClient) *DonationServiceAdapter {
+// NewDonationServiceAdapter creates the adapter. Streamers' currency and language
+// preferences live in the gateway database, so currencyRepo is used to tell the donation
+// service which currency a new donation's exchange rate snapshot should be in, and
+// languageRepo which language its message should be translated into.
+func NewDonationServiceAdapter(donationClient pb.DonationServiceClient, currencyRepo repository.CurrencyRepository, languageRepo repository.LanguageRepository) *DonationServiceAdapter {
 	return &DonationServiceAdapter{
 		donationClient: donationClient,
+		currencyRepo:   currencyRepo,
+		languageRepo:   languageRepo,
 	}
 }
 
@@ -23,14 +37,21 @@ func (d *DonationServiceAdapter) Create(donation *models.Donation) error {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
+	streamerCurrency := donation.ConvertedCurrency
+	if streamerCurrency == "" {
+		streamerCurrency = d.streamerCurrency(donation.StreamerID)
+	}
+
 	grpcReq := &pb.CreateDonationRequest{
-		Amount:        donation.Amount,
-		Currency:      string(donation.Currency),
-		Message:       donation.Message,
-		StreamerId:    uint32(donation.StreamerID),
-		DisplayName:   donation.DisplayName,
-		IsAnonymous:   donation.IsAnonymous,
-		PaymentMethod: "qris",
+		Amount:           donation.Amount,
+		Currency:         string(donation.Currency),
+		Message:          donation.Message,
+		StreamerId:       uint32(donation.StreamerID),
+		DisplayName:      donation.DisplayName,
+		IsAnonymous:      donation.IsAnonymous,
+		PaymentMethod:    toPaymentMethod(donation.PaymentProvider),
+		StreamerCurrency: string(streamerCurrency),
+		SplitDonationId:  uint32(donation.SplitDonationID),
 	}
 
 	if donation.DonatorID != 0 {
@@ -39,7 +60,7 @@ func (d *DonationServiceAdapter) Create(donation *models.Donation) error {
 
 	resp, err := d.donationClient.CreateDonation(ctx, grpcReq)
 	if err != nil {
-		return err
+		return fromCreateDonationError(err)
 	}
 
 	donation.ID = uint(resp.DonationId)
@@ -50,23 +71,17 @@ func (d *DonationServiceAdapter) CreateDonation(req *service.CreateDonationReque
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	grpcReq := &pb.CreateDonationRequest{
-		Amount:        req.Amount,
-		Currency:      req.Currency,
-		Message:       req.Message,
-		StreamerId:    uint32(req.StreamerID),
-		DisplayName:   req.DisplayName,
-		IsAnonymous:   req.IsAnonymous,
-		PaymentMethod: "qris",
-	}
-
-	if req.DonatorID != nil {
-		grpcReq.DonatorId = uint32(*req.DonatorID)
-	}
+	grpcReq := d.toPbCreateDonationRequest(req)
+	grpcReq.IdempotencyKey = req.IdempotencyKey
 
 	resp, err := d.donationClient.CreateDonation(ctx, grpcReq)
 	if err != nil {
-		return nil, err
+		return nil, fromCreateDonationError(err)
+	}
+
+	// The service returns the donation as stored, with its message moderated
+	if resp.Donation != nil {
+		return fromPbDonation(resp.Donation), nil
 	}
 
 	donation := &models.Donation{
@@ -87,6 +102,64 @@ func (d *DonationServiceAdapter) CreateDonation(req *service.CreateDonationReque
 	return donation, nil
 }
 
+func (d *DonationServiceAdapter) CreateSplitShares(reqs []*service.CreateDonationRequest) ([]*models.Donation, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.CreateSplitSharesRequest{}
+	for _, req := range reqs {
+		grpcReq.Shares = append(grpcReq.Shares, d.toPbCreateDonationRequest(req))
+	}
+
+	resp, err := d.donationClient.CreateSplitShares(ctx, grpcReq)
+	if err != nil {
+		return nil, fromCreateDonationError(err)
+	}
+
+	donations := make([]*models.Donation, 0, len(resp.Donations))
+	for _, donation := range resp.Donations {
+		donations = append(donations, fromPbDonation(donation))
+	}
+	return donations, nil
+}
+
+// toPbCreateDonationRequest builds a create request, resolving the streamer's currency
+// and language when the caller has not
+func (d *DonationServiceAdapter) toPbCreateDonationRequest(req *service.CreateDonationRequest) *pb.CreateDonationRequest {
+	streamerCurrency := req.StreamerCurrency
+	if streamerCurrency == "" {
+		streamerCurrency = d.streamerCurrency(req.StreamerID)
+	}
+	streamerLanguage := req.StreamerLanguage
+	if streamerLanguage == "" && req.Message != "" {
+		streamerLanguage = d.streamerLanguage(req.StreamerID)
+	}
+
+	grpcReq := &pb.CreateDonationRequest{
+		Amount:           req.Amount,
+		Currency:         req.Currency,
+		Message:          req.Message,
+		StreamerId:       uint32(req.StreamerID),
+		DisplayName:      req.DisplayName,
+		IsAnonymous:      req.IsAnonymous,
+		PaymentMethod:    toPaymentMethod(req.PaymentProvider),
+		StreamerCurrency: string(streamerCurrency),
+		SplitDonationId:  uint32(req.SplitDonationID),
+		StreamerLanguage: string(streamerLanguage),
+	}
+
+	if req.DonatorID != nil {
+		grpcReq.DonatorId = uint32(*req.DonatorID)
+	}
+
+	if req.Client != nil {
+		grpcReq.ClientIp = req.Client.IPAddress
+		grpcReq.ClientCountry = req.Client.Country
+		grpcReq.ClientUserId = uint32(req.Client.UserID)
+	}
+	return grpcReq
+}
+
 func (d *DonationServiceAdapter) GetByID(id uint) (*models.Donation, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -95,33 +168,85 @@ func (d *DonationServiceAdapter) GetByID(id uint) (*models.Donation, error) {
 		DonationId: uint32(id),
 	})
 	if err != nil {
-		return nil, err
+		return nil, fromDonationServiceError(err)
 	}
 
-	donation := &models.Donation{
-		Amount:      resp.Donation.Amount,
-		Currency:    models.SupportedCurrency(resp.Donation.Currency),
-		Message:     resp.Donation.Message,
-		StreamerID:  uint(resp.Donation.StreamerId),
-		DonatorID:   uint(resp.Donation.DonatorId),
-		DisplayName: resp.Donation.DisplayName,
-		IsAnonymous: resp.Donation.IsAnonymous,
-	}
-	donation.ID = uint(resp.Donation.Id)
-
-	return donation, nil
+	return fromPbDonation(resp.Donation), nil
 }
 
 func (d *DonationServiceAdapter) GetByTransactionID(transactionID string) (*models.Donation, error) {
-	return &models.Donation{}, nil
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.donationClient.GetDonationByTransactionID(ctx, &pb.GetDonationByTransactionIDRequest{
+		TransactionId: transactionID,
+	})
+	if err != nil {
+		return nil, fromDonationServiceError(err)
+	}
+
+	return fromPbDonation(resp.Donation), nil
 }
 
 func (d *DonationServiceAdapter) List(page, pageSize int) ([]*models.Donation, error) {
-	return []*models.Donation{}, nil
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.donationClient.GetDonations(ctx, &pb.GetDonationsRequest{
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+	if err != nil {
+		return nil, fromDonationServiceError(err)
+	}
+
+	return fromPbDonations(resp.Donations), nil
 }
 
 func (d *DonationServiceAdapter) GetByDonatorID(donatorID uint, page, pageSize int) ([]*models.Donation, error) {
-	return []*models.Donation{}, nil
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.donationClient.GetDonationsByDonator(ctx, &pb.GetDonationsByDonatorRequest{
+		DonatorId: uint32(donatorID),
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	})
+	if err != nil {
+		return nil, fromDonationServiceError(err)
+	}
+
+	return fromPbDonations(resp.Donations), nil
+}
+
+func (d *DonationServiceAdapter) ListDonations(req *service.DonationListRequest) (*models.DonationPage, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	resp, err := d.donationClient.ListDonations(ctx, &pb.ListDonationsRequest{
+		Filter: toPbDonationFilter(req.Filter),
+		SortBy: toPbDonationSortField(req.SortBy),
+		Order:  toPbSortOrder(req.Order),
+		Cursor: req.Cursor,
+		Limit:  int32(req.Limit),
+	})
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%w: %s", service.ErrInvalidDonationCursor, status.Convert(err).Message())
+		}
+		return nil, fromDonationServiceError(err)
+	}
+
+	page := &models.DonationPage{
+		Donations:  []*models.Donation{},
+		NextCursor: resp.NextCursor,
+		HasMore:    resp.HasMore,
+	}
+	for _, pbDonation := range resp.Donations {
+		page.Donations = append(page.Donations, fromPbDonation(pbDonation))
+	}
+
+	return page, nil
 }
 
 func (d *DonationServiceAdapter) GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.Donation, error) {
@@ -134,39 +259,457 @@ func (d *DonationServiceAdapter) GetByStreamerID(streamerID uint, page, pageSize
 		PageSize:   int32(pageSize),
 	})
 	if err != nil {
-		return nil, err
+		return nil, fromDonationServiceError(err)
 	}
 
-	var donations []*models.Donation
-	for _, pbDonation := range resp.Donations {
-		donation := &models.Donation{
-			Amount:      pbDonation.Amount,
-			Currency:    models.SupportedCurrency(pbDonation.Currency),
-			Message:     pbDonation.Message,
-			StreamerID:  uint(pbDonation.StreamerId),
-			DonatorID:   uint(pbDonation.DonatorId),
-			DisplayName: pbDonation.DisplayName,
-			IsAnonymous: pbDonation.IsAnonymous,
-		}
-		donation.ID = uint(pbDonation.Id)
-		donations = append(donations, donation)
-	}
-
-	return donations, nil
+	return fromPbDonations(resp.Donations), nil
 }
 
-func (d *DonationServiceAdapter) UpdateStatus(id uint, status models.PaymentStatus) error {
+func (d *DonationServiceAdapter) UpdateStatus(id uint, paymentStatus models.PaymentStatus, change service.StatusChange) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := d.donationClient.UpdateDonationStatus(ctx, &pb.UpdateDonationStatusRequest{
+		DonationId: uint32(id),
+		Status:     toPbPaymentStatus(paymentStatus),
+		Source:     toPbStatusChangeSource(change.Source),
+		ActorId:    uint32(change.ActorID),
+		Reason:     change.Reason,
+	})
+	if err != nil {
+		return fromDonationServiceError(err)
+	}
 	return nil
 }
 
+func (d *DonationServiceAdapter) GetStatusHistory(donationID uint) ([]*models.DonationStatusHistory, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.donationClient.GetDonationStatusHistory(ctx, &pb.GetDonationStatusHistoryRequest{
+		DonationId: uint32(donationID),
+	})
+	if err != nil {
+		return nil, fromDonationServiceError(err)
+	}
+
+	history := make([]*models.DonationStatusHistory, 0, len(resp.History))
+	for _, change := range resp.History {
+		history = append(history, &models.DonationStatusHistory{
+			ID:         uint(change.Id),
+			DonationID: uint(change.DonationId),
+			FromStatus: fromPbPaymentStatus(change.FromStatus),
+			ToStatus:   fromPbPaymentStatus(change.ToStatus),
+			Source:     fromPbStatusChangeSource(change.Source),
+			ActorID:    uint(change.ActorId),
+			Reason:     change.Reason,
+			CreatedAt:  change.CreatedAt.AsTime(),
+		})
+	}
+
+	return history, nil
+}
+
 func (d *DonationServiceAdapter) ProcessPayment(donationID uint, transactionID string, provider models.PaymentProvider) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := d.donationClient.ProcessDonationPayment(ctx, &pb.ProcessDonationPaymentRequest{
+		DonationId:    uint32(donationID),
+		TransactionId: transactionID,
+		Provider:      toPbPaymentProvider(provider),
+	})
+	if err != nil {
+		return fromDonationServiceError(err)
+	}
 	return nil
 }
 
 func (d *DonationServiceAdapter) GetLatestDonations(limit int) ([]*models.Donation, error) {
-	return []*models.Donation{}, nil
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.donationClient.GetLatestDonations(ctx, &pb.GetLatestDonationsRequest{
+		Limit: int32(limit),
+	})
+	if err != nil {
+		return nil, fromDonationServiceError(err)
+	}
+
+	return fromPbDonations(resp.Donations), nil
+}
+
+// GetTotalAmountByStreamer totals into the streamer's primary currency when no currency is given
+func (d *DonationServiceAdapter) GetTotalAmountByStreamer(streamerID uint, currency models.SupportedCurrency) (int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	if currency == "" {
+		currency = d.streamerCurrency(streamerID)
+	}
+
+	resp, err := d.donationClient.GetStreamerDonationTotal(ctx, &pb.GetStreamerDonationTotalRequest{
+		StreamerId: uint32(streamerID),
+		Currency:   string(currency),
+	})
+	if err != nil {
+		return 0, fromDonationServiceError(err)
+	}
+
+	return resp.TotalAmount, nil
+}
+
+func (d *DonationServiceAdapter) GetDonationStats(req *service.DonationStatsRequest) (*models.DonationStats, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	currency := req.Currency
+	if currency == "" {
+		currency = d.streamerCurrency(req.StreamerID)
+	}
+
+	grpcReq := &pb.GetDonationStatsRequest{
+		StreamerId: uint32(req.StreamerID),
+		Interval:   toPbStatsInterval(req.Interval),
+		Currency:   string(currency),
+	}
+	if !req.StartDate.IsZero() {
+		grpcReq.StartDate = timestamppb.New(req.StartDate)
+	}
+	if !req.EndDate.IsZero() {
+		grpcReq.EndDate = timestamppb.New(req.EndDate)
+	}
+
+	resp, err := d.donationClient.GetDonationStats(ctx, grpcReq)
+	if err != nil {
+		if status.Code(err) == codes.InvalidArgument {
+			return nil, fmt.Errorf("%w: %s", service.ErrInvalidDonationStats, status.Convert(err).Message())
+		}
+		return nil, fromDonationServiceError(err)
+	}
+
+	stats := &models.DonationStats{
+		StreamerID:     req.StreamerID,
+		Interval:       req.Interval,
+		Currency:       models.SupportedCurrency(resp.Currency),
+		StartDate:      resp.StartDate.AsTime(),
+		EndDate:        resp.EndDate.AsTime(),
+		TotalAmount:    resp.TotalAmount,
+		TotalDonations: int64(resp.TotalDonations),
+		AverageAmount:  resp.AverageAmount,
+		Buckets:        []*models.DonationStatBucket{},
+		CurrencyTotals: []*models.CurrencyTotal{},
+	}
+	if stats.Interval == "" {
+		stats.Interval = models.StatsIntervalDay
+	}
+
+	for _, stat := range resp.DailyStats {
+		period, err := time.ParseInLocation("2006-01-02", stat.Date, time.Local)
+		if err != nil {
+			continue
+		}
+		stats.Buckets = append(stats.Buckets, &models.DonationStatBucket{
+			Period:            period,
+			Currency:          models.SupportedCurrency(stat.Currency),
+			TotalAmount:       stat.Amount,
+			ConvertedAmount:   stat.ConvertedAmount,
+			ConvertedCurrency: models.SupportedCurrency(stat.ConvertedCurrency),
+			Count:             int64(stat.Count),
+		})
+	}
+
+	for _, stat := range resp.CurrencyStats {
+		stats.CurrencyTotals = append(stats.CurrencyTotals, &models.CurrencyTotal{
+			Currency:        models.SupportedCurrency(stat.Currency),
+			TotalAmount:     stat.TotalAmount,
+			ConvertedAmount: stat.ConvertedAmount,
+			Count:           int64(stat.TotalDonations),
+			AverageAmount:   stat.AverageAmount,
+		})
+	}
+
+	return stats, nil
 }
 
-func (d *DonationServiceAdapter) GetTotalAmountByStreamer(streamerID uint) (float64, error) {
-	return 0, nil
-} 
\ No newline at end of file
+// streamerCurrency returns the streamer's primary currency, or an empty currency (which
+// the donation service treats as IDR) when the preference cannot be read
+func (d *DonationServiceAdapter) streamerCurrency(streamerID uint) models.SupportedCurrency {
+	if d.currencyRepo == nil {
+		return ""
+	}
+	preference, err := d.currencyRepo.GetUserCurrencyPreference(context.Background(), streamerID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to fetch currency preference of streamer %d: %v\n", streamerID, err)
+		return ""
+	}
+	return preference.PrimaryCurrency
+}
+
+// streamerLanguage returns the streamer's primary language, or an empty language (leaving
+// the message untranslated) when the preference cannot be read
+func (d *DonationServiceAdapter) streamerLanguage(streamerID uint) models.SupportedLanguage {
+	if d.languageRepo == nil {
+		return ""
+	}
+	preference, err := d.languageRepo.GetUserLanguagePreference(context.Background(), streamerID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to fetch language preference of streamer %d: %v\n", streamerID, err)
+		return ""
+	}
+	return preference.PrimaryLanguage
+}
+
+// fromDonationServiceError maps the donation service's status codes back to the domain
+// errors they were raised for, so callers can keep using errors.Is across the wire
+func fromDonationServiceError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.NotFound:
+		return service.ErrDonationNotFound
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", service.ErrInvalidStatusTransition, st.Message())
+	}
+	return err
+}
+
+// fromCreateDonationError maps the donation service's create errors back to their sentinels
+func fromCreateDonationError(err error) error {
+	st := status.Convert(err)
+	switch {
+	case st.Code() == codes.InvalidArgument && st.Message() == service.ErrMessageRejected.Error():
+		return service.ErrMessageRejected
+	case st.Code() == codes.PermissionDenied && st.Message() == service.ErrDonationBlocked.Error():
+		return service.ErrDonationBlocked
+	case st.Code() == codes.Unauthenticated && st.Message() == service.ErrDonationChallenged.Error():
+		return service.ErrDonationChallenged
+	}
+	return fromDonationServiceError(err)
+}
+
+func toPbStatsInterval(interval models.StatsInterval) pb.StatsInterval {
+	switch interval {
+	case models.StatsIntervalWeek:
+		return pb.StatsInterval_STATS_INTERVAL_WEEK
+	case models.StatsIntervalMonth:
+		return pb.StatsInterval_STATS_INTERVAL_MONTH
+	default:
+		return pb.StatsInterval_STATS_INTERVAL_DAY
+	}
+}
+
+func toPbDonationFilter(filter models.DonationFilter) *pb.DonationFilter {
+	pbFilter := &pb.DonationFilter{
+		StreamerId:    uint32(filter.StreamerID),
+		DonatorId:     uint32(filter.DonatorID),
+		ParticipantId: uint32(filter.ParticipantID),
+		MinAmount:     filter.MinAmount,
+		MaxAmount:     filter.MaxAmount,
+		Status:        toPbPaymentStatus(filter.Status),
+		Currency:      string(filter.Currency),
+		Provider:      toPbPaymentProvider(filter.Provider),
+	}
+	if filter.StartDate != nil {
+		pbFilter.StartDate = timestamppb.New(*filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		pbFilter.EndDate = timestamppb.New(*filter.EndDate)
+	}
+	switch filter.Anonymity {
+	case models.AnonymityOnly:
+		pbFilter.Anonymity = pb.AnonymityFilter_ANONYMITY_FILTER_ONLY
+	case models.AnonymityExclude:
+		pbFilter.Anonymity = pb.AnonymityFilter_ANONYMITY_FILTER_EXCLUDE
+	}
+	return pbFilter
+}
+
+func toPbDonationSortField(field models.DonationSortField) pb.DonationSortField {
+	switch field {
+	case models.DonationSortByTime:
+		return pb.DonationSortField_DONATION_SORT_FIELD_TIME
+	case models.DonationSortByAmount:
+		return pb.DonationSortField_DONATION_SORT_FIELD_AMOUNT
+	default:
+		return pb.DonationSortField_DONATION_SORT_FIELD_UNSPECIFIED
+	}
+}
+
+func toPbSortOrder(order models.SortOrder) pb.SortOrder {
+	switch order {
+	case models.SortDescending:
+		return pb.SortOrder_SORT_ORDER_DESC
+	case models.SortAscending:
+		return pb.SortOrder_SORT_ORDER_ASC
+	default:
+		return pb.SortOrder_SORT_ORDER_UNSPECIFIED
+	}
+}
+
+// toPaymentMethod names the provider for a create request; QRIS is the gateway's default
+func toPaymentMethod(provider models.PaymentProvider) string {
+	if provider == "" {
+		return "qris"
+	}
+	return string(provider)
+}
+
+func fromPbDonation(pbDonation *pb.Donation) *models.Donation {
+	donation := &models.Donation{
+		Amount:            pbDonation.Amount,
+		Currency:          models.SupportedCurrency(pbDonation.Currency),
+		Message:           pbDonation.Message,
+		StreamerID:        uint(pbDonation.StreamerId),
+		DonatorID:         uint(pbDonation.DonatorId),
+		DisplayName:       pbDonation.DisplayName,
+		IsAnonymous:       pbDonation.IsAnonymous,
+		Status:            fromPbPaymentStatus(pbDonation.Status),
+		PaymentProvider:   fromPbPaymentProvider(pbDonation.PaymentProvider),
+		TransactionID:     pbDonation.TransactionId,
+		RefundedAmount:    pbDonation.RefundedAmount,
+		MessageStatus:     fromPbMessageStatus(pbDonation.MessageStatus),
+		ExchangeRate:      pbDonation.ExchangeRate,
+		ConvertedAmount:   pbDonation.ConvertedAmount,
+		ConvertedCurrency: models.SupportedCurrency(pbDonation.ConvertedCurrency),
+		RateSource:        pbDonation.RateSource,
+		SplitDonationID:   uint(pbDonation.SplitDonationId),
+
+		MessageLanguage:     models.SupportedLanguage(pbDonation.MessageLanguage),
+		TranslatedMessage:   pbDonation.TranslatedMessage,
+		TranslationLanguage: models.SupportedLanguage(pbDonation.TranslationLanguage),
+
+		MatchCampaignID:   uint(pbDonation.MatchCampaignId),
+		MatchedDonationID: uint(pbDonation.MatchedDonationId),
+	}
+	donation.ID = uint(pbDonation.Id)
+	if pbDonation.CreatedAt != nil {
+		donation.CreatedAt = pbDonation.CreatedAt.AsTime()
+	}
+	if pbDonation.UpdatedAt != nil {
+		donation.UpdatedAt = pbDonation.UpdatedAt.AsTime()
+	}
+	if pbDonation.PaymentTime != nil {
+		paymentTime := pbDonation.PaymentTime.AsTime()
+		donation.PaymentTime = &paymentTime
+	}
+	if pbDonation.RateTime != nil {
+		rateTime := pbDonation.RateTime.AsTime()
+		donation.RateTime = &rateTime
+	}
+
+	return donation
+}
+
+func fromPbDonations(pbDonations []*pb.Donation) []*models.Donation {
+	donations := make([]*models.Donation, 0, len(pbDonations))
+	for _, pbDonation := range pbDonations {
+		donations = append(donations, fromPbDonation(pbDonation))
+	}
+	return donations
+}
+
+func fromPbMessageStatus(messageStatus pb.MessageStatus) models.MessageStatus {
+	switch messageStatus {
+	case pb.MessageStatus_MESSAGE_STATUS_HELD:
+		return models.MessageHeld
+	case pb.MessageStatus_MESSAGE_STATUS_REJECTED:
+		return models.MessageRejected
+	default:
+		return models.MessageVisible
+	}
+}
+
+func fromPbPaymentStatus(status pb.PaymentStatus) models.PaymentStatus {
+	switch status {
+	case pb.PaymentStatus_PAYMENT_STATUS_COMPLETED:
+		return models.PaymentCompleted
+	case pb.PaymentStatus_PAYMENT_STATUS_FAILED:
+		return models.PaymentFailed
+	case pb.PaymentStatus_PAYMENT_STATUS_REFUNDED:
+		return models.PaymentRefunded
+	default:
+		return models.PaymentPending
+	}
+}
+
+func fromPbPaymentProvider(provider pb.PaymentProvider) models.PaymentProvider {
+	switch provider {
+	case pb.PaymentProvider_PAYMENT_PROVIDER_MIDTRANS:
+		return models.PaymentProviderMidtrans
+	case pb.PaymentProvider_PAYMENT_PROVIDER_PAYPAL:
+		return models.PaymentProviderPaypal
+	case pb.PaymentProvider_PAYMENT_PROVIDER_STRIPE:
+		return models.PaymentProviderStripe
+	case pb.PaymentProvider_PAYMENT_PROVIDER_QRIS:
+		return models.PaymentProviderQRIS
+	case pb.PaymentProvider_PAYMENT_PROVIDER_CRYPTO:
+		return models.PaymentProviderCrypto
+	case pb.PaymentProvider_PAYMENT_PROVIDER_SPONSOR:
+		return models.PaymentProviderSponsor
+	default:
+		return ""
+	}
+}
+
+func toPbPaymentStatus(paymentStatus models.PaymentStatus) pb.PaymentStatus {
+	switch paymentStatus {
+	case models.PaymentPending:
+		return pb.PaymentStatus_PAYMENT_STATUS_PENDING
+	case models.PaymentCompleted:
+		return pb.PaymentStatus_PAYMENT_STATUS_COMPLETED
+	case models.PaymentFailed:
+		return pb.PaymentStatus_PAYMENT_STATUS_FAILED
+	case models.PaymentRefunded:
+		return pb.PaymentStatus_PAYMENT_STATUS_REFUNDED
+	default:
+		return pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	}
+}
+
+func toPbPaymentProvider(provider models.PaymentProvider) pb.PaymentProvider {
+	switch provider {
+	case models.PaymentProviderMidtrans:
+		return pb.PaymentProvider_PAYMENT_PROVIDER_MIDTRANS
+	case models.PaymentProviderPaypal:
+		return pb.PaymentProvider_PAYMENT_PROVIDER_PAYPAL
+	case models.PaymentProviderStripe:
+		return pb.PaymentProvider_PAYMENT_PROVIDER_STRIPE
+	case models.PaymentProviderCrypto:
+		return pb.PaymentProvider_PAYMENT_PROVIDER_CRYPTO
+	case models.PaymentProviderSponsor:
+		return pb.PaymentProvider_PAYMENT_PROVIDER_SPONSOR
+	default:
+		return pb.PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+	}
+}
+
+func toPbStatusChangeSource(source models.StatusChangeSource) pb.StatusChangeSource {
+	switch source {
+	case models.StatusSourceWebhook:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_WEBHOOK
+	case models.StatusSourceAdmin:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_ADMIN
+	case models.StatusSourceUser:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_USER
+	case models.StatusSourceSystem:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_SYSTEM
+	default:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_UNSPECIFIED
+	}
+}
+
+func fromPbStatusChangeSource(source pb.StatusChangeSource) models.StatusChangeSource {
+	switch source {
+	case pb.StatusChangeSource_STATUS_CHANGE_SOURCE_WEBHOOK:
+		return models.StatusSourceWebhook
+	case pb.StatusChangeSource_STATUS_CHANGE_SOURCE_ADMIN:
+		return models.StatusSourceAdmin
+	case pb.StatusChangeSource_STATUS_CHANGE_SOURCE_USER:
+		return models.StatusSourceUser
+	default:
+		return models.StatusSourceSystem
+	}
+}
@@ -0,0 +1,184 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type DonationExportServiceAdapter struct {
+	donationClient pb.DonationServiceClient
+}
+
+func NewDonationExportServiceAdapter(donationClient pb.DonationServiceClient) *DonationExportServiceAdapter {
+	return &DonationExportServiceAdapter{
+		donationClient: donationClient,
+	}
+}
+
+// ExportDonations copies the streamed file to w. Nothing is written to w when the
+// export is refused, so callers can still answer with an error.
+func (d *DonationExportServiceAdapter) ExportDonations(ctx context.Context, req *service.DonationExportRequest, w io.Writer) error {
+	stream, err := d.donationClient.ExportDonations(ctx, toPbExportRequest(req))
+	if err != nil {
+		return fromExportError(err)
+	}
+	return copyExportChunks(stream, w)
+}
+
+func (d *DonationExportServiceAdapter) CreateExport(req *service.DonationExportRequest) (*models.DonationExport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.donationClient.CreateDonationExport(ctx, toPbExportRequest(req))
+	if err != nil {
+		return nil, fromExportError(err)
+	}
+
+	return fromPbDonationExport(resp.Export), nil
+}
+
+func (d *DonationExportServiceAdapter) GetExport(streamerID, exportID uint) (*models.DonationExport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.donationClient.GetDonationExport(ctx, &pb.GetDonationExportRequest{
+		StreamerId: uint32(streamerID),
+		ExportId:   uint32(exportID),
+	})
+	if err != nil {
+		return nil, fromExportError(err)
+	}
+
+	return fromPbDonationExport(resp.Export), nil
+}
+
+func (d *DonationExportServiceAdapter) DownloadExport(ctx context.Context, streamerID, exportID uint, w io.Writer) error {
+	stream, err := d.donationClient.DownloadDonationExport(ctx, &pb.GetDonationExportRequest{
+		StreamerId: uint32(streamerID),
+		ExportId:   uint32(exportID),
+	})
+	if err != nil {
+		return fromExportError(err)
+	}
+	return copyExportChunks(stream, w)
+}
+
+// copyExportChunks writes each received chunk to w until the stream ends
+func copyExportChunks(stream interface{ Recv() (*pb.ExportChunk, error) }, w io.Writer) error {
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fromExportError(err)
+		}
+		if _, err := w.Write(chunk.Data); err != nil {
+			return err
+		}
+	}
+}
+
+func fromExportError(err error) error {
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		reason := strings.TrimPrefix(st.Message(), service.ErrInvalidExportRequest.Error()+": ")
+		return fmt.Errorf("%w: %s", service.ErrInvalidExportRequest, reason)
+	case codes.ResourceExhausted:
+		return service.ErrExportTooLarge
+	case codes.FailedPrecondition:
+		return service.ErrExportNotReady
+	case codes.NotFound:
+		return gorm.ErrRecordNotFound
+	default:
+		return err
+	}
+}
+
+func toPbExportRequest(req *service.DonationExportRequest) *pb.ExportDonationsRequest {
+	grpcReq := &pb.ExportDonationsRequest{
+		StreamerId: uint32(req.StreamerID),
+		Format:     toPbExportFormat(req.Format),
+		Currency:   string(req.Currency),
+	}
+	if !req.StartDate.IsZero() {
+		grpcReq.StartDate = timestamppb.New(req.StartDate)
+	}
+	if !req.EndDate.IsZero() {
+		grpcReq.EndDate = timestamppb.New(req.EndDate)
+	}
+	return grpcReq
+}
+
+func fromPbDonationExport(pbExport *pb.DonationExport) *models.DonationExport {
+	export := &models.DonationExport{
+		StreamerID: uint(pbExport.StreamerId),
+		Format:     fromPbExportFormat(pbExport.Format),
+		StartDate:  pbExport.StartDate.AsTime(),
+		EndDate:    pbExport.EndDate.AsTime(),
+		Currency:   models.SupportedCurrency(pbExport.Currency),
+		Status:     fromPbDonationExportStatus(pbExport.Status),
+		RowCount:   int(pbExport.RowCount),
+		FileSize:   pbExport.FileSize,
+		Error:      pbExport.Error,
+	}
+	export.ID = uint(pbExport.Id)
+	export.CreatedAt = pbExport.CreatedAt.AsTime()
+	if pbExport.CompletedAt != nil {
+		completedAt := pbExport.CompletedAt.AsTime()
+		export.CompletedAt = &completedAt
+	}
+	if pbExport.ExpiresAt != nil {
+		expiresAt := pbExport.ExpiresAt.AsTime()
+		export.ExpiresAt = &expiresAt
+	}
+	return export
+}
+
+func toPbExportFormat(format models.ExportFormat) pb.ExportFormat {
+	switch format {
+	case models.ExportCSV:
+		return pb.ExportFormat_EXPORT_FORMAT_CSV
+	case models.ExportXLSX:
+		return pb.ExportFormat_EXPORT_FORMAT_XLSX
+	default:
+		return pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+	}
+}
+
+func fromPbExportFormat(format pb.ExportFormat) models.ExportFormat {
+	switch format {
+	case pb.ExportFormat_EXPORT_FORMAT_CSV:
+		return models.ExportCSV
+	case pb.ExportFormat_EXPORT_FORMAT_XLSX:
+		return models.ExportXLSX
+	default:
+		return ""
+	}
+}
+
+func fromPbDonationExportStatus(exportStatus pb.DonationExportStatus) models.DonationExportStatus {
+	switch exportStatus {
+	case pb.DonationExportStatus_DONATION_EXPORT_STATUS_RUNNING:
+		return models.ExportRunning
+	case pb.DonationExportStatus_DONATION_EXPORT_STATUS_COMPLETED:
+		return models.ExportCompleted
+	case pb.DonationExportStatus_DONATION_EXPORT_STATUS_FAILED:
+		return models.ExportFailed
+	default:
+		return models.ExportPending
+	}
+}
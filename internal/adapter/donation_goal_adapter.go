@@ -0,0 +1,142 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type DonationGoalServiceAdapter struct {
+	goalClient pb.DonationGoalServiceClient
+}
+
+func NewDonationGoalServiceAdapter(goalClient pb.DonationGoalServiceClient) *DonationGoalServiceAdapter {
+	return &DonationGoalServiceAdapter{
+		goalClient: goalClient,
+	}
+}
+
+func (d *DonationGoalServiceAdapter) CreateGoal(goal *models.DonationGoal) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.goalClient.CreateDonationGoal(ctx, &pb.CreateDonationGoalRequest{
+		Goal: toPbDonationGoal(goal),
+	})
+	if err != nil {
+		return err
+	}
+
+	*goal = *fromPbDonationGoal(resp.Goal)
+	return nil
+}
+
+func (d *DonationGoalServiceAdapter) UpdateGoal(goal *models.DonationGoal) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.goalClient.UpdateDonationGoal(ctx, &pb.UpdateDonationGoalRequest{
+		Goal: toPbDonationGoal(goal),
+	})
+	if err != nil {
+		return err
+	}
+
+	*goal = *fromPbDonationGoal(resp.Goal)
+	return nil
+}
+
+func (d *DonationGoalServiceAdapter) DeleteGoal(streamerID, goalID uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := d.goalClient.DeleteDonationGoal(ctx, &pb.DeleteDonationGoalRequest{
+		StreamerId: uint32(streamerID),
+		GoalId:     uint32(goalID),
+	})
+	return err
+}
+
+func (d *DonationGoalServiceAdapter) GetGoal(goalID uint) (*models.DonationGoal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.goalClient.GetDonationGoal(ctx, &pb.GetDonationGoalRequest{
+		GoalId: uint32(goalID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromPbDonationGoal(resp.Goal), nil
+}
+
+func (d *DonationGoalServiceAdapter) GetGoalsByStreamer(streamerID uint, activeOnly bool) ([]*models.DonationGoal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := d.goalClient.ListDonationGoals(ctx, &pb.ListDonationGoalsRequest{
+		StreamerId: uint32(streamerID),
+		ActiveOnly: activeOnly,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	goals := make([]*models.DonationGoal, 0, len(resp.Goals))
+	for _, pbGoal := range resp.Goals {
+		goals = append(goals, fromPbDonationGoal(pbGoal))
+	}
+
+	return goals, nil
+}
+
+func toPbDonationGoal(goal *models.DonationGoal) *pb.DonationGoal {
+	pbGoal := &pb.DonationGoal{
+		Id:           uint32(goal.ID),
+		StreamerId:   uint32(goal.StreamerID),
+		Title:        goal.Title,
+		Description:  goal.Description,
+		TargetAmount: goal.TargetAmount,
+		Currency:     string(goal.Currency),
+		IsActive:     goal.IsActive,
+	}
+	if goal.Deadline != nil {
+		pbGoal.Deadline = timestamppb.New(*goal.Deadline)
+	}
+	return pbGoal
+}
+
+func fromPbDonationGoal(pbGoal *pb.DonationGoal) *models.DonationGoal {
+	goal := &models.DonationGoal{
+		StreamerID:    uint(pbGoal.StreamerId),
+		Title:         pbGoal.Title,
+		Description:   pbGoal.Description,
+		TargetAmount:  pbGoal.TargetAmount,
+		CurrentAmount: pbGoal.CurrentAmount,
+		Currency:      models.SupportedCurrency(pbGoal.Currency),
+		IsActive:      pbGoal.IsActive,
+	}
+	goal.ID = uint(pbGoal.Id)
+
+	if pbGoal.CreatedAt != nil {
+		goal.CreatedAt = pbGoal.CreatedAt.AsTime()
+	}
+	if pbGoal.UpdatedAt != nil {
+		goal.UpdatedAt = pbGoal.UpdatedAt.AsTime()
+	}
+	if pbGoal.Deadline != nil {
+		deadline := pbGoal.Deadline.AsTime()
+		goal.Deadline = &deadline
+	}
+	if pbGoal.CompletedAt != nil {
+		completedAt := pbGoal.CompletedAt.AsTime()
+		goal.CompletedAt = &completedAt
+	}
+
+	return goal
+}
@@ -0,0 +1,99 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type LeaderboardServiceAdapter struct {
+	donationClient pb.DonationServiceClient
+}
+
+func NewLeaderboardServiceAdapter(donationClient pb.DonationServiceClient) *LeaderboardServiceAdapter {
+	return &LeaderboardServiceAdapter{
+		donationClient: donationClient,
+	}
+}
+
+func (l *LeaderboardServiceAdapter) GetLeaderboard(req *service.LeaderboardRequest) (*models.DonationLeaderboard, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	grpcReq := &pb.GetDonationLeaderboardRequest{
+		StreamerId:     uint32(req.StreamerID),
+		Period:         toPbLeaderboardPeriod(req.Period),
+		Currency:       string(req.Currency),
+		Limit:          int32(req.Limit),
+		GroupAnonymous: req.GroupAnonymous,
+	}
+	if !req.StartDate.IsZero() {
+		grpcReq.StartDate = timestamppb.New(req.StartDate)
+	}
+	if !req.EndDate.IsZero() {
+		grpcReq.EndDate = timestamppb.New(req.EndDate)
+	}
+
+	resp, err := l.donationClient.GetDonationLeaderboard(ctx, grpcReq)
+	if err != nil {
+		return nil, err
+	}
+
+	leaderboard := &models.DonationLeaderboard{
+		StreamerID: uint(resp.StreamerId),
+		Period:     fromPbLeaderboardPeriod(resp.Period),
+		Currency:   models.SupportedCurrency(resp.Currency),
+		EndDate:    resp.EndDate.AsTime(),
+		Entries:    []*models.LeaderboardEntry{},
+	}
+	if resp.StartDate != nil {
+		startDate := resp.StartDate.AsTime()
+		leaderboard.StartDate = &startDate
+	}
+
+	for _, entry := range resp.Entries {
+		leaderboard.Entries = append(leaderboard.Entries, &models.LeaderboardEntry{
+			Rank:           int(entry.Rank),
+			DonatorID:      uint(entry.DonatorId),
+			DisplayName:    entry.DisplayName,
+			IsAnonymous:    entry.IsAnonymous,
+			TotalAmount:    entry.TotalAmount,
+			DonationCount:  int64(entry.DonationCount),
+			FirstDonatedAt: entry.FirstDonatedAt.AsTime(),
+			LastDonatedAt:  entry.LastDonatedAt.AsTime(),
+		})
+	}
+
+	return leaderboard, nil
+}
+
+func toPbLeaderboardPeriod(period models.LeaderboardPeriod) pb.LeaderboardPeriod {
+	switch period {
+	case models.LeaderboardMonthly:
+		return pb.LeaderboardPeriod_LEADERBOARD_PERIOD_MONTHLY
+	case models.LeaderboardWeekly:
+		return pb.LeaderboardPeriod_LEADERBOARD_PERIOD_WEEKLY
+	case models.LeaderboardSession:
+		return pb.LeaderboardPeriod_LEADERBOARD_PERIOD_SESSION
+	default:
+		return pb.LeaderboardPeriod_LEADERBOARD_PERIOD_ALL_TIME
+	}
+}
+
+func fromPbLeaderboardPeriod(period pb.LeaderboardPeriod) models.LeaderboardPeriod {
+	switch period {
+	case pb.LeaderboardPeriod_LEADERBOARD_PERIOD_MONTHLY:
+		return models.LeaderboardMonthly
+	case pb.LeaderboardPeriod_LEADERBOARD_PERIOD_WEEKLY:
+		return models.LeaderboardWeekly
+	case pb.LeaderboardPeriod_LEADERBOARD_PERIOD_SESSION:
+		return models.LeaderboardSession
+	default:
+		return models.LeaderboardAllTime
+	}
+}
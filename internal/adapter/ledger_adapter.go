@@ -0,0 +1,131 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type LedgerServiceAdapter struct {
+	ledgerClient pb.LedgerServiceClient
+}
+
+func NewLedgerServiceAdapter(ledgerClient pb.LedgerServiceClient) *LedgerServiceAdapter {
+	return &LedgerServiceAdapter{
+		ledgerClient: ledgerClient,
+	}
+}
+
+func (l *LedgerServiceAdapter) GetStreamerBalances(streamerID uint) ([]*models.StreamerBalance, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := l.ledgerClient.GetStreamerBalance(ctx, &pb.GetStreamerBalanceRequest{
+		StreamerId: uint32(streamerID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	balances := make([]*models.StreamerBalance, len(resp.Balances))
+	for i, balance := range resp.Balances {
+		balances[i] = &models.StreamerBalance{
+			StreamerID: uint(balance.StreamerId),
+			Currency:   models.SupportedCurrency(balance.Currency),
+			Balance:    balance.Balance,
+			Credited:   balance.Credited,
+			Debited:    balance.Debited,
+		}
+	}
+
+	return balances, nil
+}
+
+func (l *LedgerServiceAdapter) GetStreamerEntries(streamerID uint, page, pageSize int) ([]*models.LedgerEntry, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := l.ledgerClient.ListLedgerEntries(ctx, &pb.ListLedgerEntriesRequest{
+		StreamerId: uint32(streamerID),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]*models.LedgerEntry, len(resp.Entries))
+	for i, entry := range resp.Entries {
+		entries[i] = fromPbLedgerEntry(entry)
+	}
+
+	return entries, nil
+}
+
+func fromPbLedgerEntry(pbEntry *pb.LedgerEntry) *models.LedgerEntry {
+	entry := &models.LedgerEntry{
+		ID:          uint(pbEntry.Id),
+		Reference:   pbEntry.Reference,
+		Type:        fromPbLedgerEntryType(pbEntry.Type),
+		StreamerID:  uint(pbEntry.StreamerId),
+		DonationID:  uint(pbEntry.DonationId),
+		RefundID:    uint(pbEntry.RefundId),
+		PayoutID:    uint(pbEntry.PayoutId),
+		Currency:    models.SupportedCurrency(pbEntry.Currency),
+		Description: pbEntry.Description,
+		Lines:       make([]models.LedgerLine, len(pbEntry.Lines)),
+	}
+	if pbEntry.CreatedAt != nil {
+		entry.CreatedAt = pbEntry.CreatedAt.AsTime()
+	}
+
+	for i, line := range pbEntry.Lines {
+		entry.Lines[i] = models.LedgerLine{
+			ID:         uint(line.Id),
+			EntryID:    entry.ID,
+			Account:    fromPbLedgerAccount(line.Account),
+			StreamerID: uint(line.StreamerId),
+			Provider:   fromPbPaymentProvider(line.Provider),
+			Currency:   models.SupportedCurrency(line.Currency),
+			Amount:     line.Amount,
+		}
+	}
+
+	return entry
+}
+
+func fromPbLedgerEntryType(entryType pb.LedgerEntryType) models.LedgerEntryType {
+	switch entryType {
+	case pb.LedgerEntryType_LEDGER_ENTRY_TYPE_PAYMENT:
+		return models.LedgerEntryPayment
+	case pb.LedgerEntryType_LEDGER_ENTRY_TYPE_REFUND:
+		return models.LedgerEntryRefund
+	case pb.LedgerEntryType_LEDGER_ENTRY_TYPE_CHARGEBACK:
+		return models.LedgerEntryChargeback
+	case pb.LedgerEntryType_LEDGER_ENTRY_TYPE_PAYOUT:
+		return models.LedgerEntryPayout
+	case pb.LedgerEntryType_LEDGER_ENTRY_TYPE_PAYOUT_REVERSAL:
+		return models.LedgerEntryPayoutReversal
+	default:
+		return ""
+	}
+}
+
+func fromPbLedgerAccount(account pb.LedgerAccount) models.LedgerAccount {
+	switch account {
+	case pb.LedgerAccount_LEDGER_ACCOUNT_PROVIDER_CLEARING:
+		return models.LedgerAccountProviderClearing
+	case pb.LedgerAccount_LEDGER_ACCOUNT_PROVIDER_FEE:
+		return models.LedgerAccountProviderFee
+	case pb.LedgerAccount_LEDGER_ACCOUNT_PLATFORM_FEE:
+		return models.LedgerAccountPlatformFee
+	case pb.LedgerAccount_LEDGER_ACCOUNT_STREAMER_BALANCE:
+		return models.LedgerAccountStreamerBalance
+	case pb.LedgerAccount_LEDGER_ACCOUNT_PAYOUTS:
+		return models.LedgerAccountPayouts
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,208 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type MatchCampaignServiceAdapter struct {
+	matchCampaignClient pb.MatchCampaignServiceClient
+}
+
+func NewMatchCampaignServiceAdapter(matchCampaignClient pb.MatchCampaignServiceClient) *MatchCampaignServiceAdapter {
+	return &MatchCampaignServiceAdapter{
+		matchCampaignClient: matchCampaignClient,
+	}
+}
+
+func (m *MatchCampaignServiceAdapter) CreateCampaign(req *service.MatchCampaignRequest) (*models.MatchCampaign, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.matchCampaignClient.CreateMatchCampaign(ctx, toPbMatchCampaignRequest(req))
+	if err != nil {
+		return nil, fromMatchCampaignError(err)
+	}
+
+	return fromPbMatchCampaign(resp), nil
+}
+
+func (m *MatchCampaignServiceAdapter) UpdateCampaign(id uint, req *service.MatchCampaignRequest) (*models.MatchCampaign, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.matchCampaignClient.UpdateMatchCampaign(ctx, &pb.UpdateMatchCampaignRequest{
+		CampaignId: uint32(id),
+		Campaign:   toPbMatchCampaignRequest(req),
+	})
+	if err != nil {
+		return nil, fromMatchCampaignError(err)
+	}
+
+	return fromPbMatchCampaign(resp), nil
+}
+
+func (m *MatchCampaignServiceAdapter) GetCampaign(id uint) (*models.MatchCampaign, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.matchCampaignClient.GetMatchCampaign(ctx, &pb.GetMatchCampaignRequest{
+		CampaignId: uint32(id),
+	})
+	if err != nil {
+		return nil, fromMatchCampaignError(err)
+	}
+
+	return fromPbMatchCampaign(resp), nil
+}
+
+func (m *MatchCampaignServiceAdapter) ListCampaigns(page, pageSize int) ([]*models.MatchCampaign, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.matchCampaignClient.ListMatchCampaigns(ctx, &pb.ListMatchCampaignsRequest{
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+	if err != nil {
+		return nil, fromMatchCampaignError(err)
+	}
+
+	campaigns := make([]*models.MatchCampaign, len(resp.Campaigns))
+	for i, campaign := range resp.Campaigns {
+		campaigns[i] = fromPbMatchCampaign(campaign)
+	}
+
+	return campaigns, nil
+}
+
+func (m *MatchCampaignServiceAdapter) GetReport(id uint) (*models.MatchCampaignReport, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.matchCampaignClient.GetMatchCampaignReport(ctx, &pb.GetMatchCampaignRequest{
+		CampaignId: uint32(id),
+	})
+	if err != nil {
+		return nil, fromMatchCampaignError(err)
+	}
+
+	streamers := make([]*models.MatchStreamerTotal, len(resp.Streamers))
+	for i, total := range resp.Streamers {
+		streamers[i] = &models.MatchStreamerTotal{
+			StreamerID:     uint(total.StreamerId),
+			MatchCount:     total.MatchCount,
+			DonationAmount: total.DonationAmount,
+			MatchedAmount:  total.MatchedAmount,
+		}
+	}
+
+	report := &models.MatchCampaignReport{
+		Remaining:      resp.Remaining,
+		DonationAmount: resp.DonationAmount,
+		MatchedAmount:  resp.MatchedAmount,
+		Streamers:      streamers,
+	}
+	if resp.Campaign != nil {
+		report.Campaign = fromPbMatchCampaign(resp.Campaign)
+	}
+	return report, nil
+}
+
+// MatchDonation is not offered over gRPC: the donation service matches donations as they
+// complete
+func (m *MatchCampaignServiceAdapter) MatchDonation(donation *models.Donation) ([]*models.Donation, error) {
+	return nil, errors.New("donations are matched by the donation service as they complete")
+}
+
+// RefundMatches is not offered over gRPC: the donation service takes matches back as
+// donations are refunded
+func (m *MatchCampaignServiceAdapter) RefundMatches(donationID uint) ([]*models.DonationRefund, error) {
+	return nil, errors.New("matches are refunded by the donation service as donations are refunded")
+}
+
+func fromMatchCampaignError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return fmt.Errorf("%w: %s", service.ErrInvalidMatchCampaign, st.Message())
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", service.ErrMatchCampaignSpent, st.Message())
+	case codes.NotFound:
+		return gorm.ErrRecordNotFound
+	default:
+		return err
+	}
+}
+
+func toPbMatchCampaignRequest(req *service.MatchCampaignRequest) *pb.MatchCampaignRequest {
+	streamerIDs := make([]uint32, len(req.StreamerIDs))
+	for i, streamerID := range req.StreamerIDs {
+		streamerIDs[i] = uint32(streamerID)
+	}
+
+	pbReq := &pb.MatchCampaignRequest{
+		Name:             req.Name,
+		SponsorName:      req.SponsorName,
+		SponsorUserId:    uint32(req.SponsorUserID),
+		StreamerIds:      streamerIDs,
+		Currency:         string(req.Currency),
+		RatioBasisPoints: req.RatioBasisPoints,
+		MaxMatchAmount:   req.MaxMatchAmount,
+		Budget:           req.Budget,
+		IsActive:         req.IsActive,
+	}
+	if !req.StartsAt.IsZero() {
+		pbReq.StartsAt = timestamppb.New(req.StartsAt)
+	}
+	if !req.EndsAt.IsZero() {
+		pbReq.EndsAt = timestamppb.New(req.EndsAt)
+	}
+	return pbReq
+}
+
+func fromPbMatchCampaign(pbCampaign *pb.MatchCampaign) *models.MatchCampaign {
+	streamerIDs := make([]uint, len(pbCampaign.StreamerIds))
+	for i, streamerID := range pbCampaign.StreamerIds {
+		streamerIDs[i] = uint(streamerID)
+	}
+
+	campaign := &models.MatchCampaign{
+		Name:             pbCampaign.Name,
+		SponsorName:      pbCampaign.SponsorName,
+		SponsorUserID:    uint(pbCampaign.SponsorUserId),
+		StreamerIDs:      streamerIDs,
+		Currency:         models.SupportedCurrency(pbCampaign.Currency),
+		RatioBasisPoints: pbCampaign.RatioBasisPoints,
+		MaxMatchAmount:   pbCampaign.MaxMatchAmount,
+		Budget:           pbCampaign.Budget,
+		Spent:            pbCampaign.Spent,
+		MatchCount:       pbCampaign.MatchCount,
+		IsActive:         pbCampaign.IsActive,
+	}
+	campaign.ID = uint(pbCampaign.Id)
+	if pbCampaign.StartsAt != nil {
+		campaign.StartsAt = pbCampaign.StartsAt.AsTime()
+	}
+	if pbCampaign.EndsAt != nil {
+		campaign.EndsAt = pbCampaign.EndsAt.AsTime()
+	}
+	if pbCampaign.CreatedAt != nil {
+		campaign.CreatedAt = pbCampaign.CreatedAt.AsTime()
+	}
+	return campaign
+}
@@ -1,6 +1,8 @@
 package adapter
 
 import (
+	"google.golang.org/protobuf/types/known/timestamppb"
+
 	"github.com/rzfd/mediashar/internal/models"
 	"github.com/rzfd/mediashar/pkg/pb"
 )
@@ -75,6 +77,8 @@ func (c *MediaShareConverter) ToProtoStatus(status models.MediaShareStatus) pb.M
 		return pb.MediaStatus_MEDIA_STATUS_APPROVED
 	case models.MediaShareStatusRejected:
 		return pb.MediaStatus_MEDIA_STATUS_REJECTED
+	case models.MediaShareStatusPlayed:
+		return pb.MediaStatus_MEDIA_STATUS_PLAYED
 	default:
 		return pb.MediaStatus_MEDIA_STATUS_PENDING
 	}
@@ -93,10 +97,22 @@ func (c *MediaShareConverter) ToProtoMediaItem(item *models.MediaQueueItem) *pb.
 		CustomTitle:       item.Title,
 		CustomDescription: item.Message,
 		ThumbnailUrl:      item.Thumbnail,
-		DurationSeconds:   0, // TODO: Add Duration to MediaQueueItem
+		DurationSeconds:   uint32(item.Duration),
 		StartTime:         0,
 		EndTime:           0,
 		Status:            c.ToProtoStatus(item.Status),
 		DonationAmount:    item.DonationAmount,
+
+		TranslatedDescription: item.TranslatedMessage,
+		TranslationLanguage:   string(item.TranslationLanguage),
+	}
+}
+
+// ToProtoMediaQueueUpdate converts MediaQueueUpdate to protobuf
+func (c *MediaShareConverter) ToProtoMediaQueueUpdate(update *models.MediaQueueUpdate) *pb.MediaQueueUpdate {
+	return &pb.MediaQueueUpdate{
+		EventType: string(update.EventType),
+		MediaItem: c.ToProtoMediaItem(update.Item),
+		Timestamp: timestamppb.New(update.Timestamp),
 	}
-} 
\ No newline at end of file
+}
@@ -0,0 +1,275 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type ModerationServiceAdapter struct {
+	moderationClient pb.ModerationServiceClient
+}
+
+func NewModerationServiceAdapter(moderationClient pb.ModerationServiceClient) *ModerationServiceAdapter {
+	return &ModerationServiceAdapter{
+		moderationClient: moderationClient,
+	}
+}
+
+func (m *ModerationServiceAdapter) GetSettings(streamerID uint) (*models.ModerationSettings, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.moderationClient.GetModerationSettings(ctx, &pb.GetModerationSettingsRequest{
+		StreamerId: uint32(streamerID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromPbModerationSettings(resp.Settings), nil
+}
+
+func (m *ModerationServiceAdapter) UpdateSettings(settings *models.ModerationSettings) (*models.ModerationSettings, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.moderationClient.UpdateModerationSettings(ctx, &pb.UpdateModerationSettingsRequest{
+		Settings: toPbModerationSettings(settings),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromPbModerationSettings(resp.Settings), nil
+}
+
+func (m *ModerationServiceAdapter) GetReviewQueue(streamerID uint, reviewStatus models.MessageReviewStatus, page, pageSize int) ([]*models.MessageReview, int64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.moderationClient.ListMessageReviews(ctx, &pb.ListMessageReviewsRequest{
+		StreamerId: uint32(streamerID),
+		Status:     toPbMessageReviewStatus(reviewStatus),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	reviews := make([]*models.MessageReview, len(resp.Reviews))
+	for i, review := range resp.Reviews {
+		reviews[i] = fromPbMessageReview(review)
+	}
+
+	return reviews, int64(resp.Total), nil
+}
+
+func (m *ModerationServiceAdapter) ApproveMessage(streamerID, reviewID uint) (*models.MessageReview, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.moderationClient.ApproveMessage(ctx, &pb.ResolveMessageReviewRequest{
+		StreamerId: uint32(streamerID),
+		ReviewId:   uint32(reviewID),
+	})
+	if err != nil {
+		return nil, fromReviewError(err)
+	}
+
+	return fromPbMessageReview(resp.Review), nil
+}
+
+func (m *ModerationServiceAdapter) RejectMessage(streamerID, reviewID uint) (*models.MessageReview, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.moderationClient.RejectMessage(ctx, &pb.ResolveMessageReviewRequest{
+		StreamerId: uint32(streamerID),
+		ReviewId:   uint32(reviewID),
+	})
+	if err != nil {
+		return nil, fromReviewError(err)
+	}
+
+	return fromPbMessageReview(resp.Review), nil
+}
+
+func (m *ModerationServiceAdapter) ListBlockedTerms() ([]*models.BlockedTerm, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.moderationClient.ListBlockedTerms(ctx, &pb.ListBlockedTermsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	terms := make([]*models.BlockedTerm, len(resp.Terms))
+	for i, term := range resp.Terms {
+		terms[i] = fromPbBlockedTerm(term)
+	}
+
+	return terms, nil
+}
+
+func (m *ModerationServiceAdapter) AddBlockedTerm(term string, isPattern bool) (*models.BlockedTerm, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := m.moderationClient.AddBlockedTerm(ctx, &pb.AddBlockedTermRequest{
+		Term:      term,
+		IsPattern: isPattern,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return fromPbBlockedTerm(resp.Term), nil
+}
+
+func (m *ModerationServiceAdapter) RemoveBlockedTerm(id uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := m.moderationClient.RemoveBlockedTerm(ctx, &pb.RemoveBlockedTermRequest{Id: uint32(id)})
+	return err
+}
+
+// fromReviewError turns review status codes back into the errors the service returns
+func fromReviewError(err error) error {
+	switch status.Code(err) {
+	case codes.NotFound:
+		return gorm.ErrRecordNotFound
+	case codes.FailedPrecondition:
+		return service.ErrReviewAlreadyResolved
+	default:
+		return err
+	}
+}
+
+func toPbModerationSettings(settings *models.ModerationSettings) *pb.ModerationSettings {
+	return &pb.ModerationSettings{
+		StreamerId:       uint32(settings.StreamerID),
+		Enabled:          settings.Enabled,
+		BannedWords:      settings.BannedWords,
+		BannedPatterns:   settings.BannedPatterns,
+		StripLinks:       settings.StripLinks,
+		MaxMessageLength: int32(settings.MaxMessageLength),
+		Action:           toPbModerationAction(settings.Action),
+	}
+}
+
+func fromPbModerationSettings(pbSettings *pb.ModerationSettings) *models.ModerationSettings {
+	settings := &models.ModerationSettings{
+		StreamerID:       uint(pbSettings.StreamerId),
+		Enabled:          pbSettings.Enabled,
+		BannedWords:      pbSettings.BannedWords,
+		BannedPatterns:   pbSettings.BannedPatterns,
+		StripLinks:       pbSettings.StripLinks,
+		MaxMessageLength: int(pbSettings.MaxMessageLength),
+		Action:           fromPbModerationAction(pbSettings.Action),
+	}
+	if settings.BannedWords == nil {
+		settings.BannedWords = []string{}
+	}
+	if settings.BannedPatterns == nil {
+		settings.BannedPatterns = []string{}
+	}
+	if pbSettings.UpdatedAt != nil {
+		settings.UpdatedAt = pbSettings.UpdatedAt.AsTime()
+	}
+
+	return settings
+}
+
+func fromPbMessageReview(pbReview *pb.MessageReview) *models.MessageReview {
+	review := &models.MessageReview{
+		DonationID:          uint(pbReview.DonationId),
+		StreamerID:          uint(pbReview.StreamerId),
+		OriginalMessage:     pbReview.OriginalMessage,
+		OriginalDisplayName: pbReview.OriginalDisplayName,
+		Message:             pbReview.Message,
+		DisplayName:         pbReview.DisplayName,
+		Violations:          pbReview.Violations,
+		Status:              fromPbMessageReviewStatus(pbReview.Status),
+	}
+	review.ID = uint(pbReview.Id)
+	if pbReview.CreatedAt != nil {
+		review.CreatedAt = pbReview.CreatedAt.AsTime()
+	}
+	if pbReview.ReviewedAt != nil {
+		reviewedAt := pbReview.ReviewedAt.AsTime()
+		review.ReviewedAt = &reviewedAt
+	}
+
+	return review
+}
+
+func fromPbBlockedTerm(pbTerm *pb.BlockedTerm) *models.BlockedTerm {
+	term := &models.BlockedTerm{
+		Term:      pbTerm.Term,
+		IsPattern: pbTerm.IsPattern,
+	}
+	term.ID = uint(pbTerm.Id)
+	if pbTerm.CreatedAt != nil {
+		term.CreatedAt = pbTerm.CreatedAt.AsTime()
+	}
+
+	return term
+}
+
+func toPbModerationAction(action models.ModerationAction) pb.ModerationAction {
+	switch action {
+	case models.ModerationMask:
+		return pb.ModerationAction_MODERATION_ACTION_MASK
+	case models.ModerationHold:
+		return pb.ModerationAction_MODERATION_ACTION_HOLD
+	case models.ModerationReject:
+		return pb.ModerationAction_MODERATION_ACTION_REJECT
+	default:
+		return pb.ModerationAction_MODERATION_ACTION_UNSPECIFIED
+	}
+}
+
+func fromPbModerationAction(action pb.ModerationAction) models.ModerationAction {
+	switch action {
+	case pb.ModerationAction_MODERATION_ACTION_HOLD:
+		return models.ModerationHold
+	case pb.ModerationAction_MODERATION_ACTION_REJECT:
+		return models.ModerationReject
+	default:
+		return models.ModerationMask
+	}
+}
+
+func toPbMessageReviewStatus(reviewStatus models.MessageReviewStatus) pb.MessageReviewStatus {
+	switch reviewStatus {
+	case models.ReviewPending:
+		return pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_PENDING
+	case models.ReviewApproved:
+		return pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_APPROVED
+	case models.ReviewRejected:
+		return pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_REJECTED
+	default:
+		return pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_UNSPECIFIED
+	}
+}
+
+func fromPbMessageReviewStatus(reviewStatus pb.MessageReviewStatus) models.MessageReviewStatus {
+	switch reviewStatus {
+	case pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_APPROVED:
+		return models.ReviewApproved
+	case pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_REJECTED:
+		return models.ReviewRejected
+	default:
+		return models.ReviewPending
+	}
+}
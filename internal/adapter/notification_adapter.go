@@ -0,0 +1,74 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type NotificationServiceAdapter struct {
+	notificationClient pb.NotificationServiceClient
+}
+
+func NewNotificationServiceAdapter(notificationClient pb.NotificationServiceClient) *NotificationServiceAdapter {
+	return &NotificationServiceAdapter{
+		notificationClient: notificationClient,
+	}
+}
+
+// SubscribeDonationEvents relays the streamer's donation events from the notification service
+func (n *NotificationServiceAdapter) SubscribeDonationEvents(ctx context.Context, streamerID uint) (<-chan *service.DonationEvent, error) {
+	stream, err := n.notificationClient.SubscribeDonationEvents(ctx, &pb.SubscribeEventsRequest{
+		UserId: uint32(streamerID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	events := make(chan *service.DonationEvent, 16)
+	go func() {
+		defer close(events)
+		for {
+			pbEvent, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() == nil {
+					fmt.Printf("Warning: Donation event stream of streamer %d ended: %v\n", streamerID, err)
+				}
+				return
+			}
+			// Skip the welcome event and anything else that is not about a donation
+			if pbEvent.Donation == nil {
+				continue
+			}
+
+			event := &service.DonationEvent{
+				Type:      fromPbEventType(pbEvent.Type),
+				Donation:  fromPbDonation(pbEvent.Donation),
+				Timestamp: pbEvent.Timestamp.AsTime(),
+				Metadata:  pbEvent.Metadata,
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+func fromPbEventType(eventType pb.EventType) service.DonationEventType {
+	switch eventType {
+	case pb.EventType_EVENT_TYPE_DONATION_CREATED:
+		return service.DonationEventCreated
+	case pb.EventType_EVENT_TYPE_DONATION_COMPLETED, pb.EventType_EVENT_TYPE_PAYMENT_VERIFIED:
+		return service.DonationEventCompleted
+	case pb.EventType_EVENT_TYPE_DONATION_FAILED:
+		return service.DonationEventFailed
+	default:
+		return service.DonationEventUpdated
+	}
+}
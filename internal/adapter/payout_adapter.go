@@ -0,0 +1,295 @@
+package adapter
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type PayoutServiceAdapter struct {
+	payoutClient pb.PayoutServiceClient
+}
+
+func NewPayoutServiceAdapter(payoutClient pb.PayoutServiceClient) *PayoutServiceAdapter {
+	return &PayoutServiceAdapter{
+		payoutClient: payoutClient,
+	}
+}
+
+func (p *PayoutServiceAdapter) CreateAccount(req *service.CreatePayoutAccountRequest) (*models.PayoutAccount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := p.payoutClient.CreatePayoutAccount(ctx, &pb.CreatePayoutAccountRequest{
+		StreamerId:    uint32(req.StreamerID),
+		Channel:       req.Channel,
+		AccountNumber: req.AccountNumber,
+		AccountName:   req.AccountName,
+	})
+	if err != nil {
+		return nil, fromPayoutError(err)
+	}
+
+	return fromPbPayoutAccount(resp), nil
+}
+
+func (p *PayoutServiceAdapter) GetAccounts(streamerID uint) ([]*models.PayoutAccount, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := p.payoutClient.ListPayoutAccounts(ctx, &pb.ListPayoutAccountsRequest{
+		StreamerId: uint32(streamerID),
+	})
+	if err != nil {
+		return nil, fromPayoutError(err)
+	}
+
+	accounts := make([]*models.PayoutAccount, len(resp.Accounts))
+	for i, account := range resp.Accounts {
+		accounts[i] = fromPbPayoutAccount(account)
+	}
+
+	return accounts, nil
+}
+
+func (p *PayoutServiceAdapter) DeleteAccount(streamerID, accountID uint) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := p.payoutClient.DeletePayoutAccount(ctx, &pb.DeletePayoutAccountRequest{
+		StreamerId: uint32(streamerID),
+		AccountId:  uint32(accountID),
+	})
+	return fromPayoutError(err)
+}
+
+func (p *PayoutServiceAdapter) RequestPayout(req *service.RequestPayoutRequest) (*models.Payout, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := p.payoutClient.RequestPayout(ctx, &pb.RequestPayoutRequest{
+		StreamerId: uint32(req.StreamerID),
+		AccountId:  uint32(req.AccountID),
+		Amount:     req.Amount,
+		Currency:   string(req.Currency),
+	})
+	if err != nil {
+		return nil, fromPayoutError(err)
+	}
+
+	return fromPbPayout(resp), nil
+}
+
+func (p *PayoutServiceAdapter) GetPayout(id uint) (*models.Payout, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := p.payoutClient.GetPayout(ctx, &pb.GetPayoutRequest{
+		PayoutId: uint32(id),
+	})
+	if err != nil {
+		return nil, fromPayoutError(err)
+	}
+
+	return fromPbPayout(resp), nil
+}
+
+func (p *PayoutServiceAdapter) GetStreamerPayouts(streamerID uint, page, pageSize int) ([]*models.Payout, error) {
+	return p.listPayouts(&pb.ListPayoutsRequest{
+		StreamerId: uint32(streamerID),
+		Page:       int32(page),
+		PageSize:   int32(pageSize),
+	})
+}
+
+func (p *PayoutServiceAdapter) ListPayouts(payoutStatus models.PayoutStatus, page, pageSize int) ([]*models.Payout, error) {
+	return p.listPayouts(&pb.ListPayoutsRequest{
+		Status:   toPbPayoutStatus(payoutStatus),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+}
+
+func (p *PayoutServiceAdapter) listPayouts(req *pb.ListPayoutsRequest) ([]*models.Payout, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := p.payoutClient.ListPayouts(ctx, req)
+	if err != nil {
+		return nil, fromPayoutError(err)
+	}
+
+	payouts := make([]*models.Payout, len(resp.Payouts))
+	for i, payout := range resp.Payouts {
+		payouts[i] = fromPbPayout(payout)
+	}
+
+	return payouts, nil
+}
+
+func (p *PayoutServiceAdapter) ApprovePayout(id, adminID uint) (*models.Payout, error) {
+	// Approving hands the payout to the disbursement provider, allow it time to answer
+	ctx, cancel := context.WithTimeout(context.Background(), 40*time.Second)
+	defer cancel()
+
+	resp, err := p.payoutClient.ApprovePayout(ctx, &pb.ApprovePayoutRequest{
+		PayoutId: uint32(id),
+		AdminId:  uint32(adminID),
+	})
+	if err != nil {
+		return nil, fromPayoutError(err)
+	}
+
+	return fromPbPayout(resp), nil
+}
+
+func (p *PayoutServiceAdapter) RejectPayout(id, adminID uint, reason string) (*models.Payout, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := p.payoutClient.RejectPayout(ctx, &pb.RejectPayoutRequest{
+		PayoutId: uint32(id),
+		AdminId:  uint32(adminID),
+		Reason:   reason,
+	})
+	if err != nil {
+		return nil, fromPayoutError(err)
+	}
+
+	return fromPbPayout(resp), nil
+}
+
+func (p *PayoutServiceAdapter) CompletePayout(id uint, providerReference string) (*models.Payout, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := p.payoutClient.CompletePayout(ctx, &pb.CompletePayoutRequest{
+		PayoutId:          uint32(id),
+		ProviderReference: providerReference,
+	})
+	if err != nil {
+		return nil, fromPayoutError(err)
+	}
+
+	return fromPbPayout(resp), nil
+}
+
+func (p *PayoutServiceAdapter) FailPayout(id uint, reason string) (*models.Payout, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := p.payoutClient.FailPayout(ctx, &pb.FailPayoutRequest{
+		PayoutId: uint32(id),
+		Reason:   reason,
+	})
+	if err != nil {
+		return nil, fromPayoutError(err)
+	}
+
+	return fromPbPayout(resp), nil
+}
+
+// fromPayoutError turns the payout service's gRPC status codes back into its errors
+func fromPayoutError(err error) error {
+	if err == nil {
+		return nil
+	}
+	st := status.Convert(err)
+	switch st.Code() {
+	case codes.InvalidArgument:
+		return fmt.Errorf("%w: %s", service.ErrInvalidPayoutRequest, st.Message())
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", service.ErrPayoutStatusConflict, st.Message())
+	case codes.NotFound:
+		return gorm.ErrRecordNotFound
+	default:
+		return err
+	}
+}
+
+func fromPbPayoutAccount(pbAccount *pb.PayoutAccount) *models.PayoutAccount {
+	account := &models.PayoutAccount{
+		StreamerID:    uint(pbAccount.StreamerId),
+		Method:        models.PayoutMethod(pbAccount.Method),
+		Channel:       pbAccount.Channel,
+		AccountNumber: pbAccount.AccountNumber,
+		AccountName:   pbAccount.AccountName,
+	}
+	account.ID = uint(pbAccount.Id)
+	if pbAccount.CreatedAt != nil {
+		account.CreatedAt = pbAccount.CreatedAt.AsTime()
+	}
+	return account
+}
+
+func fromPbPayout(pbPayout *pb.Payout) *models.Payout {
+	payout := &models.Payout{
+		StreamerID:        uint(pbPayout.StreamerId),
+		AccountID:         uint(pbPayout.AccountId),
+		Method:            models.PayoutMethod(pbPayout.Method),
+		Channel:           pbPayout.Channel,
+		AccountNumber:     pbPayout.AccountNumber,
+		AccountName:       pbPayout.AccountName,
+		Amount:            pbPayout.Amount,
+		Currency:          models.SupportedCurrency(pbPayout.Currency),
+		Status:            fromPbPayoutStatus(pbPayout.Status),
+		ReviewedBy:        uint(pbPayout.ReviewedBy),
+		ProviderReference: pbPayout.ProviderReference,
+		FailureReason:     pbPayout.FailureReason,
+	}
+	payout.ID = uint(pbPayout.Id)
+	if pbPayout.CreatedAt != nil {
+		payout.CreatedAt = pbPayout.CreatedAt.AsTime()
+	}
+	if pbPayout.ReviewedAt != nil {
+		reviewedAt := pbPayout.ReviewedAt.AsTime()
+		payout.ReviewedAt = &reviewedAt
+	}
+	if pbPayout.PaidAt != nil {
+		paidAt := pbPayout.PaidAt.AsTime()
+		payout.PaidAt = &paidAt
+	}
+	return payout
+}
+
+func toPbPayoutStatus(payoutStatus models.PayoutStatus) pb.PayoutStatus {
+	switch payoutStatus {
+	case models.PayoutRequested:
+		return pb.PayoutStatus_PAYOUT_STATUS_REQUESTED
+	case models.PayoutProcessing:
+		return pb.PayoutStatus_PAYOUT_STATUS_PROCESSING
+	case models.PayoutPaid:
+		return pb.PayoutStatus_PAYOUT_STATUS_PAID
+	case models.PayoutFailed:
+		return pb.PayoutStatus_PAYOUT_STATUS_FAILED
+	case models.PayoutRejected:
+		return pb.PayoutStatus_PAYOUT_STATUS_REJECTED
+	default:
+		return pb.PayoutStatus_PAYOUT_STATUS_UNSPECIFIED
+	}
+}
+
+func fromPbPayoutStatus(payoutStatus pb.PayoutStatus) models.PayoutStatus {
+	switch payoutStatus {
+	case pb.PayoutStatus_PAYOUT_STATUS_REQUESTED:
+		return models.PayoutRequested
+	case pb.PayoutStatus_PAYOUT_STATUS_PROCESSING:
+		return models.PayoutProcessing
+	case pb.PayoutStatus_PAYOUT_STATUS_PAID:
+		return models.PayoutPaid
+	case pb.PayoutStatus_PAYOUT_STATUS_FAILED:
+		return models.PayoutFailed
+	case pb.PayoutStatus_PAYOUT_STATUS_REJECTED:
+		return models.PayoutRejected
+	default:
+		return ""
+	}
+}
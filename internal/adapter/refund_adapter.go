@@ -0,0 +1,128 @@
+package adapter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type RefundServiceAdapter struct {
+	donationClient pb.DonationServiceClient
+}
+
+func NewRefundServiceAdapter(donationClient pb.DonationServiceClient) *RefundServiceAdapter {
+	return &RefundServiceAdapter{
+		donationClient: donationClient,
+	}
+}
+
+func (r *RefundServiceAdapter) RefundDonation(req *service.RefundDonationRequest) (*models.DonationRefund, *models.Donation, error) {
+	// Provider refunds can be slow, allow more time than regular calls
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	resp, err := r.donationClient.RefundDonation(ctx, &pb.RefundDonationRequest{
+		DonationId:  uint32(req.DonationID),
+		Amount:      req.Amount,
+		Reason:      req.Reason,
+		RequestedBy: uint32(req.RequestedBy),
+		Manual:      req.Manual,
+		Chargeback:  req.Chargeback,
+		Source:      string(req.Source),
+	})
+	if err != nil {
+		return nil, nil, fromRefundError(err)
+	}
+
+	return fromPbDonationRefund(resp.Refund), fromPbDonation(resp.Donation), nil
+}
+
+func (r *RefundServiceAdapter) GetRefundsByDonation(donationID uint) ([]*models.DonationRefund, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := r.donationClient.ListDonationRefunds(ctx, &pb.ListDonationRefundsRequest{
+		DonationId: uint32(donationID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	refunds := make([]*models.DonationRefund, 0, len(resp.Refunds))
+	for _, pbRefund := range resp.Refunds {
+		refunds = append(refunds, fromPbDonationRefund(pbRefund))
+	}
+
+	return refunds, nil
+}
+
+// ReconcilePendingRefunds is not offered over gRPC: the donation service reconciles its
+// refunds in the background
+func (r *RefundServiceAdapter) ReconcilePendingRefunds(now time.Time) (int, error) {
+	return 0, errors.New("refunds are reconciled by the donation service")
+}
+
+// fromRefundError maps the donation service's refund errors back to their sentinels
+func fromRefundError(err error) error {
+	st, ok := status.FromError(err)
+	if !ok {
+		return err
+	}
+	switch st.Code() {
+	case codes.NotFound:
+		return service.ErrDonationNotFound
+	case codes.InvalidArgument:
+		return fmt.Errorf("%w: %s", service.ErrInvalidRefundAmount, st.Message())
+	case codes.Aborted:
+		return fmt.Errorf("%w: %s", service.ErrRefundExceedsRefundable, st.Message())
+	case codes.FailedPrecondition:
+		return fmt.Errorf("%w: %s", service.ErrRefundNotAllowed, st.Message())
+	case codes.Unavailable:
+		return fmt.Errorf("%w: %s", service.ErrRefundProviderFailed, st.Message())
+	}
+	return err
+}
+
+func fromPbDonationRefund(pbRefund *pb.DonationRefund) *models.DonationRefund {
+	refund := &models.DonationRefund{
+		DonationID:       uint(pbRefund.DonationId),
+		Amount:           pbRefund.Amount,
+		Currency:         models.SupportedCurrency(pbRefund.Currency),
+		Reason:           pbRefund.Reason,
+		Status:           fromPbRefundStatus(pbRefund.Status),
+		Provider:         fromPbPaymentProvider(pbRefund.Provider),
+		ProviderRefundID: pbRefund.ProviderRefundId,
+		RequestedBy:      uint(pbRefund.RequestedBy),
+		FailureReason:    pbRefund.FailureReason,
+		Chargeback:       pbRefund.Chargeback,
+	}
+	refund.ID = uint(pbRefund.Id)
+	if pbRefund.CreatedAt != nil {
+		refund.CreatedAt = pbRefund.CreatedAt.AsTime()
+	}
+	if pbRefund.ProcessedAt != nil {
+		processedAt := pbRefund.ProcessedAt.AsTime()
+		refund.ProcessedAt = &processedAt
+	}
+
+	return refund
+}
+
+func fromPbRefundStatus(status pb.RefundStatus) models.RefundStatus {
+	switch status {
+	case pb.RefundStatus_REFUND_STATUS_SUCCEEDED:
+		return models.RefundSucceeded
+	case pb.RefundStatus_REFUND_STATUS_FAILED:
+		return models.RefundFailed
+	default:
+		return models.RefundPending
+	}
+}
@@ -0,0 +1,98 @@
+package adapter
+
+import (
+	"context"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+type RiskServiceAdapter struct {
+	riskClient pb.RiskServiceClient
+}
+
+func NewRiskServiceAdapter(riskClient pb.RiskServiceClient) *RiskServiceAdapter {
+	return &RiskServiceAdapter{
+		riskClient: riskClient,
+	}
+}
+
+func (r *RiskServiceAdapter) ListAssessments(decision models.RiskDecision, page, pageSize int) ([]*models.RiskAssessment, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	resp, err := r.riskClient.ListRiskAssessments(ctx, &pb.ListRiskAssessmentsRequest{
+		Decision: toPbRiskDecision(decision),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	assessments := make([]*models.RiskAssessment, len(resp.Assessments))
+	for i, assessment := range resp.Assessments {
+		assessments[i] = fromPbRiskAssessment(assessment)
+	}
+
+	return assessments, nil
+}
+
+func fromPbRiskAssessment(pbAssessment *pb.RiskAssessment) *models.RiskAssessment {
+	reasons := make([]models.RiskReason, len(pbAssessment.Reasons))
+	for i, reason := range pbAssessment.Reasons {
+		reasons[i] = models.RiskReason{
+			Rule:   models.RiskRule(reason.Rule),
+			Points: int(reason.Points),
+			Detail: reason.Detail,
+		}
+	}
+
+	assessment := &models.RiskAssessment{
+		ID:              uint(pbAssessment.Id),
+		StreamerID:      uint(pbAssessment.StreamerId),
+		DonatorID:       uint(pbAssessment.DonatorId),
+		IPAddress:       pbAssessment.IpAddress,
+		Country:         pbAssessment.Country,
+		Amount:          pbAssessment.Amount,
+		Currency:        models.SupportedCurrency(pbAssessment.Currency),
+		TinyAmount:      pbAssessment.TinyAmount,
+		PaymentProvider: fromPbPaymentProvider(pbAssessment.PaymentProvider),
+		SplitDonationID: uint(pbAssessment.SplitDonationId),
+		Score:           int(pbAssessment.Score),
+		Decision:        fromPbRiskDecision(pbAssessment.Decision),
+		Reasons:         reasons,
+		DonationID:      uint(pbAssessment.DonationId),
+	}
+	if pbAssessment.CreatedAt != nil {
+		assessment.CreatedAt = pbAssessment.CreatedAt.AsTime()
+	}
+	return assessment
+}
+
+func toPbRiskDecision(decision models.RiskDecision) pb.RiskDecision {
+	switch decision {
+	case models.RiskAllow:
+		return pb.RiskDecision_RISK_DECISION_ALLOW
+	case models.RiskChallenge:
+		return pb.RiskDecision_RISK_DECISION_CHALLENGE
+	case models.RiskBlock:
+		return pb.RiskDecision_RISK_DECISION_BLOCK
+	default:
+		return pb.RiskDecision_RISK_DECISION_UNSPECIFIED
+	}
+}
+
+func fromPbRiskDecision(decision pb.RiskDecision) models.RiskDecision {
+	switch decision {
+	case pb.RiskDecision_RISK_DECISION_ALLOW:
+		return models.RiskAllow
+	case pb.RiskDecision_RISK_DECISION_CHALLENGE:
+		return models.RiskChallenge
+	case pb.RiskDecision_RISK_DECISION_BLOCK:
+		return models.RiskBlock
+	default:
+		return ""
+	}
+}
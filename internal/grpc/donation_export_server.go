@@ -0,0 +1,180 @@
+package grpc
+
+import (
+	"bufio"
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+// exportChunkSize is the size of each ExportChunk message sent to clients
+const exportChunkSize = 64 * 1024
+
+// ExportDonations streams a CSV or XLSX file of a streamer's donations
+func (s *DonationGRPCServer) ExportDonations(req *pb.ExportDonationsRequest, stream pb.DonationService_ExportDonationsServer) error {
+	if s.exports == nil {
+		return status.Error(codes.Unavailable, "donation exports are not available")
+	}
+
+	writer := bufio.NewWriterSize(&exportChunkSender{send: stream.Send}, exportChunkSize)
+	if err := s.exports.ExportDonations(stream.Context(), convertPbToExportRequest(req), writer); err != nil {
+		return exportError("failed to export donations", err)
+	}
+	if err := writer.Flush(); err != nil {
+		return status.Errorf(codes.Unavailable, "failed to send export: %v", err)
+	}
+	return nil
+}
+
+// CreateDonationExport queues a background export
+func (s *DonationGRPCServer) CreateDonationExport(ctx context.Context, req *pb.ExportDonationsRequest) (*pb.DonationExportResponse, error) {
+	if s.exports == nil {
+		return nil, status.Error(codes.Unavailable, "donation exports are not available")
+	}
+
+	export, err := s.exports.CreateExport(convertPbToExportRequest(req))
+	if err != nil {
+		return nil, exportError("failed to create donation export", err)
+	}
+	return &pb.DonationExportResponse{Export: convertModelToPbDonationExport(export)}, nil
+}
+
+// GetDonationExport returns a background export's progress
+func (s *DonationGRPCServer) GetDonationExport(ctx context.Context, req *pb.GetDonationExportRequest) (*pb.DonationExportResponse, error) {
+	if s.exports == nil {
+		return nil, status.Error(codes.Unavailable, "donation exports are not available")
+	}
+
+	export, err := s.exports.GetExport(uint(req.StreamerId), uint(req.ExportId))
+	if err != nil {
+		return nil, exportError("failed to get donation export", err)
+	}
+	return &pb.DonationExportResponse{Export: convertModelToPbDonationExport(export)}, nil
+}
+
+// DownloadDonationExport streams the file of a completed background export
+func (s *DonationGRPCServer) DownloadDonationExport(req *pb.GetDonationExportRequest, stream pb.DonationService_DownloadDonationExportServer) error {
+	if s.exports == nil {
+		return status.Error(codes.Unavailable, "donation exports are not available")
+	}
+
+	writer := bufio.NewWriterSize(&exportChunkSender{send: stream.Send}, exportChunkSize)
+	if err := s.exports.DownloadExport(stream.Context(), uint(req.StreamerId), uint(req.ExportId), writer); err != nil {
+		return exportError("failed to download donation export", err)
+	}
+	if err := writer.Flush(); err != nil {
+		return status.Errorf(codes.Unavailable, "failed to send export: %v", err)
+	}
+	return nil
+}
+
+// exportError maps export service errors to gRPC status codes
+func exportError(message string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidExportRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrExportTooLarge):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, service.ErrExportNotReady):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "donation export not found")
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+// exportChunkSender sends everything written to it as ExportChunk messages
+type exportChunkSender struct {
+	send func(*pb.ExportChunk) error
+}
+
+func (w *exportChunkSender) Write(p []byte) (int, error) {
+	// Send may hold on to the message, so the buffer is copied before it is reused
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := w.send(&pb.ExportChunk{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func convertPbToExportRequest(req *pb.ExportDonationsRequest) *service.DonationExportRequest {
+	exportReq := &service.DonationExportRequest{
+		StreamerID: uint(req.StreamerId),
+		Format:     convertPbToModelExportFormat(req.Format),
+		Currency:   models.SupportedCurrency(req.Currency),
+	}
+	if req.StartDate != nil {
+		exportReq.StartDate = req.StartDate.AsTime()
+	}
+	if req.EndDate != nil {
+		exportReq.EndDate = req.EndDate.AsTime()
+	}
+	return exportReq
+}
+
+func convertModelToPbDonationExport(export *models.DonationExport) *pb.DonationExport {
+	pbExport := &pb.DonationExport{
+		Id:         uint32(export.ID),
+		StreamerId: uint32(export.StreamerID),
+		Format:     convertModelToPbExportFormat(export.Format),
+		StartDate:  timestamppb.New(export.StartDate),
+		EndDate:    timestamppb.New(export.EndDate),
+		Currency:   string(export.Currency),
+		Status:     convertModelToPbDonationExportStatus(export.Status),
+		RowCount:   int32(export.RowCount),
+		FileSize:   export.FileSize,
+		Error:      export.Error,
+		FileName:   export.FileName(),
+		CreatedAt:  timestamppb.New(export.CreatedAt),
+	}
+	if export.CompletedAt != nil {
+		pbExport.CompletedAt = timestamppb.New(*export.CompletedAt)
+	}
+	if export.ExpiresAt != nil {
+		pbExport.ExpiresAt = timestamppb.New(*export.ExpiresAt)
+	}
+	return pbExport
+}
+
+func convertPbToModelExportFormat(format pb.ExportFormat) models.ExportFormat {
+	switch format {
+	case pb.ExportFormat_EXPORT_FORMAT_CSV:
+		return models.ExportCSV
+	case pb.ExportFormat_EXPORT_FORMAT_XLSX:
+		return models.ExportXLSX
+	}
+	return ""
+}
+
+func convertModelToPbExportFormat(format models.ExportFormat) pb.ExportFormat {
+	switch format {
+	case models.ExportCSV:
+		return pb.ExportFormat_EXPORT_FORMAT_CSV
+	case models.ExportXLSX:
+		return pb.ExportFormat_EXPORT_FORMAT_XLSX
+	}
+	return pb.ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func convertModelToPbDonationExportStatus(exportStatus models.DonationExportStatus) pb.DonationExportStatus {
+	switch exportStatus {
+	case models.ExportPending:
+		return pb.DonationExportStatus_DONATION_EXPORT_STATUS_PENDING
+	case models.ExportRunning:
+		return pb.DonationExportStatus_DONATION_EXPORT_STATUS_RUNNING
+	case models.ExportCompleted:
+		return pb.DonationExportStatus_DONATION_EXPORT_STATUS_COMPLETED
+	case models.ExportFailed:
+		return pb.DonationExportStatus_DONATION_EXPORT_STATUS_FAILED
+	}
+	return pb.DonationExportStatus_DONATION_EXPORT_STATUS_UNSPECIFIED
+}
@@ -0,0 +1,139 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+// DonationGoalGRPCServer implements the gRPC DonationGoalService
+type DonationGoalGRPCServer struct {
+	pb.UnimplementedDonationGoalServiceServer
+	goalService service.DonationGoalService
+}
+
+// NewDonationGoalGRPCServer creates a new donation goal gRPC server
+func NewDonationGoalGRPCServer(goalService service.DonationGoalService) *DonationGoalGRPCServer {
+	return &DonationGoalGRPCServer{
+		goalService: goalService,
+	}
+}
+
+// CreateDonationGoal creates a goal for a streamer
+func (s *DonationGoalGRPCServer) CreateDonationGoal(ctx context.Context, req *pb.CreateDonationGoalRequest) (*pb.DonationGoalResponse, error) {
+	if req.Goal == nil {
+		return nil, status.Error(codes.InvalidArgument, "goal is required")
+	}
+
+	goal := convertPbToModelDonationGoal(req.Goal)
+	if err := s.goalService.CreateGoal(goal); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to create donation goal: %v", err)
+	}
+
+	return &pb.DonationGoalResponse{Goal: convertModelToPbDonationGoal(goal)}, nil
+}
+
+// UpdateDonationGoal updates the editable fields of a goal
+func (s *DonationGoalGRPCServer) UpdateDonationGoal(ctx context.Context, req *pb.UpdateDonationGoalRequest) (*pb.DonationGoalResponse, error) {
+	if req.Goal == nil || req.Goal.Id == 0 {
+		return nil, status.Error(codes.InvalidArgument, "goal id is required")
+	}
+
+	if err := s.goalService.UpdateGoal(convertPbToModelDonationGoal(req.Goal)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to update donation goal: %v", err)
+	}
+
+	goal, err := s.goalService.GetGoal(uint(req.Goal.Id))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "donation goal not found: %v", err)
+	}
+
+	return &pb.DonationGoalResponse{Goal: convertModelToPbDonationGoal(goal)}, nil
+}
+
+// DeleteDonationGoal deletes a streamer's goal
+func (s *DonationGoalGRPCServer) DeleteDonationGoal(ctx context.Context, req *pb.DeleteDonationGoalRequest) (*pb.DeleteDonationGoalResponse, error) {
+	if err := s.goalService.DeleteGoal(uint(req.StreamerId), uint(req.GoalId)); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to delete donation goal: %v", err)
+	}
+
+	return &pb.DeleteDonationGoalResponse{Success: true}, nil
+}
+
+// GetDonationGoal retrieves a goal with its current progress
+func (s *DonationGoalGRPCServer) GetDonationGoal(ctx context.Context, req *pb.GetDonationGoalRequest) (*pb.DonationGoalResponse, error) {
+	goal, err := s.goalService.GetGoal(uint(req.GoalId))
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "donation goal not found: %v", err)
+	}
+
+	return &pb.DonationGoalResponse{Goal: convertModelToPbDonationGoal(goal)}, nil
+}
+
+// ListDonationGoals lists a streamer's goals
+func (s *DonationGoalGRPCServer) ListDonationGoals(ctx context.Context, req *pb.ListDonationGoalsRequest) (*pb.ListDonationGoalsResponse, error) {
+	goals, err := s.goalService.GetGoalsByStreamer(uint(req.StreamerId), req.ActiveOnly)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list donation goals: %v", err)
+	}
+
+	pbGoals := make([]*pb.DonationGoal, 0, len(goals))
+	for _, goal := range goals {
+		pbGoals = append(pbGoals, convertModelToPbDonationGoal(goal))
+	}
+
+	return &pb.ListDonationGoalsResponse{Goals: pbGoals}, nil
+}
+
+// Helper functions
+
+func convertModelToPbDonationGoal(goal *models.DonationGoal) *pb.DonationGoal {
+	pbGoal := &pb.DonationGoal{
+		Id:              uint32(goal.ID),
+		StreamerId:      uint32(goal.StreamerID),
+		Title:           goal.Title,
+		Description:     goal.Description,
+		TargetAmount:    goal.TargetAmount,
+		CurrentAmount:   goal.CurrentAmount,
+		Currency:        string(goal.Currency),
+		IsActive:        goal.IsActive,
+		ProgressPercent: goal.ProgressPercent(),
+		CreatedAt:       timestamppb.New(goal.CreatedAt),
+		UpdatedAt:       timestamppb.New(goal.UpdatedAt),
+	}
+
+	if goal.Deadline != nil {
+		pbGoal.Deadline = timestamppb.New(*goal.Deadline)
+	}
+	if goal.CompletedAt != nil {
+		pbGoal.CompletedAt = timestamppb.New(*goal.CompletedAt)
+	}
+
+	return pbGoal
+}
+
+func convertPbToModelDonationGoal(pbGoal *pb.DonationGoal) *models.DonationGoal {
+	goal := &models.DonationGoal{
+		StreamerID:   uint(pbGoal.StreamerId),
+		Title:        pbGoal.Title,
+		Description:  pbGoal.Description,
+		TargetAmount: pbGoal.TargetAmount,
+		Currency:     models.SupportedCurrency(pbGoal.Currency),
+		IsActive:     pbGoal.IsActive,
+	}
+	goal.ID = uint(pbGoal.Id)
+
+	if pbGoal.Deadline != nil {
+		deadline := pbGoal.Deadline.AsTime().In(time.Local)
+		goal.Deadline = &deadline
+	}
+
+	return goal
+}
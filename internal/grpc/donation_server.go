@@ -2,12 +2,16 @@ package grpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
 
 	"github.com/rzfd/mediashar/internal/models"
 	"github.com/rzfd/mediashar/internal/service"
@@ -18,36 +22,37 @@ import (
 type DonationGRPCServer struct {
 	pb.UnimplementedDonationServiceServer
 	donationService service.DonationService
+	eventBus        service.DonationEventBus
+	refundService   service.RefundService
+	idempotency     service.IdempotencyService
+	leaderboard     service.LeaderboardService
+	exports         service.DonationExportService
 }
 
 // NewDonationGRPCServer creates a new donation gRPC server
-func NewDonationGRPCServer(donationService service.DonationService) *DonationGRPCServer {
+func NewDonationGRPCServer(donationService service.DonationService, eventBus service.DonationEventBus, refundService service.RefundService, idempotencyService service.IdempotencyService, leaderboardService service.LeaderboardService, exportService service.DonationExportService) *DonationGRPCServer {
 	return &DonationGRPCServer{
 		donationService: donationService,
+		eventBus:        eventBus,
+		refundService:   refundService,
+		idempotency:     idempotencyService,
+		leaderboard:     leaderboardService,
+		exports:         exportService,
 	}
 }
 
-// CreateDonation creates a new donation via gRPC
+// CreateDonation creates a new donation via gRPC, once per idempotency key when one is given
 func (s *DonationGRPCServer) CreateDonation(ctx context.Context, req *pb.CreateDonationRequest) (*pb.CreateDonationResponse, error) {
-	createReq := &service.CreateDonationRequest{
-		Amount:      req.Amount,
-		Currency:    req.Currency,
-		Message:     req.Message,
-		StreamerID:  uint(req.StreamerId),
-		DisplayName: req.DisplayName,
-		IsAnonymous: req.IsAnonymous,
-	}
-
-	// Set donator ID if provided
-	if req.DonatorId != 0 {
-		donatorID := uint(req.DonatorId)
-		createReq.DonatorID = &donatorID
-	}
+	return runIdempotent(s.idempotency, "DonationService/CreateDonation", req.IdempotencyKey, req, &pb.CreateDonationResponse{}, func() (*pb.CreateDonationResponse, error) {
+		return s.createDonation(req)
+	})
+}
 
+func (s *DonationGRPCServer) createDonation(req *pb.CreateDonationRequest) (*pb.CreateDonationResponse, error) {
 	// Create donation
-	donation, err := s.donationService.CreateDonation(createReq)
+	donation, err := s.donationService.CreateDonation(convertPbToCreateDonationRequest(req))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to create donation: %v", err)
+		return nil, createDonationError(err)
 	}
 
 	// Generate transaction ID for response
@@ -59,14 +64,78 @@ func (s *DonationGRPCServer) CreateDonation(ctx context.Context, req *pb.CreateD
 		PaymentUrl:    "", // Will be set by payment processor
 		QrCodeBase64:  "", // Will be set by QRIS processor
 		ExpiresAt:     timestamppb.New(time.Now().Add(15 * time.Minute)),
+		Donation:      convertModelToPbDonation(donation),
 	}, nil
 }
 
+// CreateSplitShares creates the donations of a split donation's shares
+func (s *DonationGRPCServer) CreateSplitShares(ctx context.Context, req *pb.CreateSplitSharesRequest) (*pb.CreateSplitSharesResponse, error) {
+	reqs := make([]*service.CreateDonationRequest, 0, len(req.Shares))
+	for _, share := range req.Shares {
+		reqs = append(reqs, convertPbToCreateDonationRequest(share))
+	}
+
+	donations, err := s.donationService.CreateSplitShares(reqs)
+	if err != nil {
+		return nil, createDonationError(err)
+	}
+
+	pbDonations := make([]*pb.Donation, 0, len(donations))
+	for _, donation := range donations {
+		pbDonations = append(pbDonations, convertModelToPbDonation(donation))
+	}
+	return &pb.CreateSplitSharesResponse{Donations: pbDonations}, nil
+}
+
+func convertPbToCreateDonationRequest(req *pb.CreateDonationRequest) *service.CreateDonationRequest {
+	createReq := &service.CreateDonationRequest{
+		Amount:           req.Amount,
+		Currency:         req.Currency,
+		Message:          req.Message,
+		StreamerID:       uint(req.StreamerId),
+		DisplayName:      req.DisplayName,
+		IsAnonymous:      req.IsAnonymous,
+		PaymentProvider:  convertPaymentMethodToProvider(req.PaymentMethod),
+		StreamerCurrency: models.SupportedCurrency(req.StreamerCurrency),
+		SplitDonationID:  uint(req.SplitDonationId),
+		StreamerLanguage: models.SupportedLanguage(req.StreamerLanguage),
+	}
+
+	// Set donator ID if provided
+	if req.DonatorId != 0 {
+		donatorID := uint(req.DonatorId)
+		createReq.DonatorID = &donatorID
+	}
+
+	// Requests relayed from donors carry their client for fraud screening
+	if req.ClientIp != "" {
+		createReq.Client = &service.DonationClient{
+			IPAddress: req.ClientIp,
+			Country:   req.ClientCountry,
+			UserID:    uint(req.ClientUserId),
+		}
+	}
+	return createReq
+}
+
+// createDonationError maps donation create errors to the status codes the gateway maps back
+func createDonationError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrMessageRejected):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrDonationBlocked):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, service.ErrDonationChallenged):
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+	return status.Errorf(codes.Internal, "failed to create donation: %v", err)
+}
+
 // GetDonation retrieves a donation by ID
 func (s *DonationGRPCServer) GetDonation(ctx context.Context, req *pb.GetDonationRequest) (*pb.GetDonationResponse, error) {
 	donation, err := s.donationService.GetByID(uint(req.DonationId))
 	if err != nil {
-		return nil, status.Errorf(codes.NotFound, "donation not found: %v", err)
+		return nil, donationLookupError(err)
 	}
 
 	pbDonation := convertModelToPbDonation(donation)
@@ -75,44 +144,117 @@ func (s *DonationGRPCServer) GetDonation(ctx context.Context, req *pb.GetDonatio
 	}, nil
 }
 
-// GetDonationsByStreamer retrieves donations for a specific streamer
-func (s *DonationGRPCServer) GetDonationsByStreamer(ctx context.Context, req *pb.GetDonationsByStreamerRequest) (*pb.GetDonationsListResponse, error) {
-	page := int(req.Page)
-	pageSize := int(req.PageSize)
-	
-	if page <= 0 {
-		page = 1
+// GetDonationByTransactionID retrieves a donation by its payment transaction ID
+func (s *DonationGRPCServer) GetDonationByTransactionID(ctx context.Context, req *pb.GetDonationByTransactionIDRequest) (*pb.GetDonationResponse, error) {
+	if req.TransactionId == "" {
+		return nil, status.Error(codes.InvalidArgument, "transaction_id is required")
 	}
-	if pageSize <= 0 {
-		pageSize = 10
+
+	donation, err := s.donationService.GetByTransactionID(req.TransactionId)
+	if err != nil {
+		return nil, donationLookupError(err)
 	}
 
+	return &pb.GetDonationResponse{
+		Donation: convertModelToPbDonation(donation),
+	}, nil
+}
+
+// GetDonationsByStreamer retrieves donations for a specific streamer
+func (s *DonationGRPCServer) GetDonationsByStreamer(ctx context.Context, req *pb.GetDonationsByStreamerRequest) (*pb.GetDonationsListResponse, error) {
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
 	donations, err := s.donationService.GetByStreamerID(uint(req.StreamerId), page, pageSize)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get donations: %v", err)
 	}
 
-	var pbDonations []*pb.Donation
-	for _, donation := range donations {
-		pbDonations = append(pbDonations, convertModelToPbDonation(donation))
+	return convertModelToPbDonationsList(donations, page, pageSize), nil
+}
+
+// GetDonationsByDonator retrieves donations made by a specific donator
+func (s *DonationGRPCServer) GetDonationsByDonator(ctx context.Context, req *pb.GetDonationsByDonatorRequest) (*pb.GetDonationsListResponse, error) {
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	donations, err := s.donationService.GetByDonatorID(uint(req.DonatorId), page, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get donations: %v", err)
 	}
 
-	// Calculate total pages (simplified - in production, get actual count)
-	totalPages := (len(pbDonations) + pageSize - 1) / pageSize
+	return convertModelToPbDonationsList(donations, page, pageSize), nil
+}
 
-	return &pb.GetDonationsListResponse{
-		Donations:   pbDonations,
-		TotalCount:  int32(len(pbDonations)),
-		CurrentPage: int32(page),
-		TotalPages:  int32(totalPages),
-	}, nil
+// GetDonations pages through all donations by page number
+func (s *DonationGRPCServer) GetDonations(ctx context.Context, req *pb.GetDonationsRequest) (*pb.GetDonationsListResponse, error) {
+	page, pageSize := normalizePage(req.Page, req.PageSize)
+
+	donations, err := s.donationService.List(page, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list donations: %v", err)
+	}
+
+	return convertModelToPbDonationsList(donations, page, pageSize), nil
+}
+
+// GetLatestDonations retrieves the most recent donations
+func (s *DonationGRPCServer) GetLatestDonations(ctx context.Context, req *pb.GetLatestDonationsRequest) (*pb.GetDonationsListResponse, error) {
+	limit := int(req.Limit)
+	if limit <= 0 {
+		limit = 10
+	}
+
+	donations, err := s.donationService.GetLatestDonations(limit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get latest donations: %v", err)
+	}
+
+	return convertModelToPbDonationsList(donations, 1, limit), nil
+}
+
+// ListDonations lists donations matching a filter, one cursor page at a time
+func (s *DonationGRPCServer) ListDonations(ctx context.Context, req *pb.ListDonationsRequest) (*pb.ListDonationsResponse, error) {
+	listReq := &service.DonationListRequest{
+		SortBy: convertPbToModelDonationSortField(req.SortBy),
+		Order:  convertPbToModelSortOrder(req.Order),
+		Cursor: req.Cursor,
+		Limit:  int(req.Limit),
+	}
+	if req.Filter != nil {
+		listReq.Filter = convertPbToModelDonationFilter(req.Filter)
+	}
+
+	page, err := s.donationService.ListDonations(listReq)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidDonationCursor) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		return nil, status.Errorf(codes.Internal, "failed to list donations: %v", err)
+	}
+
+	resp := &pb.ListDonationsResponse{
+		Donations:  []*pb.Donation{},
+		NextCursor: page.NextCursor,
+		HasMore:    page.HasMore,
+	}
+	for _, donation := range page.Donations {
+		resp.Donations = append(resp.Donations, convertModelToPbDonation(donation))
+	}
+
+	return resp, nil
 }
 
 // UpdateDonationStatus updates the status of a donation
 func (s *DonationGRPCServer) UpdateDonationStatus(ctx context.Context, req *pb.UpdateDonationStatusRequest) (*pb.UpdateDonationStatusResponse, error) {
 	paymentStatus := convertPbToModelPaymentStatus(req.Status)
 	
-	err := s.donationService.UpdateStatus(uint(req.DonationId), paymentStatus)
+	err := s.donationService.UpdateStatus(uint(req.DonationId), paymentStatus, service.StatusChange{
+		Source:  convertPbToModelStatusChangeSource(req.Source),
+		ActorID: uint(req.ActorId),
+		Reason:  req.Reason,
+	})
+	if errors.Is(err, service.ErrInvalidStatusTransition) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update donation status: %v", err)
 	}
@@ -123,83 +265,422 @@ func (s *DonationGRPCServer) UpdateDonationStatus(ctx context.Context, req *pb.U
 	}, nil
 }
 
-// StreamDonationEvents streams real-time donation events (placeholder)
-func (s *DonationGRPCServer) StreamDonationEvents(req *pb.StreamDonationEventsRequest, stream pb.DonationService_StreamDonationEventsServer) error {
-	// This is a placeholder implementation for streaming
-	// In a real implementation, you would:
-	// 1. Subscribe to donation events from a message queue or event store
-	// 2. Stream events to the client as they occur
-	
-	// For now, just return a sample event
-	event := &pb.DonationEvent{
-		Type:      pb.EventType_EVENT_TYPE_DONATION_CREATED,
-		Timestamp: timestamppb.Now(),
-		Metadata: map[string]string{
-			"streamer_id": string(rune(req.StreamerId)),
-		},
+// ProcessDonationPayment records the payment of a pending donation and completes it
+func (s *DonationGRPCServer) ProcessDonationPayment(ctx context.Context, req *pb.ProcessDonationPaymentRequest) (*pb.ProcessDonationPaymentResponse, error) {
+	err := s.donationService.ProcessPayment(uint(req.DonationId), req.TransactionId, convertPbToModelPaymentProvider(req.Provider))
+	if errors.Is(err, service.ErrInvalidStatusTransition) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, status.Error(codes.NotFound, "donation not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to process payment: %v", err)
 	}
 
-	if err := stream.Send(event); err != nil {
-		return status.Errorf(codes.Internal, "failed to send event: %v", err)
+	return &pb.ProcessDonationPaymentResponse{
+		Success: true,
+		Message: "Payment processed successfully",
+	}, nil
+}
+
+// GetStreamerDonationTotal returns a streamer's completed donation total, net of refunds,
+// in the requested currency
+func (s *DonationGRPCServer) GetStreamerDonationTotal(ctx context.Context, req *pb.GetStreamerDonationTotalRequest) (*pb.GetStreamerDonationTotalResponse, error) {
+	total, err := s.donationService.GetTotalAmountByStreamer(uint(req.StreamerId), models.SupportedCurrency(req.Currency))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get donation total: %v", err)
+	}
+
+	return &pb.GetStreamerDonationTotalResponse{TotalAmount: total}, nil
+}
+
+// GetDonationStatusHistory returns every status transition of a donation, oldest first
+func (s *DonationGRPCServer) GetDonationStatusHistory(ctx context.Context, req *pb.GetDonationStatusHistoryRequest) (*pb.GetDonationStatusHistoryResponse, error) {
+	history, err := s.donationService.GetStatusHistory(uint(req.DonationId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get status history: %v", err)
+	}
+
+	pbHistory := make([]*pb.DonationStatusChange, 0, len(history))
+	for _, change := range history {
+		pbHistory = append(pbHistory, &pb.DonationStatusChange{
+			Id:         uint32(change.ID),
+			DonationId: uint32(change.DonationID),
+			FromStatus: convertModelToPbPaymentStatus(change.FromStatus),
+			ToStatus:   convertModelToPbPaymentStatus(change.ToStatus),
+			Source:     convertModelToPbStatusChangeSource(change.Source),
+			ActorId:    uint32(change.ActorID),
+			Reason:     change.Reason,
+			CreatedAt:  timestamppb.New(change.CreatedAt),
+		})
 	}
 
-	return nil
+	return &pb.GetDonationStatusHistoryResponse{History: pbHistory}, nil
 }
 
-// GetDonationStats retrieves donation statistics
+// StreamDonationEvents streams real-time donation events for a streamer until the client disconnects
+func (s *DonationGRPCServer) StreamDonationEvents(req *pb.StreamDonationEventsRequest, stream pb.DonationService_StreamDonationEventsServer) error {
+	if req.StreamerId == 0 {
+		return status.Error(codes.InvalidArgument, "streamer_id is required")
+	}
+	if s.eventBus == nil {
+		return status.Error(codes.Unavailable, "donation event streaming is not enabled")
+	}
+
+	subscription := s.eventBus.Subscribe(uint(req.StreamerId))
+	defer subscription.Close()
+
+	for {
+		select {
+		case event, ok := <-subscription.Events():
+			if !ok {
+				if err := subscription.Err(); errors.Is(err, service.ErrSubscriberTooSlow) {
+					return status.Error(codes.ResourceExhausted, err.Error())
+				}
+				return nil
+			}
+
+			if err := stream.Send(convertEventToPbDonationEvent(event)); err != nil {
+				return status.Errorf(codes.Unavailable, "failed to send event: %v", err)
+			}
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
+}
+
+// GetDonationStats retrieves time-bucketed statistics over completed donations
 func (s *DonationGRPCServer) GetDonationStats(ctx context.Context, req *pb.GetDonationStatsRequest) (*pb.GetDonationStatsResponse, error) {
-	// Get total amount for streamer
-	totalAmount, err := s.donationService.GetTotalAmountByStreamer(uint(req.StreamerId))
+	statsReq := &service.DonationStatsRequest{
+		StreamerID: uint(req.StreamerId),
+		Interval:   convertPbToModelStatsInterval(req.Interval),
+		Currency:   models.SupportedCurrency(req.Currency),
+	}
+	if req.StartDate != nil {
+		statsReq.StartDate = req.StartDate.AsTime()
+	}
+	if req.EndDate != nil {
+		statsReq.EndDate = req.EndDate.AsTime()
+	}
+
+	stats, err := s.donationService.GetDonationStats(statsReq)
 	if err != nil {
+		if errors.Is(err, service.ErrInvalidDonationStats) {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
 		return nil, status.Errorf(codes.Internal, "failed to get donation stats: %v", err)
 	}
 
-	// Get recent donations to calculate count and average
-	donations, err := s.donationService.GetByStreamerID(uint(req.StreamerId), 1, 1000)
+	resp := &pb.GetDonationStatsResponse{
+		TotalAmount:    stats.TotalAmount,
+		TotalDonations: int32(stats.TotalDonations),
+		AverageAmount:  stats.AverageAmount,
+		DailyStats:     []*pb.DonationStat{},
+		CurrencyStats:  []*pb.CurrencyStat{},
+		Interval:       convertModelToPbStatsInterval(stats.Interval),
+		StartDate:      timestamppb.New(stats.StartDate),
+		EndDate:        timestamppb.New(stats.EndDate),
+		Currency:       string(stats.Currency),
+	}
+
+	for _, bucket := range stats.Buckets {
+		resp.DailyStats = append(resp.DailyStats, &pb.DonationStat{
+			Date:              bucket.Period.Format("2006-01-02"),
+			Amount:            bucket.TotalAmount,
+			Count:             int32(bucket.Count),
+			Currency:          string(bucket.Currency),
+			ConvertedAmount:   bucket.ConvertedAmount,
+			ConvertedCurrency: string(bucket.ConvertedCurrency),
+		})
+	}
+
+	for _, total := range stats.CurrencyTotals {
+		resp.CurrencyStats = append(resp.CurrencyStats, &pb.CurrencyStat{
+			Currency:        string(total.Currency),
+			TotalAmount:     total.TotalAmount,
+			TotalDonations:  int32(total.Count),
+			AverageAmount:   total.AverageAmount,
+			ConvertedAmount: total.ConvertedAmount,
+		})
+	}
+
+	return resp, nil
+}
+
+// GetDonationLeaderboard ranks a streamer's donors over a period
+func (s *DonationGRPCServer) GetDonationLeaderboard(ctx context.Context, req *pb.GetDonationLeaderboardRequest) (*pb.GetDonationLeaderboardResponse, error) {
+	if s.leaderboard == nil {
+		return nil, status.Error(codes.Unavailable, "leaderboards are not available")
+	}
+	if req.StreamerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "streamer_id is required")
+	}
+
+	period := convertPbToModelLeaderboardPeriod(req.Period)
+	if period == models.LeaderboardSession && req.StartDate == nil {
+		return nil, status.Error(codes.InvalidArgument, "start_date is required for session leaderboards")
+	}
+
+	leaderboardReq := &service.LeaderboardRequest{
+		StreamerID:     uint(req.StreamerId),
+		Period:         period,
+		Currency:       models.SupportedCurrency(req.Currency),
+		Limit:          int(req.Limit),
+		GroupAnonymous: req.GroupAnonymous,
+	}
+	if req.StartDate != nil {
+		leaderboardReq.StartDate = req.StartDate.AsTime()
+	}
+	if req.EndDate != nil {
+		leaderboardReq.EndDate = req.EndDate.AsTime()
+	}
+
+	leaderboard, err := s.leaderboard.GetLeaderboard(leaderboardReq)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get donations for stats: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get donation leaderboard: %v", err)
+	}
+
+	resp := &pb.GetDonationLeaderboardResponse{
+		StreamerId: uint32(leaderboard.StreamerID),
+		Period:     convertModelToPbLeaderboardPeriod(leaderboard.Period),
+		Currency:   string(leaderboard.Currency),
+		EndDate:    timestamppb.New(leaderboard.EndDate),
+		Entries:    []*pb.LeaderboardEntry{},
+	}
+	if leaderboard.StartDate != nil {
+		resp.StartDate = timestamppb.New(*leaderboard.StartDate)
+	}
+
+	for _, entry := range leaderboard.Entries {
+		resp.Entries = append(resp.Entries, &pb.LeaderboardEntry{
+			Rank:           int32(entry.Rank),
+			DonatorId:      uint32(entry.DonatorID),
+			DisplayName:    entry.DisplayName,
+			IsAnonymous:    entry.IsAnonymous,
+			TotalAmount:    entry.TotalAmount,
+			DonationCount:  int32(entry.DonationCount),
+			FirstDonatedAt: timestamppb.New(entry.FirstDonatedAt),
+			LastDonatedAt:  timestamppb.New(entry.LastDonatedAt),
+		})
+	}
+
+	return resp, nil
+}
+
+// RefundDonation refunds a completed donation through its payment provider
+func (s *DonationGRPCServer) RefundDonation(ctx context.Context, req *pb.RefundDonationRequest) (*pb.RefundDonationResponse, error) {
+	if s.refundService == nil {
+		return nil, status.Error(codes.Unavailable, "refunds are not available")
+	}
+	if req.DonationId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "donation_id is required")
 	}
 
-	totalCount := len(donations)
-	averageAmount := float64(0)
-	if totalCount > 0 {
-		averageAmount = totalAmount / float64(totalCount)
+	refund, donation, err := s.refundService.RefundDonation(&service.RefundDonationRequest{
+		DonationID:  uint(req.DonationId),
+		Amount:      req.Amount,
+		Reason:      req.Reason,
+		RequestedBy: uint(req.RequestedBy),
+		Manual:      req.Manual,
+		Chargeback:  req.Chargeback,
+		Source:      models.StatusChangeSource(req.Source),
+	})
+	if err != nil {
+		return nil, refundError(err)
 	}
 
-	return &pb.GetDonationStatsResponse{
-		TotalAmount:   totalAmount,
-		TotalDonations: int32(totalCount),
-		AverageAmount: averageAmount,
-		DailyStats:    []*pb.DonationStat{}, // Placeholder for daily stats
+	return &pb.RefundDonationResponse{
+		Refund:   convertModelToPbDonationRefund(refund),
+		Donation: convertModelToPbDonation(donation),
 	}, nil
 }
 
+// ListDonationRefunds lists the refunds recorded for a donation
+func (s *DonationGRPCServer) ListDonationRefunds(ctx context.Context, req *pb.ListDonationRefundsRequest) (*pb.ListDonationRefundsResponse, error) {
+	if s.refundService == nil {
+		return nil, status.Error(codes.Unavailable, "refunds are not available")
+	}
+
+	refunds, err := s.refundService.GetRefundsByDonation(uint(req.DonationId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list refunds: %v", err)
+	}
+
+	pbRefunds := make([]*pb.DonationRefund, 0, len(refunds))
+	for _, refund := range refunds {
+		pbRefunds = append(pbRefunds, convertModelToPbDonationRefund(refund))
+	}
+
+	return &pb.ListDonationRefundsResponse{Refunds: pbRefunds}, nil
+}
+
 // Helper functions
 
+// refundError maps the refund service's errors to the codes the gateway maps back
+func refundError(err error) error {
+	switch {
+	case errors.Is(err, service.ErrDonationNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, service.ErrInvalidRefundAmount):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrRefundExceedsRefundable):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, service.ErrRefundNotAllowed):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, service.ErrRefundProviderFailed):
+		return status.Error(codes.Unavailable, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "failed to refund donation: %v", err)
+	}
+}
+
+// donationLookupError reports a missing donation as NotFound and anything else as Internal
+func donationLookupError(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return status.Error(codes.NotFound, "donation not found")
+	}
+	return status.Errorf(codes.Internal, "failed to get donation: %v", err)
+}
+
+// normalizePage applies the default page (1) and page size (10)
+func normalizePage(page, pageSize int32) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+	return int(page), int(pageSize)
+}
+
+func convertModelToPbDonationsList(donations []*models.Donation, page, pageSize int) *pb.GetDonationsListResponse {
+	pbDonations := make([]*pb.Donation, 0, len(donations))
+	for _, donation := range donations {
+		pbDonations = append(pbDonations, convertModelToPbDonation(donation))
+	}
+
+	// Calculate total pages (simplified - in production, get actual count)
+	totalPages := (len(pbDonations) + pageSize - 1) / pageSize
+
+	return &pb.GetDonationsListResponse{
+		Donations:   pbDonations,
+		TotalCount:  int32(len(pbDonations)),
+		CurrentPage: int32(page),
+		TotalPages:  int32(totalPages),
+	}
+}
+
 func convertModelToPbDonation(donation *models.Donation) *pb.Donation {
 	pbDonation := &pb.Donation{
-		Id:              uint32(donation.ID),
-		Amount:          donation.Amount,
-		Currency:        string(donation.Currency),
-		Message:         donation.Message,
-		StreamerId:      uint32(donation.StreamerID),
-		DonatorId:       uint32(donation.DonatorID),
-		DisplayName:     donation.DisplayName,
-		IsAnonymous:     donation.IsAnonymous,
-		Status:          convertModelToPbPaymentStatus(donation.Status),
-		PaymentProvider: convertModelToPbPaymentProvider(donation.PaymentProvider),
-		TransactionId:   donation.TransactionID,
-		CreatedAt:       timestamppb.New(donation.CreatedAt),
-		UpdatedAt:       timestamppb.New(donation.UpdatedAt),
+		Id:                uint32(donation.ID),
+		Amount:            donation.Amount,
+		Currency:          string(donation.Currency),
+		Message:           donation.Message,
+		StreamerId:        uint32(donation.StreamerID),
+		DonatorId:         uint32(donation.DonatorID),
+		DisplayName:       donation.DisplayName,
+		IsAnonymous:       donation.IsAnonymous,
+		Status:            convertModelToPbPaymentStatus(donation.Status),
+		PaymentProvider:   convertModelToPbPaymentProvider(donation.PaymentProvider),
+		TransactionId:     donation.TransactionID,
+		CreatedAt:         timestamppb.New(donation.CreatedAt),
+		UpdatedAt:         timestamppb.New(donation.UpdatedAt),
+		RefundedAmount:    donation.RefundedAmount,
+		MessageStatus:     convertModelToPbMessageStatus(donation.MessageStatus),
+		ExchangeRate:      donation.ExchangeRate,
+		ConvertedAmount:   donation.ConvertedAmount,
+		ConvertedCurrency: string(donation.ConvertedCurrency),
+		RateSource:        donation.RateSource,
+		SplitDonationId:   uint32(donation.SplitDonationID),
+
+		MessageLanguage:     string(donation.MessageLanguage),
+		TranslatedMessage:   donation.TranslatedMessage,
+		TranslationLanguage: string(donation.TranslationLanguage),
+
+		MatchCampaignId:   uint32(donation.MatchCampaignID),
+		MatchedDonationId: uint32(donation.MatchedDonationID),
 	}
 
 	if donation.PaymentTime != nil {
 		pbDonation.PaymentTime = timestamppb.New(*donation.PaymentTime)
 	}
+	if donation.RateTime != nil {
+		pbDonation.RateTime = timestamppb.New(*donation.RateTime)
+	}
 
 	return pbDonation
 }
 
+func convertModelToPbDonationRefund(refund *models.DonationRefund) *pb.DonationRefund {
+	pbRefund := &pb.DonationRefund{
+		Id:               uint32(refund.ID),
+		DonationId:       uint32(refund.DonationID),
+		Amount:           refund.Amount,
+		Currency:         string(refund.Currency),
+		Reason:           refund.Reason,
+		Status:           convertModelToPbRefundStatus(refund.Status),
+		Provider:         convertModelToPbPaymentProvider(refund.Provider),
+		ProviderRefundId: refund.ProviderRefundID,
+		RequestedBy:      uint32(refund.RequestedBy),
+		FailureReason:    refund.FailureReason,
+		Chargeback:       refund.Chargeback,
+		CreatedAt:        timestamppb.New(refund.CreatedAt),
+	}
+
+	if refund.ProcessedAt != nil {
+		pbRefund.ProcessedAt = timestamppb.New(*refund.ProcessedAt)
+	}
+
+	return pbRefund
+}
+
+func convertModelToPbRefundStatus(refundStatus models.RefundStatus) pb.RefundStatus {
+	switch refundStatus {
+	case models.RefundPending:
+		return pb.RefundStatus_REFUND_STATUS_PENDING
+	case models.RefundSucceeded:
+		return pb.RefundStatus_REFUND_STATUS_SUCCEEDED
+	case models.RefundFailed:
+		return pb.RefundStatus_REFUND_STATUS_FAILED
+	default:
+		return pb.RefundStatus_REFUND_STATUS_UNSPECIFIED
+	}
+}
+
+func convertEventToPbDonationEvent(event *service.DonationEvent) *pb.DonationEvent {
+	metadata := map[string]string{
+		"donation_id": strconv.FormatUint(uint64(event.Donation.ID), 10),
+		"streamer_id": strconv.FormatUint(uint64(event.Donation.StreamerID), 10),
+	}
+	for k, v := range event.Metadata {
+		metadata[k] = v
+	}
+
+	return &pb.DonationEvent{
+		Type:      convertModelToPbEventType(event.Type),
+		Donation:  convertModelToPbDonation(event.Donation),
+		Timestamp: timestamppb.New(event.Timestamp),
+		Metadata:  metadata,
+	}
+}
+
+func convertModelToPbEventType(eventType service.DonationEventType) pb.EventType {
+	switch eventType {
+	case service.DonationEventCreated:
+		return pb.EventType_EVENT_TYPE_DONATION_CREATED
+	case service.DonationEventCompleted:
+		return pb.EventType_EVENT_TYPE_DONATION_COMPLETED
+	case service.DonationEventFailed:
+		return pb.EventType_EVENT_TYPE_DONATION_FAILED
+	case service.DonationEventUpdated:
+		return pb.EventType_EVENT_TYPE_DONATION_UPDATED
+	default:
+		return pb.EventType_EVENT_TYPE_UNSPECIFIED
+	}
+}
+
 func convertModelToPbPaymentStatus(status models.PaymentStatus) pb.PaymentStatus {
 	switch status {
 	case models.PaymentPending:
@@ -208,6 +689,8 @@ func convertModelToPbPaymentStatus(status models.PaymentStatus) pb.PaymentStatus
 		return pb.PaymentStatus_PAYMENT_STATUS_COMPLETED
 	case models.PaymentFailed:
 		return pb.PaymentStatus_PAYMENT_STATUS_FAILED
+	case models.PaymentRefunded:
+		return pb.PaymentStatus_PAYMENT_STATUS_REFUNDED
 	default:
 		return pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
 	}
@@ -221,11 +704,42 @@ func convertPbToModelPaymentStatus(status pb.PaymentStatus) models.PaymentStatus
 		return models.PaymentCompleted
 	case pb.PaymentStatus_PAYMENT_STATUS_FAILED:
 		return models.PaymentFailed
+	case pb.PaymentStatus_PAYMENT_STATUS_REFUNDED:
+		return models.PaymentRefunded
 	default:
 		return models.PaymentPending
 	}
 }
 
+func convertModelToPbStatusChangeSource(source models.StatusChangeSource) pb.StatusChangeSource {
+	switch source {
+	case models.StatusSourceWebhook:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_WEBHOOK
+	case models.StatusSourceAdmin:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_ADMIN
+	case models.StatusSourceUser:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_USER
+	case models.StatusSourceSystem:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_SYSTEM
+	default:
+		return pb.StatusChangeSource_STATUS_CHANGE_SOURCE_UNSPECIFIED
+	}
+}
+
+// convertPbToModelStatusChangeSource treats an unspecified source as an operator call
+func convertPbToModelStatusChangeSource(source pb.StatusChangeSource) models.StatusChangeSource {
+	switch source {
+	case pb.StatusChangeSource_STATUS_CHANGE_SOURCE_WEBHOOK:
+		return models.StatusSourceWebhook
+	case pb.StatusChangeSource_STATUS_CHANGE_SOURCE_USER:
+		return models.StatusSourceUser
+	case pb.StatusChangeSource_STATUS_CHANGE_SOURCE_SYSTEM:
+		return models.StatusSourceSystem
+	default:
+		return models.StatusSourceAdmin
+	}
+}
+
 func convertModelToPbPaymentProvider(provider models.PaymentProvider) pb.PaymentProvider {
 	switch provider {
 	case models.PaymentProviderMidtrans:
@@ -234,13 +748,36 @@ func convertModelToPbPaymentProvider(provider models.PaymentProvider) pb.Payment
 		return pb.PaymentProvider_PAYMENT_PROVIDER_PAYPAL
 	case models.PaymentProviderStripe:
 		return pb.PaymentProvider_PAYMENT_PROVIDER_STRIPE
-	case "QRIS":
+	case models.PaymentProviderQRIS:
 		return pb.PaymentProvider_PAYMENT_PROVIDER_QRIS
+	case models.PaymentProviderCrypto:
+		return pb.PaymentProvider_PAYMENT_PROVIDER_CRYPTO
+	case models.PaymentProviderSponsor:
+		return pb.PaymentProvider_PAYMENT_PROVIDER_SPONSOR
 	default:
 		return pb.PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
 	}
 }
 
+// convertPaymentMethodToProvider maps the free-form payment_method of a create request
+// to a provider, or "" when it names none
+func convertPaymentMethodToProvider(method string) models.PaymentProvider {
+	switch strings.ToLower(method) {
+	case "qris":
+		return models.PaymentProviderQRIS
+	case string(models.PaymentProviderMidtrans):
+		return models.PaymentProviderMidtrans
+	case string(models.PaymentProviderPaypal):
+		return models.PaymentProviderPaypal
+	case string(models.PaymentProviderStripe):
+		return models.PaymentProviderStripe
+	case string(models.PaymentProviderCrypto):
+		return models.PaymentProviderCrypto
+	default:
+		return ""
+	}
+}
+
 func convertPbToModelPaymentProvider(provider pb.PaymentProvider) models.PaymentProvider {
 	switch provider {
 	case pb.PaymentProvider_PAYMENT_PROVIDER_MIDTRANS:
@@ -250,12 +787,115 @@ func convertPbToModelPaymentProvider(provider pb.PaymentProvider) models.Payment
 	case pb.PaymentProvider_PAYMENT_PROVIDER_STRIPE:
 		return models.PaymentProviderStripe
 	case pb.PaymentProvider_PAYMENT_PROVIDER_QRIS:
-		return "QRIS"
+		return models.PaymentProviderQRIS
+	case pb.PaymentProvider_PAYMENT_PROVIDER_CRYPTO:
+		return models.PaymentProviderCrypto
+	case pb.PaymentProvider_PAYMENT_PROVIDER_SPONSOR:
+		return models.PaymentProviderSponsor
 	default:
 		return models.PaymentProviderMidtrans
 	}
 }
 
+func convertPbToModelStatsInterval(interval pb.StatsInterval) models.StatsInterval {
+	switch interval {
+	case pb.StatsInterval_STATS_INTERVAL_WEEK:
+		return models.StatsIntervalWeek
+	case pb.StatsInterval_STATS_INTERVAL_MONTH:
+		return models.StatsIntervalMonth
+	default:
+		return models.StatsIntervalDay
+	}
+}
+
+func convertModelToPbStatsInterval(interval models.StatsInterval) pb.StatsInterval {
+	switch interval {
+	case models.StatsIntervalDay:
+		return pb.StatsInterval_STATS_INTERVAL_DAY
+	case models.StatsIntervalWeek:
+		return pb.StatsInterval_STATS_INTERVAL_WEEK
+	case models.StatsIntervalMonth:
+		return pb.StatsInterval_STATS_INTERVAL_MONTH
+	default:
+		return pb.StatsInterval_STATS_INTERVAL_UNSPECIFIED
+	}
+}
+
+func convertPbToModelDonationFilter(pbFilter *pb.DonationFilter) models.DonationFilter {
+	filter := models.DonationFilter{
+		StreamerID:    uint(pbFilter.StreamerId),
+		DonatorID:     uint(pbFilter.DonatorId),
+		ParticipantID: uint(pbFilter.ParticipantId),
+		MinAmount:     pbFilter.MinAmount,
+		MaxAmount:     pbFilter.MaxAmount,
+		Currency:      models.SupportedCurrency(pbFilter.Currency),
+	}
+	if pbFilter.StartDate != nil {
+		startDate := pbFilter.StartDate.AsTime()
+		filter.StartDate = &startDate
+	}
+	if pbFilter.EndDate != nil {
+		endDate := pbFilter.EndDate.AsTime()
+		filter.EndDate = &endDate
+	}
+	// Unspecified enums mean "any", unlike the defaults of the regular converters
+	if pbFilter.Status != pb.PaymentStatus_PAYMENT_STATUS_UNSPECIFIED {
+		filter.Status = convertPbToModelPaymentStatus(pbFilter.Status)
+	}
+	if pbFilter.Provider != pb.PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED {
+		filter.Provider = convertPbToModelPaymentProvider(pbFilter.Provider)
+	}
+	switch pbFilter.Anonymity {
+	case pb.AnonymityFilter_ANONYMITY_FILTER_ONLY:
+		filter.Anonymity = models.AnonymityOnly
+	case pb.AnonymityFilter_ANONYMITY_FILTER_EXCLUDE:
+		filter.Anonymity = models.AnonymityExclude
+	}
+	return filter
+}
+
+func convertPbToModelDonationSortField(field pb.DonationSortField) models.DonationSortField {
+	if field == pb.DonationSortField_DONATION_SORT_FIELD_AMOUNT {
+		return models.DonationSortByAmount
+	}
+	return models.DonationSortByTime
+}
+
+func convertPbToModelSortOrder(order pb.SortOrder) models.SortOrder {
+	if order == pb.SortOrder_SORT_ORDER_ASC {
+		return models.SortAscending
+	}
+	return models.SortDescending
+}
+
+func convertPbToModelLeaderboardPeriod(period pb.LeaderboardPeriod) models.LeaderboardPeriod {
+	switch period {
+	case pb.LeaderboardPeriod_LEADERBOARD_PERIOD_MONTHLY:
+		return models.LeaderboardMonthly
+	case pb.LeaderboardPeriod_LEADERBOARD_PERIOD_WEEKLY:
+		return models.LeaderboardWeekly
+	case pb.LeaderboardPeriod_LEADERBOARD_PERIOD_SESSION:
+		return models.LeaderboardSession
+	default:
+		return models.LeaderboardAllTime
+	}
+}
+
+func convertModelToPbLeaderboardPeriod(period models.LeaderboardPeriod) pb.LeaderboardPeriod {
+	switch period {
+	case models.LeaderboardAllTime:
+		return pb.LeaderboardPeriod_LEADERBOARD_PERIOD_ALL_TIME
+	case models.LeaderboardMonthly:
+		return pb.LeaderboardPeriod_LEADERBOARD_PERIOD_MONTHLY
+	case models.LeaderboardWeekly:
+		return pb.LeaderboardPeriod_LEADERBOARD_PERIOD_WEEKLY
+	case models.LeaderboardSession:
+		return pb.LeaderboardPeriod_LEADERBOARD_PERIOD_SESSION
+	default:
+		return pb.LeaderboardPeriod_LEADERBOARD_PERIOD_UNSPECIFIED
+	}
+}
+
 func generateTransactionID(donationID uint) string {
 	return fmt.Sprintf("TXN-%d-%d", donationID, time.Now().Unix())
 } 
\ No newline at end of file
@@ -0,0 +1,83 @@
+package grpc
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+const idempotencyKeyField = "idempotency_key"
+
+// runIdempotent executes call at most once per idempotency key of the given RPC and
+// answers retries with the stored response. Failed calls are not stored, so they can
+// be retried with the same key. Without a key or a service the call simply runs.
+func runIdempotent[Resp proto.Message](idempotencyService service.IdempotencyService, method, key string, req proto.Message, replay Resp, call func() (Resp, error)) (Resp, error) {
+	if idempotencyService == nil || key == "" {
+		return call()
+	}
+
+	requestHash, err := hashIdempotentRequest(req)
+	if err != nil {
+		return replay, status.Errorf(codes.InvalidArgument, "failed to read request: %v", err)
+	}
+
+	record, err := idempotencyService.Begin(method, key, requestHash)
+	switch {
+	case errors.Is(err, models.ErrIdempotencyKeyReused):
+		return replay, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, models.ErrIdempotencyRequestInProgress):
+		return replay, status.Error(codes.Aborted, err.Error())
+	case err != nil:
+		return replay, status.Errorf(codes.Internal, "failed to check idempotency key: %v", err)
+	}
+
+	if record.IsCompleted() {
+		if err := proto.Unmarshal(record.ResponseBody, replay); err != nil {
+			return replay, status.Errorf(codes.Internal, "failed to replay stored response: %v", err)
+		}
+		return replay, nil
+	}
+
+	resp, err := call()
+	if err != nil {
+		releaseIdempotencyKey(idempotencyService, record)
+		return resp, err
+	}
+
+	body, err := proto.Marshal(resp)
+	if err == nil {
+		err = idempotencyService.Complete(record, int(codes.OK), "application/x-protobuf", body)
+	}
+	if err != nil {
+		fmt.Printf("Warning: failed to store response for idempotency key %s: %v\n", key, err)
+		releaseIdempotencyKey(idempotencyService, record)
+	}
+
+	return resp, nil
+}
+
+// hashIdempotentRequest fingerprints the request without its idempotency key
+func hashIdempotentRequest(req proto.Message) (string, error) {
+	payload := proto.Clone(req).ProtoReflect()
+	if field := payload.Descriptor().Fields().ByName(idempotencyKeyField); field != nil {
+		payload.Clear(field)
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(payload.Interface())
+	if err != nil {
+		return "", err
+	}
+	return models.HashIdempotentRequest(data), nil
+}
+
+func releaseIdempotencyKey(idempotencyService service.IdempotencyService, record *models.IdempotencyRecord) {
+	if err := idempotencyService.Release(record); err != nil {
+		fmt.Printf("Warning: failed to release idempotency key %s: %v\n", record.Key, err)
+	}
+}
@@ -0,0 +1,144 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+// LedgerGRPCServer implements the gRPC LedgerService
+type LedgerGRPCServer struct {
+	pb.UnimplementedLedgerServiceServer
+	ledgerService service.LedgerService
+}
+
+// NewLedgerGRPCServer creates a new ledger gRPC server
+func NewLedgerGRPCServer(ledgerService service.LedgerService) *LedgerGRPCServer {
+	return &LedgerGRPCServer{
+		ledgerService: ledgerService,
+	}
+}
+
+// GetStreamerBalance returns what the platform owes a streamer, per currency
+func (s *LedgerGRPCServer) GetStreamerBalance(ctx context.Context, req *pb.GetStreamerBalanceRequest) (*pb.GetStreamerBalanceResponse, error) {
+	if req.StreamerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "streamer id is required")
+	}
+
+	balances, err := s.ledgerService.GetStreamerBalances(uint(req.StreamerId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get streamer balance: %v", err)
+	}
+
+	pbBalances := make([]*pb.StreamerBalance, len(balances))
+	for i, balance := range balances {
+		pbBalances[i] = &pb.StreamerBalance{
+			StreamerId: uint32(balance.StreamerID),
+			Currency:   string(balance.Currency),
+			Balance:    balance.Balance,
+			Credited:   balance.Credited,
+			Debited:    balance.Debited,
+		}
+	}
+
+	return &pb.GetStreamerBalanceResponse{Balances: pbBalances}, nil
+}
+
+// ListLedgerEntries lists the entries that moved a streamer's balance, newest first
+func (s *LedgerGRPCServer) ListLedgerEntries(ctx context.Context, req *pb.ListLedgerEntriesRequest) (*pb.ListLedgerEntriesResponse, error) {
+	if req.StreamerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "streamer id is required")
+	}
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	entries, err := s.ledgerService.GetStreamerEntries(uint(req.StreamerId), page, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list ledger entries: %v", err)
+	}
+
+	pbEntries := make([]*pb.LedgerEntry, len(entries))
+	for i, entry := range entries {
+		pbEntries[i] = convertModelToPbLedgerEntry(entry)
+	}
+
+	return &pb.ListLedgerEntriesResponse{
+		Entries:  pbEntries,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+func convertModelToPbLedgerEntry(entry *models.LedgerEntry) *pb.LedgerEntry {
+	lines := make([]*pb.LedgerLine, len(entry.Lines))
+	for i, line := range entry.Lines {
+		lines[i] = &pb.LedgerLine{
+			Id:         uint32(line.ID),
+			Account:    convertModelToPbLedgerAccount(line.Account),
+			StreamerId: uint32(line.StreamerID),
+			Provider:   convertModelToPbPaymentProvider(line.Provider),
+			Currency:   string(line.Currency),
+			Amount:     line.Amount,
+		}
+	}
+
+	return &pb.LedgerEntry{
+		Id:          uint32(entry.ID),
+		Reference:   entry.Reference,
+		Type:        convertModelToPbLedgerEntryType(entry.Type),
+		StreamerId:  uint32(entry.StreamerID),
+		DonationId:  uint32(entry.DonationID),
+		RefundId:    uint32(entry.RefundID),
+		PayoutId:    uint32(entry.PayoutID),
+		Currency:    string(entry.Currency),
+		Description: entry.Description,
+		Lines:       lines,
+		CreatedAt:   timestamppb.New(entry.CreatedAt),
+	}
+}
+
+func convertModelToPbLedgerEntryType(entryType models.LedgerEntryType) pb.LedgerEntryType {
+	switch entryType {
+	case models.LedgerEntryPayment:
+		return pb.LedgerEntryType_LEDGER_ENTRY_TYPE_PAYMENT
+	case models.LedgerEntryRefund:
+		return pb.LedgerEntryType_LEDGER_ENTRY_TYPE_REFUND
+	case models.LedgerEntryChargeback:
+		return pb.LedgerEntryType_LEDGER_ENTRY_TYPE_CHARGEBACK
+	case models.LedgerEntryPayout:
+		return pb.LedgerEntryType_LEDGER_ENTRY_TYPE_PAYOUT
+	case models.LedgerEntryPayoutReversal:
+		return pb.LedgerEntryType_LEDGER_ENTRY_TYPE_PAYOUT_REVERSAL
+	default:
+		return pb.LedgerEntryType_LEDGER_ENTRY_TYPE_UNSPECIFIED
+	}
+}
+
+func convertModelToPbLedgerAccount(account models.LedgerAccount) pb.LedgerAccount {
+	switch account {
+	case models.LedgerAccountProviderClearing:
+		return pb.LedgerAccount_LEDGER_ACCOUNT_PROVIDER_CLEARING
+	case models.LedgerAccountProviderFee:
+		return pb.LedgerAccount_LEDGER_ACCOUNT_PROVIDER_FEE
+	case models.LedgerAccountPlatformFee:
+		return pb.LedgerAccount_LEDGER_ACCOUNT_PLATFORM_FEE
+	case models.LedgerAccountStreamerBalance:
+		return pb.LedgerAccount_LEDGER_ACCOUNT_STREAMER_BALANCE
+	case models.LedgerAccountPayouts:
+		return pb.LedgerAccount_LEDGER_ACCOUNT_PAYOUTS
+	default:
+		return pb.LedgerAccount_LEDGER_ACCOUNT_UNSPECIFIED
+	}
+}
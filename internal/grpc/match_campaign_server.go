@@ -0,0 +1,189 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+// MatchCampaignGRPCServer implements the gRPC MatchCampaignService
+type MatchCampaignGRPCServer struct {
+	pb.UnimplementedMatchCampaignServiceServer
+	matchCampaignService service.MatchCampaignService
+}
+
+// NewMatchCampaignGRPCServer creates a new match campaign gRPC server
+func NewMatchCampaignGRPCServer(matchCampaignService service.MatchCampaignService) *MatchCampaignGRPCServer {
+	return &MatchCampaignGRPCServer{
+		matchCampaignService: matchCampaignService,
+	}
+}
+
+// CreateMatchCampaign starts a sponsor's matching campaign
+func (s *MatchCampaignGRPCServer) CreateMatchCampaign(ctx context.Context, req *pb.MatchCampaignRequest) (*pb.MatchCampaign, error) {
+	campaign, err := s.matchCampaignService.CreateCampaign(convertPbToMatchCampaignRequest(req))
+	if err != nil {
+		return nil, matchCampaignError("failed to create match campaign", err)
+	}
+
+	return convertModelToPbMatchCampaign(campaign), nil
+}
+
+// UpdateMatchCampaign replaces a matching campaign's terms
+func (s *MatchCampaignGRPCServer) UpdateMatchCampaign(ctx context.Context, req *pb.UpdateMatchCampaignRequest) (*pb.MatchCampaign, error) {
+	if req.CampaignId == 0 || req.Campaign == nil {
+		return nil, status.Error(codes.InvalidArgument, "campaign id and campaign are required")
+	}
+
+	campaign, err := s.matchCampaignService.UpdateCampaign(uint(req.CampaignId), convertPbToMatchCampaignRequest(req.Campaign))
+	if err != nil {
+		return nil, matchCampaignError("failed to update match campaign", err)
+	}
+
+	return convertModelToPbMatchCampaign(campaign), nil
+}
+
+// GetMatchCampaign returns a matching campaign by ID
+func (s *MatchCampaignGRPCServer) GetMatchCampaign(ctx context.Context, req *pb.GetMatchCampaignRequest) (*pb.MatchCampaign, error) {
+	if req.CampaignId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+
+	campaign, err := s.matchCampaignService.GetCampaign(uint(req.CampaignId))
+	if err != nil {
+		return nil, matchCampaignError("failed to get match campaign", err)
+	}
+
+	return convertModelToPbMatchCampaign(campaign), nil
+}
+
+// ListMatchCampaigns lists matching campaigns, newest first
+func (s *MatchCampaignGRPCServer) ListMatchCampaigns(ctx context.Context, req *pb.ListMatchCampaignsRequest) (*pb.ListMatchCampaignsResponse, error) {
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	campaigns, err := s.matchCampaignService.ListCampaigns(page, pageSize)
+	if err != nil {
+		return nil, matchCampaignError("failed to list match campaigns", err)
+	}
+
+	pbCampaigns := make([]*pb.MatchCampaign, len(campaigns))
+	for i, campaign := range campaigns {
+		pbCampaigns[i] = convertModelToPbMatchCampaign(campaign)
+	}
+
+	return &pb.ListMatchCampaignsResponse{
+		Campaigns: pbCampaigns,
+		Page:      int32(page),
+		PageSize:  int32(pageSize),
+	}, nil
+}
+
+// GetMatchCampaignReport sums how a matching campaign's budget was spent
+func (s *MatchCampaignGRPCServer) GetMatchCampaignReport(ctx context.Context, req *pb.GetMatchCampaignRequest) (*pb.MatchCampaignReport, error) {
+	if req.CampaignId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "campaign id is required")
+	}
+
+	report, err := s.matchCampaignService.GetReport(uint(req.CampaignId))
+	if err != nil {
+		return nil, matchCampaignError("failed to get match campaign report", err)
+	}
+
+	pbStreamers := make([]*pb.MatchStreamerTotal, len(report.Streamers))
+	for i, total := range report.Streamers {
+		pbStreamers[i] = &pb.MatchStreamerTotal{
+			StreamerId:     uint32(total.StreamerID),
+			MatchCount:     total.MatchCount,
+			DonationAmount: total.DonationAmount,
+			MatchedAmount:  total.MatchedAmount,
+		}
+	}
+
+	return &pb.MatchCampaignReport{
+		Campaign:       convertModelToPbMatchCampaign(report.Campaign),
+		Remaining:      report.Remaining,
+		DonationAmount: report.DonationAmount,
+		MatchedAmount:  report.MatchedAmount,
+		Streamers:      pbStreamers,
+	}, nil
+}
+
+// matchCampaignError maps match campaign service errors to gRPC status codes
+func matchCampaignError(message string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidMatchCampaign):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrMatchCampaignSpent):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "match campaign not found")
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertPbToMatchCampaignRequest(req *pb.MatchCampaignRequest) *service.MatchCampaignRequest {
+	streamerIDs := make([]uint, len(req.StreamerIds))
+	for i, streamerID := range req.StreamerIds {
+		streamerIDs[i] = uint(streamerID)
+	}
+
+	campaignReq := &service.MatchCampaignRequest{
+		Name:             req.Name,
+		SponsorName:      req.SponsorName,
+		SponsorUserID:    uint(req.SponsorUserId),
+		StreamerIDs:      streamerIDs,
+		Currency:         models.SupportedCurrency(req.Currency),
+		RatioBasisPoints: req.RatioBasisPoints,
+		MaxMatchAmount:   req.MaxMatchAmount,
+		Budget:           req.Budget,
+		IsActive:         req.IsActive,
+	}
+	// A missing timestamp is left zero, which the service rejects
+	if req.StartsAt != nil {
+		campaignReq.StartsAt = req.StartsAt.AsTime()
+	}
+	if req.EndsAt != nil {
+		campaignReq.EndsAt = req.EndsAt.AsTime()
+	}
+	return campaignReq
+}
+
+func convertModelToPbMatchCampaign(campaign *models.MatchCampaign) *pb.MatchCampaign {
+	streamerIDs := make([]uint32, len(campaign.StreamerIDs))
+	for i, streamerID := range campaign.StreamerIDs {
+		streamerIDs[i] = uint32(streamerID)
+	}
+
+	return &pb.MatchCampaign{
+		Id:               uint32(campaign.ID),
+		Name:             campaign.Name,
+		SponsorName:      campaign.SponsorName,
+		SponsorUserId:    uint32(campaign.SponsorUserID),
+		StreamerIds:      streamerIDs,
+		Currency:         string(campaign.Currency),
+		RatioBasisPoints: campaign.RatioBasisPoints,
+		MaxMatchAmount:   campaign.MaxMatchAmount,
+		Budget:           campaign.Budget,
+		Spent:            campaign.Spent,
+		MatchCount:       campaign.MatchCount,
+		StartsAt:         timestamppb.New(campaign.StartsAt),
+		EndsAt:           timestamppb.New(campaign.EndsAt),
+		IsActive:         campaign.IsActive,
+		CreatedAt:        timestamppb.New(campaign.CreatedAt),
+	}
+}
@@ -3,6 +3,9 @@ package grpc
 import (
 	"context"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/rzfd/mediashar/internal/adapter"
 	"github.com/rzfd/mediashar/internal/models"
 	"github.com/rzfd/mediashar/internal/service/serviceImpl"
@@ -122,7 +125,7 @@ func (s *MediaShareGRPCHandler) GetMediaStats(ctx context.Context, req *pb.GetMe
 		ApprovedCount:       uint32(stats["approved_count"]),
 		RejectedCount:       uint32(stats["rejected_count"]),
 		PlayedCount:         uint32(stats["played_count"]),
-		TotalDonationAmount: float64(stats["total_donation_amount"]),
+		TotalDonationAmount: stats["total_donation_amount"],
 		YoutubeCount:        uint32(stats["youtube_count"]),
 		TiktokCount:         uint32(stats["tiktok_count"]),
 	}, nil
@@ -162,7 +165,22 @@ func (s *MediaShareGRPCHandler) RejectMedia(ctx context.Context, req *pb.RejectM
 
 // StreamMediaQueue streams real-time media queue updates
 func (s *MediaShareGRPCHandler) StreamMediaQueue(req *pb.StreamMediaQueueRequest, stream pb.MediaShareService_StreamMediaQueueServer) error {
-	// TODO: Implement real-time streaming using channels and goroutines
-	// This would typically push updates when queue changes occur
-	return nil
+	if req.StreamerId == 0 {
+		return status.Error(codes.InvalidArgument, "streamer_id is required")
+	}
+
+	updates, cancel := s.service.SubscribeQueue(uint(req.StreamerId))
+	defer cancel()
+
+	for {
+		select {
+		case update := <-updates:
+			if err := stream.Send(s.converter.ToProtoMediaQueueUpdate(update)); err != nil {
+				return status.Errorf(codes.Unavailable, "failed to send queue update: %v", err)
+			}
+
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		}
+	}
 } 
\ No newline at end of file
@@ -0,0 +1,275 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+// ModerationGRPCServer implements the gRPC ModerationService
+type ModerationGRPCServer struct {
+	pb.UnimplementedModerationServiceServer
+	moderationService service.ModerationService
+}
+
+// NewModerationGRPCServer creates a new moderation gRPC server
+func NewModerationGRPCServer(moderationService service.ModerationService) *ModerationGRPCServer {
+	return &ModerationGRPCServer{
+		moderationService: moderationService,
+	}
+}
+
+// GetModerationSettings returns a streamer's moderation settings, or the defaults
+func (s *ModerationGRPCServer) GetModerationSettings(ctx context.Context, req *pb.GetModerationSettingsRequest) (*pb.ModerationSettingsResponse, error) {
+	settings, err := s.moderationService.GetSettings(uint(req.StreamerId))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get moderation settings: %v", err)
+	}
+
+	return &pb.ModerationSettingsResponse{Settings: convertModelToPbModerationSettings(settings)}, nil
+}
+
+// UpdateModerationSettings replaces a streamer's moderation settings
+func (s *ModerationGRPCServer) UpdateModerationSettings(ctx context.Context, req *pb.UpdateModerationSettingsRequest) (*pb.ModerationSettingsResponse, error) {
+	if req.Settings == nil || req.Settings.StreamerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "streamer id is required")
+	}
+
+	settings, err := s.moderationService.UpdateSettings(convertPbToModelModerationSettings(req.Settings))
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to update moderation settings: %v", err)
+	}
+
+	return &pb.ModerationSettingsResponse{Settings: convertModelToPbModerationSettings(settings)}, nil
+}
+
+// ListMessageReviews lists a streamer's review queue
+func (s *ModerationGRPCServer) ListMessageReviews(ctx context.Context, req *pb.ListMessageReviewsRequest) (*pb.ListMessageReviewsResponse, error) {
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	reviews, total, err := s.moderationService.GetReviewQueue(uint(req.StreamerId), convertPbToModelMessageReviewStatus(req.Status), page, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list message reviews: %v", err)
+	}
+
+	pbReviews := make([]*pb.MessageReview, len(reviews))
+	for i, review := range reviews {
+		pbReviews[i] = convertModelToPbMessageReview(review)
+	}
+
+	return &pb.ListMessageReviewsResponse{
+		Reviews:  pbReviews,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// ApproveMessage shows a held message on the streamer's donation
+func (s *ModerationGRPCServer) ApproveMessage(ctx context.Context, req *pb.ResolveMessageReviewRequest) (*pb.MessageReviewResponse, error) {
+	review, err := s.moderationService.ApproveMessage(uint(req.StreamerId), uint(req.ReviewId))
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &pb.MessageReviewResponse{Review: convertModelToPbMessageReview(review)}, nil
+}
+
+// RejectMessage keeps a held message hidden for good
+func (s *ModerationGRPCServer) RejectMessage(ctx context.Context, req *pb.ResolveMessageReviewRequest) (*pb.MessageReviewResponse, error) {
+	review, err := s.moderationService.RejectMessage(uint(req.StreamerId), uint(req.ReviewId))
+	if err != nil {
+		return nil, reviewError(err)
+	}
+
+	return &pb.MessageReviewResponse{Review: convertModelToPbMessageReview(review)}, nil
+}
+
+// ListBlockedTerms lists the global blocklist
+func (s *ModerationGRPCServer) ListBlockedTerms(ctx context.Context, req *pb.ListBlockedTermsRequest) (*pb.ListBlockedTermsResponse, error) {
+	terms, err := s.moderationService.ListBlockedTerms()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list blocked terms: %v", err)
+	}
+
+	pbTerms := make([]*pb.BlockedTerm, len(terms))
+	for i, term := range terms {
+		pbTerms[i] = convertModelToPbBlockedTerm(term)
+	}
+
+	return &pb.ListBlockedTermsResponse{Terms: pbTerms}, nil
+}
+
+// AddBlockedTerm adds a word or pattern to the global blocklist
+func (s *ModerationGRPCServer) AddBlockedTerm(ctx context.Context, req *pb.AddBlockedTermRequest) (*pb.BlockedTermResponse, error) {
+	term, err := s.moderationService.AddBlockedTerm(req.Term, req.IsPattern)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to add blocked term: %v", err)
+	}
+
+	return &pb.BlockedTermResponse{Term: convertModelToPbBlockedTerm(term)}, nil
+}
+
+// RemoveBlockedTerm removes an entry from the global blocklist
+func (s *ModerationGRPCServer) RemoveBlockedTerm(ctx context.Context, req *pb.RemoveBlockedTermRequest) (*pb.RemoveBlockedTermResponse, error) {
+	if err := s.moderationService.RemoveBlockedTerm(uint(req.Id)); err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "blocked term not found")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to remove blocked term: %v", err)
+	}
+
+	return &pb.RemoveBlockedTermResponse{Success: true}, nil
+}
+
+func reviewError(err error) error {
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "message review not found")
+	case errors.Is(err, service.ErrReviewAlreadyResolved):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Errorf(codes.Internal, "failed to resolve message review: %v", err)
+	}
+}
+
+// Helper functions
+
+func convertModelToPbModerationSettings(settings *models.ModerationSettings) *pb.ModerationSettings {
+	pbSettings := &pb.ModerationSettings{
+		StreamerId:       uint32(settings.StreamerID),
+		Enabled:          settings.Enabled,
+		BannedWords:      settings.BannedWords,
+		BannedPatterns:   settings.BannedPatterns,
+		StripLinks:       settings.StripLinks,
+		MaxMessageLength: int32(settings.MaxMessageLength),
+		Action:           convertModelToPbModerationAction(settings.Action),
+	}
+
+	if !settings.UpdatedAt.IsZero() {
+		pbSettings.UpdatedAt = timestamppb.New(settings.UpdatedAt)
+	}
+
+	return pbSettings
+}
+
+func convertPbToModelModerationSettings(pbSettings *pb.ModerationSettings) *models.ModerationSettings {
+	return &models.ModerationSettings{
+		StreamerID:       uint(pbSettings.StreamerId),
+		Enabled:          pbSettings.Enabled,
+		BannedWords:      pbSettings.BannedWords,
+		BannedPatterns:   pbSettings.BannedPatterns,
+		StripLinks:       pbSettings.StripLinks,
+		MaxMessageLength: int(pbSettings.MaxMessageLength),
+		Action:           convertPbToModelModerationAction(pbSettings.Action),
+	}
+}
+
+func convertModelToPbMessageReview(review *models.MessageReview) *pb.MessageReview {
+	pbReview := &pb.MessageReview{
+		Id:                  uint32(review.ID),
+		DonationId:          uint32(review.DonationID),
+		StreamerId:          uint32(review.StreamerID),
+		OriginalMessage:     review.OriginalMessage,
+		OriginalDisplayName: review.OriginalDisplayName,
+		Message:             review.Message,
+		DisplayName:         review.DisplayName,
+		Violations:          review.Violations,
+		Status:              convertModelToPbMessageReviewStatus(review.Status),
+		CreatedAt:           timestamppb.New(review.CreatedAt),
+	}
+
+	if review.ReviewedAt != nil {
+		pbReview.ReviewedAt = timestamppb.New(*review.ReviewedAt)
+	}
+
+	return pbReview
+}
+
+func convertModelToPbBlockedTerm(term *models.BlockedTerm) *pb.BlockedTerm {
+	return &pb.BlockedTerm{
+		Id:        uint32(term.ID),
+		Term:      term.Term,
+		IsPattern: term.IsPattern,
+		CreatedAt: timestamppb.New(term.CreatedAt),
+	}
+}
+
+func convertModelToPbModerationAction(action models.ModerationAction) pb.ModerationAction {
+	switch action {
+	case models.ModerationMask:
+		return pb.ModerationAction_MODERATION_ACTION_MASK
+	case models.ModerationHold:
+		return pb.ModerationAction_MODERATION_ACTION_HOLD
+	case models.ModerationReject:
+		return pb.ModerationAction_MODERATION_ACTION_REJECT
+	default:
+		return pb.ModerationAction_MODERATION_ACTION_UNSPECIFIED
+	}
+}
+
+func convertPbToModelModerationAction(action pb.ModerationAction) models.ModerationAction {
+	switch action {
+	case pb.ModerationAction_MODERATION_ACTION_HOLD:
+		return models.ModerationHold
+	case pb.ModerationAction_MODERATION_ACTION_REJECT:
+		return models.ModerationReject
+	default:
+		return models.ModerationMask
+	}
+}
+
+func convertModelToPbMessageReviewStatus(reviewStatus models.MessageReviewStatus) pb.MessageReviewStatus {
+	switch reviewStatus {
+	case models.ReviewPending:
+		return pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_PENDING
+	case models.ReviewApproved:
+		return pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_APPROVED
+	case models.ReviewRejected:
+		return pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_REJECTED
+	default:
+		return pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_UNSPECIFIED
+	}
+}
+
+// convertPbToModelMessageReviewStatus maps unspecified to "", which matches every status
+func convertPbToModelMessageReviewStatus(reviewStatus pb.MessageReviewStatus) models.MessageReviewStatus {
+	switch reviewStatus {
+	case pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_PENDING:
+		return models.ReviewPending
+	case pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_APPROVED:
+		return models.ReviewApproved
+	case pb.MessageReviewStatus_MESSAGE_REVIEW_STATUS_REJECTED:
+		return models.ReviewRejected
+	default:
+		return ""
+	}
+}
+
+func convertModelToPbMessageStatus(messageStatus models.MessageStatus) pb.MessageStatus {
+	switch messageStatus {
+	case models.MessageVisible:
+		return pb.MessageStatus_MESSAGE_STATUS_VISIBLE
+	case models.MessageHeld:
+		return pb.MessageStatus_MESSAGE_STATUS_HELD
+	case models.MessageRejected:
+		return pb.MessageStatus_MESSAGE_STATUS_REJECTED
+	default:
+		return pb.MessageStatus_MESSAGE_STATUS_UNSPECIFIED
+	}
+}
@@ -2,6 +2,7 @@ package grpc
 
 import (
 	"context"
+	"fmt"
 	"sync"
 	"time"
 
@@ -57,10 +58,16 @@ func (s *NotificationGRPCServer) SendDonationNotification(ctx context.Context, r
 	}, nil
 }
 
-// SubscribeDonationEvents subscribes a user to donation events stream
+// SubscribeDonationEvents subscribes a user to donation events stream: the events of
+// donations made to them, relayed from the notification service, and any broadcasts
 func (s *NotificationGRPCServer) SubscribeDonationEvents(req *pb.SubscribeEventsRequest, stream pb.NotificationService_SubscribeDonationEventsServer) error {
 	userID := req.UserId
 	
+	relayed, err := s.notificationService.SubscribeEvents(stream.Context(), uint(userID), nil)
+	if err != nil {
+		return status.Errorf(codes.Unavailable, "failed to subscribe to donation events: %v", err)
+	}
+
 	// Create a channel for this subscription
 	eventChan := make(chan *pb.DonationEvent, 100)
 	
@@ -93,33 +100,41 @@ func (s *NotificationGRPCServer) SubscribeDonationEvents(req *pb.SubscribeEvents
 
 	// Listen for events
 	for {
+		var event *pb.DonationEvent
 		select {
-		case event := <-eventChan:
+		case event = <-eventChan:
 			if event == nil {
 				return nil // Channel closed
 			}
-			
-			// Filter events based on requested types if specified
-			if len(req.EventTypes) > 0 {
-				allowed := false
-				for _, eventType := range req.EventTypes {
-					if event.Type == eventType {
-						allowed = true
-						break
-					}
-				}
-				if !allowed {
-					continue
-				}
-			}
-			
-			if err := stream.Send(event); err != nil {
-				return status.Errorf(codes.Internal, "failed to send event: %v", err)
+
+		case relayedEvent, ok := <-relayed:
+			if !ok {
+				// Let the subscriber reconnect instead of silently missing donations
+				return status.Error(codes.Unavailable, "donation event relay ended")
 			}
+			event = relayedEvent
 			
 		case <-stream.Context().Done():
 			return stream.Context().Err()
 		}
+
+		// Filter events based on requested types if specified
+		if len(req.EventTypes) > 0 {
+			allowed := false
+			for _, eventType := range req.EventTypes {
+				if event.Type == eventType {
+					allowed = true
+					break
+				}
+			}
+			if !allowed {
+				continue
+			}
+		}
+		
+		if err := stream.Send(event); err != nil {
+			return status.Errorf(codes.Internal, "failed to send event: %v", err)
+		}
 	}
 }
 
@@ -184,6 +199,51 @@ func generateNotificationID(userID uint32) string {
 	return "notif_" + string(rune(userID)) + "_" + string(rune(time.Now().Unix()))
 }
 
+// DonationEventRelayService implements NotificationService by relaying each user's
+// donation events from the donation service. Notifications are not delivered yet.
+type DonationEventRelayService struct {
+	MockNotificationService
+	donationClient pb.DonationServiceClient
+}
+
+// NewDonationEventRelayService creates a notification service backed by the donation service
+func NewDonationEventRelayService(donationClient pb.DonationServiceClient) *DonationEventRelayService {
+	return &DonationEventRelayService{donationClient: donationClient}
+}
+
+// SubscribeEvents streams the events of donations made to the user until ctx is done or
+// the donation service ends the stream, then closes the channel. eventTypes is ignored;
+// the gRPC server filters by type.
+func (r *DonationEventRelayService) SubscribeEvents(ctx context.Context, userID uint, eventTypes []string) (<-chan *pb.DonationEvent, error) {
+	stream, err := r.donationClient.StreamDonationEvents(ctx, &pb.StreamDonationEventsRequest{
+		StreamerId: uint32(userID),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	eventChan := make(chan *pb.DonationEvent, 100)
+	go func() {
+		defer close(eventChan)
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				if ctx.Err() == nil {
+					fmt.Printf("Warning: Donation event stream of user %d ended: %v\n", userID, err)
+				}
+				return
+			}
+			select {
+			case eventChan <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return eventChan, nil
+}
+
 // MockNotificationService implements NotificationService for testing
 type MockNotificationService struct{}
 
@@ -218,7 +278,11 @@ func (m *MockNotificationService) SubscribeEvents(ctx context.Context, userID ui
 		select {
 		case eventChan <- mockEvent:
 		case <-ctx.Done():
+			return
 		}
+		
+		// Keep the subscription open like a real event source would
+		<-ctx.Done()
 	}()
 	
 	return eventChan, nil
@@ -15,17 +15,25 @@ import (
 type PaymentGRPCServer struct {
 	pb.UnimplementedPaymentServiceServer
 	paymentService service.PaymentService
+	idempotency    service.IdempotencyService
 }
 
 // NewPaymentGRPCServer creates a new payment gRPC server
-func NewPaymentGRPCServer(paymentService service.PaymentService) *PaymentGRPCServer {
+func NewPaymentGRPCServer(paymentService service.PaymentService, idempotencyService service.IdempotencyService) *PaymentGRPCServer {
 	return &PaymentGRPCServer{
 		paymentService: paymentService,
+		idempotency:    idempotencyService,
 	}
 }
 
-// ProcessPayment processes a payment for a donation via gRPC
+// ProcessPayment processes a payment for a donation via gRPC, once per idempotency key when one is given
 func (s *PaymentGRPCServer) ProcessPayment(ctx context.Context, req *pb.ProcessPaymentRequest) (*pb.ProcessPaymentResponse, error) {
+	return runIdempotent(s.idempotency, "PaymentService/ProcessPayment", req.IdempotencyKey, req, &pb.ProcessPaymentResponse{}, func() (*pb.ProcessPaymentResponse, error) {
+		return s.processPayment(req)
+	})
+}
+
+func (s *PaymentGRPCServer) processPayment(req *pb.ProcessPaymentRequest) (*pb.ProcessPaymentResponse, error) {
 	// Convert gRPC payment provider to model
 	provider := convertPbToModelPaymentProviderForPayment(req.Provider)
 	
@@ -106,7 +114,7 @@ func convertPbToModelPaymentProviderForPayment(provider pb.PaymentProvider) mode
 	case pb.PaymentProvider_PAYMENT_PROVIDER_STRIPE:
 		return models.PaymentProviderStripe
 	case pb.PaymentProvider_PAYMENT_PROVIDER_QRIS:
-		return "QRIS"
+		return models.PaymentProviderQRIS
 	default:
 		return models.PaymentProviderMidtrans // Default fallback
 	}
@@ -130,7 +138,7 @@ func generatePaymentURL(provider models.PaymentProvider, transactionID string) s
 func generateQRCode(provider models.PaymentProvider, transactionID string) string {
 	// Generate QR code for QRIS payments
 	// This is a placeholder implementation
-	if provider == "QRIS" {
+	if provider == models.PaymentProviderQRIS {
 		return "data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAAEAAAABCAYAAAAfFcSJAAAADUlEQVR42mP8/5+hHgAHggJ/PchI7wAAAABJRU5ErkJggg==" // Placeholder base64
 	}
 	return ""
@@ -0,0 +1,287 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+// PayoutGRPCServer implements the gRPC PayoutService
+type PayoutGRPCServer struct {
+	pb.UnimplementedPayoutServiceServer
+	payoutService service.PayoutService
+}
+
+// NewPayoutGRPCServer creates a new payout gRPC server
+func NewPayoutGRPCServer(payoutService service.PayoutService) *PayoutGRPCServer {
+	return &PayoutGRPCServer{
+		payoutService: payoutService,
+	}
+}
+
+// CreatePayoutAccount registers a bank account or e-wallet for a streamer
+func (s *PayoutGRPCServer) CreatePayoutAccount(ctx context.Context, req *pb.CreatePayoutAccountRequest) (*pb.PayoutAccount, error) {
+	if req.StreamerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "streamer id is required")
+	}
+
+	account, err := s.payoutService.CreateAccount(&service.CreatePayoutAccountRequest{
+		StreamerID:    uint(req.StreamerId),
+		Channel:       req.Channel,
+		AccountNumber: req.AccountNumber,
+		AccountName:   req.AccountName,
+	})
+	if err != nil {
+		return nil, payoutError("failed to create payout account", err)
+	}
+
+	return convertModelToPbPayoutAccount(account), nil
+}
+
+// ListPayoutAccounts lists a streamer's payout accounts
+func (s *PayoutGRPCServer) ListPayoutAccounts(ctx context.Context, req *pb.ListPayoutAccountsRequest) (*pb.ListPayoutAccountsResponse, error) {
+	if req.StreamerId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "streamer id is required")
+	}
+
+	accounts, err := s.payoutService.GetAccounts(uint(req.StreamerId))
+	if err != nil {
+		return nil, payoutError("failed to list payout accounts", err)
+	}
+
+	pbAccounts := make([]*pb.PayoutAccount, len(accounts))
+	for i, account := range accounts {
+		pbAccounts[i] = convertModelToPbPayoutAccount(account)
+	}
+
+	return &pb.ListPayoutAccountsResponse{Accounts: pbAccounts}, nil
+}
+
+// DeletePayoutAccount removes one of a streamer's payout accounts
+func (s *PayoutGRPCServer) DeletePayoutAccount(ctx context.Context, req *pb.DeletePayoutAccountRequest) (*pb.DeletePayoutAccountResponse, error) {
+	if req.StreamerId == 0 || req.AccountId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "streamer id and account id are required")
+	}
+
+	if err := s.payoutService.DeleteAccount(uint(req.StreamerId), uint(req.AccountId)); err != nil {
+		return nil, payoutError("failed to delete payout account", err)
+	}
+
+	return &pb.DeletePayoutAccountResponse{Success: true}, nil
+}
+
+// RequestPayout withdraws part of a streamer's balance to one of their accounts
+func (s *PayoutGRPCServer) RequestPayout(ctx context.Context, req *pb.RequestPayoutRequest) (*pb.Payout, error) {
+	if req.StreamerId == 0 || req.AccountId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "streamer id and account id are required")
+	}
+
+	payout, err := s.payoutService.RequestPayout(&service.RequestPayoutRequest{
+		StreamerID: uint(req.StreamerId),
+		AccountID:  uint(req.AccountId),
+		Amount:     req.Amount,
+		Currency:   models.SupportedCurrency(req.Currency),
+	})
+	if err != nil {
+		return nil, payoutError("failed to request payout", err)
+	}
+
+	return convertModelToPbPayout(payout), nil
+}
+
+// GetPayout returns a payout by ID
+func (s *PayoutGRPCServer) GetPayout(ctx context.Context, req *pb.GetPayoutRequest) (*pb.Payout, error) {
+	if req.PayoutId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "payout id is required")
+	}
+
+	payout, err := s.payoutService.GetPayout(uint(req.PayoutId))
+	if err != nil {
+		return nil, payoutError("failed to get payout", err)
+	}
+
+	return convertModelToPbPayout(payout), nil
+}
+
+// ListPayouts lists a streamer's payouts, or every payout in a status for admins
+func (s *PayoutGRPCServer) ListPayouts(ctx context.Context, req *pb.ListPayoutsRequest) (*pb.ListPayoutsResponse, error) {
+	page := int(req.Page)
+	if page < 1 {
+		page = 1
+	}
+	pageSize := int(req.PageSize)
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	var payouts []*models.Payout
+	var err error
+	if req.StreamerId != 0 {
+		payouts, err = s.payoutService.GetStreamerPayouts(uint(req.StreamerId), page, pageSize)
+	} else {
+		payouts, err = s.payoutService.ListPayouts(convertPbToModelPayoutStatus(req.Status), page, pageSize)
+	}
+	if err != nil {
+		return nil, payoutError("failed to list payouts", err)
+	}
+
+	pbPayouts := make([]*pb.Payout, len(payouts))
+	for i, payout := range payouts {
+		pbPayouts[i] = convertModelToPbPayout(payout)
+	}
+
+	return &pb.ListPayoutsResponse{
+		Payouts:  pbPayouts,
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
+
+// ApprovePayout approves a requested payout and sends it
+func (s *PayoutGRPCServer) ApprovePayout(ctx context.Context, req *pb.ApprovePayoutRequest) (*pb.Payout, error) {
+	if req.PayoutId == 0 || req.AdminId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "payout id and admin id are required")
+	}
+
+	payout, err := s.payoutService.ApprovePayout(uint(req.PayoutId), uint(req.AdminId))
+	if err != nil {
+		return nil, payoutError("failed to approve payout", err)
+	}
+
+	return convertModelToPbPayout(payout), nil
+}
+
+// RejectPayout declines a requested payout
+func (s *PayoutGRPCServer) RejectPayout(ctx context.Context, req *pb.RejectPayoutRequest) (*pb.Payout, error) {
+	if req.PayoutId == 0 || req.AdminId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "payout id and admin id are required")
+	}
+
+	payout, err := s.payoutService.RejectPayout(uint(req.PayoutId), uint(req.AdminId), req.Reason)
+	if err != nil {
+		return nil, payoutError("failed to reject payout", err)
+	}
+
+	return convertModelToPbPayout(payout), nil
+}
+
+// CompletePayout records that a processing payout was paid
+func (s *PayoutGRPCServer) CompletePayout(ctx context.Context, req *pb.CompletePayoutRequest) (*pb.Payout, error) {
+	if req.PayoutId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "payout id is required")
+	}
+
+	payout, err := s.payoutService.CompletePayout(uint(req.PayoutId), req.ProviderReference)
+	if err != nil {
+		return nil, payoutError("failed to complete payout", err)
+	}
+
+	return convertModelToPbPayout(payout), nil
+}
+
+// FailPayout records that a processing payout could not be sent
+func (s *PayoutGRPCServer) FailPayout(ctx context.Context, req *pb.FailPayoutRequest) (*pb.Payout, error) {
+	if req.PayoutId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "payout id is required")
+	}
+
+	payout, err := s.payoutService.FailPayout(uint(req.PayoutId), req.Reason)
+	if err != nil {
+		return nil, payoutError("failed to fail payout", err)
+	}
+
+	return convertModelToPbPayout(payout), nil
+}
+
+// payoutError maps payout service errors to gRPC status codes
+func payoutError(message string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPayoutRequest):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, service.ErrPayoutStatusConflict):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return status.Error(codes.NotFound, "payout or payout account not found")
+	}
+	return status.Errorf(codes.Internal, "%s: %v", message, err)
+}
+
+func convertModelToPbPayoutAccount(account *models.PayoutAccount) *pb.PayoutAccount {
+	return &pb.PayoutAccount{
+		Id:            uint32(account.ID),
+		StreamerId:    uint32(account.StreamerID),
+		Method:        string(account.Method),
+		Channel:       account.Channel,
+		AccountNumber: account.AccountNumber,
+		AccountName:   account.AccountName,
+		CreatedAt:     timestamppb.New(account.CreatedAt),
+	}
+}
+
+func convertModelToPbPayout(payout *models.Payout) *pb.Payout {
+	pbPayout := &pb.Payout{
+		Id:                uint32(payout.ID),
+		StreamerId:        uint32(payout.StreamerID),
+		AccountId:         uint32(payout.AccountID),
+		Method:            string(payout.Method),
+		Channel:           payout.Channel,
+		AccountNumber:     payout.AccountNumber,
+		AccountName:       payout.AccountName,
+		Amount:            payout.Amount,
+		Currency:          string(payout.Currency),
+		Status:            convertModelToPbPayoutStatus(payout.Status),
+		ReviewedBy:        uint32(payout.ReviewedBy),
+		ProviderReference: payout.ProviderReference,
+		FailureReason:     payout.FailureReason,
+		CreatedAt:         timestamppb.New(payout.CreatedAt),
+	}
+	if payout.ReviewedAt != nil {
+		pbPayout.ReviewedAt = timestamppb.New(*payout.ReviewedAt)
+	}
+	if payout.PaidAt != nil {
+		pbPayout.PaidAt = timestamppb.New(*payout.PaidAt)
+	}
+	return pbPayout
+}
+
+func convertModelToPbPayoutStatus(payoutStatus models.PayoutStatus) pb.PayoutStatus {
+	switch payoutStatus {
+	case models.PayoutRequested:
+		return pb.PayoutStatus_PAYOUT_STATUS_REQUESTED
+	case models.PayoutProcessing:
+		return pb.PayoutStatus_PAYOUT_STATUS_PROCESSING
+	case models.PayoutPaid:
+		return pb.PayoutStatus_PAYOUT_STATUS_PAID
+	case models.PayoutFailed:
+		return pb.PayoutStatus_PAYOUT_STATUS_FAILED
+	case models.PayoutRejected:
+		return pb.PayoutStatus_PAYOUT_STATUS_REJECTED
+	default:
+		return pb.PayoutStatus_PAYOUT_STATUS_UNSPECIFIED
+	}
+}
+
+func convertPbToModelPayoutStatus(payoutStatus pb.PayoutStatus) models.PayoutStatus {
+	switch payoutStatus {
+	case pb.PayoutStatus_PAYOUT_STATUS_REQUESTED:
+		return models.PayoutRequested
+	case pb.PayoutStatus_PAYOUT_STATUS_PROCESSING:
+		return models.PayoutProcessing
+	case pb.PayoutStatus_PAYOUT_STATUS_PAID:
+		return models.PayoutPaid
+	case pb.PayoutStatus_PAYOUT_STATUS_FAILED:
+		return models.PayoutFailed
+	case pb.PayoutStatus_PAYOUT_STATUS_REJECTED:
+		return models.PayoutRejected
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,108 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pb"
+)
+
+// RiskGRPCServer implements the gRPC RiskService
+type RiskGRPCServer struct {
+	pb.UnimplementedRiskServiceServer
+	riskReviewService service.RiskReviewService
+}
+
+// NewRiskGRPCServer creates a new risk gRPC server
+func NewRiskGRPCServer(riskReviewService service.RiskReviewService) *RiskGRPCServer {
+	return &RiskGRPCServer{
+		riskReviewService: riskReviewService,
+	}
+}
+
+// ListRiskAssessments pages through screened donation requests, newest first
+func (s *RiskGRPCServer) ListRiskAssessments(ctx context.Context, req *pb.ListRiskAssessmentsRequest) (*pb.ListRiskAssessmentsResponse, error) {
+	page, pageSize := int(req.Page), int(req.PageSize)
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	assessments, err := s.riskReviewService.ListAssessments(fromPbRiskDecision(req.Decision), page, pageSize)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list risk assessments: %v", err)
+	}
+
+	pbAssessments := make([]*pb.RiskAssessment, len(assessments))
+	for i, assessment := range assessments {
+		pbAssessments[i] = toPbRiskAssessment(assessment)
+	}
+
+	return &pb.ListRiskAssessmentsResponse{
+		Assessments: pbAssessments,
+		Page:        int32(page),
+		PageSize:    int32(pageSize),
+	}, nil
+}
+
+func toPbRiskAssessment(assessment *models.RiskAssessment) *pb.RiskAssessment {
+	reasons := make([]*pb.RiskReason, len(assessment.Reasons))
+	for i, reason := range assessment.Reasons {
+		reasons[i] = &pb.RiskReason{
+			Rule:   string(reason.Rule),
+			Points: int32(reason.Points),
+			Detail: reason.Detail,
+		}
+	}
+
+	return &pb.RiskAssessment{
+		Id:              uint32(assessment.ID),
+		StreamerId:      uint32(assessment.StreamerID),
+		DonatorId:       uint32(assessment.DonatorID),
+		IpAddress:       assessment.IPAddress,
+		Country:         assessment.Country,
+		Amount:          assessment.Amount,
+		Currency:        string(assessment.Currency),
+		TinyAmount:      assessment.TinyAmount,
+		PaymentProvider: convertModelToPbPaymentProvider(assessment.PaymentProvider),
+		SplitDonationId: uint32(assessment.SplitDonationID),
+		Score:           int32(assessment.Score),
+		Decision:        toPbRiskDecision(assessment.Decision),
+		Reasons:         reasons,
+		DonationId:      uint32(assessment.DonationID),
+		CreatedAt:       timestamppb.New(assessment.CreatedAt),
+	}
+}
+
+func toPbRiskDecision(decision models.RiskDecision) pb.RiskDecision {
+	switch decision {
+	case models.RiskAllow:
+		return pb.RiskDecision_RISK_DECISION_ALLOW
+	case models.RiskChallenge:
+		return pb.RiskDecision_RISK_DECISION_CHALLENGE
+	case models.RiskBlock:
+		return pb.RiskDecision_RISK_DECISION_BLOCK
+	default:
+		return pb.RiskDecision_RISK_DECISION_UNSPECIFIED
+	}
+}
+
+func fromPbRiskDecision(decision pb.RiskDecision) models.RiskDecision {
+	switch decision {
+	case pb.RiskDecision_RISK_DECISION_ALLOW:
+		return models.RiskAllow
+	case pb.RiskDecision_RISK_DECISION_CHALLENGE:
+		return models.RiskChallenge
+	case pb.RiskDecision_RISK_DECISION_BLOCK:
+		return models.RiskBlock
+	default:
+		return ""
+	}
+}
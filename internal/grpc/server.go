@@ -17,6 +17,11 @@ type GRPCServer struct {
 	donationService    service.DonationService
 	paymentService     service.PaymentService
 	notificationService NotificationService // Future implementation
+	eventBus           service.DonationEventBus
+	refundService      service.RefundService
+	idempotencyService service.IdempotencyService
+	leaderboardService service.LeaderboardService
+	exportService      service.DonationExportService
 	server             *grpc.Server
 }
 
@@ -31,11 +36,21 @@ func NewGRPCServer(
 	donationService service.DonationService,
 	paymentService service.PaymentService,
 	notificationService NotificationService,
+	eventBus service.DonationEventBus,
+	refundService service.RefundService,
+	idempotencyService service.IdempotencyService,
+	leaderboardService service.LeaderboardService,
+	exportService service.DonationExportService,
 ) *GRPCServer {
 	return &GRPCServer{
 		donationService:     donationService,
 		paymentService:      paymentService,
 		notificationService: notificationService,
+		eventBus:            eventBus,
+		refundService:       refundService,
+		idempotencyService:  idempotencyService,
+		leaderboardService:  leaderboardService,
+		exportService:       exportService,
 		server:              grpc.NewServer(),
 	}
 }
@@ -48,8 +63,8 @@ func (s *GRPCServer) Start(port string) error {
 	}
 
 	// Register services
-	donationServer := NewDonationGRPCServer(s.donationService)
-	paymentServer := NewPaymentGRPCServer(s.paymentService)
+	donationServer := NewDonationGRPCServer(s.donationService, s.eventBus, s.refundService, s.idempotencyService, s.leaderboardService, s.exportService)
+	paymentServer := NewPaymentGRPCServer(s.paymentService, s.idempotencyService)
 	
 	pb.RegisterDonationServiceServer(s.server, donationServer)
 	pb.RegisterPaymentServiceServer(s.server, paymentServer)
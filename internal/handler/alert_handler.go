@@ -0,0 +1,200 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+type AlertHandler struct {
+	alertService   service.AlertService
+	overlayService service.OverlayService
+}
+
+func NewAlertHandler(alertService service.AlertService, overlayService service.OverlayService) *AlertHandler {
+	return &AlertHandler{
+		alertService:   alertService,
+		overlayService: overlayService,
+	}
+}
+
+// AlertRuleRequest is the body for creating or updating an alert rule. Amounts are in
+// minor units of the currency; a zero max amount leaves the range open-ended.
+type AlertRuleRequest struct {
+	Name            string `json:"name"`
+	Currency        string `json:"currency"`
+	MinAmount       int64  `json:"min_amount"`
+	MaxAmount       int64  `json:"max_amount"`
+	MessageTemplate string `json:"message_template"`
+	SoundURL        string `json:"sound_url"`
+	ImageURL        string `json:"image_url"`
+	DurationSeconds int    `json:"duration_seconds"`
+	IsActive        *bool  `json:"is_active"`
+}
+
+// TestAlertResponse returns the rendered test alert and how many overlay connections showed it
+type TestAlertResponse struct {
+	Alert    *models.OverlayAlert `json:"alert"`
+	Overlays int                  `json:"overlays"`
+}
+
+// ListAlertRules lists all alert rules of the authenticated streamer, active or not
+func (h *AlertHandler) ListAlertRules(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	rules, err := h.alertService.GetRulesByStreamer(streamerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch alert rules", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Alert rules fetched successfully", rules))
+}
+
+// GetAlertRule returns one of the authenticated streamer's alert rules
+func (h *AlertHandler) GetAlertRule(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	ruleID, err := strconv.ParseUint(c.Param("ruleId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid alert rule ID", err))
+	}
+
+	rule, err := h.alertService.GetRule(streamerID, uint(ruleID))
+	if err != nil {
+		return alertRuleError(c, "Failed to fetch alert rule", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Alert rule fetched successfully", rule))
+}
+
+// CreateAlertRule creates an alert rule for the authenticated streamer
+func (h *AlertHandler) CreateAlertRule(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	var req AlertRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	rule := req.toModel(streamerID)
+	if err := h.alertService.CreateRule(rule); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to create alert rule", err))
+	}
+
+	return c.JSON(http.StatusCreated, utils.SuccessResponse("Alert rule created successfully", rule))
+}
+
+// UpdateAlertRule replaces an alert rule's settings
+func (h *AlertHandler) UpdateAlertRule(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	ruleID, err := strconv.ParseUint(c.Param("ruleId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid alert rule ID", err))
+	}
+
+	var req AlertRuleRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	rule := req.toModel(streamerID)
+	rule.ID = uint(ruleID)
+	if req.IsActive == nil {
+		// Leaving is_active out keeps a disabled rule disabled
+		existing, err := h.alertService.GetRule(streamerID, uint(ruleID))
+		if err != nil {
+			return alertRuleError(c, "Failed to update alert rule", err)
+		}
+		rule.IsActive = existing.IsActive
+	}
+
+	if err := h.alertService.UpdateRule(rule); err != nil {
+		return alertRuleError(c, "Failed to update alert rule", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Alert rule updated successfully", rule))
+}
+
+// DeleteAlertRule deletes an alert rule; matching donations fall back to other rules
+func (h *AlertHandler) DeleteAlertRule(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	ruleID, err := strconv.ParseUint(c.Param("ruleId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid alert rule ID", err))
+	}
+
+	if err := h.alertService.DeleteRule(streamerID, uint(ruleID)); err != nil {
+		return alertRuleError(c, "Failed to delete alert rule", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Alert rule deleted successfully", nil))
+}
+
+// SendTestAlert shows a made-up donation on the streamer's alert overlay so they can
+// check their rules without donating
+func (h *AlertHandler) SendTestAlert(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	var req service.TestAlertRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	alert, err := h.alertService.RenderTestAlert(streamerID, &req)
+	if err != nil {
+		return alertRuleError(c, "Failed to render test alert", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Test alert sent successfully", &TestAlertResponse{
+		Alert:    alert,
+		Overlays: h.overlayService.PublishAlert(streamerID, alert),
+	}))
+}
+
+func (req *AlertRuleRequest) toModel(streamerID uint) *models.AlertRule {
+	return &models.AlertRule{
+		StreamerID:      streamerID,
+		Name:            req.Name,
+		Currency:        models.SupportedCurrency(req.Currency),
+		MinAmount:       req.MinAmount,
+		MaxAmount:       req.MaxAmount,
+		MessageTemplate: req.MessageTemplate,
+		SoundURL:        req.SoundURL,
+		ImageURL:        req.ImageURL,
+		DurationSeconds: req.DurationSeconds,
+		IsActive:        true,
+	}
+}
+
+// alertRuleError responds 404 to rules of other streamers and 400 to invalid settings
+func alertRuleError(c echo.Context, message string, err error) error {
+	if errors.Is(err, service.ErrAlertRuleNotFound) {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse(message, err))
+	}
+	return c.JSON(http.StatusBadRequest, utils.ErrorResponse(message, err))
+}
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type DonationExportHandler struct {
+	exportService service.DonationExportService
+	currencyRepo  repository.CurrencyRepository
+}
+
+func NewDonationExportHandler(exportService service.DonationExportService, currencyRepo repository.CurrencyRepository) *DonationExportHandler {
+	return &DonationExportHandler{
+		exportService: exportService,
+		currencyRepo:  currencyRepo,
+	}
+}
+
+// ExportDonations downloads the authenticated streamer's donations as a file.
+// Query params: format (csv, xlsx), start_date and end_date (YYYY-MM-DD, end inclusive) and
+// currency for the converted amount column (defaults to the streamer's primary currency).
+// Ranges too large to export directly are queued as a background export and answered with 202.
+func (h *DonationExportHandler) ExportDonations(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	req, err := h.parseExportRequest(c, streamerID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid export parameters", err))
+	}
+
+	writer := &attachmentWriter{
+		response: c.Response(),
+		fileName: models.DonationExportFileName(req.StreamerID, req.StartDate, req.EndDate, req.Format),
+		format:   req.Format,
+	}
+	err = h.exportService.ExportDonations(c.Request().Context(), req, writer)
+	switch {
+	case err == nil:
+		// An export with no rows still has a header row, so the response is always written
+		return nil
+	case writer.started:
+		// The file is partly sent; all that can be done is to cut the response short
+		fmt.Printf("Warning: Donation export for streamer %d stopped midway: %v\n", streamerID, err)
+		return nil
+	case errors.Is(err, service.ErrExportTooLarge):
+		export, err := h.exportService.CreateExport(req)
+		if err != nil {
+			return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to create donation export", err))
+		}
+		return c.JSON(http.StatusAccepted, utils.SuccessResponse("Export is too large to download directly and will be prepared in the background", export))
+	case errors.Is(err, service.ErrInvalidExportRequest):
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid export parameters", err))
+	default:
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to export donations", err))
+	}
+}
+
+// CreateDonationExport queues a background export of the authenticated streamer's donations.
+// Takes the same query params as ExportDonations.
+func (h *DonationExportHandler) CreateDonationExport(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	req, err := h.parseExportRequest(c, streamerID)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid export parameters", err))
+	}
+
+	export, err := h.exportService.CreateExport(req)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidExportRequest) {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid export parameters", err))
+		}
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to create donation export", err))
+	}
+
+	return c.JSON(http.StatusAccepted, utils.SuccessResponse("Donation export queued", export))
+}
+
+// GetDonationExport returns the progress of one of the authenticated streamer's background exports
+func (h *DonationExportHandler) GetDonationExport(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	exportID, err := strconv.ParseUint(c.Param("exportId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid export ID", err))
+	}
+
+	export, err := h.exportService.GetExport(streamerID, uint(exportID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.JSON(http.StatusNotFound, utils.ErrorResponse("Export not found", err))
+		}
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch donation export", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Donation export fetched successfully", export))
+}
+
+// DownloadDonationExport downloads the file of a completed background export
+func (h *DonationExportHandler) DownloadDonationExport(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	exportID, err := strconv.ParseUint(c.Param("exportId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid export ID", err))
+	}
+
+	export, err := h.exportService.GetExport(streamerID, uint(exportID))
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return c.JSON(http.StatusNotFound, utils.ErrorResponse("Export not found", err))
+		}
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch donation export", err))
+	}
+
+	writer := &attachmentWriter{
+		response: c.Response(),
+		fileName: export.FileName(),
+		format:   export.Format,
+	}
+	err = h.exportService.DownloadExport(c.Request().Context(), streamerID, export.ID, writer)
+	switch {
+	case err == nil:
+		if !writer.started {
+			writer.writeHeader()
+		}
+		return nil
+	case writer.started:
+		fmt.Printf("Warning: Download of donation export %d stopped midway: %v\n", export.ID, err)
+		return nil
+	case errors.Is(err, service.ErrExportNotReady):
+		return c.JSON(http.StatusConflict, utils.ErrorResponse("Export is not ready for download", err))
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Export not found", err))
+	default:
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to download donation export", err))
+	}
+}
+
+// parseExportRequest reads the export query params. Both dates are required; the
+// currency defaults to the streamer's primary currency.
+func (h *DonationExportHandler) parseExportRequest(c echo.Context, streamerID uint) (*service.DonationExportRequest, error) {
+	req := &service.DonationExportRequest{
+		StreamerID: streamerID,
+		Format:     models.ExportFormat(c.QueryParam("format")),
+		Currency:   models.SupportedCurrency(c.QueryParam("currency")),
+	}
+	if req.Format == "" {
+		req.Format = models.ExportCSV
+	}
+	if !req.Format.IsValid() {
+		return nil, errors.New("format must be csv or xlsx")
+	}
+
+	var err error
+	req.StartDate, err = time.ParseInLocation("2006-01-02", c.QueryParam("start_date"), time.Local)
+	if err != nil {
+		return nil, errors.New("start_date is required as YYYY-MM-DD")
+	}
+	end, err := time.ParseInLocation("2006-01-02", c.QueryParam("end_date"), time.Local)
+	if err != nil {
+		return nil, errors.New("end_date is required as YYYY-MM-DD")
+	}
+	req.EndDate = end.AddDate(0, 0, 1)
+
+	if req.Currency == "" {
+		// Without a preference the export service falls back to IDR
+		preference, err := h.currencyRepo.GetUserCurrencyPreference(context.Background(), streamerID)
+		if err != nil {
+			fmt.Printf("Warning: Failed to fetch currency preference of streamer %d: %v\n", streamerID, err)
+		} else {
+			req.Currency = preference.PrimaryCurrency
+		}
+	}
+
+	return req, nil
+}
+
+// attachmentWriter sends the file download headers on the first write, so a handler
+// can still answer with a JSON error when the export fails before producing anything
+type attachmentWriter struct {
+	response *echo.Response
+	fileName string
+	format   models.ExportFormat
+	started  bool
+}
+
+func (w *attachmentWriter) Write(p []byte) (int, error) {
+	if !w.started {
+		w.writeHeader()
+	}
+	return w.response.Write(p)
+}
+
+func (w *attachmentWriter) writeHeader() {
+	w.started = true
+	w.response.Header().Set(echo.HeaderContentType, w.format.ContentType())
+	w.response.Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%q", w.fileName))
+	w.response.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+type DonationGoalHandler struct {
+	goalService service.DonationGoalService
+}
+
+func NewDonationGoalHandler(goalService service.DonationGoalService) *DonationGoalHandler {
+	return &DonationGoalHandler{goalService: goalService}
+}
+
+// DonationGoalRequest is the body for creating a goal. The target is in minor units of the currency.
+type DonationGoalRequest struct {
+	Title        string     `json:"title"`
+	Description  string     `json:"description"`
+	TargetAmount int64      `json:"target_amount"`
+	Currency     string     `json:"currency"`
+	Deadline     *time.Time `json:"deadline"`
+}
+
+// UpdateDonationGoalRequest is the body for updating a goal; omitted fields are left unchanged
+type UpdateDonationGoalRequest struct {
+	Title        *string    `json:"title"`
+	Description  *string    `json:"description"`
+	TargetAmount *int64     `json:"target_amount"`
+	Currency     *string    `json:"currency"`
+	Deadline     *time.Time `json:"deadline"`
+	IsActive     *bool      `json:"is_active"`
+}
+
+// DonationGoalResponse adds the computed progress to a goal
+type DonationGoalResponse struct {
+	*models.DonationGoal
+	ProgressPercent float64 `json:"progress_percent"`
+}
+
+func newDonationGoalResponse(goal *models.DonationGoal) *DonationGoalResponse {
+	return &DonationGoalResponse{DonationGoal: goal, ProgressPercent: goal.ProgressPercent()}
+}
+
+// ListDonationGoals lists a streamer's goals; pass active=true to hide finished ones
+func (h *DonationGoalHandler) ListDonationGoals(c echo.Context) error {
+	streamerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid streamer ID", err))
+	}
+
+	activeOnly, _ := strconv.ParseBool(c.QueryParam("active"))
+
+	goals, err := h.goalService.GetGoalsByStreamer(uint(streamerID), activeOnly)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch donation goals", err))
+	}
+
+	response := make([]*DonationGoalResponse, 0, len(goals))
+	for _, goal := range goals {
+		response = append(response, newDonationGoalResponse(goal))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Donation goals fetched successfully", response))
+}
+
+// GetDonationGoal gets a single goal with its progress
+func (h *DonationGoalHandler) GetDonationGoal(c echo.Context) error {
+	streamerID, goalID, err := parseDonationGoalParams(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	goal, err := h.goalService.GetGoal(goalID)
+	if err != nil || goal.StreamerID != streamerID {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation goal not found", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Donation goal found", newDonationGoalResponse(goal)))
+}
+
+// CreateDonationGoal creates a goal for the authenticated streamer
+func (h *DonationGoalHandler) CreateDonationGoal(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	var req DonationGoalRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	goal := &models.DonationGoal{
+		StreamerID:   streamerID,
+		Title:        req.Title,
+		Description:  req.Description,
+		TargetAmount: req.TargetAmount,
+		Currency:     models.SupportedCurrency(req.Currency),
+		Deadline:     req.Deadline,
+		IsActive:     true,
+	}
+
+	if err := h.goalService.CreateGoal(goal); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to create donation goal", err))
+	}
+
+	return c.JSON(http.StatusCreated, utils.SuccessResponse("Donation goal created successfully", newDonationGoalResponse(goal)))
+}
+
+// UpdateDonationGoal updates a goal owned by the authenticated streamer
+func (h *DonationGoalHandler) UpdateDonationGoal(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	goalID, err := strconv.ParseUint(c.Param("goalId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid goal ID", err))
+	}
+
+	var req UpdateDonationGoalRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	goal, err := h.goalService.GetGoal(uint(goalID))
+	if err != nil || goal.StreamerID != streamerID {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation goal not found", err))
+	}
+
+	if req.Title != nil {
+		goal.Title = *req.Title
+	}
+	if req.Description != nil {
+		goal.Description = *req.Description
+	}
+	if req.TargetAmount != nil {
+		goal.TargetAmount = *req.TargetAmount
+	}
+	if req.Currency != nil {
+		goal.Currency = models.SupportedCurrency(*req.Currency)
+	}
+	if req.Deadline != nil {
+		goal.Deadline = req.Deadline
+	}
+	if req.IsActive != nil {
+		goal.IsActive = *req.IsActive
+	}
+
+	if err := h.goalService.UpdateGoal(goal); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to update donation goal", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Donation goal updated successfully", newDonationGoalResponse(goal)))
+}
+
+// DeleteDonationGoal deletes a goal owned by the authenticated streamer
+func (h *DonationGoalHandler) DeleteDonationGoal(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	goalID, err := strconv.ParseUint(c.Param("goalId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid goal ID", err))
+	}
+
+	if err := h.goalService.DeleteGoal(streamerID, uint(goalID)); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to delete donation goal", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Donation goal deleted successfully", nil))
+}
+
+// authorizeOwnStreamer ensures the streamer in the path is the authenticated user
+func authorizeOwnStreamer(c echo.Context) (uint, error) {
+	streamerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return 0, err
+	}
+
+	userID, ok := c.Get("user_id").(uint)
+	if !ok || userID != uint(streamerID) {
+		return 0, errors.New("you can only manage your own streamer account")
+	}
+
+	return userID, nil
+}
+
+func parseDonationGoalParams(c echo.Context) (uint, uint, error) {
+	streamerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return 0, 0, errors.New("invalid streamer ID")
+	}
+
+	goalID, err := strconv.ParseUint(c.Param("goalId"), 10, 32)
+	if err != nil {
+		return 0, 0, errors.New("invalid goal ID")
+	}
+
+	return uint(streamerID), uint(goalID), nil
+}
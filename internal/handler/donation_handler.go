@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/rzfd/mediashar/internal/models"
@@ -12,10 +14,17 @@ import (
 
 type DonationHandler struct {
 	donationService service.DonationService
+	admins          map[uint]bool
 }
 
-func NewDonationHandler(donationService service.DonationService) *DonationHandler {
-	return &DonationHandler{donationService: donationService}
+// NewDonationHandler creates the donation handler. Admins may list every donation; other
+// users only the ones they made or received.
+func NewDonationHandler(donationService service.DonationService, adminUserIDs []uint) *DonationHandler {
+	admins := make(map[uint]bool, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		admins[id] = true
+	}
+	return &DonationHandler{donationService: donationService, admins: admins}
 }
 
 // CreateDonation creates a new donation
@@ -26,13 +35,24 @@ func (h *DonationHandler) CreateDonation(c echo.Context) error {
 	}
 
 	// Get donator ID from JWT token
+	req.DonatorID = nil
 	userID, ok := c.Get("user_id").(uint)
 	if ok {
 		req.DonatorID = &userID
 	}
+	req.Client = donationClient(c)
 
 	// Create donation using service method
 	donation, err := h.donationService.CreateDonation(&req)
+	if errors.Is(err, service.ErrMessageRejected) {
+		return c.JSON(http.StatusUnprocessableEntity, utils.ErrorResponse("Donation message was rejected", err))
+	}
+	if errors.Is(err, service.ErrDonationBlocked) {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Donation was declined", err))
+	}
+	if errors.Is(err, service.ErrDonationChallenged) {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Sign in to continue this donation", err))
+	}
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to create donation", err))
 	}
@@ -55,49 +75,162 @@ func (h *DonationHandler) GetDonation(c echo.Context) error {
 	return c.JSON(http.StatusOK, utils.SuccessResponse("Donation found", donation))
 }
 
-// ListDonations lists all donations with pagination
+// ListDonations lists donations one cursor page at a time: every donation for admins,
+// otherwise the ones the user made or received.
+// Query params: see parseDonationListRequest, plus streamer_id and donator_id.
 func (h *DonationHandler) ListDonations(c echo.Context) error {
-	page, _ := strconv.Atoi(c.QueryParam("page"))
-	if page <= 0 {
-		page = 1
+	req, err := parseDonationListRequest(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid query", err))
 	}
 
-	pageSize, _ := strconv.Atoi(c.QueryParam("pageSize"))
-	if pageSize <= 0 {
-		pageSize = 10
+	if streamerID := c.QueryParam("streamer_id"); streamerID != "" {
+		id, err := strconv.ParseUint(streamerID, 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid streamer_id", err))
+		}
+		req.Filter.StreamerID = uint(id)
+	}
+	if donatorID := c.QueryParam("donator_id"); donatorID != "" {
+		id, err := strconv.ParseUint(donatorID, 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid donator_id", err))
+		}
+		req.Filter.DonatorID = uint(id)
 	}
 
-	donations, err := h.donationService.List(page, pageSize)
-	if err != nil {
-		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch donations", err))
+	userID, _ := c.Get("user_id").(uint)
+	if !h.admins[userID] {
+		// Another donor's history would include the donations they made anonymously
+		if (req.Filter.StreamerID != 0 && req.Filter.StreamerID != userID) || (req.Filter.DonatorID != 0 && req.Filter.DonatorID != userID) {
+			return c.JSON(http.StatusForbidden, utils.ErrorResponse("You can only list your own donations", nil))
+		}
+		req.Filter.ParticipantID = userID
 	}
 
-	return c.JSON(http.StatusOK, utils.SuccessResponse("Donations fetched successfully", donations))
+	return h.listDonations(c, req, "Donations fetched successfully")
 }
 
-// GetStreamerDonations gets all donations for a specific streamer
+// GetStreamerDonations gets a streamer's donations one cursor page at a time.
+// Query params: see parseDonationListRequest.
 func (h *DonationHandler) GetStreamerDonations(c echo.Context) error {
 	streamerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid streamer ID", err))
 	}
 
-	page, _ := strconv.Atoi(c.QueryParam("page"))
-	if page <= 0 {
-		page = 1
+	req, err := parseDonationListRequest(c)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid query", err))
+	}
+	req.Filter.StreamerID = uint(streamerID)
+
+	return h.listDonations(c, req, "Streamer donations fetched successfully")
+}
+
+func (h *DonationHandler) listDonations(c echo.Context, req *service.DonationListRequest, message string) error {
+	page, err := h.donationService.ListDonations(req)
+	if err != nil {
+		if errors.Is(err, service.ErrInvalidDonationCursor) {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid cursor", err))
+		}
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch donations", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse(message, page))
+}
+
+// parseDonationListRequest reads the shared listing query params: cursor, limit,
+// sort_by (time, amount), order (desc, asc), start_date and end_date (YYYY-MM-DD, end
+// inclusive), min_amount, max_amount, status, currency, provider and anonymity (only, exclude).
+func parseDonationListRequest(c echo.Context) (*service.DonationListRequest, error) {
+	req := &service.DonationListRequest{
+		SortBy: models.DonationSortField(c.QueryParam("sort_by")),
+		Order:  models.SortOrder(c.QueryParam("order")),
+		Cursor: c.QueryParam("cursor"),
+		Filter: models.DonationFilter{
+			Status:    models.PaymentStatus(c.QueryParam("status")),
+			Currency:  models.SupportedCurrency(c.QueryParam("currency")),
+			Provider:  models.PaymentProvider(c.QueryParam("provider")),
+			Anonymity: models.AnonymityFilter(c.QueryParam("anonymity")),
+		},
+	}
+
+	switch req.SortBy {
+	case "", models.DonationSortByTime, models.DonationSortByAmount:
+	default:
+		return nil, errors.New("sort_by must be time or amount")
+	}
+	switch req.Order {
+	case "", models.SortDescending, models.SortAscending:
+	default:
+		return nil, errors.New("order must be desc or asc")
+	}
+	switch req.Filter.Anonymity {
+	case models.AnonymityAny, models.AnonymityOnly, models.AnonymityExclude:
+	default:
+		return nil, errors.New("anonymity must be only or exclude")
+	}
+
+	var err error
+	if limit := c.QueryParam("limit"); limit != "" {
+		if req.Limit, err = strconv.Atoi(limit); err != nil {
+			return nil, errors.New("limit must be a number")
+		}
+	}
+	if minAmount := c.QueryParam("min_amount"); minAmount != "" {
+		if req.Filter.MinAmount, err = strconv.ParseInt(minAmount, 10, 64); err != nil {
+			return nil, errors.New("min_amount must be a whole number of minor units")
+		}
+	}
+	if maxAmount := c.QueryParam("max_amount"); maxAmount != "" {
+		if req.Filter.MaxAmount, err = strconv.ParseInt(maxAmount, 10, 64); err != nil {
+			return nil, errors.New("max_amount must be a whole number of minor units")
+		}
 	}
 
-	pageSize, _ := strconv.Atoi(c.QueryParam("pageSize"))
-	if pageSize <= 0 {
-		pageSize = 10
+	if startDate := c.QueryParam("start_date"); startDate != "" {
+		start, err := time.ParseInLocation("2006-01-02", startDate, time.Local)
+		if err != nil {
+			return nil, errors.New("start_date must be YYYY-MM-DD")
+		}
+		req.Filter.StartDate = &start
+	}
+	if endDate := c.QueryParam("end_date"); endDate != "" {
+		end, err := time.ParseInLocation("2006-01-02", endDate, time.Local)
+		if err != nil {
+			return nil, errors.New("end_date must be YYYY-MM-DD")
+		}
+		end = end.AddDate(0, 0, 1)
+		req.Filter.EndDate = &end
 	}
 
-	donations, err := h.donationService.GetByStreamerID(uint(streamerID), page, pageSize)
+	return req, nil
+}
+
+// GetDonationStatusHistory lists a donation's status transitions for its streamer or donator
+func (h *DonationHandler) GetDonationStatusHistory(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
-		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch streamer donations", err))
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid donation ID", err))
 	}
 
-	return c.JSON(http.StatusOK, utils.SuccessResponse("Streamer donations fetched successfully", donations))
+	donation, err := h.donationService.GetByID(uint(id))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation not found", err))
+	}
+
+	userID, _ := c.Get("user_id").(uint)
+	if donation.StreamerID != userID && donation.DonatorID != userID {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", nil))
+	}
+
+	history, err := h.donationService.GetStatusHistory(uint(id))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch status history", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Status history fetched successfully", history))
 }
 
 // ProcessPayment updates a donation with payment information
@@ -117,6 +250,12 @@ func (h *DonationHandler) ProcessPayment(c echo.Context) error {
 		paymentData.TransactionID,
 		paymentData.PaymentProvider,
 	)
+	if errors.Is(err, service.ErrDonationNotFound) {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation not found", err))
+	}
+	if errors.Is(err, service.ErrInvalidStatusTransition) {
+		return c.JSON(http.StatusConflict, utils.ErrorResponse("Donation cannot be marked as paid", err))
+	}
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to process payment", err))
 	}
@@ -139,19 +278,68 @@ func (h *DonationHandler) GetLatestDonations(c echo.Context) error {
 	return c.JSON(http.StatusOK, utils.SuccessResponse("Latest donations fetched successfully", donations))
 }
 
-// GetTotalDonations gets the total donation amount for a streamer
+// GetTotalDonations gets the total donation amount for a streamer, in the currency
+// query param or the streamer's primary currency
 func (h *DonationHandler) GetTotalDonations(c echo.Context) error {
 	streamerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid streamer ID", err))
 	}
 
-	total, err := h.donationService.GetTotalAmountByStreamer(uint(streamerID))
+	currency := models.SupportedCurrency(c.QueryParam("currency"))
+	if currency != "" {
+		if err := service.ValidateCurrency(currency); err != nil {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid currency", err))
+		}
+	}
+
+	total, err := h.donationService.GetTotalAmountByStreamer(uint(streamerID), currency)
 	if err != nil {
 		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch total donations", err))
 	}
 
-	return c.JSON(http.StatusOK, utils.SuccessResponse("Total donations fetched successfully", map[string]float64{
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Total donations fetched successfully", map[string]int64{
 		"total": total,
 	}))
-} 
\ No newline at end of file
+}
+
+// GetDonationStats gets time-bucketed statistics over the streamer's own completed donations.
+// Query params: start_date and end_date (YYYY-MM-DD, end inclusive) and interval (day, week, month).
+func (h *DonationHandler) GetDonationStats(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	req := &service.DonationStatsRequest{
+		StreamerID: streamerID,
+		Interval:   models.StatsInterval(c.QueryParam("interval")),
+	}
+	if req.Interval != "" && !req.Interval.IsValid() {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid interval", errors.New("interval must be day, week or month")))
+	}
+
+	if startDate := c.QueryParam("start_date"); startDate != "" {
+		req.StartDate, err = time.ParseInLocation("2006-01-02", startDate, time.Local)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid start_date", err))
+		}
+	}
+	if endDate := c.QueryParam("end_date"); endDate != "" {
+		end, err := time.ParseInLocation("2006-01-02", endDate, time.Local)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid end_date", err))
+		}
+		req.EndDate = end.AddDate(0, 0, 1)
+	}
+
+	stats, err := h.donationService.GetDonationStats(req)
+	if errors.Is(err, service.ErrInvalidDonationStats) {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid stats request", err))
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch donation stats", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Donation stats fetched successfully", stats))
+}
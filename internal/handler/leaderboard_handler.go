@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+type LeaderboardHandler struct {
+	leaderboardService service.LeaderboardService
+	currencyRepo       repository.CurrencyRepository
+	platformRepo       repository.PlatformRepository
+}
+
+func NewLeaderboardHandler(leaderboardService service.LeaderboardService, currencyRepo repository.CurrencyRepository, platformRepo repository.PlatformRepository) *LeaderboardHandler {
+	return &LeaderboardHandler{
+		leaderboardService: leaderboardService,
+		currencyRepo:       currencyRepo,
+		platformRepo:       platformRepo,
+	}
+}
+
+// GetLeaderboard ranks a streamer's top supporters, with totals in the streamer's primary currency.
+// Query params: period (all_time, monthly, weekly, session), content_id (the live stream, required
+// for session), limit, and group_anonymous=true to rank anonymous donations as one entry.
+func (h *LeaderboardHandler) GetLeaderboard(c echo.Context) error {
+	streamerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid streamer ID", err))
+	}
+
+	req := &service.LeaderboardRequest{
+		StreamerID:     uint(streamerID),
+		Period:         models.LeaderboardPeriod(c.QueryParam("period")),
+		GroupAnonymous: c.QueryParam("group_anonymous") == "true",
+	}
+	if req.Period == "" {
+		req.Period = models.LeaderboardAllTime
+	}
+	if !req.Period.IsValid() {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid period", errors.New("period must be all_time, monthly, weekly or session")))
+	}
+
+	if limit := c.QueryParam("limit"); limit != "" {
+		req.Limit, err = strconv.Atoi(limit)
+		if err != nil || req.Limit <= 0 {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid limit", err))
+		}
+	}
+
+	if req.Period == models.LeaderboardSession {
+		contentID, err := strconv.ParseUint(c.QueryParam("content_id"), 10, 32)
+		if err != nil {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("content_id is required for session leaderboards", err))
+		}
+
+		content, err := h.platformRepo.GetContentByID(uint(contentID))
+		if err != nil || content.Platform.UserID != uint(streamerID) {
+			return c.JSON(http.StatusNotFound, utils.ErrorResponse("Stream not found", err))
+		}
+		if content.StartedAt == nil {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Stream has not started", nil))
+		}
+
+		req.StartDate = *content.StartedAt
+		if content.EndedAt != nil {
+			req.EndDate = *content.EndedAt
+		}
+	}
+
+	preference, err := h.currencyRepo.GetUserCurrencyPreference(context.Background(), uint(streamerID))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch streamer currency", err))
+	}
+	req.Currency = preference.PrimaryCurrency
+
+	leaderboard, err := h.leaderboardService.GetLeaderboard(req)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch leaderboard", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Leaderboard fetched successfully", leaderboard))
+}
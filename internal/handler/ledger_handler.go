@@ -0,0 +1,58 @@
+package handler
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+type LedgerHandler struct {
+	ledgerService service.LedgerService
+}
+
+func NewLedgerHandler(ledgerService service.LedgerService) *LedgerHandler {
+	return &LedgerHandler{ledgerService: ledgerService}
+}
+
+// GetBalance returns what the platform owes the authenticated streamer, per currency
+func (h *LedgerHandler) GetBalance(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	balances, err := h.ledgerService.GetStreamerBalances(streamerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch balance", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Balance fetched successfully", balances))
+}
+
+// GetLedgerEntries lists the ledger entries that moved the authenticated streamer's
+// balance, newest first
+func (h *LedgerHandler) GetLedgerEntries(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page <= 0 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(c.QueryParam("pageSize"))
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	entries, err := h.ledgerService.GetStreamerEntries(streamerID, page, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch ledger entries", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Ledger entries fetched successfully", entries))
+}
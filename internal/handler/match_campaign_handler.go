@@ -0,0 +1,167 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type MatchCampaignHandler struct {
+	matchCampaignService service.MatchCampaignService
+	admins               map[uint]bool
+}
+
+// NewMatchCampaignHandler creates the matching campaign handler. Admins may read every
+// campaign's report; sponsors only their own.
+func NewMatchCampaignHandler(matchCampaignService service.MatchCampaignService, adminUserIDs []uint) *MatchCampaignHandler {
+	admins := make(map[uint]bool, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		admins[id] = true
+	}
+	return &MatchCampaignHandler{matchCampaignService: matchCampaignService, admins: admins}
+}
+
+// MatchCampaignBody is the body for creating or updating a matching campaign. Amounts are
+// minor units of the currency, which defaults to IDR; the ratio is in basis points, so
+// 10000 matches 1:1 and 5000 matches half. Campaigns are active unless is_active is false.
+type MatchCampaignBody struct {
+	Name             string                   `json:"name"`
+	SponsorName      string                   `json:"sponsor_name"`
+	SponsorUserID    uint                     `json:"sponsor_user_id"`
+	StreamerIDs      []uint                   `json:"streamer_ids"`
+	Currency         models.SupportedCurrency `json:"currency"`
+	RatioBasisPoints int64                    `json:"ratio_basis_points"`
+	MaxMatchAmount   int64                    `json:"max_match_amount"`
+	Budget           int64                    `json:"budget"`
+	StartsAt         time.Time                `json:"starts_at"`
+	EndsAt           time.Time                `json:"ends_at"`
+	IsActive         *bool                    `json:"is_active"`
+}
+
+// CreateCampaign starts a sponsor's matching campaign
+func (h *MatchCampaignHandler) CreateCampaign(c echo.Context) error {
+	var body MatchCampaignBody
+	if err := c.Bind(&body); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	campaign, err := h.matchCampaignService.CreateCampaign(body.toRequest())
+	if err != nil {
+		return matchCampaignErrorResponse(c, "Failed to create match campaign", err)
+	}
+
+	return c.JSON(http.StatusCreated, utils.SuccessResponse("Match campaign created successfully", campaign))
+}
+
+// UpdateCampaign replaces a matching campaign's terms. Once it has matched a donation, its
+// budget cannot go below what it spent and its currency is fixed.
+func (h *MatchCampaignHandler) UpdateCampaign(c echo.Context) error {
+	campaignID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid campaign ID", err))
+	}
+
+	var body MatchCampaignBody
+	if err := c.Bind(&body); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	campaign, err := h.matchCampaignService.UpdateCampaign(uint(campaignID), body.toRequest())
+	if err != nil {
+		return matchCampaignErrorResponse(c, "Failed to update match campaign", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Match campaign updated successfully", campaign))
+}
+
+// GetCampaign returns a matching campaign
+func (h *MatchCampaignHandler) GetCampaign(c echo.Context) error {
+	campaignID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid campaign ID", err))
+	}
+
+	campaign, err := h.matchCampaignService.GetCampaign(uint(campaignID))
+	if err != nil {
+		return matchCampaignErrorResponse(c, "Failed to fetch match campaign", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Match campaign fetched successfully", campaign))
+}
+
+// ListCampaigns lists matching campaigns, newest first. Query params: page and pageSize.
+func (h *MatchCampaignHandler) ListCampaigns(c echo.Context) error {
+	page, pageSize := parsePayoutPage(c)
+	campaigns, err := h.matchCampaignService.ListCampaigns(page, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch match campaigns", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Match campaigns fetched successfully", campaigns))
+}
+
+// GetReport tells the sponsor, or an admin, how a campaign's budget was spent per streamer
+func (h *MatchCampaignHandler) GetReport(c echo.Context) error {
+	campaignID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid campaign ID", err))
+	}
+
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Unauthorized", nil))
+	}
+
+	report, err := h.matchCampaignService.GetReport(uint(campaignID))
+	// Other users are told the campaign does not exist rather than that it is not theirs
+	if err == nil && !h.admins[userID] && (report.Campaign == nil || report.Campaign.SponsorUserID != userID) {
+		err = gorm.ErrRecordNotFound
+	}
+	if err != nil {
+		return matchCampaignErrorResponse(c, "Failed to fetch match campaign report", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Match campaign report fetched successfully", report))
+}
+
+func (body *MatchCampaignBody) toRequest() *service.MatchCampaignRequest {
+	isActive := true
+	if body.IsActive != nil {
+		isActive = *body.IsActive
+	}
+	return &service.MatchCampaignRequest{
+		Name:             body.Name,
+		SponsorName:      body.SponsorName,
+		SponsorUserID:    body.SponsorUserID,
+		StreamerIDs:      body.StreamerIDs,
+		Currency:         body.Currency,
+		RatioBasisPoints: body.RatioBasisPoints,
+		MaxMatchAmount:   body.MaxMatchAmount,
+		Budget:           body.Budget,
+		StartsAt:         body.StartsAt,
+		EndsAt:           body.EndsAt,
+		IsActive:         isActive,
+	}
+}
+
+// matchCampaignErrorResponse answers with the status code matching a match campaign
+// service error
+func matchCampaignErrorResponse(c echo.Context, message string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidMatchCampaign):
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse(message, err))
+	case errors.Is(err, service.ErrMatchCampaignSpent):
+		return c.JSON(http.StatusConflict, utils.ErrorResponse(message, err))
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Match campaign not found", err))
+	default:
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse(message, err))
+	}
+}
@@ -0,0 +1,244 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+type MembershipHandler struct {
+	membershipService service.MembershipService
+}
+
+func NewMembershipHandler(membershipService service.MembershipService) *MembershipHandler {
+	return &MembershipHandler{membershipService: membershipService}
+}
+
+// MembershipTierRequest is the body for creating or updating a tier. The price is in minor
+// units of the currency.
+type MembershipTierRequest struct {
+	Name         string `json:"name"`
+	Perks        string `json:"perks"`
+	MonthlyPrice int64  `json:"monthly_price"`
+	Currency     string `json:"currency"`
+	IsActive     *bool  `json:"is_active"`
+}
+
+// SubscribeResponse returns the new membership with the donation to pay for it
+type SubscribeResponse struct {
+	Membership *models.Membership `json:"membership"`
+	Donation   *models.Donation   `json:"donation"`
+}
+
+// ListTiers lists a streamer's active membership tiers
+func (h *MembershipHandler) ListTiers(c echo.Context) error {
+	streamerID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid streamer ID", err))
+	}
+
+	tiers, err := h.membershipService.GetTiersByStreamer(uint(streamerID), true)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch membership tiers", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Membership tiers fetched successfully", tiers))
+}
+
+// CreateTier creates a membership tier for the authenticated streamer
+func (h *MembershipHandler) CreateTier(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	var req MembershipTierRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	tier := &models.MembershipTier{
+		StreamerID:   streamerID,
+		Name:         req.Name,
+		Perks:        req.Perks,
+		MonthlyPrice: req.MonthlyPrice,
+		Currency:     models.SupportedCurrency(req.Currency),
+	}
+
+	if err := h.membershipService.CreateTier(tier); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to create membership tier", err))
+	}
+
+	return c.JSON(http.StatusCreated, utils.SuccessResponse("Membership tier created successfully", tier))
+}
+
+// UpdateTier updates a tier; price changes apply from the next renewal
+func (h *MembershipHandler) UpdateTier(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	tierID, err := strconv.ParseUint(c.Param("tierId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid tier ID", err))
+	}
+
+	var req MembershipTierRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	tier := &models.MembershipTier{
+		StreamerID:   streamerID,
+		Name:         req.Name,
+		Perks:        req.Perks,
+		MonthlyPrice: req.MonthlyPrice,
+		Currency:     models.SupportedCurrency(req.Currency),
+		IsActive:     true,
+	}
+	tier.ID = uint(tierID)
+	if req.IsActive != nil {
+		tier.IsActive = *req.IsActive
+	}
+
+	if err := h.membershipService.UpdateTier(tier); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to update membership tier", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Membership tier updated successfully", tier))
+}
+
+// GetStreamerMembers lists the memberships of the authenticated streamer
+func (h *MembershipHandler) GetStreamerMembers(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page <= 0 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(c.QueryParam("pageSize"))
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	memberships, err := h.membershipService.GetMembershipsByStreamer(streamerID, page, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch members", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Members fetched successfully", memberships))
+}
+
+// Subscribe starts a membership. The returned donation is paid through the usual
+// Midtrans or QRIS endpoints; the membership activates once it completes.
+func (h *MembershipHandler) Subscribe(c echo.Context) error {
+	var req service.SubscribeRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Unauthorized", nil))
+	}
+	req.MemberID = userID
+
+	membership, donation, err := h.membershipService.Subscribe(&req)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to subscribe", err))
+	}
+
+	return c.JSON(http.StatusCreated, utils.SuccessResponse("Membership created, awaiting payment", &SubscribeResponse{
+		Membership: membership,
+		Donation:   donation,
+	}))
+}
+
+// GetMyMemberships lists the authenticated user's memberships
+func (h *MembershipHandler) GetMyMemberships(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Unauthorized", nil))
+	}
+
+	memberships, err := h.membershipService.GetMembershipsByMember(userID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch memberships", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Memberships fetched successfully", memberships))
+}
+
+// GetMembership gets a membership visible to its member or streamer
+func (h *MembershipHandler) GetMembership(c echo.Context) error {
+	membership, err := h.getAuthorizedMembership(c)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Membership not found", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Membership found", membership))
+}
+
+// GetMembershipPayments lists the donations that paid for a membership's periods
+func (h *MembershipHandler) GetMembershipPayments(c echo.Context) error {
+	membership, err := h.getAuthorizedMembership(c)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Membership not found", err))
+	}
+
+	payments, err := h.membershipService.GetPayments(membership.ID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch membership payments", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Membership payments fetched successfully", payments))
+}
+
+// CancelMembership cancels the authenticated user's membership
+func (h *MembershipHandler) CancelMembership(c echo.Context) error {
+	membershipID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid membership ID", err))
+	}
+
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Unauthorized", nil))
+	}
+
+	membership, err := h.membershipService.Cancel(userID, uint(membershipID))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to cancel membership", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Membership cancelled successfully", membership))
+}
+
+func (h *MembershipHandler) getAuthorizedMembership(c echo.Context) (*models.Membership, error) {
+	membershipID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	membership, err := h.membershipService.GetMembership(uint(membershipID))
+	if err != nil {
+		return nil, err
+	}
+
+	userID, _ := c.Get("user_id").(uint)
+	if membership.MemberID != userID && membership.StreamerID != userID {
+		return nil, errors.New("membership not found")
+	}
+
+	return membership, nil
+}
@@ -0,0 +1,174 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type ModerationHandler struct {
+	moderationService service.ModerationService
+}
+
+func NewModerationHandler(moderationService service.ModerationService) *ModerationHandler {
+	return &ModerationHandler{moderationService: moderationService}
+}
+
+// UpdateModerationSettingsRequest is the body for updating moderation settings; omitted fields are left unchanged
+type UpdateModerationSettingsRequest struct {
+	Enabled          *bool     `json:"enabled"`
+	BannedWords      *[]string `json:"banned_words"`
+	BannedPatterns   *[]string `json:"banned_patterns"`
+	StripLinks       *bool     `json:"strip_links"`
+	MaxMessageLength *int      `json:"max_message_length"`
+	Action           *string   `json:"action"`
+}
+
+// GetModerationSettings returns the authenticated streamer's moderation settings
+func (h *ModerationHandler) GetModerationSettings(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	settings, err := h.moderationService.GetSettings(streamerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch moderation settings", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Moderation settings fetched successfully", settings))
+}
+
+// UpdateModerationSettings updates the authenticated streamer's moderation settings
+func (h *ModerationHandler) UpdateModerationSettings(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	var req UpdateModerationSettingsRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	settings, err := h.moderationService.GetSettings(streamerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch moderation settings", err))
+	}
+
+	if req.Enabled != nil {
+		settings.Enabled = *req.Enabled
+	}
+	if req.BannedWords != nil {
+		settings.BannedWords = *req.BannedWords
+	}
+	if req.BannedPatterns != nil {
+		settings.BannedPatterns = *req.BannedPatterns
+	}
+	if req.StripLinks != nil {
+		settings.StripLinks = *req.StripLinks
+	}
+	if req.MaxMessageLength != nil {
+		settings.MaxMessageLength = *req.MaxMessageLength
+	}
+	if req.Action != nil {
+		action := models.ModerationAction(*req.Action)
+		if !action.IsValid() {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid action", fmt.Errorf("action must be mask, hold or reject")))
+		}
+		settings.Action = action
+	}
+
+	updated, err := h.moderationService.UpdateSettings(settings)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to update moderation settings", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Moderation settings updated successfully", updated))
+}
+
+// GetReviewQueue lists held messages; status is pending (default), approved, rejected or all
+func (h *ModerationHandler) GetReviewQueue(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	var status models.MessageReviewStatus
+	switch c.QueryParam("status") {
+	case "", string(models.ReviewPending):
+		status = models.ReviewPending
+	case string(models.ReviewApproved), string(models.ReviewRejected):
+		status = models.MessageReviewStatus(c.QueryParam("status"))
+	case "all":
+		status = ""
+	default:
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid status", fmt.Errorf("unknown review status %q", c.QueryParam("status"))))
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil || page < 1 {
+		page = 1
+	}
+
+	pageSize, err := strconv.Atoi(c.QueryParam("page_size"))
+	if err != nil || pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+
+	reviews, total, err := h.moderationService.GetReviewQueue(streamerID, status, page, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch review queue", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Review queue fetched successfully", map[string]interface{}{
+		"items":       reviews,
+		"total":       total,
+		"page":        page,
+		"page_size":   pageSize,
+		"total_pages": (total + int64(pageSize) - 1) / int64(pageSize),
+	}))
+}
+
+// ApproveMessage shows a held message on its donation
+func (h *ModerationHandler) ApproveMessage(c echo.Context) error {
+	return h.resolveReview(c, h.moderationService.ApproveMessage, "Message approved successfully")
+}
+
+// RejectMessage keeps a held message hidden
+func (h *ModerationHandler) RejectMessage(c echo.Context) error {
+	return h.resolveReview(c, h.moderationService.RejectMessage, "Message rejected successfully")
+}
+
+func (h *ModerationHandler) resolveReview(c echo.Context, resolve func(streamerID, reviewID uint) (*models.MessageReview, error), message string) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	reviewID, err := strconv.ParseUint(c.Param("reviewId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid review ID", err))
+	}
+
+	review, err := resolve(streamerID, uint(reviewID))
+	if err != nil {
+		switch {
+		case errors.Is(err, gorm.ErrRecordNotFound):
+			return c.JSON(http.StatusNotFound, utils.ErrorResponse("Message review not found", err))
+		case errors.Is(err, service.ErrReviewAlreadyResolved):
+			return c.JSON(http.StatusConflict, utils.ErrorResponse("Message review already resolved", err))
+		default:
+			return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to resolve message review", err))
+		}
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse(message, review))
+}
@@ -0,0 +1,176 @@
+package handler
+
+import (
+	"embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+// overlayPages holds one self-contained page per overlay widget
+//
+//go:embed overlay/*.html
+var overlayPages embed.FS
+
+// overlayKeepAliveInterval keeps proxies from closing idle overlay event streams
+const overlayKeepAliveInterval = 15 * time.Second
+
+type OverlayHandler struct {
+	overlayService service.OverlayService
+}
+
+func NewOverlayHandler(overlayService service.OverlayService) *OverlayHandler {
+	return &OverlayHandler{overlayService: overlayService}
+}
+
+// GetOverlayToken returns the authenticated streamer's overlay token and the browser
+// source URL of each overlay
+func (h *OverlayHandler) GetOverlayToken(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	token, err := h.overlayService.GetToken(streamerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch overlay token", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Overlay token fetched successfully", overlayTokenResponse(c, token)))
+}
+
+// RotateOverlayToken replaces the streamer's overlay token, e.g. after it leaked on stream.
+// Browser sources using the old URLs stop receiving events.
+func (h *OverlayHandler) RotateOverlayToken(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	token, err := h.overlayService.RotateToken(streamerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to rotate overlay token", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Overlay token rotated successfully", overlayTokenResponse(c, token)))
+}
+
+// ServeOverlay serves the page of an overlay widget, meant to be added as an OBS browser source
+func (h *OverlayHandler) ServeOverlay(c echo.Context) error {
+	if _, err := h.overlayService.ResolveToken(c.Param("token")); err != nil {
+		return overlayTokenError(c, err)
+	}
+
+	widget := models.OverlayWidget(c.Param("widget"))
+	if !widget.IsValid() {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Overlay not found", nil))
+	}
+
+	page, err := overlayPages.ReadFile("overlay/" + string(widget) + ".html")
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to load overlay", err))
+	}
+
+	// The token is in the URL, so keep it out of caches and Referer headers
+	c.Response().Header().Set("Cache-Control", "no-store")
+	c.Response().Header().Set("Referrer-Policy", "no-referrer")
+	return c.HTMLBlob(http.StatusOK, page)
+}
+
+// StreamOverlayEvents pushes the streamer's overlay events as server-sent events, named
+// after their type (alert, goals, media, leaderboard) with the event as JSON data. The
+// stream ends when an upstream service goes away; EventSource clients reconnect on their own.
+func (h *OverlayHandler) StreamOverlayEvents(c echo.Context) error {
+	streamerID, err := h.overlayService.ResolveToken(c.Param("token"))
+	if err != nil {
+		return overlayTokenError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	events, err := h.overlayService.Subscribe(ctx, streamerID)
+	if err != nil {
+		return c.JSON(http.StatusServiceUnavailable, utils.ErrorResponse("Overlay events are unavailable", err))
+	}
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set("Cache-Control", "no-store")
+	res.Header().Set("Connection", "keep-alive")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+	fmt.Fprint(res, "retry: 5000\n\n")
+	res.Flush()
+
+	keepAlive := time.NewTicker(overlayKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case event, ok := <-events:
+			if !ok {
+				return nil
+			}
+			data, err := json.Marshal(event)
+			if err != nil {
+				fmt.Printf("Warning: Failed to encode %s overlay event: %v\n", event.Type, err)
+				continue
+			}
+			fmt.Fprintf(res, "event: %s\ndata: %s\n\n", event.Type, data)
+			res.Flush()
+
+		case <-keepAlive.C:
+			fmt.Fprint(res, ": keep-alive\n\n")
+			res.Flush()
+
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// MarkMediaPlayed is called by the media player overlay when an item has finished playing
+func (h *OverlayHandler) MarkMediaPlayed(c echo.Context) error {
+	streamerID, err := h.overlayService.ResolveToken(c.Param("token"))
+	if err != nil {
+		return overlayTokenError(c, err)
+	}
+
+	mediaID, err := strconv.ParseUint(c.Param("media_id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid media ID", err))
+	}
+
+	if err := h.overlayService.MarkMediaPlayed(streamerID, uint(mediaID)); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to mark media as played", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Media marked as played", nil))
+}
+
+// overlayTokenError responds to a token that could not be resolved
+func overlayTokenError(c echo.Context, err error) error {
+	if errors.Is(err, service.ErrInvalidOverlayToken) {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Overlay not found", err))
+	}
+	return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to resolve overlay token", err))
+}
+
+func overlayTokenResponse(c echo.Context, token *models.OverlayToken) map[string]interface{} {
+	urls := make(map[models.OverlayWidget]string, len(models.OverlayWidgets))
+	for _, widget := range models.OverlayWidgets {
+		urls[widget] = fmt.Sprintf("%s://%s/overlay/%s/%s", c.Scheme(), c.Request().Host, token.Token, widget)
+	}
+
+	return map[string]interface{}{
+		"token": token.Token,
+		"urls":  urls,
+	}
+}
@@ -0,0 +1,264 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+	"gorm.io/gorm"
+)
+
+type PayoutHandler struct {
+	payoutService service.PayoutService
+}
+
+func NewPayoutHandler(payoutService service.PayoutService) *PayoutHandler {
+	return &PayoutHandler{payoutService: payoutService}
+}
+
+// PayoutAccountRequest is the body for registering a payout account. Channel is a bank or
+// e-wallet code from GetChannels; e-wallet account numbers are the wallet's phone number.
+type PayoutAccountRequest struct {
+	Channel       string `json:"channel"`
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+}
+
+// PayoutRequest is the body for withdrawing from the balance. The amount is in minor
+// units of the currency, which defaults to the payout currency.
+type PayoutRequest struct {
+	AccountID uint                     `json:"account_id"`
+	Amount    int64                    `json:"amount"`
+	Currency  models.SupportedCurrency `json:"currency"`
+}
+
+// PayoutReviewRequest is the body of the admin actions on a payout
+type PayoutReviewRequest struct {
+	Reason            string `json:"reason"`             // Why it was rejected or failed
+	ProviderReference string `json:"provider_reference"` // Transfer reference when marking it paid
+}
+
+// GetChannels lists the banks and e-wallets payouts can be sent to
+func (h *PayoutHandler) GetChannels(c echo.Context) error {
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Payout channels fetched successfully", models.PayoutChannels()))
+}
+
+// GetAccounts lists the authenticated streamer's payout accounts
+func (h *PayoutHandler) GetAccounts(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	accounts, err := h.payoutService.GetAccounts(streamerID)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch payout accounts", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Payout accounts fetched successfully", accounts))
+}
+
+// CreateAccount registers a bank account or e-wallet for the authenticated streamer
+func (h *PayoutHandler) CreateAccount(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	var req PayoutAccountRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	account, err := h.payoutService.CreateAccount(&service.CreatePayoutAccountRequest{
+		StreamerID:    streamerID,
+		Channel:       req.Channel,
+		AccountNumber: req.AccountNumber,
+		AccountName:   req.AccountName,
+	})
+	if err != nil {
+		return payoutErrorResponse(c, "Failed to create payout account", err)
+	}
+
+	return c.JSON(http.StatusCreated, utils.SuccessResponse("Payout account created successfully", account))
+}
+
+// DeleteAccount removes one of the authenticated streamer's payout accounts
+func (h *PayoutHandler) DeleteAccount(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	accountID, err := strconv.ParseUint(c.Param("accountId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid account ID", err))
+	}
+
+	if err := h.payoutService.DeleteAccount(streamerID, uint(accountID)); err != nil {
+		return payoutErrorResponse(c, "Failed to delete payout account", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Payout account deleted successfully", nil))
+}
+
+// RequestPayout withdraws part of the authenticated streamer's balance to one of their
+// accounts. The amount is held from the balance until an admin reviews the payout.
+func (h *PayoutHandler) RequestPayout(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	var req PayoutRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	payout, err := h.payoutService.RequestPayout(&service.RequestPayoutRequest{
+		StreamerID: streamerID,
+		AccountID:  req.AccountID,
+		Amount:     req.Amount,
+		Currency:   req.Currency,
+	})
+	if err != nil {
+		return payoutErrorResponse(c, "Failed to request payout", err)
+	}
+
+	return c.JSON(http.StatusCreated, utils.SuccessResponse("Payout requested successfully", payout))
+}
+
+// GetPayouts lists the authenticated streamer's payouts, newest first
+func (h *PayoutHandler) GetPayouts(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	page, pageSize := parsePayoutPage(c)
+	payouts, err := h.payoutService.GetStreamerPayouts(streamerID, page, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch payouts", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Payouts fetched successfully", payouts))
+}
+
+// GetPayout returns one of the authenticated streamer's payouts
+func (h *PayoutHandler) GetPayout(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	payoutID, err := strconv.ParseUint(c.Param("payoutId"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid payout ID", err))
+	}
+
+	payout, err := h.payoutService.GetPayout(uint(payoutID))
+	if err == nil && payout.StreamerID != streamerID {
+		err = gorm.ErrRecordNotFound
+	}
+	if err != nil {
+		return payoutErrorResponse(c, "Failed to fetch payout", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Payout fetched successfully", payout))
+}
+
+// ListPayouts lists payouts for admins, oldest first. Query params: status (requested,
+// processing, paid, failed, rejected; all when empty), page and pageSize.
+func (h *PayoutHandler) ListPayouts(c echo.Context) error {
+	page, pageSize := parsePayoutPage(c)
+	payouts, err := h.payoutService.ListPayouts(models.PayoutStatus(c.QueryParam("status")), page, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch payouts", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Payouts fetched successfully", payouts))
+}
+
+// ApprovePayout approves a requested payout and sends it through the disbursement
+// provider, if one is configured
+func (h *PayoutHandler) ApprovePayout(c echo.Context) error {
+	return h.reviewPayout(c, "Payout approved successfully", func(payoutID, adminID uint, req *PayoutReviewRequest) (*models.Payout, error) {
+		return h.payoutService.ApprovePayout(payoutID, adminID)
+	})
+}
+
+// RejectPayout declines a requested payout and returns its amount to the balance
+func (h *PayoutHandler) RejectPayout(c echo.Context) error {
+	return h.reviewPayout(c, "Payout rejected successfully", func(payoutID, adminID uint, req *PayoutReviewRequest) (*models.Payout, error) {
+		return h.payoutService.RejectPayout(payoutID, adminID, req.Reason)
+	})
+}
+
+// MarkPayoutPaid records that a processing payout reached the streamer
+func (h *PayoutHandler) MarkPayoutPaid(c echo.Context) error {
+	return h.reviewPayout(c, "Payout marked as paid", func(payoutID, adminID uint, req *PayoutReviewRequest) (*models.Payout, error) {
+		return h.payoutService.CompletePayout(payoutID, req.ProviderReference)
+	})
+}
+
+// MarkPayoutFailed records that a processing payout could not be sent and returns its
+// amount to the balance
+func (h *PayoutHandler) MarkPayoutFailed(c echo.Context) error {
+	return h.reviewPayout(c, "Payout marked as failed", func(payoutID, adminID uint, req *PayoutReviewRequest) (*models.Payout, error) {
+		return h.payoutService.FailPayout(payoutID, req.Reason)
+	})
+}
+
+func (h *PayoutHandler) reviewPayout(c echo.Context, message string, review func(payoutID, adminID uint, req *PayoutReviewRequest) (*models.Payout, error)) error {
+	payoutID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid payout ID", err))
+	}
+
+	adminID, ok := c.Get("user_id").(uint)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Unauthorized", nil))
+	}
+
+	var req PayoutReviewRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	payout, err := review(uint(payoutID), adminID, &req)
+	if err != nil {
+		return payoutErrorResponse(c, "Failed to update payout", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse(message, payout))
+}
+
+func parsePayoutPage(c echo.Context) (int, int) {
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page <= 0 {
+		page = 1
+	}
+	pageSize, _ := strconv.Atoi(c.QueryParam("pageSize"))
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	return page, pageSize
+}
+
+// payoutErrorResponse answers with the status code matching a payout service error
+func payoutErrorResponse(c echo.Context, message string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidPayoutRequest):
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse(message, err))
+	case errors.Is(err, service.ErrPayoutStatusConflict):
+		return c.JSON(http.StatusConflict, utils.ErrorResponse(message, err))
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Payout or payout account not found", err))
+	default:
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse(message, err))
+	}
+}
@@ -1,10 +1,12 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
 	"github.com/rzfd/mediashar/internal/service"
 	"github.com/rzfd/mediashar/pkg/utils"
 )
@@ -88,7 +90,7 @@ func (h *QRISHandler) QRISCallback(c echo.Context) error {
 // CreateQRISDonation creates a donation and immediately generates QRIS
 func (h *QRISHandler) CreateQRISDonation(c echo.Context) error {
 	var req struct {
-		Amount      float64 `json:"amount" validate:"required,min=1000"`
+		Amount      int64   `json:"amount" validate:"required,min=1000"` // Minor units
 		Currency    string  `json:"currency" validate:"required"`
 		Message     string  `json:"message"`
 		StreamerID  uint    `json:"streamer_id" validate:"required"`
@@ -108,14 +110,25 @@ func (h *QRISHandler) CreateQRISDonation(c echo.Context) error {
 
 	// Create donation
 	donation, err := h.donationService.CreateDonation(&service.CreateDonationRequest{
-		Amount:      req.Amount,
-		Currency:    req.Currency,
-		Message:     req.Message,
-		StreamerID:  req.StreamerID,
-		DonatorID:   donatorID,
-		DisplayName: req.DisplayName,
-		IsAnonymous: req.IsAnonymous,
+		Amount:          req.Amount,
+		Currency:        req.Currency,
+		Message:         req.Message,
+		StreamerID:      req.StreamerID,
+		DonatorID:       donatorID,
+		DisplayName:     req.DisplayName,
+		IsAnonymous:     req.IsAnonymous,
+		PaymentProvider: models.PaymentProviderQRIS,
+		Client:          donationClient(c),
 	})
+	if errors.Is(err, service.ErrMessageRejected) {
+		return c.JSON(http.StatusUnprocessableEntity, utils.ErrorResponse("Donation message was rejected", err))
+	}
+	if errors.Is(err, service.ErrDonationBlocked) {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Donation was declined", err))
+	}
+	if errors.Is(err, service.ErrDonationChallenged) {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Sign in to continue this donation", err))
+	}
 	if err != nil {
 		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to create donation", err))
 	}
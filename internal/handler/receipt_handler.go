@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+type ReceiptHandler struct {
+	receiptService  service.ReceiptService
+	donationService service.DonationService
+	publicBaseURL   string // Links receipts to their verification page, none when empty
+}
+
+// NewReceiptHandler creates the receipt handler. publicBaseURL is the gateway's public
+// address, e.g. https://mediashar.example; receipts carry no verification URL without it.
+func NewReceiptHandler(receiptService service.ReceiptService, donationService service.DonationService, publicBaseURL string) *ReceiptHandler {
+	return &ReceiptHandler{
+		receiptService:  receiptService,
+		donationService: donationService,
+		publicBaseURL:   strings.TrimRight(publicBaseURL, "/"),
+	}
+}
+
+// DownloadReceipt returns a completed donation's receipt to its donor or streamer.
+// Query params: format (html, default, or pdf) and lang (id, en or zh; the donor's
+// preferred language when empty).
+func (h *ReceiptHandler) DownloadReceipt(c echo.Context) error {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid donation ID", err))
+	}
+
+	format := models.ReceiptFormat(c.QueryParam("format"))
+	if format == "" {
+		format = models.ReceiptHTML
+	}
+	if !format.IsValid() {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid format, use html or pdf", nil))
+	}
+	language := models.SupportedLanguage(c.QueryParam("lang"))
+	if language != "" {
+		if err := service.ValidateLanguage(language); err != nil {
+			return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid language", err))
+		}
+	}
+
+	// Authorize before building the receipt, so its errors say nothing about other
+	// people's donations
+	donation, err := h.donationService.GetByID(uint(id))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation not found", err))
+	}
+	userID, _ := c.Get("user_id").(uint)
+	if donation.StreamerID != userID && (donation.DonatorID == 0 || donation.DonatorID != userID) {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", nil))
+	}
+
+	receipt, err := h.receiptService.GetReceipt(donation.ID, language)
+	if err != nil {
+		return receiptErrorResponse(c, "Failed to fetch receipt", err)
+	}
+	if h.publicBaseURL != "" {
+		receipt.VerifyURL = fmt.Sprintf("%s/api/receipts/verify/%s", h.publicBaseURL, receipt.VerificationCode)
+	}
+
+	var body bytes.Buffer
+	if err := h.receiptService.RenderReceipt(receipt, format, &body); err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to render receipt", err))
+	}
+
+	contentType, disposition := echo.MIMETextHTMLCharsetUTF8, "inline"
+	if format == models.ReceiptPDF {
+		contentType, disposition = "application/pdf", "attachment"
+	}
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("%s; filename=%q", disposition, fmt.Sprintf("receipt-%d.%s", receipt.DonationID, format)))
+	c.Response().Header().Set("Cache-Control", "private, no-store")
+	return c.Blob(http.StatusOK, contentType, body.Bytes())
+}
+
+// VerifyReceipt checks a receipt's verification code, so anyone handed a receipt can tell
+// it was issued for a real donation. valid is false once the donation was refunded in full.
+func (h *ReceiptHandler) VerifyReceipt(c echo.Context) error {
+	verification, err := h.receiptService.VerifyReceipt(c.Param("code"))
+	if err != nil {
+		return receiptErrorResponse(c, "Failed to verify receipt", err)
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Receipt verified", verification))
+}
+
+// receiptErrorResponse answers with the status code matching a receipt service error
+func receiptErrorResponse(c echo.Context, message string, err error) error {
+	switch {
+	case errors.Is(err, service.ErrInvalidReceiptCode):
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("No receipt was issued with this verification code", err))
+	case errors.Is(err, service.ErrDonationNotFound):
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation not found", err))
+	case errors.Is(err, service.ErrReceiptUnavailable):
+		return c.JSON(http.StatusConflict, utils.ErrorResponse(message, err))
+	default:
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse(message, err))
+	}
+}
@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+type RefundHandler struct {
+	refundService   service.RefundService
+	donationService service.DonationService
+}
+
+func NewRefundHandler(refundService service.RefundService, donationService service.DonationService) *RefundHandler {
+	return &RefundHandler{
+		refundService:   refundService,
+		donationService: donationService,
+	}
+}
+
+// RefundRequest is the body for refunding a donation. The amount is in minor units of the
+// donation's currency; 0 refunds the rest. Chargebacks are not accepted here; they are
+// recorded from the payment provider's notifications only.
+type RefundRequest struct {
+	Amount int64  `json:"amount"`
+	Reason string `json:"reason"`
+	Manual bool   `json:"manual"`
+}
+
+// RefundDonation refunds a donation received by the authenticated streamer
+func (h *RefundHandler) RefundDonation(c echo.Context) error {
+	donationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid donation ID", err))
+	}
+
+	var req RefundRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Unauthorized", nil))
+	}
+
+	donation, err := h.donationService.GetByID(uint(donationID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation not found", err))
+	}
+	if donation.StreamerID != userID {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", errors.New("only the receiving streamer can refund this donation")))
+	}
+
+	return h.refund(c, uint(donationID), &req, userID, models.StatusSourceUser)
+}
+
+// AdminRefundDonation refunds any donation on behalf of an admin
+func (h *RefundHandler) AdminRefundDonation(c echo.Context) error {
+	donationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid donation ID", err))
+	}
+
+	var req RefundRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	adminID, ok := c.Get("user_id").(uint)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Unauthorized", nil))
+	}
+
+	if _, err := h.donationService.GetByID(uint(donationID)); err != nil {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation not found", err))
+	}
+
+	return h.refund(c, uint(donationID), &req, adminID, models.StatusSourceAdmin)
+}
+
+func (h *RefundHandler) refund(c echo.Context, donationID uint, req *RefundRequest, requestedBy uint, source models.StatusChangeSource) error {
+	refund, donation, err := h.refundService.RefundDonation(&service.RefundDonationRequest{
+		DonationID:  donationID,
+		Amount:      req.Amount,
+		Reason:      req.Reason,
+		RequestedBy: requestedBy,
+		Manual:      req.Manual,
+		Source:      source,
+	})
+	switch {
+	case errors.Is(err, service.ErrDonationNotFound):
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation not found", err))
+	case errors.Is(err, service.ErrInvalidRefundAmount):
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid refund amount", err))
+	case errors.Is(err, service.ErrRefundExceedsRefundable), errors.Is(err, service.ErrRefundNotAllowed):
+		return c.JSON(http.StatusConflict, utils.ErrorResponse("Donation cannot be refunded", err))
+	case errors.Is(err, service.ErrRefundProviderFailed):
+		return c.JSON(http.StatusBadGateway, utils.ErrorResponse("Payment provider failed to refund the donation", err))
+	case err != nil:
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to refund donation", err))
+	}
+
+	// The provider refunded but recording it failed; it is recorded in the background
+	if refund.Status == models.RefundPending {
+		return c.JSON(http.StatusAccepted, utils.SuccessResponse("Refund is being processed", map[string]interface{}{
+			"refund":   refund,
+			"donation": donation,
+		}))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Donation refunded successfully", map[string]interface{}{
+		"refund":   refund,
+		"donation": donation,
+	}))
+}
+
+// GetDonationRefunds lists refunds of a donation for its streamer or donator
+func (h *RefundHandler) GetDonationRefunds(c echo.Context) error {
+	donationID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid donation ID", err))
+	}
+
+	donation, err := h.donationService.GetByID(uint(donationID))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Donation not found", err))
+	}
+
+	userID, _ := c.Get("user_id").(uint)
+	if donation.StreamerID != userID && donation.DonatorID != userID {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", nil))
+	}
+
+	refunds, err := h.refundService.GetRefundsByDonation(uint(donationID))
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch refunds", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Refunds fetched successfully", refunds))
+}
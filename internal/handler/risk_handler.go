@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+type RiskHandler struct {
+	riskReviewService service.RiskReviewService
+}
+
+func NewRiskHandler(riskReviewService service.RiskReviewService) *RiskHandler {
+	return &RiskHandler{riskReviewService: riskReviewService}
+}
+
+// ListAssessments lists screened donation requests for admins, newest first. Query params:
+// decision (allow, challenge, block; all when empty), page and pageSize.
+func (h *RiskHandler) ListAssessments(c echo.Context) error {
+	page, pageSize := parsePayoutPage(c)
+	assessments, err := h.riskReviewService.ListAssessments(models.RiskDecision(c.QueryParam("decision")), page, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch risk assessments", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Risk assessments fetched successfully", assessments))
+}
+
+// donationClient describes the donor's request for fraud screening: their address and
+// the country a trusted CDN edge saw it from, both set up by the gateway's trusted proxy
+// configuration, and the signed-in user, if any
+func donationClient(c echo.Context) *service.DonationClient {
+	client := &service.DonationClient{IPAddress: c.RealIP()}
+	if country, ok := c.Get("client_country").(string); ok {
+		client.Country = country
+	}
+	if userID, ok := c.Get("user_id").(uint); ok {
+		client.UserID = userID
+	}
+	return client
+}
@@ -0,0 +1,183 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+type SplitDonationHandler struct {
+	splitDonationService service.SplitDonationService
+	midtransService      service.MidtransService
+	qrisService          service.QRISService
+}
+
+func NewSplitDonationHandler(splitDonationService service.SplitDonationService, midtransService service.MidtransService, qrisService service.QRISService) *SplitDonationHandler {
+	return &SplitDonationHandler{
+		splitDonationService: splitDonationService,
+		midtransService:      midtransService,
+		qrisService:          qrisService,
+	}
+}
+
+// CreateSplitDonationResponse returns the new split donation with the payment for it:
+// a Snap transaction for Midtrans or a QR code for QRIS
+type CreateSplitDonationResponse struct {
+	SplitDonation *models.SplitDonation            `json:"split_donation"`
+	Midtrans      *service.MidtransPaymentResponse `json:"midtrans,omitempty"`
+	QRIS          *service.QRISResponse            `json:"qris,omitempty"`
+}
+
+// CreateSplitDonation creates a donation split between several streamers and the single
+// payment that pays all of them
+func (h *SplitDonationHandler) CreateSplitDonation(c echo.Context) error {
+	var req service.CreateSplitDonationRequest
+	if err := c.Bind(&req); err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid request", err))
+	}
+
+	// Get donator ID from JWT (optional for anonymous donations)
+	req.DonatorID = nil
+	if userID, ok := c.Get("user_id").(uint); ok && !req.IsAnonymous {
+		req.DonatorID = &userID
+	}
+	req.Client = donationClient(c)
+
+	split, err := h.splitDonationService.CreateSplitDonation(&req)
+	if errors.Is(err, service.ErrMessageRejected) {
+		return c.JSON(http.StatusUnprocessableEntity, utils.ErrorResponse("Donation message was rejected", err))
+	}
+	if errors.Is(err, service.ErrDonationBlocked) {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Donation was declined", err))
+	}
+	if errors.Is(err, service.ErrDonationChallenged) {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Sign in to continue this donation", err))
+	}
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to create split donation", err))
+	}
+
+	response := &CreateSplitDonationResponse{SplitDonation: split}
+	description := fmt.Sprintf("Donation split between %d streamers", len(split.Shares))
+	switch split.PaymentProvider {
+	case models.PaymentProviderMidtrans:
+		response.Midtrans, err = h.midtransService.CreateSnapTransaction(&service.MidtransPaymentRequest{
+			OrderID:       split.TransactionID,
+			Amount:        split.Money(),
+			CustomerName:  split.DisplayName,
+			CustomerEmail: "donor@mediashar.com", // Default email, as for single donations
+			Description:   description,
+		})
+	case models.PaymentProviderQRIS:
+		response.QRIS, err = h.qrisService.GenerateQRISPayment(split.Money(), split.TransactionID, description)
+	}
+	if err != nil {
+		// Nobody can pay the shares now, so fail them rather than let them wait to expire
+		if settleErr := h.splitDonationService.SettlePayment(split.ID, models.PaymentFailed, service.StatusChange{
+			Source: models.StatusSourceSystem,
+			Reason: "payment could not be created",
+		}); settleErr != nil {
+			fmt.Printf("Warning: Failed to fail split donation %d: %v\n", split.ID, settleErr)
+		}
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to create payment", err))
+	}
+
+	return c.JSON(http.StatusCreated, utils.SuccessResponse("Split donation created successfully", response))
+}
+
+// GetSplitDonation returns a split donation with each share's donation, for its donator
+// and every streamer with a share in it
+func (h *SplitDonationHandler) GetSplitDonation(c echo.Context) error {
+	splitID, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Invalid split donation ID", err))
+	}
+
+	split, err := h.splitDonationService.GetSplitDonation(uint(splitID), false)
+	if errors.Is(err, service.ErrSplitDonationNotFound) {
+		return c.JSON(http.StatusNotFound, utils.ErrorResponse("Split donation not found", err))
+	}
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch split donation", err))
+	}
+
+	userID, _ := c.Get("user_id").(uint)
+	if !canViewSplitDonation(split, userID) {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", nil))
+	}
+
+	// Only load the shares' donations from the donation service once access is checked
+	split, err = h.splitDonationService.GetSplitDonation(split.ID, true)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch split donation", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Split donation fetched successfully", split))
+}
+
+// GetMySplitDonations lists the split donations made by the authenticated user
+func (h *SplitDonationHandler) GetMySplitDonations(c echo.Context) error {
+	userID, ok := c.Get("user_id").(uint)
+	if !ok {
+		return c.JSON(http.StatusUnauthorized, utils.ErrorResponse("Unauthorized", nil))
+	}
+
+	page, pageSize := splitDonationPage(c)
+	splits, err := h.splitDonationService.GetSplitDonationsByDonator(userID, page, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch split donations", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Split donations fetched successfully", splits))
+}
+
+// GetStreamerSplitDonations lists the split donations the authenticated streamer has a
+// share in, with every participant's share
+func (h *SplitDonationHandler) GetStreamerSplitDonations(c echo.Context) error {
+	streamerID, err := authorizeOwnStreamer(c)
+	if err != nil {
+		return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied", err))
+	}
+
+	page, pageSize := splitDonationPage(c)
+	splits, err := h.splitDonationService.GetSplitDonationsByStreamer(streamerID, page, pageSize)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to fetch split donations", err))
+	}
+
+	return c.JSON(http.StatusOK, utils.SuccessResponse("Split donations fetched successfully", splits))
+}
+
+func canViewSplitDonation(split *models.SplitDonation, userID uint) bool {
+	if userID == 0 {
+		return false
+	}
+	if split.DonatorID == userID {
+		return true
+	}
+	for _, share := range split.Shares {
+		if share.StreamerID == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func splitDonationPage(c echo.Context) (int, int) {
+	page, _ := strconv.Atoi(c.QueryParam("page"))
+	if page <= 0 {
+		page = 1
+	}
+
+	pageSize, _ := strconv.Atoi(c.QueryParam("pageSize"))
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 10
+	}
+	return page, pageSize
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+// clientCountryHeaders are set by the CDN in front of the gateway to the visitor's country
+var clientCountryHeaders = []string{"CF-IPCountry", "CloudFront-Viewer-Country"}
+
+// TrustedProxies are the load balancers and CDN edges in front of the gateway. Only they
+// may report the client's address in X-Forwarded-For or its country in CDN headers;
+// anyone else could send those headers to pose as another client.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies reads a comma-separated list of IP addresses and CIDR ranges
+func ParseTrustedProxies(list string) (TrustedProxies, error) {
+	var proxies TrustedProxies
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid trusted proxy address %q", entry)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+
+		_, ipRange, err := net.ParseCIDR(entry)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy range %q: %w", entry, err)
+		}
+		proxies = append(proxies, ipRange)
+	}
+	return proxies, nil
+}
+
+// IPExtractor takes the client's address from X-Forwarded-For, skipping hops added by
+// trusted proxies, and otherwise uses the address the request came from
+func (p TrustedProxies) IPExtractor() echo.IPExtractor {
+	options := []echo.TrustOption{
+		echo.TrustLoopback(false),
+		echo.TrustLinkLocal(false),
+		echo.TrustPrivateNet(false),
+	}
+	for _, ipRange := range p {
+		options = append(options, echo.TrustIPRange(ipRange))
+	}
+	return echo.ExtractIPFromXFFHeader(options...)
+}
+
+// Contains reports whether the address belongs to a trusted proxy
+func (p TrustedProxies) Contains(ip net.IP) bool {
+	for _, ipRange := range p {
+		if ipRange.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientCountryMiddleware stores the visitor's two-letter country code as
+// "client_country" when the request came straight from a trusted CDN edge or proxy
+func ClientCountryMiddleware(trusted TrustedProxies) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			host, _, err := net.SplitHostPort(c.Request().RemoteAddr)
+			if err != nil {
+				host = c.Request().RemoteAddr
+			}
+			if ip := net.ParseIP(host); ip == nil || !trusted.Contains(ip) {
+				return next(c)
+			}
+
+			for _, header := range clientCountryHeaders {
+				country := strings.ToUpper(strings.TrimSpace(c.Request().Header.Get(header)))
+				// Cloudflare reports XX when it does not know the country
+				if len(country) == 2 && country != "XX" {
+					c.Set("client_country", country)
+					break
+				}
+			}
+			return next(c)
+		}
+	}
+}
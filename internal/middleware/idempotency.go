@@ -0,0 +1,118 @@
+package middleware
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/pkg/utils"
+)
+
+const (
+	// IdempotencyKeyHeader carries the client-chosen key for a retryable request
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// IdempotentReplayedHeader is set on responses served from a stored result
+	IdempotentReplayedHeader = "Idempotent-Replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// IdempotencyStore is the part of service.IdempotencyService the middleware relies on
+type IdempotencyStore interface {
+	Begin(scope, key, requestHash string) (*models.IdempotencyRecord, error)
+	Complete(record *models.IdempotencyRecord, statusCode int, contentType string, body []byte) error
+	Release(record *models.IdempotencyRecord) error
+}
+
+// IdempotencyMiddleware replays the stored response when a request is retried with the
+// same Idempotency-Key header. Keys are scoped to the route path and the authenticated
+// user, or the client IP for anonymous requests, so it must run after the JWT middleware.
+// Requests without the header pass through.
+func IdempotencyMiddleware(idempotencyStore IdempotencyStore) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			key := c.Request().Header.Get(IdempotencyKeyHeader)
+			if key == "" {
+				return next(c)
+			}
+			if len(key) > maxIdempotencyKeyLength {
+				return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Idempotency key is too long", nil))
+			}
+
+			body, err := io.ReadAll(c.Request().Body)
+			if err != nil {
+				return c.JSON(http.StatusBadRequest, utils.ErrorResponse("Failed to read request body", err))
+			}
+			c.Request().Body = io.NopCloser(bytes.NewReader(body))
+
+			record, err := idempotencyStore.Begin(idempotencyScope(c), key, models.HashIdempotentRequest(body))
+			switch {
+			case errors.Is(err, models.ErrIdempotencyKeyReused):
+				return c.JSON(http.StatusUnprocessableEntity, utils.ErrorResponse("Idempotency key was already used with a different request", nil))
+			case errors.Is(err, models.ErrIdempotencyRequestInProgress):
+				return c.JSON(http.StatusConflict, utils.ErrorResponse("A request with this idempotency key is still being processed", nil))
+			case err != nil:
+				return c.JSON(http.StatusInternalServerError, utils.ErrorResponse("Failed to check idempotency key", err))
+			}
+
+			if record.IsCompleted() {
+				c.Response().Header().Set(IdempotentReplayedHeader, "true")
+				return c.Blob(record.StatusCode, record.ContentType, record.ResponseBody)
+			}
+
+			recorder := &responseRecorder{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = recorder
+
+			if err := next(c); err != nil {
+				releaseIdempotencyKey(idempotencyStore, record, key)
+				return err
+			}
+
+			// Server errors are not final, so the client may retry them with the same key
+			statusCode := c.Response().Status
+			if statusCode >= http.StatusInternalServerError {
+				releaseIdempotencyKey(idempotencyStore, record, key)
+				return nil
+			}
+
+			contentType := c.Response().Header().Get(echo.HeaderContentType)
+			if err := idempotencyStore.Complete(record, statusCode, contentType, recorder.body.Bytes()); err != nil {
+				fmt.Printf("Warning: failed to store response for idempotency key %s: %v\n", key, err)
+				releaseIdempotencyKey(idempotencyStore, record, key)
+			}
+			return nil
+		}
+	}
+}
+
+// idempotencyScope keeps keys from different users and endpoints apart. Anonymous
+// requests are told apart by client IP, which only trusted proxies can set, so one donor
+// cannot replay another's response by guessing their key.
+func idempotencyScope(c echo.Context) string {
+	user := "anonymous:" + c.RealIP()
+	if userID, ok := c.Get("user_id").(uint); ok {
+		user = fmt.Sprintf("user:%d", userID)
+	}
+	return fmt.Sprintf("%s %s %s", c.Request().Method, c.Request().URL.Path, user)
+}
+
+func releaseIdempotencyKey(idempotencyStore IdempotencyStore, record *models.IdempotencyRecord, key string) {
+	if err := idempotencyStore.Release(record); err != nil {
+		fmt.Printf("Warning: failed to release idempotency key %s: %v\n", key, err)
+	}
+}
+
+// responseRecorder copies the response body while it is written to the client
+type responseRecorder struct {
+	http.ResponseWriter
+	body bytes.Buffer
+}
+
+func (r *responseRecorder) Write(b []byte) (int, error) {
+	r.body.Write(b)
+	return r.ResponseWriter.Write(b)
+}
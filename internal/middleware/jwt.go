@@ -66,30 +66,7 @@ func JWTMiddleware(secret string) echo.MiddlewareFunc {
 func OptionalJWTMiddleware(secret string) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			// Get token from Authorization header
-			authHeader := c.Request().Header.Get("Authorization")
-			if authHeader == "" {
-				return next(c)
-			}
-
-			// Check if token starts with "Bearer "
-			tokenString := strings.TrimPrefix(authHeader, "Bearer ")
-			if tokenString == authHeader {
-				return next(c)
-			}
-
-			// Parse and validate token
-			token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
-				return []byte(secret), nil
-			})
-
-			if err != nil || !token.Valid {
-				return next(c)
-			}
-
-			// Extract claims
-			claims, ok := token.Claims.(*JWTClaims)
-			if ok {
+			if claims, ok := bearerClaims(c, secret); ok {
 				// Set user info in context
 				c.Set("user_id", claims.UserID)
 				c.Set("user_email", claims.Email)
@@ -101,6 +78,28 @@ func OptionalJWTMiddleware(secret string) echo.MiddlewareFunc {
 	}
 }
 
+// bearerClaims returns the claims of a valid bearer token in the Authorization header,
+// if the request has one
+func bearerClaims(c echo.Context, secret string) (*JWTClaims, bool) {
+	// Check if token starts with "Bearer "
+	authHeader := c.Request().Header.Get("Authorization")
+	tokenString := strings.TrimPrefix(authHeader, "Bearer ")
+	if authHeader == "" || tokenString == authHeader {
+		return nil, false
+	}
+
+	// Parse and validate token
+	token, err := jwt.ParseWithClaims(tokenString, &JWTClaims{}, func(token *jwt.Token) (interface{}, error) {
+		return []byte(secret), nil
+	})
+	if err != nil || !token.Valid {
+		return nil, false
+	}
+
+	claims, ok := token.Claims.(*JWTClaims)
+	return claims, ok
+}
+
 // StreamerOnlyMiddleware ensures only streamers can access the endpoint
 func StreamerOnlyMiddleware() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -112,4 +111,22 @@ func StreamerOnlyMiddleware() echo.MiddlewareFunc {
 			return next(c)
 		}
 	}
-} 
\ No newline at end of file
+}
+
+// AdminOnlyMiddleware ensures only the given users can access the endpoint. It must run
+// after JWTMiddleware.
+func AdminOnlyMiddleware(adminUserIDs []uint) echo.MiddlewareFunc {
+	admins := make(map[uint]bool, len(adminUserIDs))
+	for _, id := range adminUserIDs {
+		admins[id] = true
+	}
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID, ok := c.Get("user_id").(uint)
+			if !ok || !admins[userID] {
+				return c.JSON(http.StatusForbidden, utils.ErrorResponse("Access denied: Admins only", nil))
+			}
+			return next(c)
+		}
+	}
+}
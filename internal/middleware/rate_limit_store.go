@@ -0,0 +1,73 @@
+package middleware
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// memoryRateLimitSweepInterval is how often full buckets are dropped from memory
+const memoryRateLimitSweepInterval = time.Minute
+
+// MemoryRateLimitStore keeps token buckets in the gateway's memory. Each replica counts
+// on its own, so it only enforces the limits exactly for a single gateway.
+type MemoryRateLimitStore struct {
+	mu        sync.Mutex
+	buckets   map[string]*memoryRateLimitBucket
+	lastSweep time.Time
+}
+
+type memoryRateLimitBucket struct {
+	tokens  float64
+	updated time.Time
+	fullAt  time.Time // When the bucket will have refilled, after which it can be dropped
+}
+
+func NewMemoryRateLimitStore() *MemoryRateLimitStore {
+	return &MemoryRateLimitStore{
+		buckets:   make(map[string]*memoryRateLimitBucket),
+		lastSweep: time.Now(),
+	}
+}
+
+// Take refills the bucket for key and takes a token from it if one is left
+func (s *MemoryRateLimitStore) Take(key string, policy models.RateLimitPolicy) (models.RateLimitResult, error) {
+	now := time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep(now)
+
+	bucket, ok := s.buckets[key]
+	if !ok {
+		bucket = &memoryRateLimitBucket{tokens: float64(policy.Limit), updated: now}
+		s.buckets[key] = bucket
+	}
+
+	bucket.tokens = policy.Refill(bucket.tokens, now.Sub(bucket.updated))
+	bucket.updated = now
+	allowed := bucket.tokens >= 1
+	if allowed {
+		bucket.tokens--
+	}
+
+	result := policy.Result(bucket.tokens, allowed)
+	bucket.fullAt = now.Add(result.ResetAfter)
+	return result, nil
+}
+
+// sweep drops buckets that have refilled since their last request, as a new full bucket
+// is the same as an old one
+func (s *MemoryRateLimitStore) sweep(now time.Time) {
+	if now.Sub(s.lastSweep) < memoryRateLimitSweepInterval {
+		return
+	}
+	for key, bucket := range s.buckets {
+		if !now.Before(bucket.fullAt) {
+			delete(s.buckets, key)
+		}
+	}
+	s.lastSweep = now
+}
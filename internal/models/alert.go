@@ -0,0 +1,40 @@
+package models
+
+const (
+	// DefaultAlertTemplate is used when no alert rule matches a donation
+	DefaultAlertTemplate = "{donor} donated {amount}"
+	// DefaultMatchAlertTemplate is used for a sponsor's match of a donation, {donor} being
+	// the sponsor
+	DefaultMatchAlertTemplate = "Matched by {donor}: {amount}"
+	// DefaultAlertDurationSeconds is how long an alert stays on screen unless a rule says otherwise
+	DefaultAlertDurationSeconds = 8
+)
+
+// AlertRule customises the on-stream alert of donations within an amount range. The
+// template may use the {donor}, {amount} and {message} placeholders, and {translation}
+// for the message in the streamer's language.
+type AlertRule struct {
+	Base
+	StreamerID      uint              `json:"streamer_id" gorm:"not null;index"`
+	Name            string            `json:"name" gorm:"type:varchar(100)"`
+	Currency        SupportedCurrency `json:"currency" gorm:"type:varchar(10);default:'IDR'"`
+	MinAmount       int64             `json:"min_amount" gorm:"type:bigint;not null;default:0"` // Minor units of Currency, inclusive
+	MaxAmount       int64             `json:"max_amount" gorm:"type:bigint;not null;default:0"` // Minor units of Currency, exclusive; 0 for no upper bound
+	MessageTemplate string            `json:"message_template" gorm:"type:text;not null"`
+	SoundURL        string            `json:"sound_url" gorm:"type:varchar(500)"`
+	ImageURL        string            `json:"image_url" gorm:"type:varchar(500)"`
+	DurationSeconds int               `json:"duration_seconds" gorm:"default:8"`
+	IsActive        bool              `json:"is_active" gorm:"default:true"`
+}
+
+// TableName specifies the table name for AlertRule
+func (AlertRule) TableName() string {
+	return "alert_rules"
+}
+
+// Matches reports whether an amount falls within the rule's currency and range
+func (r *AlertRule) Matches(amount Money) bool {
+	return amount.Currency == r.Currency &&
+		amount.Minor >= r.MinAmount &&
+		(r.MaxAmount == 0 || amount.Minor < r.MaxAmount)
+}
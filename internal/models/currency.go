@@ -1,5 +1,7 @@
 package models
 
+import "time"
+
 // SupportedCurrency represents supported currencies in the system
 type SupportedCurrency string
 
@@ -24,6 +26,18 @@ type CurrencyRate struct {
 	LastUpdated  int64             `json:"last_updated" gorm:"autoUpdateTime"`
 }
 
+// RateSourceIdentity is the source of the 1:1 rate between a currency and itself
+const RateSourceIdentity = "identity"
+
+// ExchangeRateQuote is an exchange rate together with where it came from and when
+type ExchangeRateQuote struct {
+	From        SupportedCurrency `json:"from"`
+	To          SupportedCurrency `json:"to"`
+	Rate        float64           `json:"rate"`
+	Source      string            `json:"source"`
+	RetrievedAt time.Time         `json:"retrieved_at"`
+}
+
 // CurrencyInfo represents detailed currency information
 type CurrencyInfo struct {
 	Base
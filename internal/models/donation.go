@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"math"
+	"time"
+)
 
 // PaymentStatus represents the status of a donation payment
 type PaymentStatus string
@@ -12,6 +15,22 @@ const (
 	PaymentRefunded  PaymentStatus = "refunded"
 )
 
+// paymentStatusTransitions lists the statuses each status may move to
+var paymentStatusTransitions = map[PaymentStatus][]PaymentStatus{
+	PaymentPending:   {PaymentCompleted, PaymentFailed},
+	PaymentCompleted: {PaymentRefunded},
+}
+
+// CanTransitionTo reports whether a donation in this status may move to next
+func (s PaymentStatus) CanTransitionTo(next PaymentStatus) bool {
+	for _, allowed := range paymentStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
 // PaymentProvider represents the payment method used for donation
 type PaymentProvider string
 
@@ -20,12 +39,15 @@ const (
 	PaymentProviderStripe   PaymentProvider = "stripe"
 	PaymentProviderCrypto   PaymentProvider = "crypto"
 	PaymentProviderMidtrans PaymentProvider = "midtrans"
+	PaymentProviderQRIS     PaymentProvider = "QRIS"
+	PaymentProviderSponsor  PaymentProvider = "sponsor" // Paid by a matching campaign's sponsor
 )
 
-// Donation represents a donation from a donator to a streamer
+// Donation represents a donation from a donator to a streamer. Amounts are integer
+// minor units of Currency (see Money).
 type Donation struct {
 	Base
-	Amount          float64         `json:"amount" gorm:"not null"`
+	Amount          int64           `json:"amount" gorm:"type:bigint;not null"`
 	Currency        SupportedCurrency `json:"currency" gorm:"default:'IDR'"`
 	Message         string          `json:"message"`
 	DonatorID       uint            `json:"donator_id" gorm:"not null;index"` // No foreign key constraint for microservices
@@ -38,4 +60,118 @@ type Donation struct {
 	PaymentTime     *time.Time      `json:"payment_time"`
 	DisplayName     string          `json:"display_name"` // Name to display (might be different from user name)
 	IsAnonymous     bool            `json:"is_anonymous" gorm:"default:false"`
-} 
\ No newline at end of file
+	RefundedAmount  int64           `json:"refunded_amount" gorm:"type:bigint;default:0"` // Sum of successful refunds
+	MessageStatus   MessageStatus   `json:"message_status" gorm:"type:varchar(20);default:'visible'"`
+	SplitDonationID uint            `json:"split_donation_id,omitempty" gorm:"index"` // Gateway split donation this is a share of, 0 when none
+
+	// Exchange-rate snapshot taken at creation: the rate into the streamer's primary
+	// currency and the converted amount (minor units of ConvertedCurrency), so totals do
+	// not move with later rates
+	ExchangeRate      float64           `json:"exchange_rate"`
+	ConvertedAmount   int64             `json:"converted_amount" gorm:"type:bigint"`
+	ConvertedCurrency SupportedCurrency `json:"converted_currency" gorm:"type:varchar(10);index"`
+	RateSource        string            `json:"rate_source" gorm:"type:varchar(50)"`
+	RateTime          *time.Time        `json:"rate_time"`
+
+	// Message translated into the streamer's primary language, added shortly after the
+	// donation is created. Message keeps what the donor wrote; TranslatedMessage stays
+	// empty when it was already in the streamer's language.
+	MessageLanguage     SupportedLanguage `json:"message_language,omitempty" gorm:"type:varchar(10)"`
+	TranslatedMessage   string            `json:"translated_message,omitempty" gorm:"type:text"`
+	TranslationLanguage SupportedLanguage `json:"translation_language,omitempty" gorm:"type:varchar(10)"`
+
+	// Set on a sponsor's donation made by a matching campaign: the campaign and the
+	// donor's donation it matches. DisplayName is the sponsor's name.
+	MatchCampaignID   uint `json:"match_campaign_id,omitempty" gorm:"index"`
+	MatchedDonationID uint `json:"matched_donation_id,omitempty" gorm:"index"`
+}
+
+// IsMatch reports whether the donation is a sponsor's match of another donation
+func (d *Donation) IsMatch() bool {
+	return d.MatchCampaignID != 0
+}
+
+// ApplyTranslation records the message's detected language and its translation
+func (d *Donation) ApplyTranslation(translation *MessageTranslation) {
+	d.MessageLanguage = translation.SourceLanguage
+	d.TranslatedMessage = translation.Text
+	d.TranslationLanguage = translation.Language
+}
+
+// HasRateSnapshot reports whether the donation's exchange-rate snapshot has been recorded
+func (d *Donation) HasRateSnapshot() bool {
+	return d.ConvertedCurrency != "" && d.ExchangeRate > 0
+}
+
+// Money returns the donated amount
+func (d *Donation) Money() Money {
+	return Money{Minor: d.Amount, Currency: d.Currency}
+}
+
+// NetAmount returns the amount kept after refunds
+func (d *Donation) NetAmount() Money {
+	return Money{Minor: d.Amount - d.RefundedAmount, Currency: d.Currency}
+}
+
+// NetConvertedAmount is the amount kept after refunds, in minor units of the snapshot
+// currency. Refunds reduce the converted amount in proportion, matching the
+// repository's SQL aggregates.
+func (d *Donation) NetConvertedAmount() int64 {
+	if d.RefundedAmount == 0 || d.Amount == 0 {
+		return d.ConvertedAmount
+	}
+	return int64(math.Round(float64(d.ConvertedAmount) * float64(d.Amount-d.RefundedAmount) / float64(d.Amount)))
+}
+ 
+// StatsInterval is the bucket size used when aggregating donation statistics
+type StatsInterval string
+
+const (
+	StatsIntervalDay   StatsInterval = "day"
+	StatsIntervalWeek  StatsInterval = "week"
+	StatsIntervalMonth StatsInterval = "month"
+)
+
+// IsValid reports whether the interval is one the stats query supports
+func (i StatsInterval) IsValid() bool {
+	switch i {
+	case StatsIntervalDay, StatsIntervalWeek, StatsIntervalMonth:
+		return true
+	}
+	return false
+}
+
+// DonationStatBucket is the aggregated total of completed donations for one period, currency
+// and snapshot currency. Amounts are in minor units.
+type DonationStatBucket struct {
+	Period            time.Time         `json:"period"`
+	Currency          SupportedCurrency `json:"currency"`
+	TotalAmount       int64             `json:"total_amount"`
+	ConvertedAmount   int64             `json:"converted_amount"`   // TotalAmount at the donations' snapshot rates
+	ConvertedCurrency SupportedCurrency `json:"converted_currency"` // Streamer's currency when the donations were made
+	Count             int64             `json:"count"`
+}
+
+// CurrencyTotal summarises completed donations in a single currency, in minor units
+type CurrencyTotal struct {
+	Currency        SupportedCurrency `json:"currency"`
+	TotalAmount     int64             `json:"total_amount"`
+	ConvertedAmount int64             `json:"converted_amount"` // In the stats currency
+	Count           int64             `json:"count"`
+	AverageAmount   int64             `json:"average_amount"`
+}
+
+// DonationStats is a streamer's time-bucketed donation report. TotalAmount and
+// AverageAmount are minor units of Currency, at the donations' snapshot rates.
+type DonationStats struct {
+	StreamerID     uint                  `json:"streamer_id"`
+	Interval       StatsInterval         `json:"interval"`
+	StartDate      time.Time             `json:"start_date"`
+	EndDate        time.Time             `json:"end_date"`
+	Currency       SupportedCurrency     `json:"currency"`
+	TotalAmount    int64                 `json:"total_amount"`
+	TotalDonations int64                 `json:"total_donations"`
+	AverageAmount  int64                 `json:"average_amount"`
+	Buckets        []*DonationStatBucket `json:"buckets"`
+	CurrencyTotals []*CurrencyTotal      `json:"currency_totals"`
+}
@@ -0,0 +1,91 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ExportFormat is the file format of a donation export
+type ExportFormat string
+
+const (
+	ExportCSV  ExportFormat = "csv"
+	ExportXLSX ExportFormat = "xlsx"
+)
+
+// IsValid reports whether the format is one exports can be written in
+func (f ExportFormat) IsValid() bool {
+	return f == ExportCSV || f == ExportXLSX
+}
+
+// ContentType is the MIME type of files in this format
+func (f ExportFormat) ContentType() string {
+	if f == ExportXLSX {
+		return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+	}
+	return "text/csv; charset=utf-8"
+}
+
+// DonationExportStatus is where a background export job is in its lifecycle
+type DonationExportStatus string
+
+const (
+	ExportPending   DonationExportStatus = "pending"
+	ExportRunning   DonationExportStatus = "running"
+	ExportCompleted DonationExportStatus = "completed"
+	ExportFailed    DonationExportStatus = "failed"
+)
+
+// ErrExportLeaseLost is returned when a worker writes to an export that was requeued
+// and claimed by another worker after it stopped reporting progress
+var ErrExportLeaseLost = errors.New("export was claimed by another worker")
+
+// DonationExport is a background export of a streamer's donations over a date range.
+// The finished file is stored in DonationExportChunk rows so any replica can serve it.
+type DonationExport struct {
+	Base
+	StreamerID  uint                 `json:"streamer_id" gorm:"not null;index"`
+	Format      ExportFormat         `json:"format" gorm:"type:varchar(10);not null"`
+	StartDate   time.Time            `json:"start_date"`
+	EndDate     time.Time            `json:"end_date"`                         // Exclusive
+	Currency    SupportedCurrency    `json:"currency" gorm:"type:varchar(10)"` // Currency amounts are converted to
+	Status      DonationExportStatus `json:"status" gorm:"type:varchar(20);default:'pending';index"`
+	RowCount    int                  `json:"row_count"`
+	FileSize    int64                `json:"file_size"`
+	Error       string               `json:"error,omitempty"`
+	StartedAt   *time.Time           `json:"started_at"`
+	Attempt     int                  `json:"-" gorm:"default:0"` // Incremented on every claim; only the latest claim may write
+	HeartbeatAt *time.Time           `json:"-"`                  // Last progress of the running attempt
+	CompletedAt *time.Time           `json:"completed_at"`
+	ExpiresAt   *time.Time           `json:"expires_at" gorm:"index"` // Set on completion; the file is deleted afterwards
+}
+
+// TableName specifies the table name for DonationExport
+func (DonationExport) TableName() string {
+	return "donation_exports"
+}
+
+// FileName is the download name of the export's file
+func (e *DonationExport) FileName() string {
+	return DonationExportFileName(e.StreamerID, e.StartDate, e.EndDate, e.Format)
+}
+
+// DonationExportFileName names an export file after the streamer and its inclusive date range
+func DonationExportFileName(streamerID uint, start, end time.Time, format ExportFormat) string {
+	last := end.Add(-time.Nanosecond)
+	return fmt.Sprintf("donations-%d-%s-%s.%s", streamerID, start.Format("20060102"), last.Format("20060102"), format)
+}
+
+// DonationExportChunk is one piece of a finished export file, in Sequence order
+type DonationExportChunk struct {
+	ID       uint   `json:"id" gorm:"primaryKey"`
+	ExportID uint   `json:"export_id" gorm:"not null;uniqueIndex:idx_export_chunk_sequence"`
+	Sequence int    `json:"sequence" gorm:"not null;uniqueIndex:idx_export_chunk_sequence"`
+	Data     []byte `json:"-" gorm:"type:bytea"`
+}
+
+// TableName specifies the table name for DonationExportChunk
+func (DonationExportChunk) TableName() string {
+	return "donation_export_chunks"
+}
@@ -0,0 +1,54 @@
+package models
+
+import "time"
+
+// DonationGoal is a fundraising target a streamer shows as a progress bar
+type DonationGoal struct {
+	Base
+	StreamerID    uint              `json:"streamer_id" gorm:"not null;index"`
+	Title         string            `json:"title" gorm:"type:varchar(255);not null"`
+	Description   string            `json:"description" gorm:"type:text"`
+	TargetAmount  int64             `json:"target_amount" gorm:"type:bigint;not null"` // Minor units of Currency
+	CurrentAmount int64             `json:"current_amount" gorm:"type:bigint;default:0"`
+	Currency      SupportedCurrency `json:"currency" gorm:"default:'IDR'"`
+	Deadline      *time.Time        `json:"deadline"`
+	IsActive      bool              `json:"is_active" gorm:"default:true;index"`
+	CompletedAt   *time.Time        `json:"completed_at"`
+}
+
+// TableName specifies the table name for DonationGoal
+func (DonationGoal) TableName() string {
+	return "donation_goals"
+}
+
+// ProgressPercent returns how much of the target has been raised, in percent
+func (g *DonationGoal) ProgressPercent() float64 {
+	if g.TargetAmount <= 0 {
+		return 0
+	}
+	return float64(g.CurrentAmount) / float64(g.TargetAmount) * 100
+}
+
+// Accepts reports whether a donation completed at the given time counts toward the goal
+func (g *DonationGoal) Accepts(at time.Time) bool {
+	if !g.IsActive || at.Before(g.CreatedAt) {
+		return false
+	}
+	return g.Deadline == nil || at.Before(*g.Deadline)
+}
+
+// DonationGoalContribution records a donation counted toward a goal so it is never counted twice
+type DonationGoalContribution struct {
+	ID               uint              `json:"id" gorm:"primaryKey"`
+	GoalID           uint              `json:"goal_id" gorm:"not null;uniqueIndex:idx_goal_donation"`
+	DonationID       uint              `json:"donation_id" gorm:"not null;uniqueIndex:idx_goal_donation"`
+	Amount           int64             `json:"amount" gorm:"type:bigint;not null"` // Minor units of the goal's currency
+	OriginalAmount   int64             `json:"original_amount" gorm:"type:bigint"`
+	OriginalCurrency SupportedCurrency `json:"original_currency"`
+	CreatedAt        time.Time         `json:"created_at"`
+}
+
+// TableName specifies the table name for DonationGoalContribution
+func (DonationGoalContribution) TableName() string {
+	return "donation_goal_contributions"
+}
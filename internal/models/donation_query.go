@@ -0,0 +1,71 @@
+package models
+
+import "time"
+
+// DonationSortField is the column a donation listing is ordered by
+type DonationSortField string
+
+const (
+	DonationSortByTime   DonationSortField = "time" // Creation time
+	DonationSortByAmount DonationSortField = "amount"
+)
+
+// SortOrder is the direction of a sorted listing
+type SortOrder string
+
+const (
+	SortDescending SortOrder = "desc"
+	SortAscending  SortOrder = "asc"
+)
+
+// AnonymityFilter selects donations by whether the donator chose to stay anonymous
+type AnonymityFilter string
+
+const (
+	AnonymityAny     AnonymityFilter = ""
+	AnonymityOnly    AnonymityFilter = "only"
+	AnonymityExclude AnonymityFilter = "exclude"
+)
+
+// DonationFilter narrows a donation listing; zero values match everything.
+// The date range is half-open over the creation time: StartDate inclusive, EndDate exclusive.
+type DonationFilter struct {
+	StreamerID uint `json:"streamer_id"`
+	DonatorID  uint `json:"donator_id"`
+	// ParticipantID keeps the donations a user either made or received
+	ParticipantID uint              `json:"participant_id"`
+	StartDate     *time.Time        `json:"start_date"`
+	EndDate       *time.Time        `json:"end_date"`
+	MinAmount     int64             `json:"min_amount"` // Minor units
+	MaxAmount     int64             `json:"max_amount"`
+	Status        PaymentStatus     `json:"status"`
+	Currency      SupportedCurrency `json:"currency"`
+	Provider      PaymentProvider   `json:"provider"`
+	Anonymity     AnonymityFilter   `json:"anonymity"`
+}
+
+// DonationCursor identifies the last donation of a page. The ID breaks ties between
+// donations with the same sort value, so pages never skip or repeat rows.
+type DonationCursor struct {
+	SortBy    DonationSortField `json:"s"`
+	Order     SortOrder         `json:"o"`
+	CreatedAt time.Time         `json:"t"`
+	Amount    int64             `json:"a"`
+	ID        uint              `json:"i"`
+}
+
+// DonationListQuery is a keyset-paginated donation listing
+type DonationListQuery struct {
+	Filter DonationFilter
+	SortBy DonationSortField
+	Order  SortOrder
+	After  *DonationCursor // Nil for the first page
+	Limit  int
+}
+
+// DonationPage is one page of a donation listing
+type DonationPage struct {
+	Donations  []*Donation `json:"donations"`
+	NextCursor string      `json:"next_cursor,omitempty"` // Empty on the last page
+	HasMore    bool        `json:"has_more"`
+}
@@ -0,0 +1,30 @@
+package models
+
+import "time"
+
+// StatusChangeSource identifies what triggered a donation status change
+type StatusChangeSource string
+
+const (
+	StatusSourceWebhook StatusChangeSource = "webhook" // Payment provider callback
+	StatusSourceAdmin   StatusChangeSource = "admin"   // Operator or internal tooling
+	StatusSourceUser    StatusChangeSource = "user"    // Donator or streamer action
+	StatusSourceSystem  StatusChangeSource = "system"  // Background jobs and internal flows
+)
+
+// DonationStatusHistory records one status transition of a donation
+type DonationStatusHistory struct {
+	ID         uint               `json:"id" gorm:"primaryKey"`
+	DonationID uint               `json:"donation_id" gorm:"not null;index"`
+	FromStatus PaymentStatus      `json:"from_status" gorm:"type:varchar(20)"`
+	ToStatus   PaymentStatus      `json:"to_status" gorm:"type:varchar(20);not null"`
+	Source     StatusChangeSource `json:"source" gorm:"type:varchar(20);not null"`
+	ActorID    uint               `json:"actor_id"` // User who caused the change, 0 for providers and jobs
+	Reason     string             `json:"reason"`
+	CreatedAt  time.Time          `json:"created_at"`
+}
+
+// TableName specifies the table name for DonationStatusHistory
+func (DonationStatusHistory) TableName() string {
+	return "donation_status_history"
+}
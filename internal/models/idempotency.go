@@ -0,0 +1,48 @@
+package models
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+)
+
+// These live with the model rather than the service so the HTTP middleware,
+// which the service package depends on, can match them without an import cycle
+var (
+	// ErrIdempotencyKeyReused is returned when a key is replayed with a different request payload
+	ErrIdempotencyKeyReused = errors.New("idempotency key was already used for a different request")
+	// ErrIdempotencyRequestInProgress is returned while the original request for a key is still running
+	ErrIdempotencyRequestInProgress = errors.New("a request with this idempotency key is still in progress")
+)
+
+// IdempotencyRecord remembers the outcome of a request made with an idempotency key
+// so retries of the same request can be answered without repeating its side effects
+type IdempotencyRecord struct {
+	ID           uint       `json:"id" gorm:"primaryKey"`
+	Scope        string     `json:"scope" gorm:"type:varchar(255);not null;uniqueIndex:idx_idempotency_scope_key"`
+	Key          string     `json:"key" gorm:"type:varchar(255);not null;uniqueIndex:idx_idempotency_scope_key"`
+	RequestHash  string     `json:"request_hash" gorm:"type:varchar(64);not null"`
+	StatusCode   int        `json:"status_code"`
+	ContentType  string     `json:"content_type" gorm:"type:varchar(100)"`
+	ResponseBody []byte     `json:"-"`
+	CompletedAt  *time.Time `json:"completed_at"` // Nil while the original request is still running
+	ExpiresAt    time.Time  `json:"expires_at" gorm:"not null;index"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// TableName specifies the table name for IdempotencyRecord
+func (IdempotencyRecord) TableName() string {
+	return "idempotency_keys"
+}
+
+// IsCompleted reports whether a response has been stored for replay
+func (r *IdempotencyRecord) IsCompleted() bool {
+	return r.CompletedAt != nil
+}
+
+// HashIdempotentRequest fingerprints a request payload for comparison on replay
+func HashIdempotentRequest(payload []byte) string {
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:])
+}
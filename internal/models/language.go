@@ -9,6 +9,19 @@ const (
 	LanguageMandarin   SupportedLanguage = "zh" // Chinese (Mandarin)
 )
 
+// MessageTranslation is a donor's message run through language detection and, when it is
+// in another language than the reader's, translated
+type MessageTranslation struct {
+	SourceLanguage SupportedLanguage // Detected language of the message
+	Language       SupportedLanguage // Reader's language, the one Text is in
+	Text           string            // Empty when the message needed no translation
+}
+
+// Translated reports whether the message was translated
+func (t *MessageTranslation) Translated() bool {
+	return t.Text != ""
+}
+
 // LanguageConfig represents language configuration and translations
 type LanguageConfig struct {
 	Base
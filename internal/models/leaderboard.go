@@ -0,0 +1,59 @@
+package models
+
+import "time"
+
+// LeaderboardPeriod is the time window a donor leaderboard covers
+type LeaderboardPeriod string
+
+const (
+	LeaderboardAllTime LeaderboardPeriod = "all_time"
+	LeaderboardMonthly LeaderboardPeriod = "monthly" // Current calendar month
+	LeaderboardWeekly  LeaderboardPeriod = "weekly"  // Current week, starting Monday
+	LeaderboardSession LeaderboardPeriod = "session" // A single stream, bounded by its start and end
+)
+
+// IsValid reports whether the period is one the leaderboard supports
+func (p LeaderboardPeriod) IsValid() bool {
+	switch p {
+	case LeaderboardAllTime, LeaderboardMonthly, LeaderboardWeekly, LeaderboardSession:
+		return true
+	}
+	return false
+}
+
+// DonorCurrencyTotal is one donor's completed donations to a streamer, net of refunds,
+// converted at each donation's snapshot rate. Currency is the streamer's currency when
+// the donations were made, or the donations' own currency while they have no snapshot.
+// Anonymous donations are grouped under DonatorID 0.
+type DonorCurrencyTotal struct {
+	DonatorID      uint              `json:"donator_id"`
+	IsAnonymous    bool              `json:"is_anonymous"`
+	DisplayName    string            `json:"display_name"` // Name used on the donor's latest donation
+	Currency       SupportedCurrency `json:"currency"`
+	TotalAmount    int64             `json:"total_amount"` // Minor units of Currency
+	Count          int64             `json:"count"`
+	FirstDonatedAt time.Time         `json:"first_donated_at"`
+	LastDonatedAt  time.Time         `json:"last_donated_at"`
+}
+
+// LeaderboardEntry is a ranked donor with totals in the leaderboard currency
+type LeaderboardEntry struct {
+	Rank           int       `json:"rank"`
+	DonatorID      uint      `json:"donator_id"`
+	DisplayName    string    `json:"display_name"`
+	IsAnonymous    bool      `json:"is_anonymous"`
+	TotalAmount    int64     `json:"total_amount"` // Minor units of the leaderboard currency
+	DonationCount  int64     `json:"donation_count"`
+	FirstDonatedAt time.Time `json:"first_donated_at"`
+	LastDonatedAt  time.Time `json:"last_donated_at"`
+}
+
+// DonationLeaderboard ranks a streamer's top donors over a period
+type DonationLeaderboard struct {
+	StreamerID uint                `json:"streamer_id"`
+	Period     LeaderboardPeriod   `json:"period"`
+	Currency   SupportedCurrency   `json:"currency"`
+	StartDate  *time.Time          `json:"start_date"` // Nil for all-time leaderboards
+	EndDate    time.Time           `json:"end_date"`
+	Entries    []*LeaderboardEntry `json:"entries"`
+}
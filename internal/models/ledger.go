@@ -0,0 +1,100 @@
+package models
+
+import (
+	"errors"
+	"strconv"
+	"time"
+)
+
+// ErrInsufficientBalance is returned when a streamer's balance cannot cover a withdrawal
+var ErrInsufficientBalance = errors.New("insufficient balance")
+
+// LedgerAccount names an account of the double-entry ledger. Provider accounts are kept
+// per payment provider and streamer balances per streamer, all per currency.
+type LedgerAccount string
+
+const (
+	LedgerAccountProviderClearing LedgerAccount = "provider_clearing" // Funds held by a payment provider for the platform
+	LedgerAccountProviderFee      LedgerAccount = "provider_fee"      // Fees charged by payment providers, a platform cost
+	LedgerAccountPlatformFee      LedgerAccount = "platform_fee"      // Platform commission on donations
+	LedgerAccountStreamerBalance  LedgerAccount = "streamer_balance"  // What the platform owes a streamer
+	LedgerAccountPayouts          LedgerAccount = "payouts"           // Money paid out to streamers
+)
+
+// LedgerEntryType is the business event an entry records
+type LedgerEntryType string
+
+const (
+	LedgerEntryPayment    LedgerEntryType = "payment"
+	LedgerEntryRefund     LedgerEntryType = "refund"
+	LedgerEntryChargeback LedgerEntryType = "chargeback"
+	// LedgerEntryPayout holds a requested withdrawal out of the streamer's balance
+	LedgerEntryPayout LedgerEntryType = "payout"
+	// LedgerEntryPayoutReversal returns a rejected or failed withdrawal to the balance
+	LedgerEntryPayoutReversal LedgerEntryType = "payout_reversal"
+)
+
+// LedgerEntry is one balanced posting to the ledger. Entries are never changed or deleted,
+// which the database enforces; a correction is posted as a new entry. Reference is unique
+// per business event, so posting the same event twice is a no-op.
+type LedgerEntry struct {
+	ID          uint              `json:"id" gorm:"primaryKey"`
+	CreatedAt   time.Time         `json:"created_at"`
+	Reference   string            `json:"reference" gorm:"type:varchar(100);uniqueIndex;not null"`
+	Type        LedgerEntryType   `json:"type" gorm:"type:varchar(20);not null"`
+	StreamerID  uint              `json:"streamer_id" gorm:"index"`
+	DonationID  uint              `json:"donation_id,omitempty" gorm:"index"`
+	RefundID    uint              `json:"refund_id,omitempty" gorm:"index"`
+	PayoutID    uint              `json:"payout_id,omitempty" gorm:"index"`
+	Currency    SupportedCurrency `json:"currency" gorm:"type:varchar(10);not null"`
+	Description string            `json:"description"`
+	Lines       []LedgerLine      `json:"lines" gorm:"foreignKey:EntryID"`
+}
+
+// TableName specifies the table name for LedgerEntry
+func (LedgerEntry) TableName() string {
+	return "ledger_entries"
+}
+
+// LedgerLine moves an amount into or out of one account. Debits are positive and credits
+// negative, so the lines of an entry always sum to zero.
+type LedgerLine struct {
+	ID         uint              `json:"id" gorm:"primaryKey"`
+	EntryID    uint              `json:"entry_id" gorm:"not null;index"`
+	Account    LedgerAccount     `json:"account" gorm:"type:varchar(30);not null;index:idx_ledger_lines_account"`
+	StreamerID uint              `json:"streamer_id,omitempty" gorm:"index:idx_ledger_lines_account"` // Streamer of streamer_balance and payouts lines
+	Provider   PaymentProvider   `json:"provider,omitempty"`                                          // Provider of provider_clearing and provider_fee lines
+	Currency   SupportedCurrency `json:"currency" gorm:"type:varchar(10);not null;index:idx_ledger_lines_account"`
+	Amount     int64             `json:"amount" gorm:"type:bigint;not null"` // Minor units of Currency, debit positive
+}
+
+// TableName specifies the table name for LedgerLine
+func (LedgerLine) TableName() string {
+	return "ledger_lines"
+}
+
+// LedgerEntryReference returns the reference of the entry recording a business event,
+// e.g. "donation:12:payment" or "refund:7:refund"
+func LedgerEntryReference(source string, id uint, entryType LedgerEntryType) string {
+	return source + ":" + strconv.FormatUint(uint64(id), 10) + ":" + string(entryType)
+}
+
+// StreamerBalance is what the platform owes a streamer in one currency. Credited counts
+// donations net of platform fees, Debited refunds, chargebacks and payouts; all amounts
+// are minor units of Currency.
+type StreamerBalance struct {
+	StreamerID uint              `json:"streamer_id"`
+	Currency   SupportedCurrency `json:"currency"`
+	Balance    int64             `json:"balance"`
+	Credited   int64             `json:"credited"`
+	Debited    int64             `json:"debited"`
+}
+
+// FeeSchedule is what a payment through one provider costs. The platform fee is taken
+// from the streamer's share; the provider fee is a platform cost and does not change what
+// the streamer is owed. ProviderFixed is only charged on payments in its currency.
+type FeeSchedule struct {
+	PlatformBasisPoints int64 `json:"platform_basis_points"` // Hundredths of a percent of the amount
+	ProviderBasisPoints int64 `json:"provider_basis_points"`
+	ProviderFixed       Money `json:"provider_fixed"`
+}
@@ -0,0 +1,111 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// MatchRatioOneToOne matches every donation with the same amount, in basis points
+const MatchRatioOneToOne = 10000
+
+// MatchCampaign is a sponsor's pledge to match the donations its streamers receive, e.g.
+// "we match every donation up to Rp10 juta". Every donation completed within the window
+// is matched at RatioBasisPoints of its amount, up to MaxMatchAmount, until Spent reaches
+// Budget. Amounts are minor units of Currency.
+type MatchCampaign struct {
+	Base
+	Name             string            `json:"name" gorm:"type:varchar(100);not null"`
+	SponsorName      string            `json:"sponsor_name" gorm:"type:varchar(100);not null"` // Shown on stream as "matched by"
+	SponsorUserID    uint              `json:"sponsor_user_id" gorm:"index"`                   // Account that may read the report, 0 for none
+	StreamerIDs      []uint            `json:"streamer_ids" gorm:"type:text;serializer:json"`
+	Currency         SupportedCurrency `json:"currency" gorm:"type:varchar(10);not null"`
+	RatioBasisPoints int64             `json:"ratio_basis_points" gorm:"not null"`           // 10000 matches 1:1
+	MaxMatchAmount   int64             `json:"max_match_amount" gorm:"type:bigint;not null"` // Per donation, 0 for no cap
+	Budget           int64             `json:"budget" gorm:"type:bigint;not null"`
+	Spent            int64             `json:"spent" gorm:"type:bigint;not null;default:0"`
+	MatchCount       int64             `json:"match_count" gorm:"not null;default:0"`
+	StartsAt         time.Time         `json:"starts_at" gorm:"not null;index"`
+	EndsAt           time.Time         `json:"ends_at" gorm:"not null;index"`
+	IsActive         bool              `json:"is_active" gorm:"not null"` // Admins pause a campaign by clearing it
+}
+
+// TableName specifies the table name for MatchCampaign
+func (MatchCampaign) TableName() string {
+	return "match_campaigns"
+}
+
+// Covers reports whether the campaign matches donations to the streamer
+func (c *MatchCampaign) Covers(streamerID uint) bool {
+	return slices.Contains(c.StreamerIDs, streamerID)
+}
+
+// IsRunning reports whether the campaign matches donations completed at the given time
+func (c *MatchCampaign) IsRunning(at time.Time) bool {
+	return c.IsActive && !at.Before(c.StartsAt) && at.Before(c.EndsAt) && c.Spent < c.Budget
+}
+
+// Remaining returns the budget left to match with
+func (c *MatchCampaign) Remaining() Money {
+	return NewMoney(max(c.Budget-c.Spent, 0), c.Currency)
+}
+
+// MatchAmount returns what the campaign gives for a donation of amount in its currency,
+// capped per donation and by the budget left
+func (c *MatchCampaign) MatchAmount(amount int64) Money {
+	match := NewMoney(amount, c.Currency).BasisPoints(c.RatioBasisPoints)
+	if c.MaxMatchAmount > 0 {
+		match.Minor = min(match.Minor, c.MaxMatchAmount)
+	}
+	match.Minor = min(match.Minor, c.Remaining().Minor)
+	return match
+}
+
+// DonationMatch records that a campaign matched a donor's donation with one from the
+// sponsor. A campaign matches each donation once; refunds of the donor's donation take
+// back the same share of the match.
+type DonationMatch struct {
+	Base
+	CampaignID        uint              `json:"campaign_id" gorm:"not null;uniqueIndex:idx_donation_matches_campaign_donation"`
+	DonationID        uint              `json:"donation_id" gorm:"not null;uniqueIndex:idx_donation_matches_campaign_donation"`
+	MatchedDonationID uint              `json:"matched_donation_id" gorm:"not null;index"` // The sponsor's donation
+	StreamerID        uint              `json:"streamer_id" gorm:"not null;index"`
+	Currency          SupportedCurrency `json:"currency" gorm:"type:varchar(10);not null"`
+	DonationAmount    int64             `json:"donation_amount" gorm:"type:bigint;not null"` // The donor's donation in Currency
+	Amount            int64             `json:"amount" gorm:"type:bigint;not null"`          // Matched amount
+	RefundedAmount    int64             `json:"refunded_amount" gorm:"type:bigint;not null;default:0"`
+}
+
+// RefundOwed returns how much of the match should be refunded once the donor's donation
+// has refunded of amount: the same share, or all of it when the donation is fully refunded
+func (m *DonationMatch) RefundOwed(refunded, amount int64) int64 {
+	if refunded >= amount {
+		return m.Amount
+	}
+	if refunded <= 0 {
+		return 0
+	}
+	return NewMoney(m.Amount, m.Currency).Allocate([]int64{refunded, amount - refunded})[0].Minor
+}
+
+// TableName specifies the table name for DonationMatch
+func (DonationMatch) TableName() string {
+	return "donation_matches"
+}
+
+// MatchStreamerTotal sums a campaign's matches of one streamer's donations
+type MatchStreamerTotal struct {
+	StreamerID     uint  `json:"streamer_id"`
+	MatchCount     int64 `json:"match_count"`
+	DonationAmount int64 `json:"donation_amount"`
+	MatchedAmount  int64 `json:"matched_amount"` // Net of refunded matches
+}
+
+// MatchCampaignReport tells a sponsor how their campaign's budget was spent. Amounts are
+// minor units of the campaign's currency.
+type MatchCampaignReport struct {
+	Campaign       *MatchCampaign        `json:"campaign"`
+	Remaining      int64                 `json:"remaining"`
+	DonationAmount int64                 `json:"donation_amount"` // Donations matched
+	MatchedAmount  int64                 `json:"matched_amount"`
+	Streamers      []*MatchStreamerTotal `json:"streamers"`
+}
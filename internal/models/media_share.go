@@ -10,7 +10,7 @@ type MediaShareSettings struct {
 	gorm.Model
 	StreamerID           uint    `gorm:"uniqueIndex" json:"streamer_id"`
 	MediaShareEnabled    bool    `json:"media_share_enabled" gorm:"default:true"`
-	MinDonationAmount    float64 `json:"min_donation_amount" gorm:"default:5000"`
+	MinDonationAmount    int64   `json:"min_donation_amount" gorm:"type:bigint;default:5000"` // Minor units of Currency
 	Currency             string  `json:"currency" gorm:"default:'IDR'"`
 	AllowYoutube         bool    `json:"allow_youtube" gorm:"default:true"`
 	AllowTiktok          bool    `json:"allow_tiktok" gorm:"default:true"`
@@ -30,6 +30,7 @@ const (
 	MediaShareStatusPending  MediaShareStatus = "pending"
 	MediaShareStatusApproved MediaShareStatus = "approved"
 	MediaShareStatusRejected MediaShareStatus = "rejected"
+	MediaShareStatusPlayed   MediaShareStatus = "played" // Shown by the media player overlay
 )
 
 // MediaShareType represents the type of media platform
@@ -51,12 +52,18 @@ type MediaShare struct {
 	Title            string           `json:"title" gorm:"type:varchar(255)"`
 	Message          string           `json:"message" gorm:"type:text"`
 	Status           MediaShareStatus `json:"status" gorm:"type:varchar(20);default:'pending'"`
-	DonationAmount   float64          `json:"donation_amount"`
+	DonationAmount   int64            `json:"donation_amount" gorm:"type:bigint"` // Minor units of Currency
 	Currency         string           `json:"currency" gorm:"default:'IDR'"`
 	DonatorName      string           `json:"donator_name" gorm:"type:varchar(100)"`
 	Thumbnail        string           `json:"thumbnail" gorm:"type:text"`
 	Duration         int              `json:"duration"` // in seconds
 	ProcessedAt      *time.Time       `json:"processed_at"`
+
+	// Message translated into the streamer's primary language shortly after submission;
+	// TranslatedMessage stays empty when it was already in the streamer's language
+	MessageLanguage     SupportedLanguage `json:"message_language,omitempty" gorm:"type:varchar(10)"`
+	TranslatedMessage   string            `json:"translated_message,omitempty" gorm:"type:text"`
+	TranslationLanguage SupportedLanguage `json:"translation_language,omitempty" gorm:"type:varchar(10)"`
 	
 	// Relations
 	Donation *Donation `gorm:"foreignKey:DonationID" json:"donation,omitempty"`
@@ -64,13 +71,20 @@ type MediaShare struct {
 	Donator  *User     `gorm:"foreignKey:DonatorID" json:"donator,omitempty"`
 }
 
+// ApplyTranslation records the message's detected language and its translation
+func (m *MediaShare) ApplyTranslation(translation *MessageTranslation) {
+	m.MessageLanguage = translation.SourceLanguage
+	m.TranslatedMessage = translation.Text
+	m.TranslationLanguage = translation.Language
+}
+
 // MediaShareRequest represents the request to share media
 type MediaShareRequest struct {
 	Type           MediaShareType `json:"type" validate:"required,oneof=youtube tiktok"`
 	URL            string         `json:"url" validate:"required,url"`
 	Title          string         `json:"title" validate:"max=255"`
 	Message        string         `json:"message" validate:"max=1000"`
-	DonationAmount float64        `json:"donation_amount" validate:"required,min=0"`
+	DonationAmount int64          `json:"donation_amount" validate:"required,min=0"` // Minor units
 }
 
 // MediaShareResponse represents the response after sharing media
@@ -93,9 +107,31 @@ type MediaQueueItem struct {
 	Message        string           `json:"message"`
 	Status         MediaShareStatus `json:"status"`
 	DonatorName    string           `json:"donator_name"`
-	DonationAmount float64          `json:"donation_amount"`
+	DonationAmount int64            `json:"donation_amount"`
 	Currency       string           `json:"currency"`
 	Thumbnail      string           `json:"thumbnail"`
+	Duration       int              `json:"duration"` // in seconds
 	SubmittedAt    time.Time        `json:"submitted_at"`
 	ProcessedAt    *time.Time       `json:"processed_at"`
-} 
\ No newline at end of file
+
+	TranslatedMessage   string            `json:"translated_message,omitempty"`
+	TranslationLanguage SupportedLanguage `json:"translation_language,omitempty"`
+}
+
+// MediaQueueEventType says what happened to an item of a streamer's media queue
+type MediaQueueEventType string
+
+const (
+	MediaQueueNewSubmission MediaQueueEventType = "new_submission"
+	MediaQueueApproved      MediaQueueEventType = "approved"
+	MediaQueueRejected      MediaQueueEventType = "rejected"
+	MediaQueuePlayed        MediaQueueEventType = "played"
+	MediaQueueTranslated    MediaQueueEventType = "translated" // The item's message was translated
+)
+
+// MediaQueueUpdate is published whenever an item joins or leaves a streamer's media queue
+type MediaQueueUpdate struct {
+	EventType MediaQueueEventType `json:"event_type"`
+	Item      *MediaQueueItem     `json:"item"`
+	Timestamp time.Time           `json:"timestamp"`
+}
@@ -0,0 +1,75 @@
+package models
+
+import "time"
+
+// MembershipStatus represents where a membership is in its billing lifecycle
+type MembershipStatus string
+
+const (
+	MembershipPending   MembershipStatus = "pending"   // Waiting for the first payment
+	MembershipActive    MembershipStatus = "active"    // Paid for the current period
+	MembershipPastDue   MembershipStatus = "past_due"  // Renewal unpaid, still inside the grace period
+	MembershipLapsed    MembershipStatus = "lapsed"    // Payment failed or grace period ran out
+	MembershipCancelled MembershipStatus = "cancelled" // Cancelled by the member
+)
+
+// MembershipTier is a monthly membership level offered by a streamer
+type MembershipTier struct {
+	Base
+	StreamerID   uint              `json:"streamer_id" gorm:"not null;index"`
+	Name         string            `json:"name" gorm:"type:varchar(100);not null"`
+	Perks        string            `json:"perks" gorm:"type:text"`
+	MonthlyPrice int64             `json:"monthly_price" gorm:"type:bigint;not null"` // Minor units of Currency
+	Currency     SupportedCurrency `json:"currency" gorm:"default:'IDR'"`
+	IsActive     bool              `json:"is_active" gorm:"default:true"`
+}
+
+// TableName specifies the table name for MembershipTier
+func (MembershipTier) TableName() string {
+	return "membership_tiers"
+}
+
+// Membership is a member's recurring subscription to a streamer's tier
+type Membership struct {
+	Base
+	TierID             uint             `json:"tier_id" gorm:"not null;index"`
+	Tier               MembershipTier   `json:"tier,omitempty" gorm:"foreignKey:TierID"`
+	StreamerID         uint             `json:"streamer_id" gorm:"not null;index"`
+	MemberID           uint             `json:"member_id" gorm:"not null;index"`
+	DisplayName        string           `json:"display_name"`
+	Status             MembershipStatus `json:"status" gorm:"type:varchar(20);default:'pending';index"`
+	CurrentPeriodStart *time.Time       `json:"current_period_start"`
+	CurrentPeriodEnd   *time.Time       `json:"current_period_end" gorm:"index"`
+	GracePeriodEnd     *time.Time       `json:"grace_period_end"`
+	PendingDonationID  uint             `json:"pending_donation_id" gorm:"index"` // Donation awaiting payment, 0 when none
+	FailedAttempts     int              `json:"failed_attempts" gorm:"default:0"`
+	CancelAtPeriodEnd  bool             `json:"cancel_at_period_end" gorm:"default:false"`
+	CancelledAt        *time.Time       `json:"cancelled_at"`
+}
+
+// TableName specifies the table name for Membership
+func (Membership) TableName() string {
+	return "memberships"
+}
+
+// HasAccess reports whether the member should currently receive the tier's perks
+func (m *Membership) HasAccess() bool {
+	return m.Status == MembershipActive || m.Status == MembershipPastDue
+}
+
+// MembershipPayment links a membership period to the donation that pays for it
+type MembershipPayment struct {
+	Base
+	MembershipID uint              `json:"membership_id" gorm:"not null;uniqueIndex:idx_membership_payment_period"`
+	DonationID   uint              `json:"donation_id" gorm:"not null;uniqueIndex"`
+	Amount       int64             `json:"amount" gorm:"type:bigint;not null"` // Minor units of Currency
+	Currency     SupportedCurrency `json:"currency"`
+	PeriodStart  time.Time         `json:"period_start" gorm:"uniqueIndex:idx_membership_payment_period"` // One payment per period
+	PeriodEnd    time.Time         `json:"period_end"`
+	Status       PaymentStatus     `json:"status" gorm:"default:'pending'"`
+}
+
+// TableName specifies the table name for MembershipPayment
+func (MembershipPayment) TableName() string {
+	return "membership_payments"
+}
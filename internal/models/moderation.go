@@ -0,0 +1,99 @@
+package models
+
+import "time"
+
+// ModerationAction is what happens to a donation whose message breaks a moderation rule
+type ModerationAction string
+
+const (
+	ModerationMask   ModerationAction = "mask"   // Replace offending words and truncate long messages
+	ModerationHold   ModerationAction = "hold"   // Hide the message until the streamer approves it
+	ModerationReject ModerationAction = "reject" // Refuse to create the donation
+)
+
+// IsValid reports whether the action is one moderation supports
+func (a ModerationAction) IsValid() bool {
+	switch a {
+	case ModerationMask, ModerationHold, ModerationReject:
+		return true
+	}
+	return false
+}
+
+// MessageStatus tells whether a donation's message may be shown to the streamer's audience
+type MessageStatus string
+
+const (
+	MessageVisible  MessageStatus = "visible"
+	MessageHeld     MessageStatus = "held"     // Waiting in the review queue, message hidden
+	MessageRejected MessageStatus = "rejected" // Rejected in review, message stays hidden
+)
+
+// ModerationSettings is a streamer's message moderation configuration. Defaults are
+// set in code rather than in column defaults so that saving false or 0 sticks.
+type ModerationSettings struct {
+	Base
+	StreamerID       uint             `json:"streamer_id" gorm:"not null;uniqueIndex"`
+	Enabled          bool             `json:"enabled"`
+	BannedWords      []string         `json:"banned_words" gorm:"type:text;serializer:json"`
+	BannedPatterns   []string         `json:"banned_patterns" gorm:"type:text;serializer:json"` // Regular expressions
+	StripLinks       bool             `json:"strip_links"`
+	MaxMessageLength int              `json:"max_message_length"` // In characters, 0 for no limit
+	Action           ModerationAction `json:"action" gorm:"type:varchar(20)"`
+}
+
+// TableName specifies the table name for ModerationSettings
+func (ModerationSettings) TableName() string {
+	return "moderation_settings"
+}
+
+// BlockedTerm is an entry of the global blocklist applied to every streamer
+type BlockedTerm struct {
+	Base
+	Term      string `json:"term" gorm:"type:varchar(255);not null"`
+	IsPattern bool   `json:"is_pattern" gorm:"default:false"` // Term is a regular expression
+}
+
+// TableName specifies the table name for BlockedTerm
+func (BlockedTerm) TableName() string {
+	return "moderation_blocked_terms"
+}
+
+// MessageReviewStatus is where a held message is in the review queue
+type MessageReviewStatus string
+
+const (
+	ReviewPending  MessageReviewStatus = "pending"
+	ReviewApproved MessageReviewStatus = "approved"
+	ReviewRejected MessageReviewStatus = "rejected"
+)
+
+// MessageReview is a held donation message waiting for the streamer's decision
+type MessageReview struct {
+	Base
+	DonationID          uint                `json:"donation_id" gorm:"not null;uniqueIndex"`
+	StreamerID          uint                `json:"streamer_id" gorm:"not null;index"`
+	OriginalMessage     string              `json:"original_message" gorm:"type:text"`
+	OriginalDisplayName string              `json:"original_display_name"`
+	Message             string              `json:"message" gorm:"type:text"` // Shown on approval: the original with links stripped
+	DisplayName         string              `json:"display_name"`
+	Violations          []string            `json:"violations" gorm:"type:text;serializer:json"`
+	Status              MessageReviewStatus `json:"status" gorm:"type:varchar(20);default:'pending';index"`
+	ReviewedAt          *time.Time          `json:"reviewed_at"`
+}
+
+// TableName specifies the table name for MessageReview
+func (MessageReview) TableName() string {
+	return "message_reviews"
+}
+
+// ModerationResult is the outcome of moderating a donation's message and display name
+type ModerationResult struct {
+	Action      ModerationAction `json:"action"` // Empty when no rule was broken
+	Message     string           `json:"message"`
+	DisplayName string           `json:"display_name"`
+	// Unmasked text with links stripped, kept for the review queue
+	ReviewMessage     string   `json:"-"`
+	ReviewDisplayName string   `json:"-"`
+	Violations        []string `json:"violations"`
+}
@@ -0,0 +1,210 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// currencyDecimalPlaces is the number of minor-unit digits of each supported currency
+var currencyDecimalPlaces = map[SupportedCurrency]int{
+	CurrencyIDR: 0,
+	CurrencyUSD: 2,
+	CurrencyCNY: 2,
+	CurrencyEUR: 2,
+	CurrencyJPY: 0,
+	CurrencySGD: 2,
+	CurrencyMYR: 2,
+}
+
+// DecimalPlaces returns how many digits the currency has after the decimal point.
+// Unknown currencies are assumed to have two.
+func (c SupportedCurrency) DecimalPlaces() int {
+	if places, ok := currencyDecimalPlaces[c]; ok {
+		return places
+	}
+	return 2
+}
+
+// CurrencyDecimalPlaces returns the decimal places of every supported currency
+func CurrencyDecimalPlaces() map[SupportedCurrency]int {
+	places := make(map[SupportedCurrency]int, len(currencyDecimalPlaces))
+	for currency, n := range currencyDecimalPlaces {
+		places[currency] = n
+	}
+	return places
+}
+
+// MinorUnitsPerMajor returns how many minor units make one whole unit of the currency
+// (100 for USD, 1 for IDR)
+func (c SupportedCurrency) MinorUnitsPerMajor() int64 {
+	factor := int64(1)
+	for i := 0; i < c.DecimalPlaces(); i++ {
+		factor *= 10
+	}
+	return factor
+}
+
+// ErrInvalidAmount is returned when an amount cannot be represented exactly in its currency
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// Money is an exact amount of a currency, held as an integer number of the currency's
+// minor units (cents for USD, whole rupiah for IDR) so sums never pick up rounding errors
+type Money struct {
+	Minor    int64             `json:"minor"`
+	Currency SupportedCurrency `json:"currency"`
+}
+
+// NewMoney creates an amount from minor units
+func NewMoney(minor int64, currency SupportedCurrency) Money {
+	return Money{Minor: minor, Currency: currency}
+}
+
+// MoneyFromMajor rounds a decimal amount in whole units (12.34 USD) to the nearest minor
+// unit, halves away from zero. Only for values that are already approximate, such as
+// converted amounts and provider responses; parse user input with ParseMoney.
+func MoneyFromMajor(amount float64, currency SupportedCurrency) Money {
+	return Money{
+		Minor:    int64(math.Round(amount * float64(currency.MinorUnitsPerMajor()))),
+		Currency: currency,
+	}
+}
+
+// ParseMoney parses a decimal amount in whole units ("12.34") exactly. Amounts with more
+// decimals than the currency has are rejected rather than rounded.
+func ParseMoney(amount string, currency SupportedCurrency) (Money, error) {
+	amount = strings.TrimSpace(amount)
+	negative := strings.HasPrefix(amount, "-")
+	whole, fraction, _ := strings.Cut(strings.TrimPrefix(amount, "-"), ".")
+	if whole == "" && fraction == "" {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	places := currency.DecimalPlaces()
+	if len(fraction) > places {
+		return Money{}, fmt.Errorf("%w: %s allows at most %d decimal places", ErrInvalidAmount, currency, places)
+	}
+	digits := whole + fraction + strings.Repeat("0", places-len(fraction))
+	if strings.ContainsAny(digits, "+-") {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	minor, err := strconv.ParseInt(digits, 10, 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+	if negative {
+		minor = -minor
+	}
+	return Money{Minor: minor, Currency: currency}, nil
+}
+
+// Major returns the amount in whole units. The result is for display and exchange-rate
+// math only; keep sums and comparisons in minor units.
+func (m Money) Major() float64 {
+	return float64(m.Minor) / float64(m.Currency.MinorUnitsPerMajor())
+}
+
+// String formats the amount with exactly the currency's decimal places, e.g. "12.50"
+func (m Money) String() string {
+	places := m.Currency.DecimalPlaces()
+	if places == 0 {
+		return strconv.FormatInt(m.Minor, 10)
+	}
+
+	sign := ""
+	minor := m.Minor
+	if minor < 0 {
+		sign = "-"
+		minor = -minor
+	}
+	factor := m.Currency.MinorUnitsPerMajor()
+	return fmt.Sprintf("%s%d.%0*d", sign, minor/factor, places, minor%factor)
+}
+
+// Add returns the sum of two amounts of the same currency
+func (m Money) Add(other Money) Money {
+	return Money{Minor: m.Minor + other.Minor, Currency: m.Currency}
+}
+
+// Sub returns the difference of two amounts of the same currency
+func (m Money) Sub(other Money) Money {
+	return Money{Minor: m.Minor - other.Minor, Currency: m.Currency}
+}
+
+// Div divides the amount into n equal parts, rounded to the nearest minor unit with
+// halves away from zero. n must be positive.
+func (m Money) Div(n int64) Money {
+	quotient, remainder := m.Minor/n, m.Minor%n
+	if remainder < 0 {
+		remainder = -remainder
+	}
+	if remainder*2 >= n {
+		if m.Minor < 0 {
+			quotient--
+		} else {
+			quotient++
+		}
+	}
+	return Money{Minor: quotient, Currency: m.Currency}
+}
+
+// Allocate divides a non-negative amount into parts proportional to weights, which must
+// be non-negative with a positive sum. Each part is rounded down to a minor unit and the
+// units left over go one each to the parts with the largest remainders, earlier parts
+// winning ties, so the parts always add up to exactly m.
+func (m Money) Allocate(weights []int64) []Money {
+	var total int64
+	for _, weight := range weights {
+		total += weight
+	}
+
+	parts := make([]Money, len(weights))
+	remainders := make([]int64, len(weights))
+	allocated := int64(0)
+	for i, weight := range weights {
+		// Split the multiplication so large amounts cannot overflow int64
+		quotient := m.Minor / total * weight
+		rest := m.Minor % total * weight
+		parts[i] = Money{Minor: quotient + rest/total, Currency: m.Currency}
+		remainders[i] = rest % total
+		allocated += parts[i].Minor
+	}
+
+	for left := m.Minor - allocated; left > 0; left-- {
+		largest := 0
+		for i := range remainders {
+			if remainders[i] > remainders[largest] {
+				largest = i
+			}
+		}
+		parts[largest].Minor++
+		remainders[largest] = -1
+	}
+	return parts
+}
+
+// BasisPoints returns bps hundredths of a percent of a non-negative amount, rounded to
+// the nearest minor unit with halves up
+func (m Money) BasisPoints(bps int64) Money {
+	// Split the multiplication so large amounts cannot overflow int64
+	whole := m.Minor / 10000 * bps
+	rest := m.Minor % 10000 * bps
+	return Money{Minor: whole + (rest+5000)/10000, Currency: m.Currency}
+}
+
+// IsPositive reports whether the amount is greater than zero
+func (m Money) IsPositive() bool {
+	return m.Minor > 0
+}
+
+// Convert applies an exchange rate (units of to per unit of m's currency) and rounds the
+// result to the nearest minor unit of to
+func (m Money) Convert(rate float64, to SupportedCurrency) Money {
+	if m.Currency == to && rate == 1 {
+		return m
+	}
+	return MoneyFromMajor(m.Major()*rate, to)
+}
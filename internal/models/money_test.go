@@ -0,0 +1,203 @@
+package models
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseMoney(t *testing.T) {
+	tests := []struct {
+		name     string
+		amount   string
+		currency SupportedCurrency
+		want     int64
+		wantErr  bool
+	}{
+		{name: "whole units", amount: "12", currency: CurrencyUSD, want: 1200},
+		{name: "cents", amount: "12.34", currency: CurrencyUSD, want: 1234},
+		{name: "one decimal", amount: "12.5", currency: CurrencyUSD, want: 1250},
+		{name: "fraction only", amount: ".05", currency: CurrencyUSD, want: 5},
+		{name: "surrounding spaces", amount: " 7.10 ", currency: CurrencyEUR, want: 710},
+		{name: "negative", amount: "-3.25", currency: CurrencyUSD, want: -325},
+		{name: "zero decimal currency", amount: "25000", currency: CurrencyIDR, want: 25000},
+		{name: "too many decimals", amount: "1.234", currency: CurrencyUSD, wantErr: true},
+		{name: "decimals on zero decimal currency", amount: "25000.5", currency: CurrencyIDR, wantErr: true},
+		{name: "empty", amount: "", currency: CurrencyUSD, wantErr: true},
+		{name: "only a point", amount: ".", currency: CurrencyUSD, wantErr: true},
+		{name: "double sign", amount: "--1", currency: CurrencyUSD, wantErr: true},
+		{name: "plus sign", amount: "+1", currency: CurrencyUSD, wantErr: true},
+		{name: "not a number", amount: "1a", currency: CurrencyUSD, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseMoney(tt.amount, tt.currency)
+			if tt.wantErr {
+				if !errors.Is(err, ErrInvalidAmount) {
+					t.Fatalf("ParseMoney(%q) error = %v, want ErrInvalidAmount", tt.amount, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseMoney(%q) error = %v", tt.amount, err)
+			}
+			if got != NewMoney(tt.want, tt.currency) {
+				t.Errorf("ParseMoney(%q) = %+v, want %d %s", tt.amount, got, tt.want, tt.currency)
+			}
+		})
+	}
+}
+
+func TestMoneyFromMajor(t *testing.T) {
+	tests := []struct {
+		amount   float64
+		currency SupportedCurrency
+		want     int64
+	}{
+		{amount: 12.34, currency: CurrencyUSD, want: 1234},
+		{amount: 0.005, currency: CurrencyUSD, want: 1},
+		{amount: -0.005, currency: CurrencyUSD, want: -1},
+		{amount: 1.15, currency: CurrencyUSD, want: 115},
+		{amount: 25000.5, currency: CurrencyIDR, want: 25001},
+		{amount: 99.4, currency: CurrencyJPY, want: 99},
+	}
+
+	for _, tt := range tests {
+		if got := MoneyFromMajor(tt.amount, tt.currency); got.Minor != tt.want || got.Currency != tt.currency {
+			t.Errorf("MoneyFromMajor(%v, %s) = %+v, want %d", tt.amount, tt.currency, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyString(t *testing.T) {
+	tests := []struct {
+		money Money
+		want  string
+	}{
+		{money: NewMoney(1250, CurrencyUSD), want: "12.50"},
+		{money: NewMoney(5, CurrencyUSD), want: "0.05"},
+		{money: NewMoney(-325, CurrencyEUR), want: "-3.25"},
+		{money: NewMoney(-5, CurrencyUSD), want: "-0.05"},
+		{money: NewMoney(0, CurrencySGD), want: "0.00"},
+		{money: NewMoney(25000, CurrencyIDR), want: "25000"},
+		{money: NewMoney(-300, CurrencyJPY), want: "-300"},
+	}
+
+	for _, tt := range tests {
+		if got := tt.money.String(); got != tt.want {
+			t.Errorf("%+v.String() = %q, want %q", tt.money, got, tt.want)
+		}
+	}
+}
+
+func TestMoneyDiv(t *testing.T) {
+	tests := []struct {
+		minor int64
+		n     int64
+		want  int64
+	}{
+		{minor: 100, n: 4, want: 25},
+		{minor: 100, n: 3, want: 33},
+		{minor: 5, n: 2, want: 3},
+		{minor: 7, n: 2, want: 4},
+		{minor: -5, n: 2, want: -3},
+		{minor: -100, n: 3, want: -33},
+		{minor: 1, n: 3, want: 0},
+		{minor: 2, n: 3, want: 1},
+		{minor: 0, n: 7, want: 0},
+	}
+
+	for _, tt := range tests {
+		if got := NewMoney(tt.minor, CurrencyUSD).Div(tt.n); got != NewMoney(tt.want, CurrencyUSD) {
+			t.Errorf("%d.Div(%d) = %d, want %d", tt.minor, tt.n, got.Minor, tt.want)
+		}
+	}
+}
+
+func TestMoneyConvert(t *testing.T) {
+	tests := []struct {
+		name  string
+		money Money
+		rate  float64
+		to    SupportedCurrency
+		want  Money
+	}{
+		{name: "same currency", money: NewMoney(1234, CurrencyUSD), rate: 1, to: CurrencyUSD, want: NewMoney(1234, CurrencyUSD)},
+		{name: "to zero decimal currency", money: NewMoney(1050, CurrencyUSD), rate: 15500, to: CurrencyIDR, want: NewMoney(162750, CurrencyIDR)},
+		{name: "from zero decimal currency", money: NewMoney(50000, CurrencyIDR), rate: 0.000065, to: CurrencyUSD, want: NewMoney(325, CurrencyUSD)},
+		{name: "rounds to nearest minor unit", money: NewMoney(100, CurrencyUSD), rate: 0.92345, to: CurrencyEUR, want: NewMoney(92, CurrencyEUR)},
+		{name: "rounds halves away from zero", money: NewMoney(1, CurrencyIDR), rate: 0.5, to: CurrencyJPY, want: NewMoney(1, CurrencyJPY)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.money.Convert(tt.rate, tt.to); got != tt.want {
+				t.Errorf("Convert(%v, %s) = %+v, want %+v", tt.rate, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMoneyAllocate(t *testing.T) {
+	tests := []struct {
+		name    string
+		minor   int64
+		weights []int64
+		want    []int64
+	}{
+		{name: "even split", minor: 300, weights: []int64{1, 1, 1}, want: []int64{100, 100, 100}},
+		{name: "leftover goes to earlier parts on ties", minor: 100, weights: []int64{1, 1, 1}, want: []int64{34, 33, 33}},
+		{name: "two units left over", minor: 101, weights: []int64{1, 1, 1}, want: []int64{34, 34, 33}},
+		{name: "leftover goes to the largest remainder", minor: 10, weights: []int64{3333, 3333, 3334}, want: []int64{3, 3, 4}},
+		{name: "percentages", minor: 99999, weights: []int64{5000, 2500, 2500}, want: []int64{49999, 25000, 25000}},
+		{name: "zero weight gets nothing", minor: 1000, weights: []int64{1, 0, 1}, want: []int64{500, 0, 500}},
+		{name: "fewer units than parts", minor: 2, weights: []int64{1, 1, 1}, want: []int64{1, 1, 0}},
+		{name: "zero amount", minor: 0, weights: []int64{1, 2}, want: []int64{0, 0}},
+		{name: "single part", minor: 12345, weights: []int64{7}, want: []int64{12345}},
+		{name: "large amount does not overflow", minor: 9_000_000_000_000_000, weights: []int64{3, 7}, want: []int64{2_700_000_000_000_000, 6_300_000_000_000_000}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts := NewMoney(tt.minor, CurrencyUSD).Allocate(tt.weights)
+			if len(parts) != len(tt.want) {
+				t.Fatalf("Allocate(%v) returned %d parts, want %d", tt.weights, len(parts), len(tt.want))
+			}
+			var sum int64
+			for i, part := range parts {
+				if part != NewMoney(tt.want[i], CurrencyUSD) {
+					t.Errorf("part %d = %+v, want %d USD", i, part, tt.want[i])
+				}
+				sum += part.Minor
+			}
+			if sum != tt.minor {
+				t.Errorf("parts add up to %d, want %d", sum, tt.minor)
+			}
+		})
+	}
+}
+
+func TestMoneyBasisPoints(t *testing.T) {
+	tests := []struct {
+		minor int64
+		bps   int64
+		want  int64
+	}{
+		{minor: 100000, bps: 290, want: 2900},
+		{minor: 10000, bps: 10000, want: 10000},
+		{minor: 12345, bps: 0, want: 0},
+		{minor: 150, bps: 100, want: 2},
+		{minor: 149, bps: 100, want: 1},
+		{minor: 50, bps: 100, want: 1},
+		{minor: 49, bps: 100, want: 0},
+		{minor: 0, bps: 500, want: 0},
+		{minor: 20000, bps: 15000, want: 30000},
+		{minor: 9_000_000_000_000_000, bps: 250, want: 225_000_000_000_000},
+	}
+
+	for _, tt := range tests {
+		if got := NewMoney(tt.minor, CurrencyIDR).BasisPoints(tt.bps); got != NewMoney(tt.want, CurrencyIDR) {
+			t.Errorf("%d.BasisPoints(%d) = %d, want %d", tt.minor, tt.bps, got.Minor, tt.want)
+		}
+	}
+}
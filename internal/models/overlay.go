@@ -0,0 +1,116 @@
+package models
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// OverlayToken is the secret in a streamer's browser source URLs. Anyone holding it can
+// watch the streamer's overlays, so streamers can rotate it at any time.
+type OverlayToken struct {
+	gorm.Model
+	StreamerID uint   `json:"streamer_id" gorm:"uniqueIndex;not null"`
+	Token      string `json:"token" gorm:"type:varchar(64);uniqueIndex;not null"`
+}
+
+// OverlayWidget is one of the overlay pages a browser source can show
+type OverlayWidget string
+
+const (
+	OverlayWidgetAlerts      OverlayWidget = "alerts"
+	OverlayWidgetGoals       OverlayWidget = "goals"
+	OverlayWidgetMedia       OverlayWidget = "media"
+	OverlayWidgetLeaderboard OverlayWidget = "leaderboard"
+)
+
+// OverlayWidgets lists every overlay page
+var OverlayWidgets = []OverlayWidget{OverlayWidgetAlerts, OverlayWidgetGoals, OverlayWidgetMedia, OverlayWidgetLeaderboard}
+
+// IsValid reports whether the widget is a known overlay page
+func (w OverlayWidget) IsValid() bool {
+	for _, widget := range OverlayWidgets {
+		if w == widget {
+			return true
+		}
+	}
+	return false
+}
+
+// OverlayEventType names the payload of an overlay event
+type OverlayEventType string
+
+const (
+	OverlayEventAlert       OverlayEventType = "alert"       // Data is an *OverlayAlert
+	OverlayEventGoals       OverlayEventType = "goals"       // Data is the active []*DonationGoal
+	OverlayEventMedia       OverlayEventType = "media"       // Data is an *OverlayMedia
+	OverlayEventLeaderboard OverlayEventType = "leaderboard" // Data is a *DonationLeaderboard
+)
+
+// OverlayEvent is pushed to a streamer's overlays
+type OverlayEvent struct {
+	Type      OverlayEventType `json:"type"`
+	Data      interface{}      `json:"data"`
+	Timestamp time.Time        `json:"timestamp"`
+}
+
+// OverlayAlert announces a completed donation. Anonymous donors and messages that
+// moderation hid are never shown on stream. Text is rendered from the streamer's
+// matching alert rule, which also picks the sound, image and display duration.
+type OverlayAlert struct {
+	DonationID      uint              `json:"donation_id"`
+	DisplayName     string            `json:"display_name"`
+	Amount          int64             `json:"amount"` // Minor units of Currency
+	Currency        SupportedCurrency `json:"currency"`
+	FormattedAmount string            `json:"formatted_amount"`
+	Message         string            `json:"message"`
+	// Message in the streamer's language, empty when it needed no translation
+	TranslatedMessage   string            `json:"translated_message,omitempty"`
+	TranslationLanguage SupportedLanguage `json:"translation_language,omitempty"`
+	Text                string            `json:"text"`
+	ShowMessage         bool              `json:"show_message"` // False when Text already includes the message
+	SoundURL            string            `json:"sound_url,omitempty"`
+	ImageURL            string            `json:"image_url,omitempty"`
+	DurationSeconds     int               `json:"duration_seconds"`
+	RuleID              uint              `json:"rule_id,omitempty"` // 0 when no rule matched
+	IsTest              bool              `json:"is_test,omitempty"`
+	// True when this re-renders an alert already sent, e.g. once its message has been
+	// translated; overlays replace the earlier alert instead of showing it again
+	Update bool `json:"update,omitempty"`
+	// A sponsor's match: the sponsor shown as "matched by" and the donation it matched
+	MatchedBy         string `json:"matched_by,omitempty"`
+	MatchedDonationID uint   `json:"matched_donation_id,omitempty"`
+}
+
+// NewOverlayAlert builds the on-stream alert of a donation, before an alert rule renders it
+func NewOverlayAlert(donation *Donation) *OverlayAlert {
+	alert := &OverlayAlert{
+		DonationID:      donation.ID,
+		DisplayName:     donation.DisplayName,
+		Amount:          donation.Amount,
+		Currency:        donation.Currency,
+		FormattedAmount: donation.Money().String(),
+		ShowMessage:     true,
+		DurationSeconds: DefaultAlertDurationSeconds,
+	}
+	if donation.IsAnonymous || alert.DisplayName == "" {
+		alert.DisplayName = "Anonymous"
+	}
+	if donation.MessageStatus == "" || donation.MessageStatus == MessageVisible {
+		alert.Message = donation.Message
+		alert.TranslatedMessage = donation.TranslatedMessage
+		alert.TranslationLanguage = donation.TranslationLanguage
+	}
+	if donation.IsMatch() {
+		alert.MatchedBy = alert.DisplayName
+		alert.MatchedDonationID = donation.MatchedDonationID
+	}
+	return alert
+}
+
+// OverlayMedia is the media player state: the item to play now (nil when the queue is
+// empty) and the queue update that changed it, if any
+type OverlayMedia struct {
+	NowPlaying *MediaQueueItem   `json:"now_playing"`
+	Update     *MediaQueueUpdate `json:"update,omitempty"`
+}
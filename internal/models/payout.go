@@ -0,0 +1,192 @@
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// PayoutMethod is how a streamer receives their payouts
+type PayoutMethod string
+
+const (
+	PayoutMethodBank    PayoutMethod = "bank"
+	PayoutMethodEWallet PayoutMethod = "ewallet"
+)
+
+// PayoutChannel is a bank or e-wallet that payouts can be sent to
+type PayoutChannel struct {
+	Code   string       `json:"code"`
+	Name   string       `json:"name"`
+	Method PayoutMethod `json:"method"`
+	// AccountLengths are the digit counts of the channel's account numbers. E-wallet
+	// accounts are phone numbers and have none.
+	AccountLengths []int `json:"account_lengths,omitempty"`
+}
+
+// payoutChannels are the Indonesian banks and e-wallets payouts can be sent to, keyed by
+// code
+var payoutChannels = map[string]PayoutChannel{
+	"BCA":       {Code: "BCA", Name: "Bank Central Asia", Method: PayoutMethodBank, AccountLengths: []int{10}},
+	"BNI":       {Code: "BNI", Name: "Bank Negara Indonesia", Method: PayoutMethodBank, AccountLengths: []int{10}},
+	"BRI":       {Code: "BRI", Name: "Bank Rakyat Indonesia", Method: PayoutMethodBank, AccountLengths: []int{15}},
+	"MANDIRI":   {Code: "MANDIRI", Name: "Bank Mandiri", Method: PayoutMethodBank, AccountLengths: []int{13}},
+	"BSI":       {Code: "BSI", Name: "Bank Syariah Indonesia", Method: PayoutMethodBank, AccountLengths: []int{10}},
+	"CIMB":      {Code: "CIMB", Name: "CIMB Niaga", Method: PayoutMethodBank, AccountLengths: []int{13, 14}},
+	"PERMATA":   {Code: "PERMATA", Name: "Bank Permata", Method: PayoutMethodBank, AccountLengths: []int{10}},
+	"DANAMON":   {Code: "DANAMON", Name: "Bank Danamon", Method: PayoutMethodBank, AccountLengths: []int{10}},
+	"BTN":       {Code: "BTN", Name: "Bank Tabungan Negara", Method: PayoutMethodBank, AccountLengths: []int{16}},
+	"JAGO":      {Code: "JAGO", Name: "Bank Jago", Method: PayoutMethodBank, AccountLengths: []int{12}},
+	"GOPAY":     {Code: "GOPAY", Name: "GoPay", Method: PayoutMethodEWallet},
+	"OVO":       {Code: "OVO", Name: "OVO", Method: PayoutMethodEWallet},
+	"DANA":      {Code: "DANA", Name: "DANA", Method: PayoutMethodEWallet},
+	"SHOPEEPAY": {Code: "SHOPEEPAY", Name: "ShopeePay", Method: PayoutMethodEWallet},
+	"LINKAJA":   {Code: "LINKAJA", Name: "LinkAja", Method: PayoutMethodEWallet},
+}
+
+// PayoutChannels returns every supported bank and e-wallet, banks first, then by code
+func PayoutChannels() []PayoutChannel {
+	channels := make([]PayoutChannel, 0, len(payoutChannels))
+	for _, channel := range payoutChannels {
+		channels = append(channels, channel)
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		if channels[i].Method != channels[j].Method {
+			return channels[i].Method == PayoutMethodBank
+		}
+		return channels[i].Code < channels[j].Code
+	})
+	return channels
+}
+
+// GetPayoutChannel looks up a bank or e-wallet by its code, ignoring case
+func GetPayoutChannel(code string) (PayoutChannel, bool) {
+	channel, ok := payoutChannels[strings.ToUpper(strings.TrimSpace(code))]
+	return channel, ok
+}
+
+// NormalizeAccountNumber checks an account number against the channel's format and returns
+// it in canonical form: bank account numbers as bare digits, e-wallet phone numbers
+// starting with 08. Spaces, dots and dashes are ignored, and phone numbers may start with
+// +62 or 62.
+func (c PayoutChannel) NormalizeAccountNumber(number string) (string, error) {
+	number = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '.' {
+			return -1
+		}
+		return r
+	}, number)
+
+	if c.Method == PayoutMethodEWallet {
+		switch {
+		case strings.HasPrefix(number, "+62"):
+			number = "0" + number[3:]
+		case strings.HasPrefix(number, "62"):
+			number = "0" + number[2:]
+		}
+		if !isDigits(number) || !strings.HasPrefix(number, "08") || len(number) < 10 || len(number) > 13 {
+			return "", fmt.Errorf("%s accounts are Indonesian mobile numbers such as 081234567890", c.Name)
+		}
+		return number, nil
+	}
+
+	if isDigits(number) {
+		for _, length := range c.AccountLengths {
+			if len(number) == length {
+				return number, nil
+			}
+		}
+	}
+	lengths := make([]string, len(c.AccountLengths))
+	for i, length := range c.AccountLengths {
+		lengths[i] = fmt.Sprint(length)
+	}
+	return "", fmt.Errorf("%s account numbers are %s digits", c.Name, strings.Join(lengths, " or "))
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// PayoutAccount is a bank account or e-wallet a streamer withdraws their balance to
+type PayoutAccount struct {
+	Base
+	StreamerID    uint         `json:"streamer_id" gorm:"not null;index"`
+	Method        PayoutMethod `json:"method" gorm:"type:varchar(20);not null"`
+	Channel       string       `json:"channel" gorm:"type:varchar(20);not null"` // PayoutChannel code, e.g. BCA or GOPAY
+	AccountNumber string       `json:"account_number" gorm:"type:varchar(30);not null"`
+	AccountName   string       `json:"account_name" gorm:"type:varchar(100);not null"`
+}
+
+// TableName specifies the table name for PayoutAccount
+func (PayoutAccount) TableName() string {
+	return "payout_accounts"
+}
+
+// PayoutStatus represents where a withdrawal is in its lifecycle
+type PayoutStatus string
+
+const (
+	PayoutRequested  PayoutStatus = "requested"  // Waiting for an admin; the amount is held from the balance
+	PayoutProcessing PayoutStatus = "processing" // Approved and handed to the disbursement provider
+	PayoutPaid       PayoutStatus = "paid"       // Money reached the streamer's account
+	PayoutFailed     PayoutStatus = "failed"     // Disbursement failed; the amount went back to the balance
+	PayoutRejected   PayoutStatus = "rejected"   // Declined by an admin; the amount went back to the balance
+)
+
+// payoutTransitions lists the statuses each payout status can move to
+var payoutTransitions = map[PayoutStatus][]PayoutStatus{
+	PayoutRequested:  {PayoutProcessing, PayoutRejected},
+	PayoutProcessing: {PayoutPaid, PayoutFailed},
+}
+
+// CanTransitionTo reports whether a payout in this status may move to next
+func (s PayoutStatus) CanTransitionTo(next PayoutStatus) bool {
+	for _, allowed := range payoutTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ReturnsBalance reports whether a payout ending in this status gives its amount back to
+// the streamer's balance
+func (s PayoutStatus) ReturnsBalance() bool {
+	return s == PayoutFailed || s == PayoutRejected
+}
+
+// Payout is a streamer's request to withdraw part of their balance. The destination is
+// copied from the account when requested, so later account changes do not redirect it.
+type Payout struct {
+	Base
+	StreamerID        uint              `json:"streamer_id" gorm:"not null;index"`
+	AccountID         uint              `json:"account_id" gorm:"not null"`
+	Method            PayoutMethod      `json:"method" gorm:"type:varchar(20);not null"`
+	Channel           string            `json:"channel" gorm:"type:varchar(20);not null"`
+	AccountNumber     string            `json:"account_number" gorm:"type:varchar(30);not null"`
+	AccountName       string            `json:"account_name" gorm:"type:varchar(100);not null"`
+	Amount            int64             `json:"amount" gorm:"type:bigint;not null"` // Minor units of Currency
+	Currency          SupportedCurrency `json:"currency" gorm:"type:varchar(10);not null"`
+	Status            PayoutStatus      `json:"status" gorm:"type:varchar(20);default:'requested';index"`
+	ReviewedBy        uint              `json:"reviewed_by,omitempty"` // Admin who approved or rejected it
+	ReviewedAt        *time.Time        `json:"reviewed_at"`
+	ProviderReference string            `json:"provider_reference"` // Disbursement ID at the provider
+	FailureReason     string            `json:"failure_reason"`     // Why it failed or was rejected
+	PaidAt            *time.Time        `json:"paid_at"`
+}
+
+// TableName specifies the table name for Payout
+func (Payout) TableName() string {
+	return "payouts"
+}
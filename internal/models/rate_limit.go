@@ -0,0 +1,74 @@
+package models
+
+import (
+	"math"
+	"time"
+)
+
+// RateLimitPolicy is a token bucket holding up to Limit requests, refilled evenly over
+// Period, so a client can burst Limit requests and then make one every Period/Limit
+type RateLimitPolicy struct {
+	Name   string // Routes whose policies share a name share a bucket
+	Limit  int
+	Period time.Duration
+}
+
+// Unlimited reports whether the policy lets every request through
+func (p RateLimitPolicy) Unlimited() bool {
+	return p.Limit <= 0 || p.Period <= 0
+}
+
+// RefillRate returns how many tokens the bucket gains per second
+func (p RateLimitPolicy) RefillRate() float64 {
+	return float64(p.Limit) / p.Period.Seconds()
+}
+
+// Refill returns the tokens in a bucket elapsed after it held tokens, capped at Limit
+func (p RateLimitPolicy) Refill(tokens float64, elapsed time.Duration) float64 {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return math.Min(float64(p.Limit), tokens+elapsed.Seconds()*p.RefillRate())
+}
+
+// Result describes a bucket left with tokens after a request it allowed or refused
+func (p RateLimitPolicy) Result(tokens float64, allowed bool) RateLimitResult {
+	result := RateLimitResult{
+		Allowed:    allowed,
+		Remaining:  int(math.Floor(tokens)),
+		ResetAfter: p.timeToGain(float64(p.Limit) - tokens),
+	}
+	if !allowed {
+		result.RetryAfter = p.timeToGain(1 - tokens)
+	}
+	return result
+}
+
+func (p RateLimitPolicy) timeToGain(tokens float64) time.Duration {
+	if tokens <= 0 {
+		return 0
+	}
+	return time.Duration(math.Ceil(tokens / p.RefillRate() * float64(time.Second)))
+}
+
+// RateLimitResult is the outcome of taking a token for a request
+type RateLimitResult struct {
+	Allowed    bool
+	Remaining  int           // Requests that can still be made right away
+	ResetAfter time.Duration // Until the bucket is full again
+	RetryAfter time.Duration // Until the next request is allowed, when this one was refused
+}
+
+// RateLimitBucket is a token bucket kept in the gateway database, so that every gateway
+// replica draws from the same one
+type RateLimitBucket struct {
+	Key       string    `json:"key" gorm:"primaryKey;type:varchar(255)"`
+	Tokens    float64   `json:"tokens" gorm:"type:double precision;not null"`
+	Allowed   bool      `json:"allowed"` // Whether the latest request was let through
+	UpdatedAt time.Time `json:"updated_at" gorm:"not null;index"`
+}
+
+// TableName specifies the table name for RateLimitBucket
+func (RateLimitBucket) TableName() string {
+	return "rate_limit_buckets"
+}
@@ -0,0 +1,55 @@
+package models
+
+import "time"
+
+// ReceiptFormat is a file format receipts are rendered in
+type ReceiptFormat string
+
+const (
+	ReceiptHTML ReceiptFormat = "html"
+	ReceiptPDF  ReceiptFormat = "pdf"
+)
+
+// IsValid reports whether receipts can be rendered in the format
+func (f ReceiptFormat) IsValid() bool {
+	return f == ReceiptHTML || f == ReceiptPDF
+}
+
+// DonationReceipt is a completed donation's receipt, localized for the donor. Amounts are
+// minor units of Currency.
+type DonationReceipt struct {
+	DonationID       uint              `json:"donation_id"`
+	VerificationCode string            `json:"verification_code"`
+	VerifyURL        string            `json:"verify_url,omitempty"` // Where anyone can check the code
+	Language         SupportedLanguage `json:"language"`
+	Timezone         string            `json:"timezone"`
+	DonatorID        uint              `json:"donator_id,omitempty"` // 0 for guests
+	DonorName        string            `json:"donor_name"`
+	StreamerID       uint              `json:"streamer_id"`
+	StreamerName     string            `json:"streamer_name"`
+	Amount           int64             `json:"amount"`
+	Currency         SupportedCurrency `json:"currency"`
+	FormattedAmount  string            `json:"formatted_amount"`
+	RefundedAmount   int64             `json:"refunded_amount,omitempty"`
+	FormattedRefund  string            `json:"formatted_refund,omitempty"`
+	PaidAt           time.Time         `json:"paid_at"` // In the donor's timezone
+	FormattedDate    string            `json:"formatted_date"`
+	TransactionID    string            `json:"transaction_id"`
+	// Labels holds the receipt's text in Language, by translation key
+	Labels map[string]string `json:"-"`
+}
+
+// ReceiptVerification is what anyone holding a receipt may learn by checking its code.
+// It leaves out the donor, who may have given anonymously.
+type ReceiptVerification struct {
+	Valid           bool              `json:"valid"`
+	DonationID      uint              `json:"donation_id"`
+	Status          PaymentStatus     `json:"status"`
+	StreamerName    string            `json:"streamer_name"`
+	Amount          int64             `json:"amount"`
+	Currency        SupportedCurrency `json:"currency"`
+	FormattedAmount string            `json:"formatted_amount"`
+	RefundedAmount  int64             `json:"refunded_amount"`
+	TransactionID   string            `json:"transaction_id"`
+	PaidAt          time.Time         `json:"paid_at"`
+}
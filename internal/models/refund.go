@@ -0,0 +1,35 @@
+package models
+
+import "time"
+
+// RefundStatus represents the outcome of a refund attempt
+type RefundStatus string
+
+const (
+	RefundPending   RefundStatus = "pending"
+	RefundSucceeded RefundStatus = "succeeded"
+	RefundFailed    RefundStatus = "failed"
+)
+
+// DonationRefund records a full or partial refund of a donation. A chargeback is recorded
+// as a refund the provider already made on the donor's behalf.
+type DonationRefund struct {
+	Base
+	DonationID       uint              `json:"donation_id" gorm:"not null;index"`
+	Amount           int64             `json:"amount" gorm:"type:bigint;not null"` // Minor units of Currency
+	Currency         SupportedCurrency `json:"currency"`
+	Reason           string            `json:"reason" gorm:"type:text"`
+	Status           RefundStatus      `json:"status" gorm:"type:varchar(20);default:'pending'"`
+	Provider         PaymentProvider   `json:"provider"`
+	ProviderRefundID string            `json:"provider_refund_id"`
+	RequestedBy      uint              `json:"requested_by"`
+	Chargeback       bool              `json:"chargeback" gorm:"default:false"`
+	Manual           bool              `json:"manual" gorm:"default:false"` // Made outside the provider
+	FailureReason    string            `json:"failure_reason"`
+	ProcessedAt      *time.Time        `json:"processed_at"`
+}
+
+// TableName specifies the table name for DonationRefund
+func (DonationRefund) TableName() string {
+	return "donation_refunds"
+}
@@ -0,0 +1,87 @@
+package models
+
+import "time"
+
+// RiskDecision is what happens to a donation request after fraud screening
+type RiskDecision string
+
+const (
+	RiskAllow     RiskDecision = "allow"     // The donation is created
+	RiskChallenge RiskDecision = "challenge" // The donor has to prove they are genuine first
+	RiskBlock     RiskDecision = "block"     // The donation is refused
+)
+
+// RiskRule names a fraud check that can add to a donation request's score
+type RiskRule string
+
+const (
+	RiskRuleDonorVelocity    RiskRule = "donor_velocity"    // Too many attempts by one donor
+	RiskRuleIPVelocity       RiskRule = "ip_velocity"       // Too many attempts from one IP address
+	RiskRuleStreamerVelocity RiskRule = "streamer_velocity" // Too many attempts at one streamer
+	RiskRuleTinyAmounts      RiskRule = "tiny_amounts"      // Repeated tiny amounts, typical of card testing
+	RiskRuleCountryMismatch  RiskRule = "country_mismatch"  // The IP's country does not use the donation's currency
+)
+
+// RiskReason is one rule that fired, with the points it added
+type RiskReason struct {
+	Rule   RiskRule `json:"rule"`
+	Points int      `json:"points"`
+	Detail string   `json:"detail"`
+}
+
+// RiskAssessment records the screening of one donation request. Every request is kept,
+// whatever the decision, because the velocity rules count them; blocked and challenged
+// ones are what admins review.
+type RiskAssessment struct {
+	ID              uint              `json:"id" gorm:"primaryKey"`
+	CreatedAt       time.Time         `json:"created_at" gorm:"index:idx_risk_streamer_time,priority:2;index:idx_risk_donator_time,priority:2;index:idx_risk_ip_time,priority:2"`
+	StreamerID      uint              `json:"streamer_id" gorm:"index:idx_risk_streamer_time,priority:1"`
+	DonatorID       uint              `json:"donator_id,omitempty" gorm:"index:idx_risk_donator_time,priority:1"`
+	IPAddress       string            `json:"ip_address" gorm:"type:varchar(45);index:idx_risk_ip_time,priority:1"`
+	Country         string            `json:"country,omitempty" gorm:"type:varchar(2)"` // ISO 3166-1 alpha-2 of the IP, when known
+	Amount          int64             `json:"amount" gorm:"type:bigint"`                // Minor units of Currency
+	Currency        SupportedCurrency `json:"currency" gorm:"type:varchar(10)"`
+	TinyAmount      bool              `json:"tiny_amount"`
+	PaymentProvider PaymentProvider   `json:"payment_provider,omitempty"`
+	SplitDonationID uint              `json:"split_donation_id,omitempty" gorm:"index"`
+	Score           int               `json:"score"`
+	Decision        RiskDecision      `json:"decision" gorm:"type:varchar(20);index"`
+	Reasons         []RiskReason      `json:"reasons" gorm:"type:text;serializer:json"`
+	DonationID      uint              `json:"donation_id,omitempty"` // Set once an allowed request created its donation
+}
+
+// AddReason records a rule that fired and adds its points to the score
+func (a *RiskAssessment) AddReason(rule RiskRule, points int, detail string) {
+	a.Reasons = append(a.Reasons, RiskReason{Rule: rule, Points: points, Detail: detail})
+	a.Score += points
+}
+
+// TableName specifies the table name for RiskAssessment
+func (RiskAssessment) TableName() string {
+	return "risk_assessments"
+}
+
+// currencyCountries are the ISO country codes where a currency is the local one. Currencies
+// spent everywhere, such as USD and EUR, are left out.
+var currencyCountries = map[SupportedCurrency][]string{
+	CurrencyIDR: {"ID"},
+	CurrencySGD: {"SG"},
+	CurrencyMYR: {"MY"},
+	CurrencyJPY: {"JP"},
+	CurrencyCNY: {"CN"},
+}
+
+// IsLocalTo reports whether the currency is used in the country, treating currencies
+// without a home country as used everywhere
+func (c SupportedCurrency) IsLocalTo(country string) bool {
+	countries, ok := currencyCountries[c]
+	if !ok {
+		return true
+	}
+	for _, local := range countries {
+		if local == country {
+			return true
+		}
+	}
+	return false
+}
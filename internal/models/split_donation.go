@@ -0,0 +1,79 @@
+package models
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SplitMode decides how a split donation's amount is divided between its streamers
+type SplitMode string
+
+const (
+	SplitModeEqual      SplitMode = "equal"      // Every streamer gets the same share
+	SplitModePercentage SplitMode = "percentage" // Each share sets its own percentage
+)
+
+// SplitDonationOrderPrefix starts the payment transaction IDs of split donations, so
+// provider notifications can be routed to the split instead of a single donation
+const SplitDonationOrderPrefix = "SPLIT-"
+
+// SplitBasisPointsTotal is 100% in basis points (hundredths of a percent)
+const SplitBasisPointsTotal = 10000
+
+// SplitDonation is a single payment divided into one donation per streamer, e.g. to tip
+// every participant of a collab stream at once. Status follows the payment; refunds are
+// made per share and summed into RefundedAmount.
+type SplitDonation struct {
+	Base
+	DonatorID       uint                 `json:"donator_id" gorm:"index"` // 0 for guests
+	DisplayName     string               `json:"display_name"`
+	IsAnonymous     bool                 `json:"is_anonymous" gorm:"default:false"`
+	Message         string               `json:"message" gorm:"type:text"`
+	Amount          int64                `json:"amount" gorm:"type:bigint;not null"` // Minor units of Currency
+	Currency        SupportedCurrency    `json:"currency" gorm:"default:'IDR'"`
+	Mode            SplitMode            `json:"mode" gorm:"type:varchar(20);not null"`
+	Status          PaymentStatus        `json:"status" gorm:"default:'pending';index"`
+	PaymentProvider PaymentProvider      `json:"payment_provider"`
+	TransactionID   string               `json:"transaction_id" gorm:"index"`
+	PaymentTime     *time.Time           `json:"payment_time"`
+	Shares          []SplitDonationShare `json:"shares" gorm:"foreignKey:SplitDonationID"`
+	RefundedAmount  int64                `json:"refunded_amount" gorm:"-"` // Sum of the shares' refunds, loaded with the donations
+}
+
+// TableName specifies the table name for SplitDonation
+func (SplitDonation) TableName() string {
+	return "split_donations"
+}
+
+// Money returns the total amount paid
+func (s *SplitDonation) Money() Money {
+	return Money{Minor: s.Amount, Currency: s.Currency}
+}
+
+// SplitOrderID returns the payment transaction ID of a split donation
+func SplitOrderID(splitID uint, at time.Time) string {
+	return SplitDonationOrderPrefix + strconv.FormatUint(uint64(splitID), 10) + "-" + strconv.FormatInt(at.Unix(), 10)
+}
+
+// IsSplitOrderID reports whether a payment transaction ID belongs to a split donation
+func IsSplitOrderID(transactionID string) bool {
+	return strings.HasPrefix(transactionID, SplitDonationOrderPrefix)
+}
+
+// SplitDonationShare is one streamer's part of a split donation and the donation that
+// pays it out, which lives in the donation service
+type SplitDonationShare struct {
+	Base
+	SplitDonationID uint      `json:"split_donation_id" gorm:"not null;index"`
+	StreamerID      uint      `json:"streamer_id" gorm:"not null;index"`
+	BasisPoints     int64     `json:"basis_points" gorm:"not null"`       // Share of the total in hundredths of a percent
+	Amount          int64     `json:"amount" gorm:"type:bigint;not null"` // Minor units of the split's currency
+	DonationID      uint      `json:"donation_id" gorm:"index"`
+	Donation        *Donation `json:"donation,omitempty" gorm:"-"` // Loaded from the donation service on request
+}
+
+// TableName specifies the table name for SplitDonationShare
+func (SplitDonationShare) TableName() string {
+	return "split_donation_shares"
+}
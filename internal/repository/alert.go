@@ -0,0 +1,12 @@
+package repository
+
+import "github.com/rzfd/mediashar/internal/models"
+
+type AlertRuleRepository interface {
+	Create(rule *models.AlertRule) error
+	GetByID(id uint) (*models.AlertRule, error)
+	Update(rule *models.AlertRule) error
+	Delete(id uint) error
+	// GetByStreamerID returns the streamer's rules ordered by currency, then highest minimum first
+	GetByStreamerID(streamerID uint, activeOnly bool) ([]*models.AlertRule, error)
+}
@@ -10,6 +10,9 @@ type CurrencyRepository interface {
 	// GetExchangeRate retrieves exchange rate from database
 	GetExchangeRate(ctx context.Context, from, to models.SupportedCurrency) (float64, error)
 	
+	// GetExchangeRateQuote retrieves exchange rate from database with its source and update time
+	GetExchangeRateQuote(ctx context.Context, from, to models.SupportedCurrency) (*models.ExchangeRateQuote, error)
+	
 	// SaveExchangeRate saves exchange rate to database
 	SaveExchangeRate(ctx context.Context, rate *models.CurrencyRate) error
 	
@@ -1,6 +1,10 @@
 package repository
 
-import "github.com/rzfd/mediashar/internal/models"
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
 
 type DonationRepository interface {
 	Create(donation *models.Donation) error
@@ -11,7 +15,42 @@ type DonationRepository interface {
 	List(offset, limit int) ([]*models.Donation, error)
 	GetByDonatorID(donatorID uint, offset, limit int) ([]*models.Donation, error)
 	GetByStreamerID(streamerID uint, offset, limit int) ([]*models.Donation, error)
+	// ListPage returns up to query.Limit donations matching the filter that sort after the cursor
+	ListPage(query *models.DonationListQuery) ([]*models.Donation, error)
+	CountByFilter(filter models.DonationFilter) (int64, error)
+	// StreamByFilter calls fn for each donation matching the filter, oldest first, reading
+	// them from a database cursor one at a time. Iteration stops at the first error.
+	StreamByFilter(filter models.DonationFilter, fn func(*models.Donation) error) error
 	UpdateStatus(id uint, status models.PaymentStatus) error
 	GetLatestDonations(limit int) ([]*models.Donation, error)
-	GetTotalAmountByStreamer(streamerID uint) (float64, error)
+	// GetTotalAmountByStreamer sums a streamer's completed donations per snapshot currency
+	GetTotalAmountByStreamer(streamerID uint) ([]models.Money, error)
+	GetStatsBuckets(streamerID uint, interval models.StatsInterval, start, end time.Time) ([]*models.DonationStatBucket, error)
+	// GetDonorTotals sums completed donations per donor and currency between start
+	// (nil for no lower bound) and end. Anonymous donations are grouped under donator 0
+	// when includeAnonymous is set and left out otherwise.
+	GetDonorTotals(streamerID uint, start *time.Time, end time.Time, includeAnonymous bool) ([]*models.DonorCurrencyTotal, error)
+	ReserveRefund(id uint, amount int64) (bool, error)
+	ReleaseRefund(id uint, amount int64) error
+	// TransitionStatus moves a donation from one status to another and records the
+	// change in its history. It returns false if the donation is no longer in from.
+	TransitionStatus(id uint, from, to models.PaymentStatus, history *models.DonationStatusHistory) (bool, error)
+	GetStatusHistory(donationID uint) ([]*models.DonationStatusHistory, error)
+	// GetExpiredPending returns up to limit pending donations created before the deadline
+	// of their payment provider, falling back to defaultDeadline for other providers
+	GetExpiredPending(deadlines map[models.PaymentProvider]time.Time, defaultDeadline time.Time, limit int) ([]*models.Donation, error)
+	// GetWithoutRateSnapshot returns up to limit donations, oldest first, that have no
+	// exchange-rate snapshot yet
+	GetWithoutRateSnapshot(limit int) ([]*models.Donation, error)
+	// GetLatestSnapshotCurrency returns the snapshot currency of the streamer's latest
+	// snapshotted donation, or an empty currency when there is none
+	GetLatestSnapshotCurrency(streamerID uint) (models.SupportedCurrency, error)
+	// SaveRateSnapshot stores a donation's exchange-rate snapshot unless it already has
+	// one, and reports whether it was stored
+	SaveRateSnapshot(donation *models.Donation) (bool, error)
+	// SaveTranslation stores the donation's message language and translation
+	SaveTranslation(donation *models.Donation) error
+	// SavePaymentDetails stores the donation's transaction ID, provider and payment
+	// time, leaving its status to TransitionStatus
+	SavePaymentDetails(donation *models.Donation) error
 } 
\ No newline at end of file
@@ -0,0 +1,31 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+type DonationExportRepository interface {
+	Create(export *models.DonationExport) error
+	GetByID(id uint) (*models.DonationExport, error)
+	// ClaimPending marks the oldest pending export as running under a new attempt and
+	// returns it, or nil when there is none. Concurrent callers never claim the same export.
+	ClaimPending() (*models.DonationExport, error)
+	// RequeueStale puts running exports without progress since the given time back in the queue
+	RequeueStale(before time.Time) (int64, error)
+	// Complete, Fail and AppendChunk only apply while the export is still running under
+	// the attempt it was claimed with, and return models.ErrExportLeaseLost otherwise
+	Complete(export *models.DonationExport) error
+	// Fail marks an export failed and deletes its chunks; the record is kept until
+	// expiresAt so the reason can be read
+	Fail(export *models.DonationExport, reason string, expiresAt time.Time) error
+
+	// AppendChunk stores a chunk and records progress on the export
+	AppendChunk(export *models.DonationExport, chunk *models.DonationExportChunk) error
+	// StreamChunks calls fn with each chunk of an export's file in order
+	StreamChunks(exportID uint, fn func(data []byte) error) error
+	DeleteChunks(exportID uint) error
+	// DeleteExpired removes exports whose file expired before the given time, with their chunks
+	DeleteExpired(before time.Time) (int64, error)
+}
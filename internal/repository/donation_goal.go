@@ -0,0 +1,14 @@
+package repository
+
+import "github.com/rzfd/mediashar/internal/models"
+
+type DonationGoalRepository interface {
+	Create(goal *models.DonationGoal) error
+	GetByID(id uint) (*models.DonationGoal, error)
+	Update(goal *models.DonationGoal) error
+	Delete(id uint) error
+	GetByStreamerID(streamerID uint, activeOnly bool) ([]*models.DonationGoal, error)
+	// AddContribution records the contribution and advances the goal atomically.
+	// It returns false if the donation was already counted toward the goal.
+	AddContribution(contribution *models.DonationGoalContribution) (bool, error)
+}
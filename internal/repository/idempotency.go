@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+type IdempotencyRepository interface {
+	// Create inserts the record and reports false when the scope and key are already taken
+	Create(record *models.IdempotencyRecord) (bool, error)
+	Get(scope, key string) (*models.IdempotencyRecord, error)
+	Complete(id uint, statusCode int, contentType string, body []byte) error
+	Delete(id uint) error
+	DeleteExpired(now time.Time) (int64, error)
+}
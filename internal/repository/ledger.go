@@ -0,0 +1,22 @@
+package repository
+
+import "github.com/rzfd/mediashar/internal/models"
+
+// LedgerRepository stores the append-only ledger; it has no way to change or remove an
+// entry once posted
+type LedgerRepository interface {
+	// Post stores an entry with its lines in one transaction. It reports false, storing
+	// nothing, when an entry with the same reference already exists.
+	Post(entry *models.LedgerEntry) (bool, error)
+	GetByReference(reference string) (*models.LedgerEntry, error)
+	// GetByStreamerID returns the entries that moved a streamer's balance, newest first
+	GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.LedgerEntry, error)
+	GetStreamerBalances(streamerID uint) ([]*models.StreamerBalance, error)
+
+	// GetUnpostedPayments returns completed or refunded donations after afterID that have
+	// no payment entry, in ID order
+	GetUnpostedPayments(afterID uint, limit int) ([]*models.Donation, error)
+	// GetUnpostedRefunds returns succeeded refunds after afterID that have no entry, in ID
+	// order
+	GetUnpostedRefunds(afterID uint, limit int) ([]*models.DonationRefund, error)
+}
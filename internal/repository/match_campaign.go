@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// MatchCampaignRepository stores sponsors' matching campaigns and their matches. A
+// campaign's budget only changes in the transaction that records a match.
+type MatchCampaignRepository interface {
+	Create(campaign *models.MatchCampaign) error
+	// UpdateTerms saves a campaign's terms, leaving its spending alone. It reports false,
+	// changing nothing, when the budget is below what was already spent or the currency
+	// changed after the first match.
+	UpdateTerms(campaign *models.MatchCampaign) (bool, error)
+	GetByID(id uint) (*models.MatchCampaign, error)
+	// List returns campaigns, newest first
+	List(page, pageSize int) ([]*models.MatchCampaign, error)
+	// ListRunning returns the active campaigns whose window includes at and which have
+	// budget left
+	ListRunning(at time.Time) ([]*models.MatchCampaign, error)
+
+	// CreateMatch matches a donation from a campaign, holding the campaign's row lock so
+	// concurrent matches see each other's spending. build gets the locked campaign and
+	// returns the match and the sponsor's donation, or nil when there is nothing to
+	// match; both are stored and the match amount is taken from the budget. It reports
+	// false, storing nothing, when the campaign already matched the donation or build
+	// returned nil.
+	CreateMatch(campaignID, donationID uint, build func(*models.MatchCampaign) (*models.DonationMatch, *models.Donation)) (bool, error)
+	// RefundMatches takes back the matches of a donor's donation in one transaction,
+	// holding the row locks of their campaigns. build gets each match, the donor's
+	// donation and the sponsor's locked donation, and returns the sponsor's refund or nil
+	// when nothing more is owed. Each refund is stored, added to the sponsor's donation
+	// and the match, which become refunded once fully refunded, and returned to the
+	// campaign's budget.
+	RefundMatches(donationID uint, build func(match *models.DonationMatch, donation, sponsor *models.Donation) *models.DonationRefund) ([]*models.DonationRefund, error)
+	// GetStreamerTotals sums a campaign's matches per streamer
+	GetStreamerTotals(campaignID uint) ([]*models.MatchStreamerTotal, error)
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+type MembershipRepository interface {
+	// Tier operations
+	CreateTier(tier *models.MembershipTier) error
+	GetTierByID(id uint) (*models.MembershipTier, error)
+	UpdateTier(tier *models.MembershipTier) error
+	GetTiersByStreamerID(streamerID uint, activeOnly bool) ([]*models.MembershipTier, error)
+
+	// Membership operations
+	Create(membership *models.Membership) error
+	GetByID(id uint) (*models.Membership, error)
+	Update(membership *models.Membership) error
+	GetByMemberID(memberID uint) ([]*models.Membership, error)
+	GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.Membership, error)
+	// GetCurrent returns the member's pending, active or past-due membership with a streamer, or nil if there is none
+	GetCurrent(memberID, streamerID uint) (*models.Membership, error)
+	// ClaimDueForRenewal locks active memberships whose period ended at or before now,
+	// skipping rows another worker already holds, and passes each to claim. The changes
+	// claim makes are saved in the same transaction, so a membership is claimed once.
+	ClaimDueForRenewal(now time.Time, limit int, claim func(membership *models.Membership)) ([]*models.Membership, error)
+	// GetAwaitingDonation returns past-due memberships claimed for renewal before the
+	// given time whose renewal donation was never recorded
+	GetAwaitingDonation(claimedBefore time.Time, limit int) ([]*models.Membership, error)
+	// GetAwaitingPayment returns memberships with an outstanding donation
+	GetAwaitingPayment(limit int) ([]*models.Membership, error)
+
+	// Payment operations
+	CreatePayment(payment *models.MembershipPayment) error
+	GetPaymentByDonationID(donationID uint) (*models.MembershipPayment, error)
+	UpdatePayment(payment *models.MembershipPayment) error
+	GetPaymentsByMembershipID(membershipID uint) ([]*models.MembershipPayment, error)
+}
@@ -0,0 +1,20 @@
+package repository
+
+import "github.com/rzfd/mediashar/internal/models"
+
+type ModerationRepository interface {
+	// GetSettings returns nil without an error when the streamer has not configured moderation
+	GetSettings(streamerID uint) (*models.ModerationSettings, error)
+	SaveSettings(settings *models.ModerationSettings) error
+
+	ListBlockedTerms() ([]*models.BlockedTerm, error)
+	CreateBlockedTerm(term *models.BlockedTerm) error
+	DeleteBlockedTerm(id uint) error
+
+	CreateReview(review *models.MessageReview) error
+	GetReviewByID(id uint) (*models.MessageReview, error)
+	GetReviewsByStreamerID(streamerID uint, status models.MessageReviewStatus, page, pageSize int) ([]*models.MessageReview, int64, error)
+	// ResolveReview settles a pending review and applies the decision to its donation's
+	// message in one transaction. It returns false if the review was already resolved.
+	ResolveReview(review *models.MessageReview, status models.MessageReviewStatus) (bool, error)
+}
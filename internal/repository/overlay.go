@@ -0,0 +1,10 @@
+package repository
+
+import "github.com/rzfd/mediashar/internal/models"
+
+type OverlayRepository interface {
+	// GetByStreamerID returns nil without an error when the streamer has no token yet
+	GetByStreamerID(streamerID uint) (*models.OverlayToken, error)
+	GetByToken(token string) (*models.OverlayToken, error)
+	Save(token *models.OverlayToken) error
+}
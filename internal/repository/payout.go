@@ -0,0 +1,27 @@
+package repository
+
+import "github.com/rzfd/mediashar/internal/models"
+
+// PayoutRepository stores streamers' payout accounts and withdrawals. Every change to a
+// payout's money is written in the same transaction as its ledger entry.
+type PayoutRepository interface {
+	CreateAccount(account *models.PayoutAccount) error
+	GetAccountByID(id uint) (*models.PayoutAccount, error)
+	GetAccountsByStreamerID(streamerID uint) ([]*models.PayoutAccount, error)
+	DeleteAccount(id uint) error
+
+	// Create stores a payout together with the ledger entry holding its amount, which
+	// hold builds once the payout has an ID. Requests of one streamer are serialised and
+	// fail with models.ErrInsufficientBalance when the balance cannot cover the amount.
+	Create(payout *models.Payout, hold func(*models.Payout) *models.LedgerEntry) error
+	// TransitionStatus saves a payout's status and review fields if it is still in
+	// status from, posting entry (when not nil) in the same transaction. It reports false,
+	// changing nothing, when the payout has moved on.
+	TransitionStatus(payout *models.Payout, from models.PayoutStatus, entry *models.LedgerEntry) (bool, error)
+	GetByID(id uint) (*models.Payout, error)
+	// GetByStreamerID returns a streamer's payouts, newest first
+	GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.Payout, error)
+	// List returns payouts in a status, or all when status is empty, oldest first so
+	// admins work through requests in order
+	List(status models.PayoutStatus, page, pageSize int) ([]*models.Payout, error)
+}
@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+type RateLimitRepository interface {
+	// Take refills the bucket for key by the database clock and takes a token from it if
+	// one is left, atomically, so concurrent gateway replicas share the bucket
+	Take(key string, policy models.RateLimitPolicy) (models.RateLimitResult, error)
+	// DeleteIdle removes buckets untouched since before, which have long refilled
+	DeleteIdle(before time.Time) (int64, error)
+}
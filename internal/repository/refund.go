@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+type RefundRepository interface {
+	Create(refund *models.DonationRefund) error
+	Update(refund *models.DonationRefund) error
+	// Finish saves a pending refund's outcome: status, provider refund ID, failure reason
+	// and processing time. It reports false, changing nothing, when the refund was no
+	// longer pending.
+	Finish(refund *models.DonationRefund) (bool, error)
+	// GetStalePending returns refunds still pending that were created before the given
+	// time, oldest first
+	GetStalePending(createdBefore time.Time, limit int) ([]*models.DonationRefund, error)
+	GetByID(id uint) (*models.DonationRefund, error)
+	GetByDonationID(donationID uint) ([]*models.DonationRefund, error)
+}
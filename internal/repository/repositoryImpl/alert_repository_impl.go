@@ -0,0 +1,47 @@
+package repositoryImpl
+
+import (
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+)
+
+type alertRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewAlertRuleRepository(db *gorm.DB) repository.AlertRuleRepository {
+	return &alertRuleRepository{db: db}
+}
+
+func (r *alertRuleRepository) Create(rule *models.AlertRule) error {
+	return r.db.Create(rule).Error
+}
+
+func (r *alertRuleRepository) GetByID(id uint) (*models.AlertRule, error) {
+	var rule models.AlertRule
+	if err := r.db.First(&rule, id).Error; err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *alertRuleRepository) Update(rule *models.AlertRule) error {
+	return r.db.Model(rule).
+		Select("name", "currency", "min_amount", "max_amount", "message_template", "sound_url", "image_url", "duration_seconds", "is_active").
+		Updates(rule).Error
+}
+
+func (r *alertRuleRepository) Delete(id uint) error {
+	return r.db.Delete(&models.AlertRule{}, id).Error
+}
+
+func (r *alertRuleRepository) GetByStreamerID(streamerID uint, activeOnly bool) ([]*models.AlertRule, error) {
+	var rules []*models.AlertRule
+	query := r.db.Where("streamer_id = ?", streamerID)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	err := query.Order("currency ASC, min_amount DESC, id ASC").Find(&rules).Error
+	return rules, err
+}
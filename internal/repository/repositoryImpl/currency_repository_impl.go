@@ -22,6 +22,15 @@ func NewCurrencyRepository(db *gorm.DB) repository.CurrencyRepository {
 
 // GetExchangeRate retrieves exchange rate from database
 func (r *CurrencyRepositoryImpl) GetExchangeRate(ctx context.Context, from, to models.SupportedCurrency) (float64, error) {
+	quote, err := r.GetExchangeRateQuote(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+	return quote.Rate, nil
+}
+
+// GetExchangeRateQuote retrieves exchange rate from database with its source and update time
+func (r *CurrencyRepositoryImpl) GetExchangeRateQuote(ctx context.Context, from, to models.SupportedCurrency) (*models.ExchangeRateQuote, error) {
 	var rate models.CurrencyRate
 	
 	// Look for direct rate
@@ -33,7 +42,13 @@ func (r *CurrencyRepositoryImpl) GetExchangeRate(ctx context.Context, from, to m
 	if err == nil {
 		// Check if rate is not too old (older than 1 hour)
 		if time.Now().Unix()-rate.LastUpdated < 3600 {
-			return rate.Rate, nil
+			return &models.ExchangeRateQuote{
+				From:        from,
+				To:          to,
+				Rate:        rate.Rate,
+				Source:      rate.Source,
+				RetrievedAt: time.Unix(rate.LastUpdated, 0),
+			}, nil
 		}
 	}
 	
@@ -46,11 +61,17 @@ func (r *CurrencyRepositoryImpl) GetExchangeRate(ctx context.Context, from, to m
 	if err == nil && rate.Rate > 0 {
 		// Check if inverse rate is not too old
 		if time.Now().Unix()-rate.LastUpdated < 3600 {
-			return 1.0 / rate.Rate, nil
+			return &models.ExchangeRateQuote{
+				From:        from,
+				To:          to,
+				Rate:        1.0 / rate.Rate,
+				Source:      rate.Source,
+				RetrievedAt: time.Unix(rate.LastUpdated, 0),
+			}, nil
 		}
 	}
 	
-	return 0, fmt.Errorf("exchange rate not found for %s to %s", from, to)
+	return nil, fmt.Errorf("exchange rate not found for %s to %s", from, to)
 }
 
 // SaveExchangeRate saves exchange rate to database
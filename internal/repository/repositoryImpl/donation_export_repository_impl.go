@@ -0,0 +1,164 @@
+package repositoryImpl
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type donationExportRepository struct {
+	db *gorm.DB
+}
+
+func NewDonationExportRepository(db *gorm.DB) repository.DonationExportRepository {
+	return &donationExportRepository{db: db}
+}
+
+func (r *donationExportRepository) Create(export *models.DonationExport) error {
+	return r.db.Create(export).Error
+}
+
+func (r *donationExportRepository) GetByID(id uint) (*models.DonationExport, error) {
+	var export models.DonationExport
+	err := r.db.First(&export, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &export, nil
+}
+
+func (r *donationExportRepository) ClaimPending() (*models.DonationExport, error) {
+	var claimed *models.DonationExport
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// SKIP LOCKED lets every replica claim a different export without waiting
+		var export models.DonationExport
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ?", models.ExportPending).
+			Order("id ASC").
+			First(&export).Error
+		if err == gorm.ErrRecordNotFound {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		if err := tx.Model(&export).Updates(map[string]interface{}{
+			"status":       models.ExportRunning,
+			"started_at":   now,
+			"heartbeat_at": now,
+			"attempt":      export.Attempt + 1,
+		}).Error; err != nil {
+			return err
+		}
+
+		export.Status = models.ExportRunning
+		export.StartedAt = &now
+		export.HeartbeatAt = &now
+		export.Attempt++
+		claimed = &export
+		return nil
+	})
+	return claimed, err
+}
+
+func (r *donationExportRepository) RequeueStale(before time.Time) (int64, error) {
+	result := r.db.Model(&models.DonationExport{}).
+		Where("status = ? AND COALESCE(heartbeat_at, started_at) < ?", models.ExportRunning, before).
+		Updates(map[string]interface{}{"status": models.ExportPending, "started_at": nil, "heartbeat_at": nil})
+	return result.RowsAffected, result.Error
+}
+
+func (r *donationExportRepository) Complete(export *models.DonationExport) error {
+	return r.updateLeased(r.db, export, map[string]interface{}{
+		"status":       models.ExportCompleted,
+		"row_count":    export.RowCount,
+		"file_size":    export.FileSize,
+		"completed_at": export.CompletedAt,
+		"expires_at":   export.ExpiresAt,
+	})
+}
+
+func (r *donationExportRepository) Fail(export *models.DonationExport, reason string, expiresAt time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := r.updateLeased(tx, export, map[string]interface{}{
+			"status":       models.ExportFailed,
+			"error":        reason,
+			"completed_at": time.Now(),
+			"expires_at":   expiresAt,
+		}); err != nil {
+			return err
+		}
+		return tx.Where("export_id = ?", export.ID).Delete(&models.DonationExportChunk{}).Error
+	})
+}
+
+func (r *donationExportRepository) AppendChunk(export *models.DonationExport, chunk *models.DonationExportChunk) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Holding the export row until the chunk is in keeps a requeue from slipping in between
+		if err := r.updateLeased(tx, export, map[string]interface{}{"heartbeat_at": time.Now()}); err != nil {
+			return err
+		}
+		return tx.Create(chunk).Error
+	})
+}
+
+// updateLeased updates an export only while it is running under the caller's attempt
+func (r *donationExportRepository) updateLeased(db *gorm.DB, export *models.DonationExport, updates map[string]interface{}) error {
+	result := db.Model(&models.DonationExport{}).
+		Where("id = ? AND attempt = ? AND status = ?", export.ID, export.Attempt, models.ExportRunning).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return models.ErrExportLeaseLost
+	}
+	return nil
+}
+
+func (r *donationExportRepository) StreamChunks(exportID uint, fn func(data []byte) error) error {
+	rows, err := r.db.Model(&models.DonationExportChunk{}).
+		Select("data").
+		Where("export_id = ?", exportID).
+		Order("sequence ASC").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var data []byte
+		if err := rows.Scan(&data); err != nil {
+			return err
+		}
+		if err := fn(data); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r *donationExportRepository) DeleteChunks(exportID uint) error {
+	return r.db.Where("export_id = ?", exportID).Delete(&models.DonationExportChunk{}).Error
+}
+
+func (r *donationExportRepository) DeleteExpired(before time.Time) (int64, error) {
+	var deleted int64
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		expired := tx.Model(&models.DonationExport{}).Select("id").Where("expires_at < ?", before)
+		if err := tx.Where("export_id IN (?)", expired).Delete(&models.DonationExportChunk{}).Error; err != nil {
+			return err
+		}
+
+		result := tx.Unscoped().Where("expires_at < ?", before).Delete(&models.DonationExport{})
+		deleted = result.RowsAffected
+		return result.Error
+	})
+	return deleted, err
+}
@@ -0,0 +1,80 @@
+package repositoryImpl
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type donationGoalRepository struct {
+	db *gorm.DB
+}
+
+func NewDonationGoalRepository(db *gorm.DB) repository.DonationGoalRepository {
+	return &donationGoalRepository{db: db}
+}
+
+func (r *donationGoalRepository) Create(goal *models.DonationGoal) error {
+	return r.db.Create(goal).Error
+}
+
+func (r *donationGoalRepository) GetByID(id uint) (*models.DonationGoal, error) {
+	var goal models.DonationGoal
+	err := r.db.First(&goal, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &goal, nil
+}
+
+// Update saves the editable fields only, so progress written concurrently by
+// AddContribution is never overwritten with a stale value
+func (r *donationGoalRepository) Update(goal *models.DonationGoal) error {
+	return r.db.Model(goal).
+		Select("title", "description", "target_amount", "currency", "deadline", "is_active").
+		Updates(goal).Error
+}
+
+func (r *donationGoalRepository) Delete(id uint) error {
+	return r.db.Delete(&models.DonationGoal{}, id).Error
+}
+
+func (r *donationGoalRepository) GetByStreamerID(streamerID uint, activeOnly bool) ([]*models.DonationGoal, error) {
+	var goals []*models.DonationGoal
+	query := r.db.Where("streamer_id = ?", streamerID)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	err := query.Order("created_at DESC").Find(&goals).Error
+	return goals, err
+}
+
+func (r *donationGoalRepository) AddContribution(contribution *models.DonationGoalContribution) (bool, error) {
+	added := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(contribution)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		added = true
+
+		err := tx.Model(&models.DonationGoal{}).
+			Where("id = ?", contribution.GoalID).
+			Update("current_amount", gorm.Expr("current_amount + ?", contribution.Amount)).Error
+		if err != nil {
+			return err
+		}
+
+		// Stamp the first time the goal reaches its target
+		return tx.Model(&models.DonationGoal{}).
+			Where("id = ? AND completed_at IS NULL AND current_amount >= target_amount", contribution.GoalID).
+			Update("completed_at", time.Now()).Error
+	})
+	return added, err
+}
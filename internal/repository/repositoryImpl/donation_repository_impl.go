@@ -1,6 +1,9 @@
 package repositoryImpl
 
 import (
+	"fmt"
+	"time"
+
 	"github.com/rzfd/mediashar/internal/models"
 	"github.com/rzfd/mediashar/internal/repository"
 	"gorm.io/gorm"
@@ -71,6 +74,103 @@ func (r *donationRepository) GetByStreamerID(streamerID uint, offset, limit int)
 	return donations, err
 }
 
+// ListPage uses keyset pagination: rows are compared to the cursor on (sort column, id)
+// rather than skipped with OFFSET, so deep pages cost the same as the first and stay
+// consistent while new donations arrive.
+func (r *donationRepository) ListPage(query *models.DonationListQuery) ([]*models.Donation, error) {
+	db := applyDonationFilter(r.db.Model(&models.Donation{}), query.Filter)
+
+	column := "created_at"
+	if query.SortBy == models.DonationSortByAmount {
+		column = "amount"
+	}
+	direction, comparison := "DESC", "<"
+	if query.Order == models.SortAscending {
+		direction, comparison = "ASC", ">"
+	}
+
+	if query.After != nil {
+		var value interface{} = query.After.CreatedAt
+		if query.SortBy == models.DonationSortByAmount {
+			value = query.After.Amount
+		}
+		db = db.Where(fmt.Sprintf("(%s, id) %s (?, ?)", column, comparison), value, query.After.ID)
+	}
+
+	var donations []*models.Donation
+	err := db.Order(fmt.Sprintf("%s %s, id %s", column, direction, direction)).
+		Limit(query.Limit).
+		Find(&donations).Error
+	return donations, err
+}
+
+func (r *donationRepository) CountByFilter(filter models.DonationFilter) (int64, error) {
+	var count int64
+	err := applyDonationFilter(r.db.Model(&models.Donation{}), filter).Count(&count).Error
+	return count, err
+}
+
+func (r *donationRepository) StreamByFilter(filter models.DonationFilter, fn func(*models.Donation) error) error {
+	rows, err := applyDonationFilter(r.db.Model(&models.Donation{}), filter).
+		Order("created_at ASC, id ASC").
+		Rows()
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var donation models.Donation
+		if err := r.db.ScanRows(rows, &donation); err != nil {
+			return err
+		}
+		if err := fn(&donation); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func applyDonationFilter(db *gorm.DB, filter models.DonationFilter) *gorm.DB {
+	if filter.StreamerID != 0 {
+		db = db.Where("streamer_id = ?", filter.StreamerID)
+	}
+	if filter.DonatorID != 0 {
+		db = db.Where("donator_id = ?", filter.DonatorID)
+	}
+	if filter.ParticipantID != 0 {
+		db = db.Where("(donator_id = ? OR streamer_id = ?)", filter.ParticipantID, filter.ParticipantID)
+	}
+	if filter.StartDate != nil {
+		db = db.Where("created_at >= ?", *filter.StartDate)
+	}
+	if filter.EndDate != nil {
+		db = db.Where("created_at < ?", *filter.EndDate)
+	}
+	if filter.MinAmount > 0 {
+		db = db.Where("amount >= ?", filter.MinAmount)
+	}
+	if filter.MaxAmount > 0 {
+		db = db.Where("amount <= ?", filter.MaxAmount)
+	}
+	if filter.Status != "" {
+		db = db.Where("status = ?", filter.Status)
+	}
+	if filter.Currency != "" {
+		db = db.Where("currency = ?", filter.Currency)
+	}
+	if filter.Provider != "" {
+		db = db.Where("payment_provider = ?", filter.Provider)
+	}
+	switch filter.Anonymity {
+	case models.AnonymityOnly:
+		db = db.Where("is_anonymous = ?", true)
+	case models.AnonymityExclude:
+		db = db.Where("is_anonymous = ?", false)
+	}
+	return db
+}
+
 func (r *donationRepository) UpdateStatus(id uint, status models.PaymentStatus) error {
 	return r.db.Model(&models.Donation{}).Where("id = ?", id).Update("status", status).Error
 }
@@ -84,11 +184,211 @@ func (r *donationRepository) GetLatestDonations(limit int) ([]*models.Donation,
 	return donations, err
 }
 
-func (r *donationRepository) GetTotalAmountByStreamer(streamerID uint) (float64, error) {
-	var total float64
+// snapshotCurrencySQL is the currency a donation is aggregated in: its snapshot
+// currency, or its own currency while it still waits for a snapshot
+const snapshotCurrencySQL = "CASE WHEN exchange_rate > 0 THEN converted_currency ELSE currency END"
+
+// netConvertedAmountSQL is a donation's amount in snapshotCurrencySQL less the refunded
+// share, rounded to minor units the same way as Donation.NetConvertedAmount
+const netConvertedAmountSQL = "CASE WHEN exchange_rate > 0 THEN ROUND(converted_amount::numeric * (amount - refunded_amount) / NULLIF(amount, 0)) ELSE amount - refunded_amount END"
+
+// GetTotalAmountByStreamer sums completed donations, net of partial refunds, per
+// snapshot currency, each at its snapshot rate. Donations without a snapshot are summed
+// in their own currency.
+func (r *donationRepository) GetTotalAmountByStreamer(streamerID uint) ([]models.Money, error) {
+	var totals []models.Money
+	err := r.db.Model(&models.Donation{}).
+		Select(snapshotCurrencySQL+" AS currency, COALESCE(SUM("+netConvertedAmountSQL+"), 0)::bigint AS minor").
+		Where("streamer_id = ? AND status = ?", streamerID, models.PaymentCompleted).
+		Group("1").
+		Order("1").
+		Scan(&totals).Error
+	return totals, err
+}
+
+// GetStatsBuckets aggregates completed donations, net of partial refunds, per period,
+// currency and snapshot currency in SQL. A donation falls into the period of its
+// payment time, or creation time if unpaid; one without a snapshot yet counts in its
+// own currency.
+func (r *donationRepository) GetStatsBuckets(streamerID uint, interval models.StatsInterval, start, end time.Time) ([]*models.DonationStatBucket, error) {
+	var buckets []*models.DonationStatBucket
 	err := r.db.Model(&models.Donation{}).
+		Select(`date_trunc(?, COALESCE(payment_time, created_at)) AS period, currency, `+snapshotCurrencySQL+` AS converted_currency,
+			COALESCE(SUM(amount - refunded_amount), 0)::bigint AS total_amount,
+			COALESCE(SUM(`+netConvertedAmountSQL+`), 0)::bigint AS converted_amount,
+			COUNT(*) AS count`, string(interval)).
+		Where("streamer_id = ? AND status = ?", streamerID, models.PaymentCompleted).
+		Where("COALESCE(payment_time, created_at) >= ? AND COALESCE(payment_time, created_at) < ?", start, end).
+		Group("1, 2, 3").
+		Order("1, 2, 3").
+		Scan(&buckets).Error
+	return buckets, err
+}
+
+// GetDonorTotals aggregates completed donations, net of partial refunds, per donor and
+// snapshot currency in SQL, each at its snapshot rate. Donations without a donator
+// account count as anonymous; donations still waiting for a snapshot are summed in
+// their own currency, like the streamer totals.
+func (r *donationRepository) GetDonorTotals(streamerID uint, start *time.Time, end time.Time, includeAnonymous bool) ([]*models.DonorCurrencyTotal, error) {
+	query := r.db.Model(&models.Donation{}).
+		Select(`CASE WHEN is_anonymous OR donator_id = 0 THEN 0 ELSE donator_id END AS donator_id,
+			(is_anonymous OR donator_id = 0) AS is_anonymous,
+			`+snapshotCurrencySQL+` AS currency,
+			COALESCE(SUM(`+netConvertedAmountSQL+`), 0)::bigint AS total_amount,
+			COUNT(*) AS count,
+			MIN(COALESCE(payment_time, created_at)) AS first_donated_at,
+			MAX(COALESCE(payment_time, created_at)) AS last_donated_at,
+			(ARRAY_AGG(display_name ORDER BY COALESCE(payment_time, created_at) DESC))[1] AS display_name`).
 		Where("streamer_id = ? AND status = ?", streamerID, models.PaymentCompleted).
-		Select("COALESCE(SUM(amount), 0) as total").
-		Scan(&total).Error
-	return total, err
-} 
\ No newline at end of file
+		Where("COALESCE(payment_time, created_at) < ?", end)
+
+	if start != nil {
+		query = query.Where("COALESCE(payment_time, created_at) >= ?", *start)
+	}
+	if !includeAnonymous {
+		query = query.Where("is_anonymous = ? AND donator_id <> 0", false)
+	}
+
+	var totals []*models.DonorCurrencyTotal
+	err := query.
+		Group("1, 2, 3").
+		Order("1, 3").
+		Scan(&totals).Error
+	return totals, err
+}
+
+// ReserveRefund adds amount to the refunded total of a completed donation, but only
+// if the total stays within the donation amount. It returns false otherwise, which
+// keeps concurrent refunds from exceeding what was paid.
+func (r *donationRepository) ReserveRefund(id uint, amount int64) (bool, error) {
+	result := r.db.Model(&models.Donation{}).
+		Where("id = ? AND status = ? AND refunded_amount + ? <= amount", id, models.PaymentCompleted, amount).
+		Update("refunded_amount", gorm.Expr("refunded_amount + ?", amount))
+	return result.RowsAffected == 1, result.Error
+}
+
+// ReleaseRefund gives back an amount reserved by a refund that did not go through
+func (r *donationRepository) ReleaseRefund(id uint, amount int64) error {
+	return r.db.Model(&models.Donation{}).
+		Where("id = ?", id).
+		Update("refunded_amount", gorm.Expr("GREATEST(refunded_amount - ?, 0)", amount)).Error
+}
+
+func (r *donationRepository) TransitionStatus(id uint, from, to models.PaymentStatus, history *models.DonationStatusHistory) (bool, error) {
+	updated := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		updates := map[string]interface{}{"status": to}
+		if to == models.PaymentCompleted {
+			updates["payment_time"] = gorm.Expr("COALESCE(payment_time, ?)", time.Now())
+		}
+
+		// The status guard makes a late or duplicate update a no-op instead of an overwrite
+		result := tx.Model(&models.Donation{}).
+			Where("id = ? AND status = ?", id, from).
+			Updates(updates)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		history.DonationID = id
+		history.FromStatus = from
+		history.ToStatus = to
+		if err := tx.Create(history).Error; err != nil {
+			return err
+		}
+
+		updated = true
+		return nil
+	})
+	return updated, err
+}
+
+func (r *donationRepository) GetStatusHistory(donationID uint) ([]*models.DonationStatusHistory, error) {
+	var history []*models.DonationStatusHistory
+	err := r.db.Where("donation_id = ?", donationID).
+		Order("created_at ASC, id ASC").
+		Find(&history).Error
+	return history, err
+}
+
+func (r *donationRepository) GetExpiredPending(deadlines map[models.PaymentProvider]time.Time, defaultDeadline time.Time, limit int) ([]*models.Donation, error) {
+	// Compare each donation against its own provider's deadline in a single query
+	deadline := "?::timestamptz"
+	args := []interface{}{defaultDeadline}
+	if len(deadlines) > 0 {
+		deadline = "CASE payment_provider"
+		args = nil
+		for provider, providerDeadline := range deadlines {
+			deadline += " WHEN ? THEN ?::timestamptz"
+			args = append(args, provider, providerDeadline)
+		}
+		deadline += " ELSE ?::timestamptz END"
+		args = append(args, defaultDeadline)
+	}
+
+	var donations []*models.Donation
+	err := r.db.Where("status = ?", models.PaymentPending).
+		Where("created_at < "+deadline, args...).
+		Order("created_at ASC, id ASC").
+		Limit(limit).
+		Find(&donations).Error
+	return donations, err
+}
+
+func (r *donationRepository) GetWithoutRateSnapshot(limit int) ([]*models.Donation, error) {
+	var donations []*models.Donation
+	err := r.db.Where("exchange_rate IS NULL OR exchange_rate <= 0").
+		Order("id ASC").
+		Limit(limit).
+		Find(&donations).Error
+	return donations, err
+}
+
+func (r *donationRepository) GetLatestSnapshotCurrency(streamerID uint) (models.SupportedCurrency, error) {
+	var currencies []models.SupportedCurrency
+	err := r.db.Model(&models.Donation{}).
+		Where("streamer_id = ? AND exchange_rate > 0 AND converted_currency <> ''", streamerID).
+		Order("created_at DESC").
+		Limit(1).
+		Pluck("converted_currency", &currencies).Error
+	if err != nil || len(currencies) == 0 {
+		return "", err
+	}
+	return currencies[0], nil
+}
+
+func (r *donationRepository) SaveRateSnapshot(donation *models.Donation) (bool, error) {
+	result := r.db.Model(&models.Donation{}).
+		Where("id = ? AND (exchange_rate IS NULL OR exchange_rate <= 0)", donation.ID).
+		Updates(map[string]interface{}{
+			"exchange_rate":      donation.ExchangeRate,
+			"converted_amount":   donation.ConvertedAmount,
+			"converted_currency": donation.ConvertedCurrency,
+			"rate_source":        donation.RateSource,
+			"rate_time":          donation.RateTime,
+		})
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *donationRepository) SaveTranslation(donation *models.Donation) error {
+	return r.db.Model(&models.Donation{}).
+		Where("id = ?", donation.ID).
+		Updates(map[string]interface{}{
+			"message_language":     donation.MessageLanguage,
+			"translated_message":   donation.TranslatedMessage,
+			"translation_language": donation.TranslationLanguage,
+		}).Error
+}
+
+func (r *donationRepository) SavePaymentDetails(donation *models.Donation) error {
+	return r.db.Model(&models.Donation{}).
+		Where("id = ?", donation.ID).
+		Updates(map[string]interface{}{
+			"transaction_id":   donation.TransactionID,
+			"payment_provider": donation.PaymentProvider,
+			"payment_time":     donation.PaymentTime,
+		}).Error
+}
@@ -0,0 +1,167 @@
+package repositoryImpl
+
+import (
+	"database/sql/driver"
+	"errors"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+)
+
+// newMockDonationRepository returns a repository on a mocked Postgres connection. Each
+// test lists the statements it expects; unexpected ones fail the call.
+func newMockDonationRepository(t *testing.T) (repository.DonationRepository, sqlmock.Sqlmock) {
+	t.Helper()
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("failed to open mock database: %v", err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{})
+	if err != nil {
+		t.Fatalf("failed to open gorm: %v", err)
+	}
+	return NewDonationRepository(db), mock
+}
+
+func TestDonationRepositoryReserveRefund(t *testing.T) {
+	reserveSQL := regexp.QuoteMeta(`UPDATE "donations" SET "refunded_amount"=refunded_amount + $1,"updated_at"=$2 WHERE (id = $3 AND status = $4 AND refunded_amount + $5 <= amount) AND "donations"."deleted_at" IS NULL`)
+
+	tests := []struct {
+		name         string
+		rowsAffected int64
+		execErr      error
+		want         bool
+		wantErr      bool
+	}{
+		{name: "reserved", rowsAffected: 1, want: true},
+		{name: "exceeds the refundable amount or not completed", rowsAffected: 0, want: false},
+		{name: "database error", execErr: errors.New("connection reset"), wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newMockDonationRepository(t)
+
+			mock.ExpectBegin()
+			exec := mock.ExpectExec(reserveSQL).
+				WithArgs(int64(2500), sqlmock.AnyArg(), uint(7), models.PaymentCompleted, int64(2500))
+			if tt.execErr != nil {
+				exec.WillReturnError(tt.execErr)
+				mock.ExpectRollback()
+			} else {
+				exec.WillReturnResult(sqlmock.NewResult(0, tt.rowsAffected))
+				mock.ExpectCommit()
+			}
+
+			got, err := repo.ReserveRefund(7, 2500)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ReserveRefund() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("ReserveRefund() = %v, want %v", got, tt.want)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestDonationRepositoryTransitionStatus(t *testing.T) {
+	completeSQL := regexp.QuoteMeta(`UPDATE "donations" SET "payment_time"=COALESCE(payment_time, $1),"status"=$2,"updated_at"=$3 WHERE (id = $4 AND status = $5) AND "donations"."deleted_at" IS NULL`)
+	updateSQL := regexp.QuoteMeta(`UPDATE "donations" SET "status"=$1,"updated_at"=$2 WHERE (id = $3 AND status = $4) AND "donations"."deleted_at" IS NULL`)
+	historySQL := regexp.QuoteMeta(`INSERT INTO "donation_status_history" ("donation_id","from_status","to_status","source","actor_id","reason","created_at") VALUES ($1,$2,$3,$4,$5,$6,$7) RETURNING "id"`)
+
+	tests := []struct {
+		name       string
+		to         models.PaymentStatus
+		updateSQL  string
+		updateArgs []driver.Value
+		updated    int64
+		historyErr error
+		want       bool
+		wantErr    bool
+	}{
+		{
+			name:       "completing records the payment time",
+			to:         models.PaymentCompleted,
+			updateSQL:  completeSQL,
+			updateArgs: []driver.Value{sqlmock.AnyArg(), models.PaymentCompleted, sqlmock.AnyArg(), uint(7), models.PaymentPending},
+			updated:    1,
+			want:       true,
+		},
+		{
+			name:       "failing leaves the payment time alone",
+			to:         models.PaymentFailed,
+			updateSQL:  updateSQL,
+			updateArgs: []driver.Value{models.PaymentFailed, sqlmock.AnyArg(), uint(7), models.PaymentPending},
+			updated:    1,
+			want:       true,
+		},
+		{
+			name:       "donation no longer in the from status",
+			to:         models.PaymentFailed,
+			updateSQL:  updateSQL,
+			updateArgs: []driver.Value{models.PaymentFailed, sqlmock.AnyArg(), uint(7), models.PaymentPending},
+			updated:    0,
+			want:       false,
+		},
+		{
+			name:       "history that cannot be recorded rolls the update back",
+			to:         models.PaymentFailed,
+			updateSQL:  updateSQL,
+			updateArgs: []driver.Value{models.PaymentFailed, sqlmock.AnyArg(), uint(7), models.PaymentPending},
+			updated:    1,
+			historyErr: errors.New("connection reset"),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo, mock := newMockDonationRepository(t)
+
+			mock.ExpectBegin()
+			mock.ExpectExec(tt.updateSQL).
+				WithArgs(tt.updateArgs...).
+				WillReturnResult(sqlmock.NewResult(0, tt.updated))
+			if tt.updated > 0 {
+				insert := mock.ExpectQuery(historySQL).
+					WithArgs(uint(7), models.PaymentPending, tt.to, models.StatusSourceWebhook, uint(0), "provider notification", sqlmock.AnyArg())
+				if tt.historyErr != nil {
+					insert.WillReturnError(tt.historyErr)
+				} else {
+					insert.WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(11))
+				}
+			}
+			if tt.wantErr {
+				mock.ExpectRollback()
+			} else {
+				mock.ExpectCommit()
+			}
+
+			history := &models.DonationStatusHistory{Source: models.StatusSourceWebhook, Reason: "provider notification"}
+			got, err := repo.TransitionStatus(7, models.PaymentPending, tt.to, history)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("TransitionStatus() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("TransitionStatus() = %v, want %v", got, tt.want)
+			}
+			if tt.want && history.ID != 11 {
+				t.Errorf("history ID = %d, want 11", history.ID)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package repositoryImpl
+
+import (
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ledgerRepository struct {
+	db *gorm.DB
+}
+
+func NewLedgerRepository(db *gorm.DB) repository.LedgerRepository {
+	return &ledgerRepository{db: db}
+}
+
+func (r *ledgerRepository) Post(entry *models.LedgerEntry) (bool, error) {
+	posted := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var err error
+		posted, err = insertLedgerEntry(tx, entry)
+		return err
+	})
+	return posted, err
+}
+
+func (r *ledgerRepository) GetByReference(reference string) (*models.LedgerEntry, error) {
+	var entry models.LedgerEntry
+	err := r.db.Preload("Lines", orderLinesByID).
+		Where("reference = ?", reference).
+		First(&entry).Error
+	if err != nil {
+		return nil, err
+	}
+	return &entry, nil
+}
+
+func (r *ledgerRepository) GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.LedgerEntry, error) {
+	var entries []*models.LedgerEntry
+	offset := (page - 1) * pageSize
+	err := r.db.Preload("Lines", orderLinesByID).
+		Where("id IN (?)", r.db.Model(&models.LedgerLine{}).
+			Select("entry_id").
+			Where("account = ? AND streamer_id = ?", models.LedgerAccountStreamerBalance, streamerID)).
+		Order("id DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&entries).Error
+	return entries, err
+}
+
+func (r *ledgerRepository) GetStreamerBalances(streamerID uint) ([]*models.StreamerBalance, error) {
+	var balances []*models.StreamerBalance
+	// Streamer balances are credit accounts: credits (negative lines) raise them
+	err := r.db.Model(&models.LedgerLine{}).
+		Select(`? AS streamer_id, currency,
+			-SUM(amount) AS balance,
+			COALESCE(SUM(CASE WHEN amount < 0 THEN -amount ELSE 0 END), 0) AS credited,
+			COALESCE(SUM(CASE WHEN amount > 0 THEN amount ELSE 0 END), 0) AS debited`, streamerID).
+		Where("account = ? AND streamer_id = ?", models.LedgerAccountStreamerBalance, streamerID).
+		Group("currency").
+		Order("currency").
+		Scan(&balances).Error
+	return balances, err
+}
+
+func (r *ledgerRepository) GetUnpostedPayments(afterID uint, limit int) ([]*models.Donation, error) {
+	var donations []*models.Donation
+	err := r.db.
+		Where("id > ? AND status IN ?", afterID, []models.PaymentStatus{models.PaymentCompleted, models.PaymentRefunded}).
+		Where("NOT EXISTS (SELECT 1 FROM ledger_entries e WHERE e.donation_id = donations.id AND e.type = ?)", models.LedgerEntryPayment).
+		Order("id ASC").
+		Limit(limit).
+		Find(&donations).Error
+	return donations, err
+}
+
+func (r *ledgerRepository) GetUnpostedRefunds(afterID uint, limit int) ([]*models.DonationRefund, error) {
+	var refunds []*models.DonationRefund
+	err := r.db.
+		Where("id > ? AND status = ?", afterID, models.RefundSucceeded).
+		Where("NOT EXISTS (SELECT 1 FROM ledger_entries e WHERE e.refund_id = donation_refunds.id)").
+		Order("id ASC").
+		Limit(limit).
+		Find(&refunds).Error
+	return refunds, err
+}
+
+// insertLedgerEntry stores an entry and its lines inside a caller's transaction, so other
+// records can be written atomically with their ledger postings. It reports false, storing
+// nothing, when the reference was already posted.
+func insertLedgerEntry(tx *gorm.DB, entry *models.LedgerEntry) (bool, error) {
+	result := tx.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "reference"}},
+		DoNothing: true,
+	}).Omit("Lines").Create(entry)
+	if result.Error != nil || result.RowsAffected == 0 {
+		return false, result.Error
+	}
+
+	for i := range entry.Lines {
+		entry.Lines[i].EntryID = entry.ID
+	}
+	if err := tx.Create(&entry.Lines).Error; err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// streamerBalance returns what the platform owes a streamer in one currency
+func streamerBalance(db *gorm.DB, streamerID uint, currency models.SupportedCurrency) (int64, error) {
+	var balance int64
+	err := db.Model(&models.LedgerLine{}).
+		Select("COALESCE(-SUM(amount), 0)").
+		Where("account = ? AND streamer_id = ? AND currency = ?", models.LedgerAccountStreamerBalance, streamerID, currency).
+		Scan(&balance).Error
+	return balance, err
+}
+
+func orderLinesByID(db *gorm.DB) *gorm.DB {
+	return db.Order("id ASC")
+}
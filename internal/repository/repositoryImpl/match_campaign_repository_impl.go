@@ -0,0 +1,207 @@
+package repositoryImpl
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+)
+
+type matchCampaignRepository struct {
+	db *gorm.DB
+}
+
+func NewMatchCampaignRepository(db *gorm.DB) repository.MatchCampaignRepository {
+	return &matchCampaignRepository{db: db}
+}
+
+func (r *matchCampaignRepository) Create(campaign *models.MatchCampaign) error {
+	return r.db.Create(campaign).Error
+}
+
+func (r *matchCampaignRepository) UpdateTerms(campaign *models.MatchCampaign) (bool, error) {
+	// Selecting the columns saves zero values too, e.g. a paused campaign's is_active
+	result := r.db.Model(campaign).
+		Where("spent <= ? AND (spent = 0 OR currency = ?)", campaign.Budget, campaign.Currency).
+		Select("name", "sponsor_name", "sponsor_user_id", "streamer_ids", "currency", "ratio_basis_points",
+			"max_match_amount", "budget", "starts_at", "ends_at", "is_active").
+		Updates(campaign)
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *matchCampaignRepository) GetByID(id uint) (*models.MatchCampaign, error) {
+	var campaign models.MatchCampaign
+	if err := r.db.First(&campaign, id).Error; err != nil {
+		return nil, err
+	}
+	return &campaign, nil
+}
+
+func (r *matchCampaignRepository) List(page, pageSize int) ([]*models.MatchCampaign, error) {
+	var campaigns []*models.MatchCampaign
+	err := r.db.Order("id DESC").
+		Offset((page - 1) * pageSize).Limit(pageSize).
+		Find(&campaigns).Error
+	return campaigns, err
+}
+
+func (r *matchCampaignRepository) ListRunning(at time.Time) ([]*models.MatchCampaign, error) {
+	var campaigns []*models.MatchCampaign
+	err := r.db.Where("is_active AND starts_at <= ? AND ends_at > ? AND spent < budget", at, at).
+		Order("id ASC").
+		Find(&campaigns).Error
+	return campaigns, err
+}
+
+func (r *matchCampaignRepository) CreateMatch(campaignID, donationID uint, build func(*models.MatchCampaign) (*models.DonationMatch, *models.Donation)) (bool, error) {
+	created := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var campaign models.MatchCampaign
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&campaign, campaignID).Error; err != nil {
+			return err
+		}
+
+		var existing int64
+		if err := tx.Model(&models.DonationMatch{}).
+			Where("campaign_id = ? AND donation_id = ?", campaignID, donationID).
+			Count(&existing).Error; err != nil {
+			return err
+		}
+		if existing > 0 {
+			return nil
+		}
+
+		match, donation := build(&campaign)
+		if match == nil || donation == nil {
+			return nil
+		}
+
+		if err := tx.Create(donation).Error; err != nil {
+			return err
+		}
+		match.CampaignID = campaignID
+		match.DonationID = donationID
+		match.MatchedDonationID = donation.ID
+		if err := tx.Create(match).Error; err != nil {
+			return err
+		}
+
+		if err := tx.Model(&models.MatchCampaign{}).
+			Where("id = ?", campaignID).
+			Updates(map[string]interface{}{
+				"spent":       gorm.Expr("spent + ?", match.Amount),
+				"match_count": gorm.Expr("match_count + 1"),
+			}).Error; err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
+	return created, err
+}
+
+func (r *matchCampaignRepository) RefundMatches(donationID uint, build func(match *models.DonationMatch, donation, sponsor *models.Donation) *models.DonationRefund) ([]*models.DonationRefund, error) {
+	var refunds []*models.DonationRefund
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		refunds = nil
+
+		var matches []*models.DonationMatch
+		if err := tx.Where("donation_id = ?", donationID).Order("campaign_id ASC").Find(&matches).Error; err != nil {
+			return err
+		}
+		if len(matches) == 0 {
+			return nil
+		}
+
+		// Campaigns are locked before matches are read again, as in CreateMatch, so
+		// concurrent refunds of the donation see each other's amounts
+		campaignIDs := make([]uint, 0, len(matches))
+		for _, match := range matches {
+			campaignIDs = append(campaignIDs, match.CampaignID)
+		}
+		var campaigns []*models.MatchCampaign
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id IN ?", campaignIDs).
+			Order("id ASC").
+			Find(&campaigns).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("donation_id = ?", donationID).Order("campaign_id ASC").Find(&matches).Error; err != nil {
+			return err
+		}
+
+		var donation models.Donation
+		if err := tx.First(&donation, donationID).Error; err != nil {
+			return err
+		}
+
+		for _, match := range matches {
+			var sponsor models.Donation
+			if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&sponsor, match.MatchedDonationID).Error; err != nil {
+				return err
+			}
+			refund := build(match, &donation, &sponsor)
+			if refund == nil {
+				continue
+			}
+
+			refund.DonationID = sponsor.ID
+			if err := tx.Create(refund).Error; err != nil {
+				return err
+			}
+
+			updates := map[string]interface{}{"refunded_amount": gorm.Expr("refunded_amount + ?", refund.Amount)}
+			sponsor.RefundedAmount += refund.Amount
+			if sponsor.RefundedAmount >= sponsor.Amount && sponsor.Status == models.PaymentCompleted {
+				updates["status"] = models.PaymentRefunded
+				if err := tx.Create(&models.DonationStatusHistory{
+					DonationID: sponsor.ID,
+					FromStatus: models.PaymentCompleted,
+					ToStatus:   models.PaymentRefunded,
+					Source:     models.StatusSourceSystem,
+					Reason:     "matched donation refunded",
+				}).Error; err != nil {
+					return err
+				}
+				sponsor.Status = models.PaymentRefunded
+			}
+			if err := tx.Model(&models.Donation{}).Where("id = ?", sponsor.ID).Updates(updates).Error; err != nil {
+				return err
+			}
+
+			if err := tx.Model(&models.DonationMatch{}).
+				Where("id = ?", match.ID).
+				Update("refunded_amount", gorm.Expr("refunded_amount + ?", refund.Amount)).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&models.MatchCampaign{}).
+				Where("id = ?", match.CampaignID).
+				Update("spent", gorm.Expr("spent - ?", refund.Amount)).Error; err != nil {
+				return err
+			}
+			refunds = append(refunds, refund)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return refunds, nil
+}
+
+func (r *matchCampaignRepository) GetStreamerTotals(campaignID uint) ([]*models.MatchStreamerTotal, error) {
+	var totals []*models.MatchStreamerTotal
+	err := r.db.Model(&models.DonationMatch{}).
+		Select(`streamer_id,
+			COUNT(*) AS match_count,
+			COALESCE(SUM(donation_amount), 0)::bigint AS donation_amount,
+			COALESCE(SUM(amount - refunded_amount), 0)::bigint AS matched_amount`).
+		Where("campaign_id = ?", campaignID).
+		Group("streamer_id").
+		Order("matched_amount DESC, streamer_id ASC").
+		Scan(&totals).Error
+	return totals, err
+}
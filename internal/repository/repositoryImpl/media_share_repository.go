@@ -18,7 +18,9 @@ type MediaShareRepository interface {
 	GetByDonationID(donationID uint) (*models.MediaShare, error)
 	GetQueueByStreamerID(streamerID uint, status string, limit, offset int) ([]*models.MediaQueueItem, error)
 	GetTotalQueueCount(streamerID uint, status string) (int64, error)
+	GetNextApproved(streamerID uint) (*models.MediaQueueItem, error)
 	UpdateStatus(id uint, status models.MediaShareStatus) error
+	SaveTranslation(mediaShare *models.MediaShare) error
 	GetStatsByStreamerID(streamerID uint) (map[string]int64, error)
 }
 
@@ -92,8 +94,11 @@ func (r *mediaShareRepository) GetQueueByStreamerID(streamerID uint, status stri
 			media_shares.donation_amount,
 			media_shares.currency,
 			media_shares.thumbnail,
+			media_shares.duration,
 			media_shares.created_at as submitted_at,
-			media_shares.processed_at
+			media_shares.processed_at,
+			media_shares.translated_message,
+			media_shares.translation_language
 		`).
 		Where("media_shares.streamer_id = ?", streamerID).
 		Order("media_shares.created_at DESC")
@@ -110,6 +115,38 @@ func (r *mediaShareRepository) GetQueueByStreamerID(streamerID uint, status stri
 	return results, err
 }
 
+// GetNextApproved returns the longest-waiting approved media, or nil when none is waiting.
+// Auto-approved media count as approved when they were submitted.
+func (r *mediaShareRepository) GetNextApproved(streamerID uint) (*models.MediaQueueItem, error) {
+	var results []*models.MediaQueueItem
+	err := r.db.Table("media_shares").
+		Select(`
+			media_shares.id,
+			media_shares.type,
+			media_shares.url,
+			media_shares.title,
+			media_shares.message,
+			media_shares.status,
+			media_shares.donator_name,
+			media_shares.donation_amount,
+			media_shares.currency,
+			media_shares.thumbnail,
+			media_shares.duration,
+			media_shares.created_at as submitted_at,
+			media_shares.processed_at,
+			media_shares.translated_message,
+			media_shares.translation_language
+		`).
+		Where("media_shares.streamer_id = ? AND media_shares.status = ? AND media_shares.deleted_at IS NULL", streamerID, models.MediaShareStatusApproved).
+		Order("COALESCE(media_shares.processed_at, media_shares.created_at) ASC, media_shares.id ASC").
+		Limit(1).
+		Scan(&results).Error
+	if err != nil || len(results) == 0 {
+		return nil, err
+	}
+	return results[0], nil
+}
+
 func (r *mediaShareRepository) GetTotalQueueCount(streamerID uint, status string) (int64, error) {
 	var count int64
 	query := r.db.Model(&models.MediaShare{}).Where("streamer_id = ?", streamerID)
@@ -132,6 +169,16 @@ func (r *mediaShareRepository) UpdateStatus(id uint, status models.MediaShareSta
 		}).Error
 }
 
+func (r *mediaShareRepository) SaveTranslation(mediaShare *models.MediaShare) error {
+	return r.db.Model(&models.MediaShare{}).
+		Where("id = ?", mediaShare.ID).
+		Updates(map[string]interface{}{
+			"message_language":     mediaShare.MessageLanguage,
+			"translated_message":   mediaShare.TranslatedMessage,
+			"translation_language": mediaShare.TranslationLanguage,
+		}).Error
+}
+
 func (r *mediaShareRepository) GetStatsByStreamerID(streamerID uint) (map[string]int64, error) {
 	stats := make(map[string]int64)
 	
@@ -156,6 +203,7 @@ func (r *mediaShareRepository) GetStatsByStreamerID(streamerID uint) (map[string
 	stats["pending"] = 0
 	stats["approved"] = 0
 	stats["rejected"] = 0
+	stats["played"] = 0
 	stats["total"] = 0
 	
 	// Fill actual counts
@@ -165,9 +213,9 @@ func (r *mediaShareRepository) GetStatsByStreamerID(streamerID uint) (map[string
 	}
 	
 	// Get total donation amount from media shares
-	var totalAmount float64
+	var totalAmount int64
 	err = r.db.Model(&models.MediaShare{}).
-		Select("COALESCE(SUM(donation_amount), 0)").
+		Select("COALESCE(SUM(donation_amount), 0)::bigint").
 		Where("streamer_id = ?", streamerID).
 		Scan(&totalAmount).Error
 	
@@ -175,7 +223,7 @@ func (r *mediaShareRepository) GetStatsByStreamerID(streamerID uint) (map[string
 		return stats, err
 	}
 	
-	stats["total_amount"] = int64(totalAmount)
+	stats["total_amount"] = totalAmount
 	
 	return stats, nil
 } 
\ No newline at end of file
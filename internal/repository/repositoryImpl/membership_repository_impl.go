@@ -0,0 +1,177 @@
+package repositoryImpl
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type membershipRepository struct {
+	db *gorm.DB
+}
+
+func NewMembershipRepository(db *gorm.DB) repository.MembershipRepository {
+	return &membershipRepository{db: db}
+}
+
+func (r *membershipRepository) CreateTier(tier *models.MembershipTier) error {
+	return r.db.Create(tier).Error
+}
+
+func (r *membershipRepository) GetTierByID(id uint) (*models.MembershipTier, error) {
+	var tier models.MembershipTier
+	err := r.db.First(&tier, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tier, nil
+}
+
+func (r *membershipRepository) UpdateTier(tier *models.MembershipTier) error {
+	return r.db.Model(tier).
+		Select("name", "perks", "monthly_price", "currency", "is_active").
+		Updates(tier).Error
+}
+
+func (r *membershipRepository) GetTiersByStreamerID(streamerID uint, activeOnly bool) ([]*models.MembershipTier, error) {
+	var tiers []*models.MembershipTier
+	query := r.db.Where("streamer_id = ?", streamerID)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	err := query.Order("monthly_price ASC").Find(&tiers).Error
+	return tiers, err
+}
+
+func (r *membershipRepository) Create(membership *models.Membership) error {
+	return r.db.Omit("Tier").Create(membership).Error
+}
+
+func (r *membershipRepository) GetByID(id uint) (*models.Membership, error) {
+	var membership models.Membership
+	err := r.db.Preload("Tier").First(&membership, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &membership, nil
+}
+
+func (r *membershipRepository) Update(membership *models.Membership) error {
+	return r.db.Omit("Tier").Save(membership).Error
+}
+
+func (r *membershipRepository) GetByMemberID(memberID uint) ([]*models.Membership, error) {
+	var memberships []*models.Membership
+	err := r.db.Preload("Tier").
+		Where("member_id = ?", memberID).
+		Order("created_at DESC").
+		Find(&memberships).Error
+	return memberships, err
+}
+
+func (r *membershipRepository) GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.Membership, error) {
+	var memberships []*models.Membership
+	offset := (page - 1) * pageSize
+	err := r.db.Preload("Tier").
+		Where("streamer_id = ?", streamerID).
+		Order("created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&memberships).Error
+	return memberships, err
+}
+
+func (r *membershipRepository) GetCurrent(memberID, streamerID uint) (*models.Membership, error) {
+	var membership models.Membership
+	err := r.db.Preload("Tier").
+		Where("member_id = ? AND streamer_id = ?", memberID, streamerID).
+		Where("status IN ?", []models.MembershipStatus{models.MembershipPending, models.MembershipActive, models.MembershipPastDue}).
+		Order("created_at DESC").
+		First(&membership).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &membership, nil
+}
+
+func (r *membershipRepository) ClaimDueForRenewal(now time.Time, limit int, claim func(membership *models.Membership)) ([]*models.Membership, error) {
+	var memberships []*models.Membership
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// SKIP LOCKED lets every replica claim different memberships without waiting
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("status = ? AND current_period_end <= ? AND pending_donation_id = 0", models.MembershipActive, now).
+			Order("current_period_end ASC").
+			Limit(limit).
+			Find(&memberships).Error
+		if err != nil {
+			return err
+		}
+
+		for _, membership := range memberships {
+			if err := tx.First(&membership.Tier, membership.TierID).Error; err != nil {
+				return err
+			}
+
+			claim(membership)
+			if err := tx.Omit("Tier").Save(membership).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+func (r *membershipRepository) GetAwaitingDonation(claimedBefore time.Time, limit int) ([]*models.Membership, error) {
+	var memberships []*models.Membership
+	err := r.db.Preload("Tier").
+		Where("status = ? AND pending_donation_id = 0 AND updated_at < ?", models.MembershipPastDue, claimedBefore).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&memberships).Error
+	return memberships, err
+}
+
+func (r *membershipRepository) GetAwaitingPayment(limit int) ([]*models.Membership, error) {
+	var memberships []*models.Membership
+	err := r.db.Preload("Tier").
+		Where("pending_donation_id <> 0").
+		Where("status IN ?", []models.MembershipStatus{models.MembershipPending, models.MembershipPastDue}).
+		Order("updated_at ASC").
+		Limit(limit).
+		Find(&memberships).Error
+	return memberships, err
+}
+
+func (r *membershipRepository) CreatePayment(payment *models.MembershipPayment) error {
+	return r.db.Create(payment).Error
+}
+
+func (r *membershipRepository) GetPaymentByDonationID(donationID uint) (*models.MembershipPayment, error) {
+	var payment models.MembershipPayment
+	err := r.db.Where("donation_id = ?", donationID).First(&payment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
+
+func (r *membershipRepository) UpdatePayment(payment *models.MembershipPayment) error {
+	return r.db.Save(payment).Error
+}
+
+func (r *membershipRepository) GetPaymentsByMembershipID(membershipID uint) ([]*models.MembershipPayment, error) {
+	var payments []*models.MembershipPayment
+	err := r.db.Where("membership_id = ?", membershipID).
+		Order("period_start DESC").
+		Find(&payments).Error
+	return payments, err
+}
@@ -0,0 +1,120 @@
+package repositoryImpl
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+)
+
+type moderationRepository struct {
+	db *gorm.DB
+}
+
+func NewModerationRepository(db *gorm.DB) repository.ModerationRepository {
+	return &moderationRepository{db: db}
+}
+
+func (r *moderationRepository) GetSettings(streamerID uint) (*models.ModerationSettings, error) {
+	var settings models.ModerationSettings
+	err := r.db.Where("streamer_id = ?", streamerID).First(&settings).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &settings, nil
+}
+
+func (r *moderationRepository) SaveSettings(settings *models.ModerationSettings) error {
+	return r.db.Save(settings).Error
+}
+
+func (r *moderationRepository) ListBlockedTerms() ([]*models.BlockedTerm, error) {
+	var terms []*models.BlockedTerm
+	err := r.db.Order("id ASC").Find(&terms).Error
+	return terms, err
+}
+
+func (r *moderationRepository) CreateBlockedTerm(term *models.BlockedTerm) error {
+	return r.db.Create(term).Error
+}
+
+func (r *moderationRepository) DeleteBlockedTerm(id uint) error {
+	result := r.db.Delete(&models.BlockedTerm{}, id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+func (r *moderationRepository) CreateReview(review *models.MessageReview) error {
+	return r.db.Create(review).Error
+}
+
+func (r *moderationRepository) GetReviewByID(id uint) (*models.MessageReview, error) {
+	var review models.MessageReview
+	err := r.db.First(&review, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &review, nil
+}
+
+func (r *moderationRepository) GetReviewsByStreamerID(streamerID uint, status models.MessageReviewStatus, page, pageSize int) ([]*models.MessageReview, int64, error) {
+	query := r.db.Model(&models.MessageReview{}).Where("streamer_id = ?", streamerID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+
+	var reviews []*models.MessageReview
+	offset := (page - 1) * pageSize
+	err := query.Order("created_at ASC").
+		Offset(offset).Limit(pageSize).
+		Find(&reviews).Error
+	return reviews, total, err
+}
+
+func (r *moderationRepository) ResolveReview(review *models.MessageReview, status models.MessageReviewStatus) (bool, error) {
+	resolved := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		result := tx.Model(&models.MessageReview{}).
+			Where("id = ? AND status = ?", review.ID, models.ReviewPending).
+			Updates(map[string]interface{}{"status": status, "reviewed_at": now})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		donationUpdates := map[string]interface{}{"message_status": models.MessageRejected}
+		if status == models.ReviewApproved {
+			donationUpdates = map[string]interface{}{
+				"message_status": models.MessageVisible,
+				"message":        review.Message,
+				"display_name":   review.DisplayName,
+			}
+		}
+		if err := tx.Model(&models.Donation{}).Where("id = ?", review.DonationID).Updates(donationUpdates).Error; err != nil {
+			return err
+		}
+
+		review.Status = status
+		review.ReviewedAt = &now
+		resolved = true
+		return nil
+	})
+	return resolved, err
+}
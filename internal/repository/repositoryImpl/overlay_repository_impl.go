@@ -0,0 +1,39 @@
+package repositoryImpl
+
+import (
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+)
+
+type overlayRepository struct {
+	db *gorm.DB
+}
+
+func NewOverlayRepository(db *gorm.DB) repository.OverlayRepository {
+	return &overlayRepository{db: db}
+}
+
+func (r *overlayRepository) GetByStreamerID(streamerID uint) (*models.OverlayToken, error) {
+	var token models.OverlayToken
+	err := r.db.Where("streamer_id = ?", streamerID).First(&token).Error
+	if err == gorm.ErrRecordNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (r *overlayRepository) GetByToken(token string) (*models.OverlayToken, error) {
+	var overlayToken models.OverlayToken
+	if err := r.db.Where("token = ?", token).First(&overlayToken).Error; err != nil {
+		return nil, err
+	}
+	return &overlayToken, nil
+}
+
+func (r *overlayRepository) Save(token *models.OverlayToken) error {
+	return r.db.Save(token).Error
+}
@@ -0,0 +1,135 @@
+package repositoryImpl
+
+import (
+	"fmt"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+)
+
+// payoutLockClass namespaces the advisory locks that serialise a streamer's withdrawals
+const payoutLockClass = 20
+
+type payoutRepository struct {
+	db *gorm.DB
+}
+
+func NewPayoutRepository(db *gorm.DB) repository.PayoutRepository {
+	return &payoutRepository{db: db}
+}
+
+func (r *payoutRepository) CreateAccount(account *models.PayoutAccount) error {
+	return r.db.Create(account).Error
+}
+
+func (r *payoutRepository) GetAccountByID(id uint) (*models.PayoutAccount, error) {
+	var account models.PayoutAccount
+	err := r.db.First(&account, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+func (r *payoutRepository) GetAccountsByStreamerID(streamerID uint) ([]*models.PayoutAccount, error) {
+	var accounts []*models.PayoutAccount
+	err := r.db.Where("streamer_id = ?", streamerID).
+		Order("created_at ASC").
+		Find(&accounts).Error
+	return accounts, err
+}
+
+func (r *payoutRepository) DeleteAccount(id uint) error {
+	return r.db.Delete(&models.PayoutAccount{}, id).Error
+}
+
+func (r *payoutRepository) Create(payout *models.Payout, hold func(*models.Payout) *models.LedgerEntry) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		// Held until commit, so two requests cannot both spend the same balance
+		if err := tx.Exec("SELECT pg_advisory_xact_lock(?, ?)", payoutLockClass, int32(payout.StreamerID)).Error; err != nil {
+			return err
+		}
+
+		balance, err := streamerBalance(tx, payout.StreamerID, payout.Currency)
+		if err != nil {
+			return err
+		}
+		if balance < payout.Amount {
+			return fmt.Errorf("%w: %s %s available", models.ErrInsufficientBalance, models.NewMoney(balance, payout.Currency), payout.Currency)
+		}
+
+		if err := tx.Create(payout).Error; err != nil {
+			return err
+		}
+		entry := hold(payout)
+		posted, err := insertLedgerEntry(tx, entry)
+		if err != nil {
+			return err
+		}
+		if !posted {
+			return fmt.Errorf("ledger entry %s already exists", entry.Reference)
+		}
+		return nil
+	})
+}
+
+func (r *payoutRepository) TransitionStatus(payout *models.Payout, from models.PayoutStatus, entry *models.LedgerEntry) (bool, error) {
+	transitioned := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.Payout{}).
+			Where("id = ? AND status = ?", payout.ID, from).
+			Updates(map[string]interface{}{
+				"status":             payout.Status,
+				"reviewed_by":        payout.ReviewedBy,
+				"reviewed_at":        payout.ReviewedAt,
+				"provider_reference": payout.ProviderReference,
+				"failure_reason":     payout.FailureReason,
+				"paid_at":            payout.PaidAt,
+			})
+		if result.Error != nil || result.RowsAffected == 0 {
+			return result.Error
+		}
+
+		if entry != nil {
+			if _, err := insertLedgerEntry(tx, entry); err != nil {
+				return err
+			}
+		}
+		transitioned = true
+		return nil
+	})
+	return transitioned, err
+}
+
+func (r *payoutRepository) GetByID(id uint) (*models.Payout, error) {
+	var payout models.Payout
+	err := r.db.First(&payout, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &payout, nil
+}
+
+func (r *payoutRepository) GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.Payout, error) {
+	var payouts []*models.Payout
+	offset := (page - 1) * pageSize
+	err := r.db.Where("streamer_id = ?", streamerID).
+		Order("id DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&payouts).Error
+	return payouts, err
+}
+
+func (r *payoutRepository) List(status models.PayoutStatus, page, pageSize int) ([]*models.Payout, error) {
+	var payouts []*models.Payout
+	offset := (page - 1) * pageSize
+	query := r.db.Model(&models.Payout{})
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	err := query.Order("id ASC").
+		Offset(offset).Limit(pageSize).
+		Find(&payouts).Error
+	return payouts, err
+}
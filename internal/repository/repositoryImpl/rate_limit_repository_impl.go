@@ -0,0 +1,52 @@
+package repositoryImpl
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+)
+
+// refilledTokens is the bucket's tokens after refilling for the time since it was last used
+const refilledTokens = `LEAST(@limit, rate_limit_buckets.tokens +
+	EXTRACT(EPOCH FROM statement_timestamp() - rate_limit_buckets.updated_at)::double precision * @rate)`
+
+// takeRateLimitToken creates a full bucket less one token, or refills an existing one and
+// takes a token if a whole one is left. Every SET expression sees the row as it was, and
+// the row lock held by the upsert serializes requests for the same key.
+var takeRateLimitToken = fmt.Sprintf(`
+INSERT INTO rate_limit_buckets (key, tokens, allowed, updated_at)
+VALUES (@key, @limit - 1, true, statement_timestamp())
+ON CONFLICT (key) DO UPDATE SET
+	tokens = %[1]s - CASE WHEN %[1]s >= 1 THEN 1 ELSE 0 END,
+	allowed = %[1]s >= 1,
+	updated_at = statement_timestamp()
+RETURNING tokens, allowed`, refilledTokens)
+
+type rateLimitRepository struct {
+	db *gorm.DB
+}
+
+func NewRateLimitRepository(db *gorm.DB) repository.RateLimitRepository {
+	return &rateLimitRepository{db: db}
+}
+
+func (r *rateLimitRepository) Take(key string, policy models.RateLimitPolicy) (models.RateLimitResult, error) {
+	var bucket models.RateLimitBucket
+	err := r.db.Raw(takeRateLimitToken, map[string]interface{}{
+		"key":   key,
+		"limit": float64(policy.Limit),
+		"rate":  policy.RefillRate(),
+	}).Row().Scan(&bucket.Tokens, &bucket.Allowed)
+	if err != nil {
+		return models.RateLimitResult{}, err
+	}
+	return policy.Result(bucket.Tokens, bucket.Allowed), nil
+}
+
+func (r *rateLimitRepository) DeleteIdle(before time.Time) (int64, error) {
+	result := r.db.Where("updated_at < ?", before).Delete(&models.RateLimitBucket{})
+	return result.RowsAffected, result.Error
+}
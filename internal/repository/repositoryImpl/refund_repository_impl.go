@@ -0,0 +1,63 @@
+package repositoryImpl
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+)
+
+type refundRepository struct {
+	db *gorm.DB
+}
+
+func NewRefundRepository(db *gorm.DB) repository.RefundRepository {
+	return &refundRepository{db: db}
+}
+
+func (r *refundRepository) Create(refund *models.DonationRefund) error {
+	return r.db.Create(refund).Error
+}
+
+func (r *refundRepository) Update(refund *models.DonationRefund) error {
+	return r.db.Save(refund).Error
+}
+
+func (r *refundRepository) Finish(refund *models.DonationRefund) (bool, error) {
+	result := r.db.Model(&models.DonationRefund{}).
+		Where("id = ? AND status = ?", refund.ID, models.RefundPending).
+		Updates(map[string]interface{}{
+			"status":             refund.Status,
+			"provider_refund_id": refund.ProviderRefundID,
+			"failure_reason":     refund.FailureReason,
+			"processed_at":       refund.ProcessedAt,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r *refundRepository) GetStalePending(createdBefore time.Time, limit int) ([]*models.DonationRefund, error) {
+	var refunds []*models.DonationRefund
+	err := r.db.Where("status = ? AND created_at < ?", models.RefundPending, createdBefore).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&refunds).Error
+	return refunds, err
+}
+
+func (r *refundRepository) GetByID(id uint) (*models.DonationRefund, error) {
+	var refund models.DonationRefund
+	err := r.db.First(&refund, id).Error
+	if err != nil {
+		return nil, err
+	}
+	return &refund, nil
+}
+
+func (r *refundRepository) GetByDonationID(donationID uint) ([]*models.DonationRefund, error) {
+	var refunds []*models.DonationRefund
+	err := r.db.Where("donation_id = ?", donationID).
+		Order("created_at DESC").
+		Find(&refunds).Error
+	return refunds, err
+}
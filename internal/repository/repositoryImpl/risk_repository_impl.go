@@ -0,0 +1,71 @@
+package repositoryImpl
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+)
+
+type riskRepository struct {
+	db *gorm.DB
+}
+
+func NewRiskRepository(db *gorm.DB) repository.RiskRepository {
+	return &riskRepository{db: db}
+}
+
+func (r *riskRepository) Create(assessment *models.RiskAssessment) error {
+	return r.db.Create(assessment).Error
+}
+
+func (r *riskRepository) SetDonationID(assessmentID, donationID uint) error {
+	return r.db.Model(&models.RiskAssessment{}).
+		Where("id = ?", assessmentID).
+		Update("donation_id", donationID).Error
+}
+
+func (r *riskRepository) GetBySplitDonationID(splitDonationID uint) (*models.RiskAssessment, error) {
+	var assessment models.RiskAssessment
+	err := r.db.Where("split_donation_id = ?", splitDonationID).
+		Order("id ASC").
+		First(&assessment).Error
+	if err != nil {
+		return nil, err
+	}
+	return &assessment, nil
+}
+
+func (r *riskRepository) CountSince(filter repository.RiskAttemptFilter, since time.Time) (int64, error) {
+	query := r.db.Model(&models.RiskAssessment{}).Where("created_at >= ?", since)
+	if filter.DonatorID != 0 {
+		query = query.Where("donator_id = ?", filter.DonatorID)
+	}
+	if filter.IPAddress != "" {
+		query = query.Where("ip_address = ?", filter.IPAddress)
+	}
+	if filter.StreamerID != 0 {
+		query = query.Where("streamer_id = ?", filter.StreamerID)
+	}
+	if filter.TinyOnly {
+		query = query.Where("tiny_amount = ?", true)
+	}
+
+	var count int64
+	err := query.Count(&count).Error
+	return count, err
+}
+
+func (r *riskRepository) List(decision models.RiskDecision, page, pageSize int) ([]*models.RiskAssessment, error) {
+	var assessments []*models.RiskAssessment
+	offset := (page - 1) * pageSize
+	query := r.db.Model(&models.RiskAssessment{})
+	if decision != "" {
+		query = query.Where("decision = ?", decision)
+	}
+	err := query.Order("id DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&assessments).Error
+	return assessments, err
+}
@@ -0,0 +1,87 @@
+package repositoryImpl
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"gorm.io/gorm"
+)
+
+type splitDonationRepository struct {
+	db *gorm.DB
+}
+
+func NewSplitDonationRepository(db *gorm.DB) repository.SplitDonationRepository {
+	return &splitDonationRepository{db: db}
+}
+
+func (r *splitDonationRepository) Create(split *models.SplitDonation) error {
+	return r.db.Create(split).Error
+}
+
+func (r *splitDonationRepository) GetByID(id uint) (*models.SplitDonation, error) {
+	var split models.SplitDonation
+	if err := r.db.Preload("Shares", orderSharesByID).First(&split, id).Error; err != nil {
+		return nil, err
+	}
+	return &split, nil
+}
+
+func (r *splitDonationRepository) GetByTransactionID(transactionID string) (*models.SplitDonation, error) {
+	var split models.SplitDonation
+	err := r.db.Preload("Shares", orderSharesByID).
+		Where("transaction_id = ?", transactionID).
+		First(&split).Error
+	if err != nil {
+		return nil, err
+	}
+	return &split, nil
+}
+
+func (r *splitDonationRepository) Update(split *models.SplitDonation) error {
+	return r.db.Omit("Shares").Save(split).Error
+}
+
+func (r *splitDonationRepository) UpdateShare(share *models.SplitDonationShare) error {
+	return r.db.Save(share).Error
+}
+
+func (r *splitDonationRepository) TransitionStatus(id uint, from, to models.PaymentStatus) (bool, error) {
+	updates := map[string]interface{}{"status": to}
+	if to == models.PaymentCompleted {
+		updates["payment_time"] = gorm.Expr("COALESCE(payment_time, ?)", time.Now())
+	}
+
+	result := r.db.Model(&models.SplitDonation{}).
+		Where("id = ? AND status = ?", id, from).
+		Updates(updates)
+	return result.RowsAffected > 0, result.Error
+}
+
+func (r *splitDonationRepository) GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.SplitDonation, error) {
+	var splits []*models.SplitDonation
+	offset := (page - 1) * pageSize
+	err := r.db.Preload("Shares", orderSharesByID).
+		Where("id IN (?)", r.db.Model(&models.SplitDonationShare{}).Select("split_donation_id").Where("streamer_id = ?", streamerID)).
+		Order("created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&splits).Error
+	return splits, err
+}
+
+func (r *splitDonationRepository) GetByDonatorID(donatorID uint, page, pageSize int) ([]*models.SplitDonation, error) {
+	var splits []*models.SplitDonation
+	offset := (page - 1) * pageSize
+	err := r.db.Preload("Shares", orderSharesByID).
+		Where("donator_id = ?", donatorID).
+		Order("created_at DESC").
+		Offset(offset).Limit(pageSize).
+		Find(&splits).Error
+	return splits, err
+}
+
+// orderSharesByID keeps shares in the order the donor listed them
+func orderSharesByID(db *gorm.DB) *gorm.DB {
+	return db.Order("id ASC")
+}
@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// RiskAttemptFilter selects the earlier donation requests a velocity rule counts. Empty
+// fields do not filter.
+type RiskAttemptFilter struct {
+	DonatorID  uint
+	IPAddress  string
+	StreamerID uint
+	TinyOnly   bool // Only requests for tiny amounts
+}
+
+type RiskRepository interface {
+	Create(assessment *models.RiskAssessment) error
+	SetDonationID(assessmentID, donationID uint) error
+	// GetBySplitDonationID returns the assessment of a split donation's first share
+	GetBySplitDonationID(splitDonationID uint) (*models.RiskAssessment, error)
+	// CountSince counts requests matching the filter made at or after since
+	CountSince(filter RiskAttemptFilter, since time.Time) (int64, error)
+	// List returns assessments with a decision, or all when decision is empty, newest first
+	List(decision models.RiskDecision, page, pageSize int) ([]*models.RiskAssessment, error)
+}
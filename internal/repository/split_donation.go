@@ -0,0 +1,19 @@
+package repository
+
+import "github.com/rzfd/mediashar/internal/models"
+
+type SplitDonationRepository interface {
+	// Create stores the split donation together with its shares
+	Create(split *models.SplitDonation) error
+	GetByID(id uint) (*models.SplitDonation, error)
+	GetByTransactionID(transactionID string) (*models.SplitDonation, error)
+	// Update saves the split donation's own columns, not its shares
+	Update(split *models.SplitDonation) error
+	UpdateShare(share *models.SplitDonationShare) error
+	// TransitionStatus moves the split from one status to another, reporting false when
+	// its status was no longer from
+	TransitionStatus(id uint, from, to models.PaymentStatus) (bool, error)
+	// GetByStreamerID returns the split donations the streamer has a share in, newest first
+	GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.SplitDonation, error)
+	GetByDonatorID(donatorID uint, page, pageSize int) ([]*models.SplitDonation, error)
+}
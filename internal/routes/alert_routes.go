@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupAlertRoutes configures the streamer's alert rule and test alert routes
+func SetupAlertRoutes(api *echo.Group, alertHandler *handler.AlertHandler, jwtSecret string) {
+	// Streamer-only routes (authentication + streamer role required)
+	streamerAlerts := api.Group("/streamers/:id", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
+	streamerAlerts.GET("/alert-rules", alertHandler.ListAlertRules)
+	streamerAlerts.POST("/alert-rules", alertHandler.CreateAlertRule)
+	streamerAlerts.GET("/alert-rules/:ruleId", alertHandler.GetAlertRule)
+	streamerAlerts.PUT("/alert-rules/:ruleId", alertHandler.UpdateAlertRule)
+	streamerAlerts.DELETE("/alert-rules/:ruleId", alertHandler.DeleteAlertRule)
+	streamerAlerts.POST("/test-alert", alertHandler.SendTestAlert)
+}
@@ -0,0 +1,17 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupDonationExportRoutes configures donation export routes for streamer bookkeeping
+func SetupDonationExportRoutes(api *echo.Group, exportHandler *handler.DonationExportHandler, jwtSecret string) {
+	// Streamer-only routes (authentication + streamer role required)
+	exports := api.Group("/streamers/:id/donations", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
+	exports.GET("/export", exportHandler.ExportDonations)
+	exports.POST("/exports", exportHandler.CreateDonationExport)
+	exports.GET("/exports/:exportId", exportHandler.GetDonationExport)
+	exports.GET("/exports/:exportId/download", exportHandler.DownloadDonationExport)
+}
@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupDonationGoalRoutes configures donation goal routes
+func SetupDonationGoalRoutes(api *echo.Group, goalHandler *handler.DonationGoalHandler, jwtSecret string) {
+	// Public goal routes (viewers and overlays can follow progress)
+	api.GET("/streamers/:id/goals", goalHandler.ListDonationGoals)
+	api.GET("/streamers/:id/goals/:goalId", goalHandler.GetDonationGoal)
+
+	// Streamer-only routes (authentication + streamer role required)
+	streamerGoals := api.Group("/streamers/:id/goals", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
+	streamerGoals.POST("", goalHandler.CreateDonationGoal)
+	streamerGoals.PUT("/:goalId", goalHandler.UpdateDonationGoal)
+	streamerGoals.DELETE("/:goalId", goalHandler.DeleteDonationGoal)
+}
@@ -7,18 +7,20 @@ import (
 )
 
 // SetupDonationRoutes configures donation-related routes
-func SetupDonationRoutes(api *echo.Group, donationHandler *handler.DonationHandler, jwtSecret string) {
+func SetupDonationRoutes(api *echo.Group, donationHandler *handler.DonationHandler, idempotencyStore middleware.IdempotencyStore, jwtSecret string) {
 	// Protected donation routes (authentication required)
 	protectedDonations := api.Group("/donations", middleware.JWTMiddleware(jwtSecret))
-	protectedDonations.POST("", donationHandler.CreateDonation)
+	protectedDonations.POST("", donationHandler.CreateDonation, middleware.IdempotencyMiddleware(idempotencyStore))
 	protectedDonations.GET("", donationHandler.ListDonations)
 	protectedDonations.GET("/:id", donationHandler.GetDonation)
+	protectedDonations.GET("/:id/history", donationHandler.GetDonationStatusHistory)
 	protectedDonations.GET("/latest", donationHandler.GetLatestDonations)
 
 	// Streamer-only routes (authentication + streamer role required)
 	streamerDonations := api.Group("/streamers", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
 	streamerDonations.GET("/:id/donations", donationHandler.GetStreamerDonations)
 	streamerDonations.GET("/:id/total", donationHandler.GetTotalDonations)
+	streamerDonations.GET("/:id/stats", donationHandler.GetDonationStats)
 
 	// Payment processing routes (authentication required)
 	protectedPayments := api.Group("/payments", middleware.JWTMiddleware(jwtSecret))
@@ -0,0 +1,12 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+)
+
+// SetupLeaderboardRoutes configures donor leaderboard routes
+func SetupLeaderboardRoutes(api *echo.Group, leaderboardHandler *handler.LeaderboardHandler) {
+	// Public so stream overlays can show top supporters without a session
+	api.GET("/streamers/:id/leaderboard", leaderboardHandler.GetLeaderboard)
+}
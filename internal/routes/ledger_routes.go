@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupLedgerRoutes configures streamer balance routes
+func SetupLedgerRoutes(api *echo.Group, ledgerHandler *handler.LedgerHandler, jwtSecret string) {
+	// Streamer-only routes (authentication + streamer role required)
+	streamer := api.Group("/streamers/:id", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
+	streamer.GET("/balance", ledgerHandler.GetBalance)
+	streamer.GET("/ledger", ledgerHandler.GetLedgerEntries)
+}
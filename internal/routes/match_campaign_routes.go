@@ -0,0 +1,20 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupMatchCampaignRoutes configures sponsor matching campaign and report routes
+func SetupMatchCampaignRoutes(api *echo.Group, matchCampaignHandler *handler.MatchCampaignHandler, adminUserIDs []uint, jwtSecret string) {
+	// Protected routes (authentication required; sponsors read their own campaign's report)
+	api.GET("/match-campaigns/:id/report", matchCampaignHandler.GetReport, middleware.JWTMiddleware(jwtSecret))
+
+	// Admin-only routes (authentication + listed in ADMIN_USER_IDS)
+	admin := api.Group("/admin/match-campaigns", middleware.JWTMiddleware(jwtSecret), middleware.AdminOnlyMiddleware(adminUserIDs))
+	admin.POST("", matchCampaignHandler.CreateCampaign)
+	admin.GET("", matchCampaignHandler.ListCampaigns)
+	admin.GET("/:id", matchCampaignHandler.GetCampaign)
+	admin.PUT("/:id", matchCampaignHandler.UpdateCampaign)
+}
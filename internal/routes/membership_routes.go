@@ -0,0 +1,27 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupMembershipRoutes configures membership tier and subscription routes
+func SetupMembershipRoutes(api *echo.Group, membershipHandler *handler.MembershipHandler, jwtSecret string) {
+	// Public tier listing
+	api.GET("/streamers/:id/tiers", membershipHandler.ListTiers)
+
+	// Streamer-only routes (authentication + streamer role required)
+	streamerMemberships := api.Group("/streamers/:id", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
+	streamerMemberships.POST("/tiers", membershipHandler.CreateTier)
+	streamerMemberships.PUT("/tiers/:tierId", membershipHandler.UpdateTier)
+	streamerMemberships.GET("/members", membershipHandler.GetStreamerMembers)
+
+	// Member routes (authentication required)
+	protectedMemberships := api.Group("/memberships", middleware.JWTMiddleware(jwtSecret))
+	protectedMemberships.POST("", membershipHandler.Subscribe)
+	protectedMemberships.GET("", membershipHandler.GetMyMemberships)
+	protectedMemberships.GET("/:id", membershipHandler.GetMembership)
+	protectedMemberships.GET("/:id/payments", membershipHandler.GetMembershipPayments)
+	protectedMemberships.POST("/:id/cancel", membershipHandler.CancelMembership)
+}
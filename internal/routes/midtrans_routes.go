@@ -7,7 +7,7 @@ import (
 )
 
 // SetupMidtransRoutes sets up all Midtrans related routes
-func SetupMidtransRoutes(api *echo.Group, midtransHandler *handler.MidtransHandler, jwtSecret string) {
+func SetupMidtransRoutes(api *echo.Group, midtransHandler *handler.MidtransHandler, idempotencyStore middleware.IdempotencyStore, jwtSecret string) {
 	midtrans := api.Group("/midtrans")
 
 	// Public routes (no authentication required)
@@ -16,5 +16,5 @@ func SetupMidtransRoutes(api *echo.Group, midtransHandler *handler.MidtransHandl
 
 	// Protected routes (authentication required)
 	protected := midtrans.Group("", middleware.JWTMiddleware(jwtSecret))
-	protected.POST("/payment/:donationId", midtransHandler.CreatePayment)
+	protected.POST("/payment/:donationId", midtransHandler.CreatePayment, middleware.IdempotencyMiddleware(idempotencyStore))
 } 
\ No newline at end of file
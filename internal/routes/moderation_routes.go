@@ -0,0 +1,18 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupModerationRoutes configures donation message moderation routes
+func SetupModerationRoutes(api *echo.Group, moderationHandler *handler.ModerationHandler, jwtSecret string) {
+	// Streamer-only routes (authentication + streamer role required)
+	moderation := api.Group("/streamers/:id/moderation", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
+	moderation.GET("", moderationHandler.GetModerationSettings)
+	moderation.PUT("", moderationHandler.UpdateModerationSettings)
+	moderation.GET("/queue", moderationHandler.GetReviewQueue)
+	moderation.PUT("/queue/:reviewId/approve", moderationHandler.ApproveMessage)
+	moderation.PUT("/queue/:reviewId/reject", moderationHandler.RejectMessage)
+}
@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupOverlayRoutes configures the browser-source overlay routes
+func SetupOverlayRoutes(e *echo.Echo, api *echo.Group, overlayHandler *handler.OverlayHandler, jwtSecret string) {
+	// Streamer-only routes managing the secret token in the overlay URLs
+	overlayToken := api.Group("/streamers/:id/overlay-token", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
+	overlayToken.GET("", overlayHandler.GetOverlayToken)
+	overlayToken.POST("/rotate", overlayHandler.RotateOverlayToken)
+
+	// Overlay pages authenticate with the token in the URL, as OBS cannot log in
+	overlay := e.Group("/overlay/:token")
+	overlay.GET("/events", overlayHandler.StreamOverlayEvents)
+	overlay.POST("/media/:media_id/played", overlayHandler.MarkMediaPlayed)
+	overlay.GET("/:widget", overlayHandler.ServeOverlay)
+}
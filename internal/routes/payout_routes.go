@@ -0,0 +1,30 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupPayoutRoutes configures payout account, withdrawal and payout review routes
+func SetupPayoutRoutes(api *echo.Group, payoutHandler *handler.PayoutHandler, adminUserIDs []uint, jwtSecret string) {
+	// Public routes
+	api.GET("/payouts/channels", payoutHandler.GetChannels)
+
+	// Streamer-only routes (authentication + streamer role required)
+	streamer := api.Group("/streamers/:id", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
+	streamer.GET("/payout-accounts", payoutHandler.GetAccounts)
+	streamer.POST("/payout-accounts", payoutHandler.CreateAccount)
+	streamer.DELETE("/payout-accounts/:accountId", payoutHandler.DeleteAccount)
+	streamer.GET("/payouts", payoutHandler.GetPayouts)
+	streamer.POST("/payouts", payoutHandler.RequestPayout)
+	streamer.GET("/payouts/:payoutId", payoutHandler.GetPayout)
+
+	// Admin-only routes (authentication + listed in ADMIN_USER_IDS)
+	admin := api.Group("/admin/payouts", middleware.JWTMiddleware(jwtSecret), middleware.AdminOnlyMiddleware(adminUserIDs))
+	admin.GET("", payoutHandler.ListPayouts)
+	admin.POST("/:id/approve", payoutHandler.ApprovePayout)
+	admin.POST("/:id/reject", payoutHandler.RejectPayout)
+	admin.POST("/:id/paid", payoutHandler.MarkPayoutPaid)
+	admin.POST("/:id/failed", payoutHandler.MarkPayoutFailed)
+}
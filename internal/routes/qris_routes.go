@@ -7,10 +7,10 @@ import (
 )
 
 // SetupQRISRoutes configures QRIS-related routes
-func SetupQRISRoutes(api *echo.Group, qrisHandler *handler.QRISHandler, jwtSecret string) {
+func SetupQRISRoutes(api *echo.Group, qrisHandler *handler.QRISHandler, idempotencyStore middleware.IdempotencyStore, jwtSecret string) {
 	// Public QRIS routes (optional authentication for anonymous donations)
 	qrisPublic := api.Group("", middleware.OptionalJWTMiddleware(jwtSecret))
-	qrisPublic.POST("/qris/donate", qrisHandler.CreateQRISDonation)
+	qrisPublic.POST("/qris/donate", qrisHandler.CreateQRISDonation, middleware.IdempotencyMiddleware(idempotencyStore))
 
 	// Protected QRIS routes (authentication required)
 	protectedQRIS := api.Group("/qris", middleware.JWTMiddleware(jwtSecret))
@@ -0,0 +1,16 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupReceiptRoutes configures donation receipt downloads and receipt verification
+func SetupReceiptRoutes(api *echo.Group, receiptHandler *handler.ReceiptHandler, jwtSecret string) {
+	// Public routes
+	api.GET("/receipts/verify/:code", receiptHandler.VerifyReceipt)
+
+	// Protected routes (authentication required; donor or streamer of the donation)
+	api.GET("/donations/:id/receipt", receiptHandler.DownloadReceipt, middleware.JWTMiddleware(jwtSecret))
+}
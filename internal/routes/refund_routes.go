@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupRefundRoutes configures donation refund routes
+func SetupRefundRoutes(api *echo.Group, refundHandler *handler.RefundHandler, adminUserIDs []uint, jwtSecret string) {
+	// Protected refund routes (authentication required)
+	protectedRefunds := api.Group("/donations/:id/refunds", middleware.JWTMiddleware(jwtSecret))
+	protectedRefunds.GET("", refundHandler.GetDonationRefunds)
+	protectedRefunds.POST("", refundHandler.RefundDonation, middleware.StreamerOnlyMiddleware())
+
+	// Admin-only routes (authentication + listed in ADMIN_USER_IDS)
+	admin := api.Group("/admin/donations/:id/refunds", middleware.JWTMiddleware(jwtSecret), middleware.AdminOnlyMiddleware(adminUserIDs))
+	admin.POST("", refundHandler.AdminRefundDonation)
+}
@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupRiskRoutes configures the admin review of donation requests screened for fraud
+func SetupRiskRoutes(api *echo.Group, riskHandler *handler.RiskHandler, adminUserIDs []uint, jwtSecret string) {
+	// Admin-only routes (authentication + listed in ADMIN_USER_IDS)
+	admin := api.Group("/admin/risk", middleware.JWTMiddleware(jwtSecret), middleware.AdminOnlyMiddleware(adminUserIDs))
+	admin.GET("/assessments", riskHandler.ListAssessments)
+}
@@ -3,10 +3,11 @@ package routes
 import (
 	"github.com/labstack/echo/v4"
 	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
 )
 
 // SetupRoutes configures all the routes for the application
-func SetupRoutes(e *echo.Echo, userHandler *handler.UserHandler, donationHandler *handler.DonationHandler, webhookHandler *handler.WebhookHandler, authHandler *handler.AuthHandler, qrisHandler *handler.QRISHandler, platformHandler *handler.PlatformHandler, midtransHandler *handler.MidtransHandler, currencyHandler *handler.CurrencyHandler, languageHandler *handler.LanguageHandler, mediaShareHandler *handler.MediaShareHandler, jwtSecret string) {
+func SetupRoutes(e *echo.Echo, userHandler *handler.UserHandler, donationHandler *handler.DonationHandler, webhookHandler *handler.WebhookHandler, authHandler *handler.AuthHandler, qrisHandler *handler.QRISHandler, platformHandler *handler.PlatformHandler, midtransHandler *handler.MidtransHandler, currencyHandler *handler.CurrencyHandler, languageHandler *handler.LanguageHandler, mediaShareHandler *handler.MediaShareHandler, donationGoalHandler *handler.DonationGoalHandler, membershipHandler *handler.MembershipHandler, refundHandler *handler.RefundHandler, leaderboardHandler *handler.LeaderboardHandler, moderationHandler *handler.ModerationHandler, donationExportHandler *handler.DonationExportHandler, overlayHandler *handler.OverlayHandler, alertHandler *handler.AlertHandler, splitDonationHandler *handler.SplitDonationHandler, ledgerHandler *handler.LedgerHandler, payoutHandler *handler.PayoutHandler, riskHandler *handler.RiskHandler, matchCampaignHandler *handler.MatchCampaignHandler, receiptHandler *handler.ReceiptHandler, idempotencyStore middleware.IdempotencyStore, adminUserIDs []uint, jwtSecret string) {
 	// Health check routes (no prefix)
 	healthHandler := handler.NewHealthHandler()
 	e.GET("/health", healthHandler.HealthCheck)
@@ -22,12 +23,26 @@ func SetupRoutes(e *echo.Echo, userHandler *handler.UserHandler, donationHandler
 	// Setup routes by handler
 	SetupAuthRoutes(api, authHandler, jwtSecret)
 	SetupUserRoutes(api, userHandler, jwtSecret)
-	SetupDonationRoutes(api, donationHandler, jwtSecret)
-	SetupQRISRoutes(api, qrisHandler, jwtSecret)
-	SetupMidtransRoutes(api, midtransHandler, jwtSecret)
+	SetupDonationRoutes(api, donationHandler, idempotencyStore, jwtSecret)
+	SetupReceiptRoutes(api, receiptHandler, jwtSecret)
+	SetupDonationGoalRoutes(api, donationGoalHandler, jwtSecret)
+	SetupMembershipRoutes(api, membershipHandler, jwtSecret)
+	SetupSplitDonationRoutes(api, splitDonationHandler, idempotencyStore, jwtSecret)
+	SetupRefundRoutes(api, refundHandler, adminUserIDs, jwtSecret)
+	SetupLedgerRoutes(api, ledgerHandler, jwtSecret)
+	SetupPayoutRoutes(api, payoutHandler, adminUserIDs, jwtSecret)
+	SetupRiskRoutes(api, riskHandler, adminUserIDs, jwtSecret)
+	SetupMatchCampaignRoutes(api, matchCampaignHandler, adminUserIDs, jwtSecret)
+	SetupLeaderboardRoutes(api, leaderboardHandler)
+	SetupModerationRoutes(api, moderationHandler, jwtSecret)
+	SetupDonationExportRoutes(api, donationExportHandler, jwtSecret)
+	SetupQRISRoutes(api, qrisHandler, idempotencyStore, jwtSecret)
+	SetupMidtransRoutes(api, midtransHandler, idempotencyStore, jwtSecret)
 	SetupWebhookRoutes(api, webhookHandler, qrisHandler)
 	SetupPlatformRoutes(api, platformHandler, jwtSecret)
 	SetupCurrencyRoutes(api, currencyHandler, jwtSecret)
 	SetupLanguageRoutes(api, languageHandler, jwtSecret)
 	SetupMediaShareRoutes(api, mediaShareHandler, jwtSecret)
+	SetupOverlayRoutes(e, api, overlayHandler, jwtSecret)
+	SetupAlertRoutes(api, alertHandler, jwtSecret)
 } 
\ No newline at end of file
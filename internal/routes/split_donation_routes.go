@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/labstack/echo/v4"
+	"github.com/rzfd/mediashar/internal/handler"
+	"github.com/rzfd/mediashar/internal/middleware"
+)
+
+// SetupSplitDonationRoutes configures routes for donations split between several streamers
+func SetupSplitDonationRoutes(api *echo.Group, splitDonationHandler *handler.SplitDonationHandler, idempotencyStore middleware.IdempotencyStore, jwtSecret string) {
+	// Public route (optional authentication for anonymous donations)
+	splitPublic := api.Group("/split-donations", middleware.OptionalJWTMiddleware(jwtSecret))
+	splitPublic.POST("", splitDonationHandler.CreateSplitDonation, middleware.IdempotencyMiddleware(idempotencyStore))
+
+	// Protected routes (authentication required)
+	protectedSplits := api.Group("/split-donations", middleware.JWTMiddleware(jwtSecret))
+	protectedSplits.GET("", splitDonationHandler.GetMySplitDonations)
+	protectedSplits.GET("/:id", splitDonationHandler.GetSplitDonation)
+
+	// Streamer-only routes (authentication + streamer role required)
+	streamerSplits := api.Group("/streamers/:id", middleware.JWTMiddleware(jwtSecret), middleware.StreamerOnlyMiddleware())
+	streamerSplits.GET("/split-donations", splitDonationHandler.GetStreamerSplitDonations)
+}
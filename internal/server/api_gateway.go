@@ -3,6 +3,9 @@ package server
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -21,30 +24,57 @@ import (
 	"github.com/rzfd/mediashar/internal/service/serviceImpl"
 	"github.com/rzfd/mediashar/internal/utils"
 	customMiddleware "github.com/rzfd/mediashar/internal/middleware"
+	"github.com/rzfd/mediashar/migrations"
 	"github.com/rzfd/mediashar/pkg/logger"
 	"github.com/rzfd/mediashar/pkg/metrics"
 	"github.com/rzfd/mediashar/pkg/pb"
 )
 
 type APIGateway struct {
-	donationClient     pb.DonationServiceClient
-	paymentClient      pb.PaymentServiceClient
-	notificationClient pb.NotificationServiceClient
-	echo               *echo.Echo
-	config             *configs.Config
+	donationClient      pb.DonationServiceClient
+	donationGoalClient  pb.DonationGoalServiceClient
+	moderationClient    pb.ModerationServiceClient
+	ledgerClient        pb.LedgerServiceClient
+	payoutClient        pb.PayoutServiceClient
+	riskClient          pb.RiskServiceClient
+	matchCampaignClient pb.MatchCampaignServiceClient
+	paymentClient       pb.PaymentServiceClient
+	notificationClient  pb.NotificationServiceClient
+	echo                *echo.Echo
+	config              *configs.Config
 }
 
 type Handlers struct {
-	UserHandler       *handler.UserHandler
-	AuthHandler       *handler.AuthHandler
-	PlatformHandler   *handler.PlatformHandler
-	QRISHandler       *handler.QRISHandler
-	CurrencyHandler   *handler.CurrencyHandler
-	LanguageHandler   *handler.LanguageHandler
-	MediaShareHandler *handler.MediaShareHandler
-	DonationHandler   *handler.DonationHandler
-	WebhookHandler    *handler.WebhookHandler
-	MidtransHandler   *handler.MidtransHandler
+	UserHandler           *handler.UserHandler
+	AuthHandler           *handler.AuthHandler
+	PlatformHandler       *handler.PlatformHandler
+	QRISHandler           *handler.QRISHandler
+	CurrencyHandler       *handler.CurrencyHandler
+	LanguageHandler       *handler.LanguageHandler
+	MediaShareHandler     *handler.MediaShareHandler
+	DonationHandler       *handler.DonationHandler
+	WebhookHandler        *handler.WebhookHandler
+	MidtransHandler       *handler.MidtransHandler
+	DonationGoalHandler   *handler.DonationGoalHandler
+	MembershipHandler     *handler.MembershipHandler
+	RefundHandler         *handler.RefundHandler
+	LeaderboardHandler    *handler.LeaderboardHandler
+	ModerationHandler     *handler.ModerationHandler
+	DonationExportHandler *handler.DonationExportHandler
+	OverlayHandler        *handler.OverlayHandler
+	AlertHandler          *handler.AlertHandler
+	SplitDonationHandler  *handler.SplitDonationHandler
+	LedgerHandler         *handler.LedgerHandler
+	PayoutHandler         *handler.PayoutHandler
+	RiskHandler           *handler.RiskHandler
+	MatchCampaignHandler  *handler.MatchCampaignHandler
+	ReceiptHandler        *handler.ReceiptHandler
+
+	// Shared by the donation and payment creation routes to replay retried requests
+	IdempotencyService service.IdempotencyService
+
+	// Token buckets of the rate limiter, in memory or shared between gateway replicas
+	RateLimitStore customMiddleware.RateLimitStore
 }
 
 func NewAPIGateway(config *configs.Config) (*APIGateway, error) {
@@ -131,9 +161,15 @@ func connectToMicroservices(config *configs.Config) (*APIGateway, error) {
 	appLogger.Info("Connected to notification service", "url", notificationURL)
 
 	return &APIGateway{
-		donationClient:     pb.NewDonationServiceClient(donationConn),
-		paymentClient:      pb.NewPaymentServiceClient(paymentConn),
-		notificationClient: pb.NewNotificationServiceClient(notificationConn),
+		donationClient:      pb.NewDonationServiceClient(donationConn),
+		donationGoalClient:  pb.NewDonationGoalServiceClient(donationConn),
+		moderationClient:    pb.NewModerationServiceClient(donationConn),
+		ledgerClient:        pb.NewLedgerServiceClient(donationConn),
+		payoutClient:        pb.NewPayoutServiceClient(donationConn),
+		riskClient:          pb.NewRiskServiceClient(donationConn),
+		matchCampaignClient: pb.NewMatchCampaignServiceClient(donationConn),
+		paymentClient:       pb.NewPaymentServiceClient(paymentConn),
+		notificationClient:  pb.NewNotificationServiceClient(notificationConn),
 	}, nil
 }
 
@@ -144,6 +180,10 @@ func initializeHandlers(db *gorm.DB, gateway *APIGateway, config *configs.Config
 	currencyRepo := repositoryImpl.NewCurrencyRepository(db)
 	languageRepo := repositoryImpl.NewLanguageRepository(db)
 	mediaShareRepo := repositoryImpl.NewMediaShareRepository(db)
+	membershipRepo := repositoryImpl.NewMembershipRepository(db)
+	overlayRepo := repositoryImpl.NewOverlayRepository(db)
+	alertRuleRepo := repositoryImpl.NewAlertRuleRepository(db)
+	splitDonationRepo := repositoryImpl.NewSplitDonationRepository(db)
 
 	// Initialize services
 	userService := serviceImpl.NewUserService(userRepo)
@@ -151,35 +191,83 @@ func initializeHandlers(db *gorm.DB, gateway *APIGateway, config *configs.Config
 	platformService := serviceImpl.NewPlatformService()
 	currencyService := service.NewCurrencyService(currencyRepo)
 	languageService := service.NewLanguageService(languageRepo)
-	mediaShareService := serviceImpl.NewMediaShareService(mediaShareRepo)
+	messageTranslator := initMessageTranslator(languageService)
+	mediaShareService := serviceImpl.NewMediaShareServiceWithTranslator(mediaShareRepo, messageTranslator, languageRepo)
 
 	// Create service adapters
-	donationService := adapter.NewDonationServiceAdapter(gateway.donationClient)
+	donationService := adapter.NewDonationServiceAdapter(gateway.donationClient, currencyRepo, languageRepo)
 	paymentService := adapter.NewPaymentServiceAdapter(gateway.paymentClient)
+	donationGoalService := adapter.NewDonationGoalServiceAdapter(gateway.donationGoalClient)
+	refundService := adapter.NewRefundServiceAdapter(gateway.donationClient)
+	leaderboardService := adapter.NewLeaderboardServiceAdapter(gateway.donationClient)
+	moderationService := adapter.NewModerationServiceAdapter(gateway.moderationClient)
+	ledgerService := adapter.NewLedgerServiceAdapter(gateway.ledgerClient)
+	payoutService := adapter.NewPayoutServiceAdapter(gateway.payoutClient)
+	riskReviewService := adapter.NewRiskServiceAdapter(gateway.riskClient)
+	matchCampaignService := adapter.NewMatchCampaignServiceAdapter(gateway.matchCampaignClient)
+	donationExportService := adapter.NewDonationExportServiceAdapter(gateway.donationClient)
+	notificationService := adapter.NewNotificationServiceAdapter(gateway.notificationClient)
+
+	// Overlays combine donation events with goals, the leaderboard and the media queue;
+	// alerts are rendered with the streamer's alert rules
+	alertService := serviceImpl.NewAlertService(alertRuleRepo, currencyService)
+	overlayService := serviceImpl.NewOverlayService(overlayRepo, currencyRepo, notificationService, donationGoalService, leaderboardService, mediaShareService, alertService)
+
+	// Memberships are billed through regular donations, renewed in the background
+	membershipService := serviceImpl.NewMembershipService(membershipRepo, donationService, getDurationEnv("MEMBERSHIP_GRACE_PERIOD", 72*time.Hour))
+	go startMembershipRenewalWorker(membershipService, getDurationEnv("MEMBERSHIP_RENEWAL_INTERVAL", time.Minute))
 	
+	// Split donations pay one donation per streamer with a single Midtrans or QRIS payment
+	splitDonationService := serviceImpl.NewSplitDonationService(splitDonationRepo, donationService)
+
 	// Use real Midtrans service instead of adapter
-	midtransService := serviceImpl.NewMidtransService(config, donationService)
+	midtransService := serviceImpl.NewMidtransService(config, donationService, splitDonationService, refundService)
 	
 	qrisService := serviceImpl.NewQRISService("MERCHANT123", "MediaShar Donation", donationService)
 
+	// Receipts of completed donations, localized for the donor
+	receiptService := initReceiptService(donationService, userService, languageService, languageRepo, currencyService, config.Auth.JWTSecret)
+
 	// Initialize handlers
 	return &Handlers{
-		UserHandler:       handler.NewUserHandler(userService, donationService),
-		AuthHandler:       handler.NewAuthHandler(userService, authService),
-		PlatformHandler:   handler.NewPlatformHandler(platformService, platformRepo),
-		QRISHandler:       handler.NewQRISHandler(qrisService, donationService),
-		CurrencyHandler:   handler.NewCurrencyHandler(currencyService),
-		LanguageHandler:   handler.NewLanguageHandler(languageService),
-		MediaShareHandler: handler.NewMediaShareHandler(mediaShareService),
-		DonationHandler:   handler.NewDonationHandler(donationService),
-		WebhookHandler:    handler.NewWebhookHandler(paymentService),
-		MidtransHandler:   handler.NewMidtransHandler(midtransService, donationService),
+		UserHandler:           handler.NewUserHandler(userService, donationService),
+		AuthHandler:           handler.NewAuthHandler(userService, authService),
+		PlatformHandler:       handler.NewPlatformHandler(platformService, platformRepo),
+		QRISHandler:           handler.NewQRISHandler(qrisService, donationService),
+		CurrencyHandler:       handler.NewCurrencyHandler(currencyService),
+		LanguageHandler:       handler.NewLanguageHandler(languageService),
+		MediaShareHandler:     handler.NewMediaShareHandler(mediaShareService),
+		DonationHandler:       handler.NewDonationHandler(donationService, getUintListEnv("ADMIN_USER_IDS")),
+		WebhookHandler:        handler.NewWebhookHandler(paymentService),
+		MidtransHandler:       handler.NewMidtransHandler(midtransService, donationService),
+		DonationGoalHandler:   handler.NewDonationGoalHandler(donationGoalService),
+		MembershipHandler:     handler.NewMembershipHandler(membershipService),
+		RefundHandler:         handler.NewRefundHandler(refundService, donationService),
+		LeaderboardHandler:    handler.NewLeaderboardHandler(leaderboardService, currencyRepo, platformRepo),
+		ModerationHandler:     handler.NewModerationHandler(moderationService),
+		DonationExportHandler: handler.NewDonationExportHandler(donationExportService, currencyRepo),
+		OverlayHandler:        handler.NewOverlayHandler(overlayService),
+		AlertHandler:          handler.NewAlertHandler(alertService, overlayService),
+		SplitDonationHandler:  handler.NewSplitDonationHandler(splitDonationService, midtransService, qrisService),
+		LedgerHandler:         handler.NewLedgerHandler(ledgerService),
+		PayoutHandler:         handler.NewPayoutHandler(payoutService),
+		RiskHandler:           handler.NewRiskHandler(riskReviewService),
+		MatchCampaignHandler:  handler.NewMatchCampaignHandler(matchCampaignService, getUintListEnv("ADMIN_USER_IDS")),
+		ReceiptHandler:        handler.NewReceiptHandler(receiptService, donationService, receiptPublicBaseURL()),
+		IdempotencyService:    initIdempotencyService(db),
+		RateLimitStore:        initRateLimitStore(db),
 	}
 }
 
 func setupEchoServer(handlers *Handlers, config *configs.Config) *echo.Echo {
 	e := echo.New()
 
+	// Client addresses and countries are read from forwarding headers only when a
+	// trusted proxy set them, so donors cannot pick their own for rate limits and screening
+	trustedProxies := trustedProxiesFromEnv()
+	e.IPExtractor = trustedProxies.IPExtractor()
+	e.Use(customMiddleware.ClientCountryMiddleware(trustedProxies))
+
 	// Global middleware
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
@@ -197,13 +285,24 @@ func setupEchoServer(handlers *Handlers, config *configs.Config) *echo.Echo {
 		},
 		AllowHeaders: []string{
 			"Origin", "Content-Type", "Accept", "Authorization", "X-Requested-With",
+			customMiddleware.IdempotencyKeyHeader,
+			customMiddleware.APIKeyHeader,
 		},
 		AllowCredentials: true,
 		ExposeHeaders: []string{
 			"Content-Length",
+			customMiddleware.IdempotentReplayedHeader,
+			customMiddleware.RateLimitLimitHeader,
+			customMiddleware.RateLimitRemainingHeader,
+			customMiddleware.RateLimitResetHeader,
+			customMiddleware.RateLimitPolicyHeader,
+			echo.HeaderRetryAfter,
 		},
 	}))
 
+	// Rate limiting, after CORS so preflight requests are answered without a token
+	e.Use(rateLimitMiddleware(handlers.RateLimitStore, config))
+
 	// Health check endpoint
 	e.GET("/health", func(c echo.Context) error {
 		return c.JSON(200, map[string]interface{}{
@@ -232,6 +331,22 @@ func setupEchoServer(handlers *Handlers, config *configs.Config) *echo.Echo {
 		handlers.CurrencyHandler, 
 		handlers.LanguageHandler, 
 		handlers.MediaShareHandler, 
+		handlers.DonationGoalHandler,
+		handlers.MembershipHandler,
+		handlers.RefundHandler,
+		handlers.LeaderboardHandler,
+		handlers.ModerationHandler,
+		handlers.DonationExportHandler,
+		handlers.OverlayHandler,
+		handlers.AlertHandler,
+		handlers.SplitDonationHandler,
+		handlers.LedgerHandler,
+		handlers.PayoutHandler,
+		handlers.RiskHandler,
+		handlers.MatchCampaignHandler,
+		handlers.ReceiptHandler,
+		handlers.IdempotencyService,
+		getUintListEnv("ADMIN_USER_IDS"),
 		config.Auth.JWTSecret)
 
 	return e
@@ -259,7 +374,49 @@ func initAdvancedUserMetricsCollector(db *gorm.DB) {
 	}
 }
 
+// startMembershipRenewalWorker periodically settles membership payments and issues renewals
+func startMembershipRenewalWorker(membershipService service.MembershipService, interval time.Duration) {
+	appLogger := logger.GetLogger()
+	appLogger.Info("Starting membership renewal worker", "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		if err := membershipService.ProcessRenewals(time.Now()); err != nil {
+			appLogger.Error(err, "Membership renewal run finished with errors")
+		}
+	}
+}
+
+// getDurationEnv reads a duration such as "72h" from the environment
+func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
+	duration, err := time.ParseDuration(utils.GetEnv(key, ""))
+	if err != nil || duration <= 0 {
+		return defaultValue
+	}
+	return duration
+}
+
+// getUintListEnv reads a comma-separated list of IDs, skipping entries that are not one
+func getUintListEnv(key string) []uint {
+	var ids []uint
+	for _, field := range strings.Split(utils.GetEnv(key, ""), ",") {
+		id, err := strconv.ParseUint(strings.TrimSpace(field), 10, 32)
+		if err != nil || id == 0 {
+			continue
+		}
+		ids = append(ids, uint(id))
+	}
+	return ids
+}
+
 func migrateGatewayTables(db *gorm.DB) error {
+	// Money columns are rescaled to minor units before AutoMigrate retypes them
+	if err := db.Exec(migrations.AmountsToMinorUnits).Error; err != nil {
+		return fmt.Errorf("failed to convert amounts to minor units: %w", err)
+	}
+
 	return db.AutoMigrate(
 		&models.User{},
 		&models.StreamingPlatform{},
@@ -273,6 +430,15 @@ func migrateGatewayTables(db *gorm.DB) error {
 		&models.UserLanguagePreference{},
 		&models.MediaShare{},
 		&models.MediaShareSettings{},
+		&models.MembershipTier{},
+		&models.Membership{},
+		&models.MembershipPayment{},
+		&models.IdempotencyRecord{},
+		&models.OverlayToken{},
+		&models.AlertRule{},
+		&models.SplitDonation{},
+		&models.SplitDonationShare{},
+		&models.RateLimitBucket{},
 	)
 }
 
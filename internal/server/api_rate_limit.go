@@ -0,0 +1,124 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/configs"
+	customMiddleware "github.com/rzfd/mediashar/internal/middleware"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/repository/repositoryImpl"
+	"github.com/rzfd/mediashar/internal/utils"
+	"github.com/rzfd/mediashar/pkg/logger"
+)
+
+// rateLimitBucketIdleTime is how long a shared bucket is kept after its last request. It
+// must be longer than the longest policy period, after which any bucket is full again.
+const rateLimitBucketIdleTime = time.Hour
+
+// initRateLimitStore picks where the gateway keeps its rate limit buckets with
+// RATE_LIMIT_STORE: "memory", the default, for a single gateway, or "database" to share
+// them between gateway replicas through the gateway database
+func initRateLimitStore(db *gorm.DB) customMiddleware.RateLimitStore {
+	switch name := strings.ToLower(utils.GetEnv("RATE_LIMIT_STORE", "memory")); name {
+	case "database":
+		rateLimitRepo := repositoryImpl.NewRateLimitRepository(db)
+		go startRateLimitBucketCleanup(rateLimitRepo, 10*time.Minute)
+		return rateLimitRepo
+	case "memory", "":
+	default:
+		fmt.Printf("Warning: Unknown rate limit store %q, using memory\n", name)
+	}
+	return customMiddleware.NewMemoryRateLimitStore()
+}
+
+// rateLimitMiddleware limits every route by RATE_LIMIT_DEFAULT (default "300/1m" per
+// user or IP address), with stricter buckets for logins (RATE_LIMIT_AUTH, "5/1m"),
+// creating donations and payments (RATE_LIMIT_DONATE, "10/1m") and the translation
+// proxy (RATE_LIMIT_TRANSLATE, "20/1m"). Health checks, metrics and payment provider
+// webhooks are not limited. RATE_LIMIT_API_KEYS lists the X-API-Key values of trusted
+// integrations, comma-separated.
+func rateLimitMiddleware(store customMiddleware.RateLimitStore, config *configs.Config) echo.MiddlewareFunc {
+	auth := getRateLimitPolicyEnv("RATE_LIMIT_AUTH", models.RateLimitPolicy{Name: "auth", Limit: 5, Period: time.Minute})
+	donate := getRateLimitPolicyEnv("RATE_LIMIT_DONATE", models.RateLimitPolicy{Name: "donate", Limit: 10, Period: time.Minute})
+	translate := getRateLimitPolicyEnv("RATE_LIMIT_TRANSLATE", models.RateLimitPolicy{Name: "translate", Limit: 20, Period: time.Minute})
+	unlimited := models.RateLimitPolicy{Name: "unlimited"}
+
+	var apiKeys []string
+	for _, key := range strings.Split(utils.GetEnv("RATE_LIMIT_API_KEYS", ""), ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			apiKeys = append(apiKeys, key)
+		}
+	}
+
+	return customMiddleware.RateLimitMiddleware(customMiddleware.RateLimitConfig{
+		Store:     store,
+		JWTSecret: config.Auth.JWTSecret,
+		APIKeys:   apiKeys,
+		Default:   getRateLimitPolicyEnv("RATE_LIMIT_DEFAULT", models.RateLimitPolicy{Name: "default", Limit: 300, Period: time.Minute}),
+		Routes: map[string]models.RateLimitPolicy{
+			"POST /api/auth/login":    auth,
+			"POST /api/auth/register": auth,
+			"POST /api/auth/google":   auth,
+
+			"POST /api/donations":                    donate,
+			"POST /api/qris/donate":                  donate,
+			"POST /api/split-donations":              donate,
+			"POST /api/qris/donations/:id/generate":  donate,
+			"POST /api/midtrans/payment/:donationId": donate,
+
+			"POST /api/language/translate":      translate,
+			"POST /api/language/translate/bulk": translate,
+			"POST /api/language/detect":         translate,
+
+			"GET /health":                unlimited,
+			"GET /ready":                 unlimited,
+			"GET /metrics":               unlimited,
+			"GET /api/health":            unlimited,
+			"GET /api/ready":             unlimited,
+			"POST /api/webhooks/paypal":  unlimited,
+			"POST /api/webhooks/stripe":  unlimited,
+			"POST /api/webhooks/crypto":  unlimited,
+			"POST /api/webhooks/qris":    unlimited,
+			"POST /api/midtrans/webhook": unlimited,
+		},
+	})
+}
+
+// getRateLimitPolicyEnv reads a policy's limit and period written as "limit/period",
+// e.g. "5/1m"; "0" turns the limit off
+func getRateLimitPolicyEnv(key string, defaultValue models.RateLimitPolicy) models.RateLimitPolicy {
+	if strings.TrimSpace(utils.GetEnv(key, "")) == "0" {
+		return models.RateLimitPolicy{Name: defaultValue.Name}
+	}
+	policy := defaultValue
+	if limit, period, ok := getCountPerDurationEnv(key); ok && period <= rateLimitBucketIdleTime {
+		policy.Limit = limit
+		policy.Period = period
+	}
+	return policy
+}
+
+// startRateLimitBucketCleanup periodically deletes shared buckets that have refilled
+func startRateLimitBucketCleanup(rateLimitRepo repository.RateLimitRepository, interval time.Duration) {
+	appLogger := logger.GetLogger()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		deleted, err := rateLimitRepo.DeleteIdle(time.Now().Add(-rateLimitBucketIdleTime))
+		if err != nil {
+			appLogger.Error(err, "Failed to delete idle rate limit buckets")
+			continue
+		}
+		if deleted > 0 {
+			appLogger.Info("Deleted idle rate limit buckets", "count", deleted)
+		}
+	}
+}
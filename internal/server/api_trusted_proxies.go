@@ -0,0 +1,20 @@
+package server
+
+import (
+	"fmt"
+
+	customMiddleware "github.com/rzfd/mediashar/internal/middleware"
+	"github.com/rzfd/mediashar/internal/utils"
+)
+
+// trustedProxiesFromEnv reads TRUSTED_PROXIES, the comma-separated addresses and CIDR
+// ranges of the load balancers and CDN edges in front of the gateway. Without any, the
+// gateway uses each connection's own address and ignores forwarding and CDN headers.
+func trustedProxiesFromEnv() customMiddleware.TrustedProxies {
+	proxies, err := customMiddleware.ParseTrustedProxies(utils.GetEnv("TRUSTED_PROXIES", ""))
+	if err != nil {
+		fmt.Printf("Warning: Ignoring TRUSTED_PROXIES: %v\n", err)
+		return nil
+	}
+	return proxies
+}
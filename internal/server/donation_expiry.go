@@ -0,0 +1,77 @@
+package server
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository/repositoryImpl"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/internal/service/serviceImpl"
+	"github.com/rzfd/mediashar/internal/utils"
+	"github.com/rzfd/mediashar/pkg/logger"
+)
+
+// defaultExpiryWindows match how long each provider keeps a payment open: a QRIS code
+// is valid for 15 minutes and a Midtrans Snap order for 24 hours
+var defaultExpiryWindows = map[models.PaymentProvider]time.Duration{
+	models.PaymentProviderQRIS:     15 * time.Minute,
+	models.PaymentProviderMidtrans: 24 * time.Hour,
+}
+
+// initDonationExpiryWorker fails pending donations once their payment deadline passes.
+// Windows are set per provider with DONATION_EXPIRY_WINDOW_<PROVIDER> (e.g.
+// DONATION_EXPIRY_WINDOW_QRIS=15m), with DONATION_EXPIRY_WINDOW for everything else.
+func initDonationExpiryWorker(db *gorm.DB, eventBus service.DonationEventBus) service.DonationExpiryService {
+	windows := service.DonationExpiryWindows{
+		Default:   getDurationEnv("DONATION_EXPIRY_WINDOW", 24*time.Hour),
+		Providers: map[models.PaymentProvider]time.Duration{},
+	}
+	providers := []models.PaymentProvider{
+		models.PaymentProviderQRIS,
+		models.PaymentProviderMidtrans,
+		models.PaymentProviderPaypal,
+		models.PaymentProviderStripe,
+		models.PaymentProviderCrypto,
+	}
+	for _, provider := range providers {
+		key := "DONATION_EXPIRY_WINDOW_" + strings.ToUpper(string(provider))
+		if window := getDurationEnv(key, defaultExpiryWindows[provider]); window > 0 {
+			windows.Providers[provider] = window
+		}
+	}
+
+	batchSize, err := strconv.Atoi(utils.GetEnv("DONATION_EXPIRY_BATCH_SIZE", "100"))
+	if err != nil || batchSize <= 0 {
+		batchSize = 100
+	}
+
+	donationRepo := repositoryImpl.NewDonationRepository(db)
+	expiryService := serviceImpl.NewDonationExpiryService(donationRepo, eventBus, windows, batchSize)
+
+	go startDonationExpiryWorker(expiryService, getDurationEnv("DONATION_EXPIRY_INTERVAL", time.Minute))
+
+	return expiryService
+}
+
+// startDonationExpiryWorker periodically expires stale pending donations
+func startDonationExpiryWorker(expiryService service.DonationExpiryService, interval time.Duration) {
+	appLogger := logger.GetLogger()
+	appLogger.Info("Starting donation expiry worker", "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		expired, err := expiryService.ExpirePendingDonations(time.Now())
+		if err != nil {
+			appLogger.Error(err, "Donation expiry run finished with errors")
+		}
+		if expired > 0 {
+			appLogger.Info("Expired stale pending donations", "count", expired)
+		}
+	}
+}
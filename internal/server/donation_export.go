@@ -0,0 +1,78 @@
+package server
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/repository/repositoryImpl"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/internal/service/serviceImpl"
+	"github.com/rzfd/mediashar/internal/utils"
+	"github.com/rzfd/mediashar/pkg/logger"
+)
+
+// exportPurgeInterval is how often expired export files are deleted
+const exportPurgeInterval = time.Hour
+
+// initDonationExportService creates the export service and starts the worker that
+// builds background exports. Ranges with more than DONATION_EXPORT_SYNC_MAX_ROWS
+// donations must be exported in the background; their files are kept for
+// DONATION_EXPORT_TTL.
+func initDonationExportService(db *gorm.DB) service.DonationExportService {
+	syncMaxRows, err := strconv.ParseInt(utils.GetEnv("DONATION_EXPORT_SYNC_MAX_ROWS", "5000"), 10, 64)
+	if err != nil || syncMaxRows <= 0 {
+		syncMaxRows = 5000
+	}
+	ttl := getDurationEnv("DONATION_EXPORT_TTL", 7*24*time.Hour)
+
+	currencyRepo := repositoryImpl.NewCurrencyRepository(db)
+	currencyService := service.NewCurrencyService(currencyRepo)
+	donationRepo := repositoryImpl.NewDonationRepository(db)
+	exportRepo := repositoryImpl.NewDonationExportRepository(db)
+
+	worker := serviceImpl.NewDonationExportWorker(donationRepo, exportRepo, currencyService, ttl)
+	go startDonationExportWorker(worker, getDurationEnv("DONATION_EXPORT_POLL_INTERVAL", 10*time.Second), getDurationEnv("DONATION_EXPORT_STALE_AFTER", 30*time.Minute))
+
+	return serviceImpl.NewDonationExportService(donationRepo, exportRepo, currencyService, syncMaxRows, ttl)
+}
+
+// startDonationExportWorker builds queued exports as they arrive, requeues exports
+// whose replica stopped mid-build, and deletes expired files
+func startDonationExportWorker(worker service.DonationExportWorker, interval, staleAfter time.Duration) {
+	appLogger := logger.GetLogger()
+	appLogger.Info("Starting donation export worker", "interval", interval.String())
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	lastPurge := time.Now()
+	for range ticker.C {
+		requeued, err := worker.RequeueStale(time.Now().Add(-staleAfter))
+		if err != nil {
+			appLogger.Error(err, "Failed to requeue stale donation exports")
+		} else if requeued > 0 {
+			appLogger.Info("Requeued stale donation exports", "count", requeued)
+		}
+
+		completed, err := worker.RunPendingExports(context.Background())
+		if err != nil {
+			appLogger.Error(err, "Donation export run finished with errors")
+		}
+		if completed > 0 {
+			appLogger.Info("Completed donation exports", "count", completed)
+		}
+
+		if time.Since(lastPurge) >= exportPurgeInterval {
+			lastPurge = time.Now()
+			purged, err := worker.PurgeExpired()
+			if err != nil {
+				appLogger.Error(err, "Failed to purge expired donation exports")
+			} else if purged > 0 {
+				appLogger.Info("Purged expired donation exports", "count", purged)
+			}
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository/repositoryImpl"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/internal/service/serviceImpl"
+	"github.com/rzfd/mediashar/internal/utils"
+	"github.com/rzfd/mediashar/pkg/logger"
+)
+
+// defaultProviderFees are the providers' published rates: 0.7% for QRIS and the card rate
+// of 2.9% plus Rp2,000 for Midtrans
+var defaultProviderFees = map[models.PaymentProvider]models.FeeSchedule{
+	models.PaymentProviderQRIS:     {ProviderBasisPoints: 70},
+	models.PaymentProviderMidtrans: {ProviderBasisPoints: 290, ProviderFixed: models.NewMoney(2000, models.CurrencyIDR)},
+}
+
+// initLedgerService posts completed donations, refunds and chargebacks to the ledger and
+// reconciles whatever it missed in the background, on start and then every
+// LEDGER_RECONCILE_INTERVAL (default 10m) until done is closed. Fees are set per provider with
+// LEDGER_PLATFORM_FEE_BPS_<PROVIDER>, LEDGER_PROVIDER_FEE_BPS_<PROVIDER> (basis points,
+// 250 = 2.5%) and LEDGER_PROVIDER_FEE_FIXED_<PROVIDER> (e.g. "2000 IDR"), with
+// LEDGER_PLATFORM_FEE_BPS as the platform fee of every provider without its own.
+func initLedgerService(db *gorm.DB, eventBus service.DonationEventBus, done <-chan struct{}) service.LedgerService {
+	platformFee := getBasisPointsEnv("LEDGER_PLATFORM_FEE_BPS", 500)
+	fees := service.LedgerFeeSchedules{
+		Default:   models.FeeSchedule{PlatformBasisPoints: platformFee},
+		Providers: map[models.PaymentProvider]models.FeeSchedule{},
+	}
+	providers := []models.PaymentProvider{
+		models.PaymentProviderQRIS,
+		models.PaymentProviderMidtrans,
+		models.PaymentProviderPaypal,
+		models.PaymentProviderStripe,
+		models.PaymentProviderCrypto,
+		models.PaymentProviderSponsor,
+	}
+	for _, provider := range providers {
+		suffix := "_" + strings.ToUpper(string(provider))
+		schedule := defaultProviderFees[provider]
+		schedule.PlatformBasisPoints = getBasisPointsEnv("LEDGER_PLATFORM_FEE_BPS"+suffix, platformFee)
+		schedule.ProviderBasisPoints = getBasisPointsEnv("LEDGER_PROVIDER_FEE_BPS"+suffix, schedule.ProviderBasisPoints)
+		schedule.ProviderFixed = getMoneyEnv("LEDGER_PROVIDER_FEE_FIXED"+suffix, schedule.ProviderFixed)
+		fees.Providers[provider] = schedule
+	}
+
+	ledgerRepo := repositoryImpl.NewLedgerRepository(db)
+	donationRepo := repositoryImpl.NewDonationRepository(db)
+	refundRepo := repositoryImpl.NewRefundRepository(db)
+	ledgerPoster := serviceImpl.NewLedgerPoster(ledgerRepo, donationRepo, refundRepo, fees, eventBus, 500)
+
+	go startLedgerReconciliation(ledgerPoster, getDurationEnv("LEDGER_RECONCILE_INTERVAL", 10*time.Minute), done)
+
+	return serviceImpl.NewLedgerService(ledgerRepo)
+}
+
+// startLedgerReconciliation keeps the ledger in line with the donations and refunds whose
+// events a listener dropped or failed to post while the service was running
+func startLedgerReconciliation(ledgerPoster service.LedgerPoster, interval time.Duration, done <-chan struct{}) {
+	runLedgerReconciliation(ledgerPoster)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			runLedgerReconciliation(ledgerPoster)
+		case <-done:
+			return
+		}
+	}
+}
+
+// runLedgerReconciliation posts donations and refunds made before the ledger existed or
+// whose events were lost
+func runLedgerReconciliation(ledgerPoster service.LedgerPoster) {
+	posted, err := ledgerPoster.ReconcileLedger()
+	if err != nil {
+		logger.GetLogger().Error(err, "Ledger reconciliation finished with errors")
+	}
+	if posted > 0 {
+		logger.GetLogger().Info("Posted missing ledger entries", "count", posted)
+	}
+}
+
+// getBasisPointsEnv reads a fee in basis points, from 0 to 10000 (100%)
+func getBasisPointsEnv(key string, defaultValue int64) int64 {
+	bps, err := strconv.ParseInt(utils.GetEnv(key, ""), 10, 64)
+	if err != nil || bps < 0 || bps > 10000 {
+		return defaultValue
+	}
+	return bps
+}
+
+// getMoneyEnv reads a non-negative amount written with its currency, e.g. "0.30 USD"
+func getMoneyEnv(key string, defaultValue models.Money) models.Money {
+	amount, currency, ok := strings.Cut(strings.TrimSpace(utils.GetEnv(key, "")), " ")
+	if !ok {
+		return defaultValue
+	}
+	fee, err := models.ParseMoney(amount, models.SupportedCurrency(strings.ToUpper(strings.TrimSpace(currency))))
+	if err != nil || fee.Minor < 0 || service.ValidateCurrency(fee.Currency) != nil {
+		return defaultValue
+	}
+	return fee
+}
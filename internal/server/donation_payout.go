@@ -0,0 +1,35 @@
+package server
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository/repositoryImpl"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/internal/service/serviceImpl"
+	"github.com/rzfd/mediashar/internal/utils"
+)
+
+// initPayoutService lets streamers withdraw their ledger balance. PAYOUT_MINIMUM sets the
+// smallest withdrawal and its currency (default "50000 IDR"). PAYOUT_DISBURSEMENT_PROVIDER
+// picks who sends approved payouts: "fake" pays them instantly without moving money, for
+// development; "manual", the default, leaves the transfer to an admin, who then marks
+// the payout paid or failed.
+func initPayoutService(db *gorm.DB) service.PayoutService {
+	minimum := getMoneyEnv("PAYOUT_MINIMUM", models.NewMoney(50000, models.CurrencyIDR))
+
+	var provider service.DisbursementProvider
+	switch name := strings.ToLower(utils.GetEnv("PAYOUT_DISBURSEMENT_PROVIDER", "manual")); name {
+	case "fake":
+		provider = serviceImpl.NewFakeDisbursementProvider()
+	case "manual", "":
+	default:
+		fmt.Printf("Warning: Unknown disbursement provider %q, payouts will be sent manually\n", name)
+	}
+
+	payoutRepo := repositoryImpl.NewPayoutRepository(db)
+	return serviceImpl.NewPayoutService(payoutRepo, provider, minimum)
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository/repositoryImpl"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/internal/service/serviceImpl"
+	"github.com/rzfd/mediashar/internal/utils"
+	"github.com/rzfd/mediashar/pkg/logger"
+)
+
+// startDonationRateBackfill snapshots donations without an exchange-rate snapshot at
+// today's rates, on start and then every DONATION_RATE_BACKFILL_INTERVAL until done is
+// closed. Donations go
+// into their streamer's currency; DONATION_RATE_BACKFILL_CURRENCY (default IDR, the
+// default primary currency) covers streamers with no snapshot to take it from.
+func startDonationRateBackfill(db *gorm.DB, done <-chan struct{}) {
+	currencyService := service.NewCurrencyService(repositoryImpl.NewCurrencyRepository(db))
+	backfill := serviceImpl.NewDonationRateBackfill(repositoryImpl.NewDonationRepository(db), currencyService, 500)
+	fallback := models.SupportedCurrency(utils.GetEnv("DONATION_RATE_BACKFILL_CURRENCY", string(models.CurrencyIDR)))
+
+	runDonationRateBackfill(backfill, fallback)
+
+	ticker := time.NewTicker(getDurationEnv("DONATION_RATE_BACKFILL_INTERVAL", 10*time.Minute))
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			runDonationRateBackfill(backfill, fallback)
+		case <-done:
+			return
+		}
+	}
+}
+
+func runDonationRateBackfill(backfill service.DonationRateBackfill, fallback models.SupportedCurrency) {
+	stored, err := backfill.BackfillRateSnapshots(fallback)
+	if err != nil {
+		logger.GetLogger().Error(err, "Donation rate snapshot backfill finished with errors")
+	}
+	if stored > 0 {
+		logger.GetLogger().Info("Backfilled donation rate snapshots", "count", stored)
+	}
+}
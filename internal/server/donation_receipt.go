@@ -0,0 +1,38 @@
+package server
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/internal/service/serviceImpl"
+	"github.com/rzfd/mediashar/internal/utils"
+)
+
+// initReceiptService issues donation receipts whose verification codes are signed with
+// RECEIPT_SIGNING_KEY. Without one, a key is derived from the JWT secret, so rotating
+// that secret also invalidates every receipt's code.
+func initReceiptService(donationService service.DonationService, userService service.UserService, languageService service.LanguageService, languageRepo repository.LanguageRepository, currencyService service.CurrencyService, jwtSecret string) service.ReceiptService {
+	signingKey := []byte(utils.GetEnv("RECEIPT_SIGNING_KEY", ""))
+	if len(signingKey) == 0 {
+		fmt.Printf("Warning: RECEIPT_SIGNING_KEY is not set, deriving receipt codes from the JWT secret\n")
+		mac := hmac.New(sha256.New, []byte(jwtSecret))
+		mac.Write([]byte("donation-receipt"))
+		signingKey = mac.Sum(nil)
+	}
+
+	return serviceImpl.NewReceiptService(donationService, userService, languageService, languageRepo, currencyService, signingKey)
+}
+
+// receiptPublicBaseURL is the gateway's public address that receipts link their
+// verification page to, from PUBLIC_BASE_URL. Request headers are not used because
+// clients choose them.
+func receiptPublicBaseURL() string {
+	baseURL := utils.GetEnv("PUBLIC_BASE_URL", "")
+	if baseURL == "" {
+		fmt.Printf("Warning: PUBLIC_BASE_URL is not set, receipts will not link to their verification page\n")
+	}
+	return baseURL
+}
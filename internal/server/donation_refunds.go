@@ -0,0 +1,32 @@
+package server
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/logger"
+)
+
+// startRefundReconciliation periodically finishes refunds left pending, e.g. because the
+// provider refunded but recording it failed, until done is closed
+func startRefundReconciliation(refundService service.RefundService, interval time.Duration, done <-chan struct{}) {
+	appLogger := logger.GetLogger()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			reconciled, err := refundService.ReconcilePendingRefunds(time.Now())
+			if err != nil {
+				appLogger.Error(err, "Refund reconciliation finished with errors")
+			}
+			if reconciled > 0 {
+				appLogger.Info("Reconciled pending refunds", "count", reconciled)
+			}
+		case <-done:
+			return
+		}
+	}
+}
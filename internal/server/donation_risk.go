@@ -0,0 +1,88 @@
+package server
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository/repositoryImpl"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/internal/service/serviceImpl"
+	"github.com/rzfd/mediashar/internal/utils"
+)
+
+// initRiskEngine screens donation requests from the gateway before they become donations.
+// Each velocity rule is configured as "max/window", e.g. RISK_IP_VELOCITY=10/10m, and
+// scored by its _POINTS variable; 0 points turns a rule off. RISK_TINY_AMOUNT_<CURRENCY>
+// sets what counts as tiny, e.g. RISK_TINY_AMOUNT_USD="1.00 USD". Requests scoring
+// RISK_CHALLENGE_SCORE need a signed-in donor and those scoring RISK_BLOCK_SCORE are
+// refused.
+func initRiskEngine(db *gorm.DB) service.RiskEngine {
+	defaults := serviceImpl.DefaultRiskRules
+	rules := service.RiskRules{
+		DonorVelocity:         getRiskVelocityEnv("RISK_DONOR_VELOCITY", defaults.DonorVelocity),
+		IPVelocity:            getRiskVelocityEnv("RISK_IP_VELOCITY", defaults.IPVelocity),
+		StreamerVelocity:      getRiskVelocityEnv("RISK_STREAMER_VELOCITY", defaults.StreamerVelocity),
+		TinyAmounts:           getRiskVelocityEnv("RISK_TINY_AMOUNTS", defaults.TinyAmounts),
+		TinyAmountThresholds:  map[models.SupportedCurrency]int64{},
+		CountryMismatchPoints: getNonNegativeIntEnv("RISK_COUNTRY_MISMATCH_POINTS", defaults.CountryMismatchPoints),
+		ChallengeScore:        getNonNegativeIntEnv("RISK_CHALLENGE_SCORE", defaults.ChallengeScore),
+		BlockScore:            getNonNegativeIntEnv("RISK_BLOCK_SCORE", defaults.BlockScore),
+	}
+	for currency, threshold := range defaults.TinyAmountThresholds {
+		amount := getMoneyEnv("RISK_TINY_AMOUNT_"+string(currency), models.NewMoney(threshold, currency))
+		if amount.Currency == currency {
+			rules.TinyAmountThresholds[currency] = amount.Minor
+		} else {
+			rules.TinyAmountThresholds[currency] = threshold
+		}
+	}
+
+	return serviceImpl.NewRiskEngine(repositoryImpl.NewRiskRepository(db), rules)
+}
+
+// initRiskReviewService lets admins look through screened donation requests
+func initRiskReviewService(db *gorm.DB) service.RiskReviewService {
+	return serviceImpl.NewRiskReviewService(repositoryImpl.NewRiskRepository(db))
+}
+
+// getRiskVelocityEnv reads a velocity rule written as "max/window", e.g. "5/10m", with its
+// points in key_POINTS
+func getRiskVelocityEnv(key string, defaultValue service.RiskVelocityRule) service.RiskVelocityRule {
+	rule := defaultValue
+	rule.Points = getNonNegativeIntEnv(key+"_POINTS", defaultValue.Points)
+	if max, window, ok := getCountPerDurationEnv(key); ok {
+		rule.Max = max
+		rule.Window = window
+	}
+	return rule
+}
+
+// getCountPerDurationEnv reads a positive count per duration written as "count/duration",
+// e.g. "5/10m"
+func getCountPerDurationEnv(key string) (int, time.Duration, bool) {
+	count, window, ok := strings.Cut(strings.TrimSpace(utils.GetEnv(key, "")), "/")
+	if !ok {
+		return 0, 0, false
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(count))
+	if err != nil || n <= 0 {
+		return 0, 0, false
+	}
+	duration, err := time.ParseDuration(strings.TrimSpace(window))
+	if err != nil || duration <= 0 {
+		return 0, 0, false
+	}
+	return n, duration, true
+}
+
+func getNonNegativeIntEnv(key string, defaultValue int) int {
+	value, err := strconv.Atoi(utils.GetEnv(key, ""))
+	if err != nil || value < 0 {
+		return defaultValue
+	}
+	return value
+}
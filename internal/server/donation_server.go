@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"time"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -17,6 +18,7 @@ import (
 	"github.com/rzfd/mediashar/internal/service/serviceImpl"
 	"github.com/rzfd/mediashar/internal/utils"
 	grpcServer "github.com/rzfd/mediashar/internal/grpc"
+	"github.com/rzfd/mediashar/migrations"
 	"github.com/rzfd/mediashar/pkg/metrics"
 	"github.com/rzfd/mediashar/pkg/pb"
 )
@@ -25,6 +27,7 @@ type DonationServer struct {
 	server  *grpc.Server
 	service service.DonationService
 	port    string
+	done    chan struct{} // Closed on Stop to end the background jobs
 }
 
 func NewDonationServer(config *configs.Config) (*DonationServer, error) {
@@ -34,16 +37,63 @@ func NewDonationServer(config *configs.Config) (*DonationServer, error) {
 		return nil, fmt.Errorf("failed to initialize database: %w", err)
 	}
 
+	// Real-time donation events shared by the service and the streaming RPC
+	eventBus := serviceImpl.NewDonationEventBus(100)
+	done := make(chan struct{})
+
 	// Initialize services
-	donationService := initDonationServices(db)
+	donationService := initDonationServices(db, eventBus)
+	riskReviewService := initRiskReviewService(db)
+	goalService := initDonationGoalService(db, eventBus)
+	refundService := initRefundService(db, config, eventBus, done)
+	idempotencyService := initIdempotencyService(db)
+	leaderboardService := initLeaderboardService(db)
+	moderationService := initModerationService(db, eventBus)
+
+	// Post completed donations, refunds and chargebacks to the streamer balance ledger
+	ledgerService := initLedgerService(db, eventBus, done)
+
+	// Withdrawals of those balances, approved by admins
+	payoutService := initPayoutService(db)
+
+	// Sponsors match completed donations with donations of their own
+	matchCampaignService := initMatchCampaignService(db, eventBus)
+
+	// Fail donations nobody paid for once their provider's payment window closes
+	initDonationExpiryWorker(db, eventBus)
+
+	// Streams small exports directly and builds large ones in the background
+	exportService := initDonationExportService(db)
+
+	// Give donations made before rate snapshots existed, or while rates were unavailable,
+	// one, so totals include them at a fixed rate
+	go startDonationRateBackfill(db, done)
 
 	// Create gRPC server
 	grpcSrv := grpc.NewServer()
 	
 	// Register donation service
-	donationGRPCServer := grpcServer.NewDonationGRPCServer(donationService)
+	donationGRPCServer := grpcServer.NewDonationGRPCServer(donationService, eventBus, refundService, idempotencyService, leaderboardService, exportService)
 	pb.RegisterDonationServiceServer(grpcSrv, donationGRPCServer)
 
+	// Register donation goal service
+	pb.RegisterDonationGoalServiceServer(grpcSrv, grpcServer.NewDonationGoalGRPCServer(goalService))
+
+	// Register moderation service
+	pb.RegisterModerationServiceServer(grpcSrv, grpcServer.NewModerationGRPCServer(moderationService))
+
+	// Register ledger service
+	pb.RegisterLedgerServiceServer(grpcSrv, grpcServer.NewLedgerGRPCServer(ledgerService))
+
+	// Register payout service
+	pb.RegisterPayoutServiceServer(grpcSrv, grpcServer.NewPayoutGRPCServer(payoutService))
+
+	// Register risk review service
+	pb.RegisterRiskServiceServer(grpcSrv, grpcServer.NewRiskGRPCServer(riskReviewService))
+
+	// Register match campaign service
+	pb.RegisterMatchCampaignServiceServer(grpcSrv, grpcServer.NewMatchCampaignGRPCServer(matchCampaignService))
+
 	// Enable reflection for development
 	reflection.Register(grpcSrv)
 
@@ -51,6 +101,7 @@ func NewDonationServer(config *configs.Config) (*DonationServer, error) {
 		server:  grpcSrv,
 		service: donationService,
 		port:    utils.GetEnv("GRPC_PORT", "9091"),
+		done:    done,
 	}, nil
 }
 
@@ -67,6 +118,7 @@ func (ds *DonationServer) Start() error {
 }
 
 func (s *DonationServer) Stop() {
+	close(s.done)
 	s.server.GracefulStop()
 }
 
@@ -110,7 +162,7 @@ func initDonationDatabase(config *configs.Config) (*gorm.DB, error) {
 	return db, nil
 }
 
-func initDonationServices(db *gorm.DB) service.DonationService {
+func initDonationServices(db *gorm.DB, eventBus service.DonationEventBus) service.DonationService {
 	// Initialize repositories
 	donationRepo := repositoryImpl.NewDonationRepository(db)
 	userRepo := repositoryImpl.NewUserRepository(db)
@@ -123,14 +175,114 @@ func initDonationServices(db *gorm.DB) service.DonationService {
 	// Initialize user aggregator service
 	userAggregator := service.NewUserAggregatorService(userCacheRepo, userClient)
 	
+	// Screen donation messages against streamer and global moderation rules
+	moderator := serviceImpl.NewMessageModerator(repositoryImpl.NewModerationRepository(db))
+
+	// Snapshot each new donation's exchange rate into the streamer's currency
+	currencyService := service.NewCurrencyService(repositoryImpl.NewCurrencyRepository(db))
+
+	// Score requests from the gateway for card testing and other abuse
+	riskEngine := initRiskEngine(db)
+
+	// Translate messages into the streamer's language once donations are created, caching
+	// translations in the donation database
+	translator := initMessageTranslator(service.NewLanguageService(repositoryImpl.NewLanguageRepository(db)))
+
 	// Initialize donation service
-	return serviceImpl.NewDonationServiceWithUserAggregator(donationRepo, userRepo, userAggregator)
+	return serviceImpl.NewDonationServiceWithUserAggregator(donationRepo, userRepo, userAggregator, eventBus, moderator, currencyService, riskEngine, translator)
+}
+
+// initDonationGoalService wires goal tracking to the event bus so completed
+// donations advance goal progress as soon as they are published
+func initDonationGoalService(db *gorm.DB, eventBus service.DonationEventBus) service.DonationGoalService {
+	currencyRepo := repositoryImpl.NewCurrencyRepository(db)
+	currencyService := service.NewCurrencyService(currencyRepo)
+	goalRepo := repositoryImpl.NewDonationGoalRepository(db)
+
+	return serviceImpl.NewDonationGoalService(goalRepo, currencyService, eventBus)
+}
+
+// initMatchCampaignService listens for completed donations to match. Donations in
+// another currency than a campaign's are converted with their rate snapshot, or the
+// cached exchange rates.
+func initMatchCampaignService(db *gorm.DB, eventBus service.DonationEventBus) service.MatchCampaignService {
+	currencyService := service.NewCurrencyService(repositoryImpl.NewCurrencyRepository(db))
+	campaignRepo := repositoryImpl.NewMatchCampaignRepository(db)
+
+	return serviceImpl.NewMatchCampaignService(campaignRepo, currencyService, eventBus)
+}
+
+// initLeaderboardService ranks donors with totals converted through the cached exchange rates
+func initLeaderboardService(db *gorm.DB) service.LeaderboardService {
+	currencyRepo := repositoryImpl.NewCurrencyRepository(db)
+	currencyService := service.NewCurrencyService(currencyRepo)
+	donationRepo := repositoryImpl.NewDonationRepository(db)
+
+	return serviceImpl.NewLeaderboardService(donationRepo, currencyService)
+}
+
+// initModerationService manages moderation settings and the held message review queue
+func initModerationService(db *gorm.DB, eventBus service.DonationEventBus) service.ModerationService {
+	moderationRepo := repositoryImpl.NewModerationRepository(db)
+	donationRepo := repositoryImpl.NewDonationRepository(db)
+
+	return serviceImpl.NewModerationService(moderationRepo, donationRepo, eventBus)
+}
+
+// initRefundService routes refunds to the processor of each donation's payment provider
+// and reconciles refunds left pending every REFUND_RECONCILE_INTERVAL (default 5m)
+func initRefundService(db *gorm.DB, config *configs.Config, eventBus service.DonationEventBus, done <-chan struct{}) service.RefundService {
+	processors := map[models.PaymentProvider]service.PaymentProcessor{}
+	if config.Midtrans.ServerKey != "" {
+		processors[models.PaymentProviderMidtrans] = serviceImpl.NewMidtransPaymentProcessor(config)
+	}
+
+	donationRepo := repositoryImpl.NewDonationRepository(db)
+	refundRepo := repositoryImpl.NewRefundRepository(db)
+
+	refundService := serviceImpl.NewRefundService(refundRepo, donationRepo, processors, eventBus)
+	go startRefundReconciliation(refundService, getDurationEnv("REFUND_RECONCILE_INTERVAL", 5*time.Minute), done)
+
+	return refundService
 }
 
 func migrateDonationTables(db *gorm.DB) error {
-	return db.AutoMigrate(
+	// Money columns are rescaled to minor units before AutoMigrate retypes them
+	if err := db.Exec(migrations.AmountsToMinorUnits).Error; err != nil {
+		return fmt.Errorf("failed to convert amounts to minor units: %w", err)
+	}
+
+	err := db.AutoMigrate(
 		&models.User{},
 		&models.UserCache{},
 		&models.Donation{},
+		&models.CurrencyRate{},
+		&models.DonationGoal{},
+		&models.DonationGoalContribution{},
+		&models.DonationRefund{},
+		&models.DonationStatusHistory{},
+		&models.IdempotencyRecord{},
+		&models.ModerationSettings{},
+		&models.BlockedTerm{},
+		&models.MessageReview{},
+		&models.DonationExport{},
+		&models.DonationExportChunk{},
+		&models.LedgerEntry{},
+		&models.LedgerLine{},
+		&models.PayoutAccount{},
+		&models.Payout{},
+		&models.RiskAssessment{},
+		&models.LanguageConfig{},
+		&models.MatchCampaign{},
+		&models.DonationMatch{},
 	)
+	if err != nil {
+		return err
+	}
+
+	// The ledger is append-only, enforced by the database itself
+	if err := db.Exec(migrations.LedgerImmutable).Error; err != nil {
+		return fmt.Errorf("failed to protect ledger tables: %w", err)
+	}
+	return nil
 } 
\ No newline at end of file
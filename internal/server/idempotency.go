@@ -0,0 +1,42 @@
+package server
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/repository/repositoryImpl"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/internal/service/serviceImpl"
+	"github.com/rzfd/mediashar/pkg/logger"
+)
+
+// initIdempotencyService stores idempotency keys in the given service database and
+// purges them in the background once IDEMPOTENCY_KEY_TTL has passed
+func initIdempotencyService(db *gorm.DB) service.IdempotencyService {
+	idempotencyRepo := repositoryImpl.NewIdempotencyRepository(db)
+	idempotencyService := serviceImpl.NewIdempotencyService(idempotencyRepo, getDurationEnv("IDEMPOTENCY_KEY_TTL", 24*time.Hour))
+
+	go startIdempotencyKeyCleanup(idempotencyService, getDurationEnv("IDEMPOTENCY_KEY_CLEANUP_INTERVAL", time.Hour))
+
+	return idempotencyService
+}
+
+// startIdempotencyKeyCleanup periodically deletes expired idempotency keys
+func startIdempotencyKeyCleanup(idempotencyService service.IdempotencyService, interval time.Duration) {
+	appLogger := logger.GetLogger()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		purged, err := idempotencyService.PurgeExpired()
+		if err != nil {
+			appLogger.Error(err, "Failed to purge expired idempotency keys")
+			continue
+		}
+		if purged > 0 {
+			appLogger.Info("Purged expired idempotency keys", "count", purged)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package server
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/internal/service/serviceImpl"
+	"github.com/rzfd/mediashar/internal/utils"
+)
+
+// initMessageTranslator translates donors' messages into streamers' languages through the
+// language service. MESSAGE_TRANSLATION_ENABLED=false turns translation off;
+// MESSAGE_TRANSLATION_TIMEOUT (default 10s) bounds each message.
+func initMessageTranslator(languageService service.LanguageService) service.MessageTranslator {
+	if enabled, err := strconv.ParseBool(utils.GetEnv("MESSAGE_TRANSLATION_ENABLED", "true")); err == nil && !enabled {
+		return nil
+	}
+	return serviceImpl.NewMessageTranslator(languageService, getDurationEnv("MESSAGE_TRANSLATION_TIMEOUT", 10*time.Second))
+}
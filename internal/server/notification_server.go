@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 
 	grpcServer "github.com/rzfd/mediashar/internal/grpc"
@@ -16,13 +17,20 @@ import (
 
 type NotificationServer struct {
 	server  *grpc.Server
-	service *grpcServer.MockNotificationService
+	service grpcServer.NotificationService
 	port    string
 }
 
 func NewNotificationServer() (*NotificationServer, error) {
+	// Donation events are relayed from the donation service
+	donationURL := utils.GetEnv("DONATION_SERVICE_URL", "localhost:9091")
+	donationConn, err := grpc.Dial(donationURL, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to donation service: %w", err)
+	}
+
 	// Initialize notification service
-	notificationService := grpcServer.NewMockNotificationService()
+	notificationService := grpcServer.NewDonationEventRelayService(pb.NewDonationServiceClient(donationConn))
 
 	// Create gRPC server
 	grpcSrv := grpc.NewServer()
@@ -17,6 +17,7 @@ import (
 	"github.com/rzfd/mediashar/internal/service/serviceImpl"
 	"github.com/rzfd/mediashar/internal/utils"
 	grpcServer "github.com/rzfd/mediashar/internal/grpc"
+	"github.com/rzfd/mediashar/migrations"
 	"github.com/rzfd/mediashar/pkg/metrics"
 	"github.com/rzfd/mediashar/pkg/pb"
 )
@@ -41,7 +42,7 @@ func NewPaymentServer(config *configs.Config) (*PaymentServer, error) {
 	grpcSrv := grpc.NewServer()
 	
 	// Register payment service
-	paymentGRPCServer := grpcServer.NewPaymentGRPCServer(paymentService)
+	paymentGRPCServer := grpcServer.NewPaymentGRPCServer(paymentService, initIdempotencyService(db))
 	pb.RegisterPaymentServiceServer(grpcSrv, paymentGRPCServer)
 
 	// Enable reflection for development
@@ -120,8 +121,14 @@ func initPaymentServices(db *gorm.DB, config *configs.Config) service.PaymentSer
 }
 
 func migratePaymentTables(db *gorm.DB) error {
+	// Money columns are rescaled to minor units before AutoMigrate retypes them
+	if err := db.Exec(migrations.AmountsToMinorUnits).Error; err != nil {
+		return fmt.Errorf("failed to convert amounts to minor units: %w", err)
+	}
+
 	return db.AutoMigrate(
 		&models.Donation{},
 		&models.User{},
+		&models.IdempotencyRecord{},
 	)
 } 
\ No newline at end of file
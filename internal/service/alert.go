@@ -0,0 +1,37 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// ErrAlertRuleNotFound is returned for a rule that does not exist or belongs to another streamer
+var ErrAlertRuleNotFound = errors.New("alert rule not found")
+
+// TestAlertRequest describes the made-up donation of a test alert. Amount is in minor
+// units of Currency. When RuleID is set that rule renders the alert, even if it is
+// inactive, and the amount and currency default to the rule's minimum.
+type TestAlertRequest struct {
+	RuleID      uint   `json:"rule_id"`
+	DisplayName string `json:"display_name"`
+	Amount      int64  `json:"amount"`
+	Currency    string `json:"currency"`
+	Message     string `json:"message"`
+}
+
+// AlertService manages streamers' alert rules and renders donation alerts with them
+type AlertService interface {
+	CreateRule(rule *models.AlertRule) error
+	UpdateRule(rule *models.AlertRule) error
+	DeleteRule(streamerID, ruleID uint) error
+	GetRule(streamerID, ruleID uint) (*models.AlertRule, error)
+	GetRulesByStreamer(streamerID uint) ([]*models.AlertRule, error)
+
+	// RenderAlert builds the on-stream alert of a donation with the streamer's matching
+	// rule: the one with the highest minimum in the donation's currency, else in the
+	// currency of its exchange-rate snapshot. Without a match the default alert is used.
+	RenderAlert(donation *models.Donation) *models.OverlayAlert
+	// RenderTestAlert builds an alert for a made-up donation to the streamer
+	RenderTestAlert(streamerID uint, req *TestAlertRequest) (*models.OverlayAlert, error)
+}
@@ -15,10 +15,13 @@ import (
 type CurrencyService interface {
 	// Exchange rate operations
 	GetExchangeRate(ctx context.Context, from, to models.SupportedCurrency) (float64, error)
+	GetExchangeRateQuote(ctx context.Context, from, to models.SupportedCurrency) (*models.ExchangeRateQuote, error)
 	UpdateExchangeRates(ctx context.Context) error
 	
 	// Currency conversion
 	ConvertAmount(ctx context.Context, amount float64, from, to models.SupportedCurrency) (float64, error)
+	// ConvertMoney converts an exact amount, rounding to the nearest minor unit of to
+	ConvertMoney(ctx context.Context, amount models.Money, to models.SupportedCurrency) (models.Money, error)
 	
 	// Currency information
 	GetSupportedCurrencies(ctx context.Context) ([]models.SupportedCurrency, error)
@@ -60,15 +63,7 @@ type CurrencySeparator struct {
 // GetDefaultCurrencyFormatter returns a default currency formatter
 func GetDefaultCurrencyFormatter() *CurrencyFormatter {
 	return &CurrencyFormatter{
-		DecimalPlaces: map[models.SupportedCurrency]int{
-			models.CurrencyIDR: 0, // Indonesian Rupiah - no decimals
-			models.CurrencyUSD: 2, // US Dollar - 2 decimals
-			models.CurrencyCNY: 2, // Chinese Yuan - 2 decimals
-			models.CurrencyEUR: 2, // Euro - 2 decimals
-			models.CurrencyJPY: 0, // Japanese Yen - no decimals
-			models.CurrencySGD: 2, // Singapore Dollar - 2 decimals
-			models.CurrencyMYR: 2, // Malaysian Ringgit - 2 decimals
-		},
+		DecimalPlaces: models.CurrencyDecimalPlaces(),
 		Separators: map[models.SupportedCurrency]CurrencySeparator{
 			models.CurrencyIDR: {Decimal: ",", Thousand: "."},  // Indonesian format
 			models.CurrencyUSD: {Decimal: ".", Thousand: ","},  // US format
@@ -174,6 +169,9 @@ type LatestRatesResponse struct {
 	ConversionRates    map[string]float64           `json:"conversion_rates"`
 }
 
+// exchangeRateAPISource is the source recorded for rates fetched from exchangerate-api.com
+const exchangeRateAPISource = "exchangerate-api"
+
 // CurrencyServiceImpl implements CurrencyService interface
 type CurrencyServiceImpl struct {
 	currencyRepo repository.CurrencyRepository
@@ -194,9 +192,28 @@ func NewCurrencyService(currencyRepo repository.CurrencyRepository) CurrencyServ
 
 // GetExchangeRate gets real-time exchange rate from external API
 func (s *CurrencyServiceImpl) GetExchangeRate(ctx context.Context, from, to models.SupportedCurrency) (float64, error) {
+	quote, err := s.GetExchangeRateQuote(ctx, from, to)
+	if err != nil {
+		return 0, err
+	}
+	return quote.Rate, nil
+}
+
+// GetExchangeRateQuote gets the exchange rate along with its source and when it was retrieved
+func (s *CurrencyServiceImpl) GetExchangeRateQuote(ctx context.Context, from, to models.SupportedCurrency) (*models.ExchangeRateQuote, error) {
+	if from == to {
+		return &models.ExchangeRateQuote{
+			From:        from,
+			To:          to,
+			Rate:        1,
+			Source:      models.RateSourceIdentity,
+			RetrievedAt: time.Now(),
+		}, nil
+	}
+
 	// Try to get from cache/database first
-	if rate, err := s.currencyRepo.GetExchangeRate(ctx, from, to); err == nil && rate > 0 {
-		return rate, nil
+	if quote, err := s.currencyRepo.GetExchangeRateQuote(ctx, from, to); err == nil && quote.Rate > 0 {
+		return quote, nil
 	}
 
 	// Fetch from external API
@@ -204,32 +221,38 @@ func (s *CurrencyServiceImpl) GetExchangeRate(ctx context.Context, from, to mode
 	
 	resp, err := s.httpClient.Get(url)
 	if err != nil {
-		return 0, fmt.Errorf("failed to fetch exchange rate: %w", err)
+		return nil, fmt.Errorf("failed to fetch exchange rate: %w", err)
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return 0, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+		return nil, fmt.Errorf("API request failed with status: %d", resp.StatusCode)
 	}
 
 	var apiResp LatestRatesResponse
 	if err := json.NewDecoder(resp.Body).Decode(&apiResp); err != nil {
-		return 0, fmt.Errorf("failed to decode API response: %w", err)
+		return nil, fmt.Errorf("failed to decode API response: %w", err)
 	}
 
 	if apiResp.Result != "success" {
-		return 0, fmt.Errorf("API returned error result")
+		return nil, fmt.Errorf("API returned error result")
 	}
 
 	rate, exists := apiResp.ConversionRates[string(to)]
 	if !exists {
-		return 0, fmt.Errorf("currency %s not found in rates", to)
+		return nil, fmt.Errorf("currency %s not found in rates", to)
 	}
 
 	// Cache the rate in database
 	go s.cacheExchangeRate(ctx, from, to, rate)
 
-	return rate, nil
+	return &models.ExchangeRateQuote{
+		From:        from,
+		To:          to,
+		Rate:        rate,
+		Source:      exchangeRateAPISource,
+		RetrievedAt: time.Now(),
+	}, nil
 }
 
 // ConvertAmount converts amount from one currency to another
@@ -246,6 +269,20 @@ func (s *CurrencyServiceImpl) ConvertAmount(ctx context.Context, amount float64,
 	return amount * rate, nil
 }
 
+// ConvertMoney converts an exact amount into another currency at the current rate
+func (s *CurrencyServiceImpl) ConvertMoney(ctx context.Context, amount models.Money, to models.SupportedCurrency) (models.Money, error) {
+	if amount.Currency == to {
+		return amount, nil
+	}
+
+	rate, err := s.GetExchangeRate(ctx, amount.Currency, to)
+	if err != nil {
+		return models.Money{}, err
+	}
+
+	return amount.Convert(rate, to), nil
+}
+
 // FormatCurrency formats amount according to currency rules
 func (s *CurrencyServiceImpl) FormatCurrency(amount float64, currency models.SupportedCurrency) string {
 	symbol := currency.GetSymbol()
@@ -302,7 +339,7 @@ func (s *CurrencyServiceImpl) UpdateExchangeRates(ctx context.Context) error {
 			FromCurrency: baseCurrency,
 			ToCurrency:   targetCurrency,
 			Rate:         rate,
-			Source:       "exchangerate-api",
+			Source:       exchangeRateAPISource,
 			IsActive:     true,
 			LastUpdated:  time.Now().Unix(),
 		})
@@ -320,7 +357,7 @@ func (s *CurrencyServiceImpl) cacheExchangeRate(ctx context.Context, from, to mo
 		FromCurrency: from,
 		ToCurrency:   to,
 		Rate:         rate,
-		Source:       "exchangerate-api",
+		Source:       exchangeRateAPISource,
 		IsActive:     true,
 		LastUpdated:  time.Now().Unix(),
 	}
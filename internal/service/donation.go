@@ -1,27 +1,93 @@
 package service
 
-import "github.com/rzfd/mediashar/internal/models"
+import (
+	"errors"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
 
 type CreateDonationRequest struct {
-	Amount      float64 `json:"amount"`
-	Currency    string  `json:"currency"`
-	Message     string  `json:"message"`
-	StreamerID  uint    `json:"streamer_id"`
-	DonatorID   *uint   `json:"donator_id,omitempty"`
-	DisplayName string  `json:"display_name"`
-	IsAnonymous bool    `json:"is_anonymous"`
+	Amount      int64  `json:"amount"` // Minor units of Currency
+	Currency    string `json:"currency"`
+	Message     string `json:"message"`
+	StreamerID  uint   `json:"streamer_id"`
+	DonatorID   *uint  `json:"donator_id,omitempty"`
+	DisplayName string `json:"display_name"`
+	IsAnonymous bool   `json:"is_anonymous"`
+	// Provider the donor will pay with, if known; decides when an unpaid donation expires
+	PaymentProvider models.PaymentProvider `json:"payment_provider,omitempty"`
+	// Streamer's primary currency, resolved by the gateway; the donation records its
+	// exchange rate into this currency (IDR when empty)
+	StreamerCurrency models.SupportedCurrency `json:"-"`
+	// Split donation this donation is one streamer's share of, set by the gateway
+	SplitDonationID uint `json:"-"`
+	// Where the request came from, set by the gateway for fraud screening; requests
+	// without one are not screened
+	Client *DonationClient `json:"-"`
+	// Streamer's primary language, resolved by the gateway; messages in another language
+	// are translated into it after the donation is created
+	StreamerLanguage models.SupportedLanguage `json:"-"`
+	// Retries with the same key return the donation created by the first request
+	IdempotencyKey string `json:"-"`
+}
+
+// ErrExchangeRateUnavailable is returned when an amount cannot be converted because no
+// exchange rate is available
+var ErrExchangeRateUnavailable = errors.New("exchange rate to the streamer's currency is unavailable")
+
+// DonationStatsRequest selects the streamer, date range and bucket size for statistics.
+// The range is half-open: StartDate inclusive, EndDate exclusive.
+type DonationStatsRequest struct {
+	StreamerID uint                 `json:"streamer_id"`
+	StartDate  time.Time            `json:"start_date"`
+	EndDate    time.Time            `json:"end_date"`
+	Interval   models.StatsInterval `json:"interval"`
+	// Currency of the overall totals (IDR when empty). Donations are counted at their
+	// snapshot rate; only snapshots in another currency are converted at today's rate.
+	Currency models.SupportedCurrency `json:"currency"`
+}
+
+// ErrDonationNotFound is returned when no donation matches an ID or transaction ID
+var ErrDonationNotFound = errors.New("donation not found")
+
+// ErrInvalidDonationStats wraps the reason a donation stats request was rejected
+var ErrInvalidDonationStats = errors.New("invalid donation stats request")
+
+// ErrInvalidDonationCursor is returned for a cursor that is malformed or was issued for other sorting
+var ErrInvalidDonationCursor = errors.New("invalid donation cursor")
+
+// DonationListRequest selects one page of a filtered, sorted donation listing. Cursor is
+// the NextCursor of the previous page and must be used with the same sorting.
+type DonationListRequest struct {
+	Filter models.DonationFilter    `json:"filter"`
+	SortBy models.DonationSortField `json:"sort_by"`
+	Order  models.SortOrder         `json:"order"`
+	Cursor string                   `json:"cursor"`
+	Limit  int                      `json:"limit"`
 }
 
 type DonationService interface {
 	Create(donation *models.Donation) error
 	CreateDonation(req *CreateDonationRequest) (*models.Donation, error)
+	// CreateSplitShares creates the donations of one split donation's shares. The split is
+	// screened for risk once for its whole amount and every share's message is moderated
+	// before any is stored; if one cannot be created, those already stored are failed.
+	CreateSplitShares(reqs []*CreateDonationRequest) ([]*models.Donation, error)
 	GetByID(id uint) (*models.Donation, error)
 	GetByTransactionID(transactionID string) (*models.Donation, error)
 	List(page, pageSize int) ([]*models.Donation, error)
 	GetByDonatorID(donatorID uint, page, pageSize int) ([]*models.Donation, error)
 	GetByStreamerID(streamerID uint, page, pageSize int) ([]*models.Donation, error)
-	UpdateStatus(id uint, status models.PaymentStatus) error
+	ListDonations(req *DonationListRequest) (*models.DonationPage, error)
+	// UpdateStatus moves a donation to a new status if the state machine allows it,
+	// returning a *StatusTransitionError otherwise. Repeating the current status is a no-op.
+	UpdateStatus(id uint, status models.PaymentStatus, change StatusChange) error
+	GetStatusHistory(donationID uint) ([]*models.DonationStatusHistory, error)
 	ProcessPayment(donationID uint, transactionID string, provider models.PaymentProvider) error
 	GetLatestDonations(limit int) ([]*models.Donation, error)
-	GetTotalAmountByStreamer(streamerID uint) (float64, error)
+	// GetTotalAmountByStreamer sums a streamer's completed donations, net of refunds, into
+	// currency (IDR when empty) at their snapshot rates
+	GetTotalAmountByStreamer(streamerID uint, currency models.SupportedCurrency) (int64, error)
+	GetDonationStats(req *DonationStatsRequest) (*models.DonationStats, error)
 } 
\ No newline at end of file
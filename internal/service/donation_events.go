@@ -0,0 +1,64 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// ErrSubscriberTooSlow is reported when a subscription is dropped because it fell behind
+var ErrSubscriberTooSlow = errors.New("donation event subscriber too slow, events dropped")
+
+// DonationEventType identifies what happened to a donation
+type DonationEventType string
+
+const (
+	DonationEventCreated   DonationEventType = "donation_created"
+	DonationEventCompleted DonationEventType = "donation_completed"
+	DonationEventFailed    DonationEventType = "donation_failed"
+	DonationEventUpdated   DonationEventType = "donation_updated"
+)
+
+// DonationEvent is published whenever a donation is created or changes state
+type DonationEvent struct {
+	Type      DonationEventType `json:"type"`
+	Donation  *models.Donation  `json:"donation"`
+	Timestamp time.Time         `json:"timestamp"`
+	Metadata  map[string]string `json:"metadata,omitempty"`
+}
+
+// DonationSubscription delivers donation events for a single streamer.
+// The Events channel is closed when the subscription ends; Err reports why
+// the bus ended it (nil when the subscriber closed it itself).
+type DonationSubscription interface {
+	Events() <-chan *DonationEvent
+	Err() error
+	Close()
+}
+
+// DonationEventListener reacts to every published donation event inside the service,
+// e.g. to update goal progress. Listeners run asynchronously and must be idempotent.
+type DonationEventListener interface {
+	HandleDonationEvent(event *DonationEvent)
+}
+
+// DonationEventBus fans donation events out to per-streamer subscribers and listeners
+type DonationEventBus interface {
+	Publish(event *DonationEvent)
+	Subscribe(streamerID uint) DonationSubscription
+	SubscriberCount(streamerID uint) int
+	AddListener(listener DonationEventListener)
+}
+
+// DonationEventTypeForStatus maps a payment status change to the event type to publish
+func DonationEventTypeForStatus(status models.PaymentStatus) DonationEventType {
+	switch status {
+	case models.PaymentCompleted:
+		return DonationEventCompleted
+	case models.PaymentFailed:
+		return DonationEventFailed
+	default:
+		return DonationEventUpdated
+	}
+}
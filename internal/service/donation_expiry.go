@@ -0,0 +1,24 @@
+package service
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// DonationExpiredReason is recorded in the status history of donations failed for being unpaid
+const DonationExpiredReason = "expired"
+
+// DonationExpiryWindows is how long each payment provider gives a donor to pay
+type DonationExpiryWindows struct {
+	Default   time.Duration                            // Providers without their own window, and donations with none yet
+	Providers map[models.PaymentProvider]time.Duration // Per-provider payment deadlines
+}
+
+// DonationExpiryService fails pending donations whose payment deadline has passed
+type DonationExpiryService interface {
+	// ExpirePendingDonations fails donations still pending past their deadline at now
+	// and returns how many this call expired. Concurrent runs on several replicas are
+	// safe: each donation is expired, recorded and announced exactly once.
+	ExpirePendingDonations(now time.Time) (int, error)
+}
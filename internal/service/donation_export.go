@@ -0,0 +1,54 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+var (
+	// ErrExportTooLarge is returned when a date range has too many donations to export
+	// directly; a background export should be created instead
+	ErrExportTooLarge = errors.New("too many donations for a direct export, create a background export instead")
+	// ErrExportNotReady is returned when downloading an export that has not completed
+	ErrExportNotReady = errors.New("donation export is not ready for download")
+	// ErrInvalidExportRequest wraps the reason an export request was rejected
+	ErrInvalidExportRequest = errors.New("invalid export request")
+)
+
+// DonationExportRequest selects the donations to export. The range is half-open over
+// the creation time: StartDate inclusive, EndDate exclusive.
+type DonationExportRequest struct {
+	StreamerID uint                     `json:"streamer_id"`
+	Format     models.ExportFormat      `json:"format"`
+	StartDate  time.Time                `json:"start_date"`
+	EndDate    time.Time                `json:"end_date"`
+	Currency   models.SupportedCurrency `json:"currency"` // Currency of the converted amount column
+}
+
+// DonationExportService produces CSV and XLSX files of a streamer's donations
+type DonationExportService interface {
+	// ExportDonations writes the file straight to w, or returns ErrExportTooLarge
+	// before writing anything when the range is too big for a direct export
+	ExportDonations(ctx context.Context, req *DonationExportRequest, w io.Writer) error
+	// CreateExport queues a background export
+	CreateExport(req *DonationExportRequest) (*models.DonationExport, error)
+	GetExport(streamerID, exportID uint) (*models.DonationExport, error)
+	// DownloadExport writes a completed export's file to w
+	DownloadExport(ctx context.Context, streamerID, exportID uint, w io.Writer) error
+}
+
+// DonationExportWorker builds queued exports in the background
+type DonationExportWorker interface {
+	// RunPendingExports builds queued exports until none are left and returns how many
+	// it completed. Several replicas may run it at once; each export is built once.
+	RunPendingExports(ctx context.Context) (int, error)
+	// RequeueStale queues exports again whose build started before the given time and
+	// never finished, e.g. because the replica building them stopped
+	RequeueStale(before time.Time) (int64, error)
+	// PurgeExpired deletes exports and their files once they expire
+	PurgeExpired() (int64, error)
+}
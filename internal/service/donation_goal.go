@@ -0,0 +1,12 @@
+package service
+
+import "github.com/rzfd/mediashar/internal/models"
+
+// DonationGoalService manages streamer donation goals and their progress
+type DonationGoalService interface {
+	CreateGoal(goal *models.DonationGoal) error
+	UpdateGoal(goal *models.DonationGoal) error
+	DeleteGoal(streamerID, goalID uint) error
+	GetGoal(goalID uint) (*models.DonationGoal, error)
+	GetGoalsByStreamer(streamerID uint, activeOnly bool) ([]*models.DonationGoal, error)
+}
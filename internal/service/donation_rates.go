@@ -0,0 +1,16 @@
+package service
+
+import "github.com/rzfd/mediashar/internal/models"
+
+// RateSourceBackfillPrefix marks snapshots recorded after the fact with the rate of the
+// backfill day, for donations made before snapshots existed
+const RateSourceBackfillPrefix = "backfill:"
+
+// DonationRateBackfill records exchange-rate snapshots for donations that have none
+type DonationRateBackfill interface {
+	// BackfillRateSnapshots snapshots every donation without one at today's rates and
+	// returns how many it stored. Each donation is snapshotted into the streamer currency
+	// recorded when it was created, else its streamer's latest snapshot currency, else
+	// fallback. Safe to run on several replicas.
+	BackfillRateSnapshots(fallback models.SupportedCurrency) (int, error)
+}
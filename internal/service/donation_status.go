@@ -0,0 +1,34 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// ErrInvalidStatusTransition is matched by every rejected donation status change
+var ErrInvalidStatusTransition = errors.New("invalid donation status transition")
+
+// StatusTransitionError reports a status change the state machine does not allow,
+// including one lost to a concurrent update
+type StatusTransitionError struct {
+	DonationID uint
+	From       models.PaymentStatus
+	To         models.PaymentStatus
+}
+
+func (e *StatusTransitionError) Error() string {
+	return fmt.Sprintf("donation %d cannot move from %s to %s", e.DonationID, e.From, e.To)
+}
+
+func (e *StatusTransitionError) Unwrap() error {
+	return ErrInvalidStatusTransition
+}
+
+// StatusChange describes who or what is changing a donation's status
+type StatusChange struct {
+	Source  models.StatusChangeSource `json:"source"`
+	ActorID uint                      `json:"actor_id"`
+	Reason  string                    `json:"reason"`
+}
@@ -0,0 +1,17 @@
+package service
+
+import "github.com/rzfd/mediashar/internal/models"
+
+// IdempotencyService stores request outcomes per idempotency key so client retries
+// receive the original response instead of repeating the request
+type IdempotencyService interface {
+	// Begin claims the key for a request. A completed record means the caller should
+	// replay its stored response; an incomplete one means the caller owns the key and
+	// must Complete or Release it. Fails with models.ErrIdempotencyKeyReused or
+	// models.ErrIdempotencyRequestInProgress when the key cannot be used.
+	Begin(scope, key, requestHash string) (*models.IdempotencyRecord, error)
+	Complete(record *models.IdempotencyRecord, statusCode int, contentType string, body []byte) error
+	// Release forgets an unfinished key so the request can be retried
+	Release(record *models.IdempotencyRecord) error
+	PurgeExpired() (int64, error)
+}
@@ -102,6 +102,18 @@ func GetDefaultTranslations() map[models.SupportedLanguage]map[string]string {
 			"payment.failed":        "Pembayaran gagal",
 			"payment.cancelled":     "Pembayaran dibatalkan",
 			
+			// Receipts
+			"receipt.title":             "Kwitansi Donasi",
+			"receipt.thanks":            "Terima kasih atas donasi Anda.",
+			"receipt.donor":             "Donatur",
+			"receipt.streamer":          "Streamer",
+			"receipt.currency":          "Mata Uang",
+			"receipt.date":              "Tanggal",
+			"receipt.transaction_id":    "ID Transaksi",
+			"receipt.refunded":          "Dikembalikan",
+			"receipt.verification_code": "Kode Verifikasi",
+			"receipt.verify_hint":       "Periksa keaslian kwitansi ini di {url}",
+			
 			// Authentication
 			"auth.login":            "Masuk",
 			"auth.register":         "Daftar",
@@ -142,6 +154,18 @@ func GetDefaultTranslations() map[models.SupportedLanguage]map[string]string {
 			"payment.failed":        "Payment failed",
 			"payment.cancelled":     "Payment cancelled",
 			
+			// Receipts
+			"receipt.title":             "Donation Receipt",
+			"receipt.thanks":            "Thank you for your donation.",
+			"receipt.donor":             "Donor",
+			"receipt.streamer":          "Streamer",
+			"receipt.currency":          "Currency",
+			"receipt.date":              "Date",
+			"receipt.transaction_id":    "Transaction ID",
+			"receipt.refunded":          "Refunded",
+			"receipt.verification_code": "Verification Code",
+			"receipt.verify_hint":       "Check this receipt at {url}",
+			
 			// Authentication
 			"auth.login":            "Login",
 			"auth.register":         "Register",
@@ -182,6 +206,18 @@ func GetDefaultTranslations() map[models.SupportedLanguage]map[string]string {
 			"payment.failed":        "付款失败",
 			"payment.cancelled":     "付款已取消",
 			
+			// Receipts
+			"receipt.title":             "捐赠收据",
+			"receipt.thanks":            "感谢您的捐赠。",
+			"receipt.donor":             "捐赠者",
+			"receipt.streamer":          "主播",
+			"receipt.currency":          "货币",
+			"receipt.date":              "日期",
+			"receipt.transaction_id":    "交易编号",
+			"receipt.refunded":          "已退款",
+			"receipt.verification_code": "验证码",
+			"receipt.verify_hint":       "请在 {url} 验证此收据",
+			
 			// Authentication
 			"auth.login":            "登录",
 			"auth.register":         "注册",
@@ -0,0 +1,24 @@
+package service
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// LeaderboardRequest selects a streamer's donor ranking. StartDate and EndDate bound
+// session leaderboards and are ignored for the other periods.
+type LeaderboardRequest struct {
+	StreamerID     uint                     `json:"streamer_id"`
+	Period         models.LeaderboardPeriod `json:"period"`
+	StartDate      time.Time                `json:"start_date"`
+	EndDate        time.Time                `json:"end_date"`
+	Currency       models.SupportedCurrency `json:"currency"` // Totals are converted to this currency, IDR when empty
+	Limit          int                      `json:"limit"`
+	GroupAnonymous bool                     `json:"group_anonymous"` // Rank anonymous donations as one entry instead of leaving them out
+}
+
+// LeaderboardService ranks donors by the completed donations they made to a streamer
+type LeaderboardService interface {
+	GetLeaderboard(req *LeaderboardRequest) (*models.DonationLeaderboard, error)
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// ErrUnbalancedLedgerEntry is returned when an entry's lines do not add up to zero
+var ErrUnbalancedLedgerEntry = errors.New("ledger entry does not balance")
+
+// LedgerFeeSchedules are the fees charged on payments through each provider
+type LedgerFeeSchedules struct {
+	Default   models.FeeSchedule                            // Providers without their own schedule
+	Providers map[models.PaymentProvider]models.FeeSchedule // Per-provider schedules
+}
+
+// For returns the fee schedule of a payment provider
+func (f LedgerFeeSchedules) For(provider models.PaymentProvider) models.FeeSchedule {
+	if schedule, ok := f.Providers[provider]; ok {
+		return schedule
+	}
+	return f.Default
+}
+
+// LedgerPoster posts to the double-entry ledger of what the platform owes each streamer.
+// Completed donations, refunds and chargebacks are posted as they are published on the
+// donation event bus; each is posted once, however often it is seen.
+type LedgerPoster interface {
+	// PostPayment records a completed donation: the provider's funds, its fee, the
+	// platform fee and the streamer's share. Fees follow the provider's current schedule.
+	PostPayment(donation *models.Donation) (*models.LedgerEntry, error)
+	// PostRefund records a succeeded refund or chargeback, taking it from the streamer's
+	// balance and returning the same part of the platform fee. Provider fees are kept.
+	PostRefund(refund *models.DonationRefund) (*models.LedgerEntry, error)
+
+	// ReconcileLedger posts completed donations and succeeded refunds the ledger is
+	// missing, e.g. made before it existed or whose events were lost, and returns how
+	// many entries it posted
+	ReconcileLedger() (int, error)
+}
+
+// LedgerService answers what the platform owes a streamer and why
+type LedgerService interface {
+	// GetStreamerBalances returns the streamer's balance in every currency they were paid in
+	GetStreamerBalances(streamerID uint) ([]*models.StreamerBalance, error)
+	// GetStreamerEntries returns the entries that moved the streamer's balance, newest first
+	GetStreamerEntries(streamerID uint, page, pageSize int) ([]*models.LedgerEntry, error)
+}
@@ -0,0 +1,56 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+var (
+	// ErrInvalidMatchCampaign wraps the reason a matching campaign's terms were rejected
+	ErrInvalidMatchCampaign = errors.New("invalid match campaign")
+	// ErrMatchCampaignSpent is returned when changing a campaign's budget below what it
+	// already matched, or its currency after the first match
+	ErrMatchCampaignSpent = errors.New("match campaign has already spent part of its budget")
+)
+
+// MatchCampaignRequest holds a matching campaign's terms. Amounts are minor units of
+// Currency.
+type MatchCampaignRequest struct {
+	Name             string                   `json:"name"`
+	SponsorName      string                   `json:"sponsor_name"`
+	SponsorUserID    uint                     `json:"sponsor_user_id"`
+	StreamerIDs      []uint                   `json:"streamer_ids"`
+	Currency         models.SupportedCurrency `json:"currency"`
+	RatioBasisPoints int64                    `json:"ratio_basis_points"`
+	MaxMatchAmount   int64                    `json:"max_match_amount"`
+	Budget           int64                    `json:"budget"`
+	StartsAt         time.Time                `json:"starts_at"`
+	EndsAt           time.Time                `json:"ends_at"`
+	IsActive         bool                     `json:"is_active"`
+}
+
+// MatchCampaignService runs sponsors' matching campaigns. Completed donations to a
+// campaign's streamers are matched as they are published on the donation event bus: the
+// sponsor's match is stored as a completed donation of its own, linked to the donor's.
+type MatchCampaignService interface {
+	CreateCampaign(req *MatchCampaignRequest) (*models.MatchCampaign, error)
+	// UpdateCampaign replaces a campaign's terms; matches already made stay as they are
+	UpdateCampaign(id uint, req *MatchCampaignRequest) (*models.MatchCampaign, error)
+	GetCampaign(id uint) (*models.MatchCampaign, error)
+	// ListCampaigns returns campaigns, newest first
+	ListCampaigns(page, pageSize int) ([]*models.MatchCampaign, error)
+	// GetReport sums how a campaign's budget was spent, per streamer
+	GetReport(id uint) (*models.MatchCampaignReport, error)
+
+	// MatchDonation matches a completed donation from every running campaign of its
+	// streamer and returns the sponsors' donations. Each campaign matches a donation
+	// once, however often it is called.
+	MatchDonation(donation *models.Donation) ([]*models.Donation, error)
+	// RefundMatches takes back the same share of every match of a donation as was
+	// refunded from it, or all of a match once it is fully refunded or charged back. The
+	// sponsors' donations are refunded and the amounts returned to the campaigns' budgets.
+	// Calling it again refunds nothing more.
+	RefundMatches(donationID uint) ([]*models.DonationRefund, error)
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+type SubscribeRequest struct {
+	MemberID    uint   `json:"member_id"`
+	TierID      uint   `json:"tier_id"`
+	DisplayName string `json:"display_name"`
+}
+
+type MembershipService interface {
+	CreateTier(tier *models.MembershipTier) error
+	UpdateTier(tier *models.MembershipTier) error
+	GetTier(tierID uint) (*models.MembershipTier, error)
+	GetTiersByStreamer(streamerID uint, activeOnly bool) ([]*models.MembershipTier, error)
+
+	// Subscribe starts a pending membership and returns the donation that pays for its first period
+	Subscribe(req *SubscribeRequest) (*models.Membership, *models.Donation, error)
+	// Cancel stops a membership; a paid-up membership stays active until its period ends
+	Cancel(memberID, membershipID uint) (*models.Membership, error)
+	GetMembership(membershipID uint) (*models.Membership, error)
+	GetMembershipsByMember(memberID uint) ([]*models.Membership, error)
+	GetMembershipsByStreamer(streamerID uint, page, pageSize int) ([]*models.Membership, error)
+	GetPayments(membershipID uint) ([]*models.MembershipPayment, error)
+
+	// ProcessRenewals settles outstanding membership donations and issues renewals
+	// for memberships whose period ended at or before now
+	ProcessRenewals(now time.Time) error
+}
@@ -5,13 +5,12 @@ import (
 )
 
 type MidtransPaymentRequest struct {
-	OrderID      string  `json:"order_id"`
-	Amount       float64 `json:"amount"`
-	Currency     string  `json:"currency"`
-	CustomerName string  `json:"customer_name"`
-	CustomerEmail string `json:"customer_email"`
-	Description  string  `json:"description"`
-	CallbackURL  string  `json:"callback_url"`
+	OrderID       string       `json:"order_id"`
+	Amount        models.Money `json:"amount"` // Must be IDR, the only currency Midtrans charges in
+	CustomerName  string       `json:"customer_name"`
+	CustomerEmail string       `json:"customer_email"`
+	Description   string       `json:"description"`
+	CallbackURL   string       `json:"callback_url"`
 }
 
 type MidtransPaymentResponse struct {
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+var (
+	// ErrMessageRejected is returned when moderation refuses a donation because of its message
+	ErrMessageRejected = errors.New("donation message was rejected by moderation")
+	// ErrReviewAlreadyResolved is returned when approving or rejecting a message that was already reviewed
+	ErrReviewAlreadyResolved = errors.New("message review was already resolved")
+)
+
+// MessageModerator screens donation messages as donations are created
+type MessageModerator interface {
+	// ModerateDonation applies the streamer's and the global rules to a message and display name
+	ModerateDonation(streamerID uint, message, displayName string) (*models.ModerationResult, error)
+	// QueueForReview puts a held donation message in the streamer's review queue
+	QueueForReview(donation *models.Donation, result *models.ModerationResult) (*models.MessageReview, error)
+}
+
+// ModerationService manages moderation rules and the review queue of held messages
+type ModerationService interface {
+	// GetSettings returns the streamer's settings, or the defaults if none were saved
+	GetSettings(streamerID uint) (*models.ModerationSettings, error)
+	UpdateSettings(settings *models.ModerationSettings) (*models.ModerationSettings, error)
+
+	GetReviewQueue(streamerID uint, status models.MessageReviewStatus, page, pageSize int) ([]*models.MessageReview, int64, error)
+	ApproveMessage(streamerID, reviewID uint) (*models.MessageReview, error)
+	RejectMessage(streamerID, reviewID uint) (*models.MessageReview, error)
+
+	ListBlockedTerms() ([]*models.BlockedTerm, error)
+	AddBlockedTerm(term string, isPattern bool) (*models.BlockedTerm, error)
+	RemoveBlockedTerm(id uint) error
+}
@@ -0,0 +1,39 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// ErrInvalidOverlayToken is returned for an overlay token that does not exist or was rotated
+var ErrInvalidOverlayToken = errors.New("invalid overlay token")
+
+// DonationEventSource streams the donation events of a single streamer
+type DonationEventSource interface {
+	// SubscribeDonationEvents delivers events until ctx is done or the source fails,
+	// then closes the channel
+	SubscribeDonationEvents(ctx context.Context, streamerID uint) (<-chan *DonationEvent, error)
+}
+
+// OverlayService feeds the browser-source overlays (alerts, goals, media player and
+// leaderboard) of a streamer, identified by the streamer's secret overlay token
+type OverlayService interface {
+	// GetToken returns the streamer's overlay token, creating one on first use
+	GetToken(streamerID uint) (*models.OverlayToken, error)
+	// RotateToken replaces the streamer's token; overlays using the old one stop working
+	RotateToken(streamerID uint) (*models.OverlayToken, error)
+	// ResolveToken returns the streamer an overlay token belongs to
+	ResolveToken(token string) (uint, error)
+
+	// Subscribe streams overlay events until ctx is done or an upstream stream fails,
+	// then closes the channel. It starts with the current goals, leaderboard and media
+	// so a freshly loaded overlay is not empty.
+	Subscribe(ctx context.Context, streamerID uint) (<-chan *models.OverlayEvent, error)
+	// MarkMediaPlayed is called by the media player overlay when an item has finished
+	MarkMediaPlayed(streamerID, mediaID uint) error
+	// PublishAlert shows an alert, such as a test alert, on the streamer's connected
+	// overlay connections and returns how many received it
+	PublishAlert(streamerID uint, alert *models.OverlayAlert) int
+}
@@ -4,9 +4,11 @@ import "github.com/rzfd/mediashar/internal/models"
 
 // PaymentProcessor defines the interface for processing payments
 type PaymentProcessor interface {
-	ProcessPayment(amount float64, currency string, description string) (string, error)
+	ProcessPayment(amount models.Money, description string) (string, error)
 	VerifyPayment(transactionID string) (bool, error)
-	RefundPayment(transactionID string) error
+	// RefundPayment refunds amount of a captured transaction and returns the provider's
+	// refund ID. refundKey identifies the refund so a retried call is not applied twice.
+	RefundPayment(transactionID, refundKey string, amount models.Money, reason string) (string, error)
 }
 
 // PaymentService handles payment processing for donations
@@ -0,0 +1,75 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+var (
+	// ErrInvalidPayoutRequest wraps the reason a payout or payout account was rejected
+	ErrInvalidPayoutRequest = errors.New("invalid payout request")
+	// ErrPayoutStatusConflict is returned when a payout is no longer in a status that
+	// allows the requested change, e.g. approving a payout that was already rejected
+	ErrPayoutStatusConflict = errors.New("payout status does not allow this change")
+)
+
+type CreatePayoutAccountRequest struct {
+	StreamerID    uint   `json:"streamer_id"`
+	Channel       string `json:"channel"` // Bank or e-wallet code, e.g. BCA or GOPAY
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+}
+
+type RequestPayoutRequest struct {
+	StreamerID uint                     `json:"streamer_id"`
+	AccountID  uint                     `json:"account_id"`
+	Amount     int64                    `json:"amount"` // Minor units of Currency
+	Currency   models.SupportedCurrency `json:"currency"`
+}
+
+// DisbursementResult is what a disbursement provider made of a payout. Status is
+// PayoutProcessing while the transfer is still on its way, which an admin then settles.
+type DisbursementResult struct {
+	Reference     string
+	Status        models.PayoutStatus
+	FailureReason string
+}
+
+// DisbursementProvider sends approved payouts to the streamer's bank or e-wallet
+type DisbursementProvider interface {
+	Name() string
+	// Disburse starts the transfer of a payout. An error means the outcome is unknown,
+	// so the payout stays processing until it is checked and settled by hand.
+	Disburse(ctx context.Context, payout *models.Payout) (*DisbursementResult, error)
+}
+
+// PayoutService lets streamers withdraw their ledger balance to a bank account or
+// e-wallet, after an admin approves. The amount is held from the balance when requested
+// and given back when the payout is rejected or fails.
+type PayoutService interface {
+	CreateAccount(req *CreatePayoutAccountRequest) (*models.PayoutAccount, error)
+	GetAccounts(streamerID uint) ([]*models.PayoutAccount, error)
+	// DeleteAccount removes one of the streamer's accounts; payouts already requested to
+	// it are still sent
+	DeleteAccount(streamerID, accountID uint) error
+
+	RequestPayout(req *RequestPayoutRequest) (*models.Payout, error)
+	GetPayout(id uint) (*models.Payout, error)
+	GetStreamerPayouts(streamerID uint, page, pageSize int) ([]*models.Payout, error)
+	// ListPayouts returns payouts in a status, or all when status is empty, oldest first
+	ListPayouts(status models.PayoutStatus, page, pageSize int) ([]*models.Payout, error)
+
+	// ApprovePayout moves a requested payout to processing and hands it to the
+	// disbursement provider, if one is configured; without one an admin transfers the
+	// money and settles the payout with CompletePayout or FailPayout
+	ApprovePayout(id, adminID uint) (*models.Payout, error)
+	// RejectPayout declines a requested payout and returns its amount to the balance
+	RejectPayout(id, adminID uint, reason string) (*models.Payout, error)
+	// CompletePayout records that a processing payout reached the streamer
+	CompletePayout(id uint, providerReference string) (*models.Payout, error)
+	// FailPayout records that a processing payout could not be sent and returns its
+	// amount to the balance
+	FailPayout(id uint, reason string) (*models.Payout, error)
+}
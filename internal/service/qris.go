@@ -10,19 +10,22 @@ type QRISResponse struct {
 	QRISString    string    `json:"qris_string"`
 	QRCodeBase64  string    `json:"qr_code_base64"`
 	ExpiryTime    time.Time `json:"expiry_time"`
-	Amount        float64   `json:"amount"`
+	Amount        int64     `json:"amount"` // Minor units of the donation's currency
 	TransactionID string    `json:"transaction_id"`
 }
 
 type QRISPaymentStatus struct {
 	Status        string     `json:"status"`
 	TransactionID string     `json:"transaction_id"`
-	Amount        float64    `json:"amount"`
+	Amount        int64      `json:"amount"`
 	PaidAt        *time.Time `json:"paid_at,omitempty"`
 }
 
 type QRISService interface {
 	GenerateQRIS(donation *models.Donation) (*QRISResponse, error)
+	// GenerateQRISPayment generates a QRIS code for a payment that is not a single
+	// donation, such as a split donation, under the caller's transaction ID
+	GenerateQRISPayment(amount models.Money, transactionID, description string) (*QRISResponse, error)
 	ValidateQRISPayment(qrisID string) (*QRISPaymentStatus, error)
 	ProcessQRISCallback(payload []byte) error
 } 
\ No newline at end of file
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"errors"
+	"io"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+var (
+	// ErrReceiptUnavailable is returned for donations that were not paid, or were refunded
+	// in full
+	ErrReceiptUnavailable = errors.New("receipts are only available for completed donations")
+	// ErrInvalidReceiptCode is returned for verification codes that were not issued for
+	// any receipt
+	ErrInvalidReceiptCode = errors.New("invalid receipt verification code")
+)
+
+// ReceiptService issues receipts of completed donations and checks their verification
+// codes. A code names its donation and is signed, so receipts need no storage of their own.
+type ReceiptService interface {
+	// GetReceipt builds a donation's receipt in a language, or the donor's preferred
+	// language when it is empty, with the date in the donor's timezone
+	GetReceipt(donationID uint, language models.SupportedLanguage) (*models.DonationReceipt, error)
+	// RenderReceipt writes a receipt as an HTML page or a PDF document
+	RenderReceipt(receipt *models.DonationReceipt, format models.ReceiptFormat, w io.Writer) error
+	// VerifyReceipt checks a receipt's verification code against its donation as it is now
+	VerifyReceipt(code string) (*models.ReceiptVerification, error)
+}
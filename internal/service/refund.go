@@ -0,0 +1,46 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+var (
+	// ErrInvalidRefundAmount is returned for a refund of zero or less
+	ErrInvalidRefundAmount = errors.New("refund amount must be greater than zero")
+	// ErrRefundExceedsRefundable is returned when a refund is larger than what is left of
+	// the donation, including when another refund of it went through first
+	ErrRefundExceedsRefundable = errors.New("refund amount exceeds the refundable amount")
+	// ErrRefundNotAllowed wraps why a donation cannot be refunded, e.g. it is not
+	// completed or its provider does not support refunds
+	ErrRefundNotAllowed = errors.New("donation cannot be refunded")
+	// ErrRefundProviderFailed wraps the payment provider's refusal or failure to refund
+	ErrRefundProviderFailed = errors.New("payment provider failed to refund")
+)
+
+type RefundDonationRequest struct {
+	DonationID  uint   `json:"donation_id"`
+	Amount      int64  `json:"amount"` // Minor units of the donation's currency; 0 refunds the remaining amount
+	Reason      string `json:"reason"`
+	RequestedBy uint   `json:"requested_by"`
+	Manual      bool   `json:"manual"`     // Record a refund already made outside the provider
+	Chargeback  bool   `json:"chargeback"` // Record a chargeback the provider already took back
+	// Source is recorded in the status history when the refund completes the donation's
+	// refund; empty means the donation's streamer
+	Source models.StatusChangeSource `json:"source"`
+}
+
+// RefundService refunds completed donations through their payment provider
+type RefundService interface {
+	// RefundDonation refunds a donation. A refund the provider made but that could not be
+	// recorded is returned still pending, without an error; ReconcilePendingRefunds
+	// records it later.
+	RefundDonation(req *RefundDonationRequest) (*models.DonationRefund, *models.Donation, error)
+	GetRefundsByDonation(donationID uint) ([]*models.DonationRefund, error)
+	// ReconcilePendingRefunds finishes refunds left pending for a while, e.g. because
+	// recording the provider's result failed, by retrying them with the same refund key.
+	// It returns how many it finished.
+	ReconcilePendingRefunds(now time.Time) (int, error)
+}
@@ -0,0 +1,70 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+var (
+	// ErrDonationBlocked is returned when fraud screening refuses a donation request
+	ErrDonationBlocked = errors.New("donation was blocked by fraud screening")
+	// ErrDonationChallenged is returned when a donation request looks risky enough that the
+	// donor has to sign in before trying again
+	ErrDonationChallenged = errors.New("donation requires verification, sign in to continue")
+)
+
+// DonationClient describes where a donation request came from, for fraud screening. It is
+// filled in by the gateway; donations the platform creates itself have none and are not
+// screened.
+type DonationClient struct {
+	IPAddress string
+	Country   string // ISO 3166-1 alpha-2 code reported by the edge, empty when unknown
+	// UserID is the signed-in donor, also for anonymous donations; 0 when signed out.
+	// Signed-in donors pass the challenge.
+	UserID uint
+}
+
+// RiskVelocityRule adds Points when more than Max requests are made within Window. A rule
+// with no points is off.
+type RiskVelocityRule struct {
+	Max    int
+	Window time.Duration
+	Points int
+}
+
+// RiskRules configure fraud screening. A request's score is the sum of the points of the
+// rules it breaks; at ChallengeScore the donor is challenged and at BlockScore the request
+// is refused.
+type RiskRules struct {
+	DonorVelocity    RiskVelocityRule // Requests by one signed-in user
+	IPVelocity       RiskVelocityRule // Requests from one IP address
+	StreamerVelocity RiskVelocityRule // Requests to one streamer, from anyone
+	// TinyAmounts counts tiny requests from the same donor or IP address; an amount is
+	// tiny below its currency's threshold, in minor units
+	TinyAmounts          RiskVelocityRule
+	TinyAmountThresholds map[models.SupportedCurrency]int64
+	// CountryMismatchPoints are added when the IP's country does not use the donation's
+	// currency
+	CountryMismatchPoints int
+	ChallengeScore        int
+	BlockScore            int
+}
+
+// RiskEngine screens donation requests for card testing and other abuse before they
+// become donations
+type RiskEngine interface {
+	// Assess scores a request and records the assessment, whatever the decision. Shares
+	// of a split donation after the first get the first share's assessment.
+	Assess(req *CreateDonationRequest) (*models.RiskAssessment, error)
+	// LinkDonation records the donation an allowed request created
+	LinkDonation(assessment *models.RiskAssessment, donationID uint) error
+}
+
+// RiskReviewService lets admins review screened donation requests
+type RiskReviewService interface {
+	// ListAssessments returns assessments with a decision, or all when decision is
+	// empty, newest first
+	ListAssessments(decision models.RiskDecision, page, pageSize int) ([]*models.RiskAssessment, error)
+}
@@ -0,0 +1,262 @@
+package serviceImpl
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+const (
+	// maxAlertDurationSeconds keeps a single alert from blocking the queue behind it
+	maxAlertDurationSeconds = 60
+	maxAlertTemplateLength  = 500
+	maxAlertRuleNameLength  = 100
+	maxAlertAssetURLLength  = 500
+	// testAlertDisplayName is shown when a test alert does not name a donor
+	testAlertDisplayName = "Test Donor"
+)
+
+type alertService struct {
+	alertRuleRepo   repository.AlertRuleRepository
+	currencyService service.CurrencyService
+}
+
+func NewAlertService(alertRuleRepo repository.AlertRuleRepository, currencyService service.CurrencyService) service.AlertService {
+	return &alertService{
+		alertRuleRepo:   alertRuleRepo,
+		currencyService: currencyService,
+	}
+}
+
+func (s *alertService) CreateRule(rule *models.AlertRule) error {
+	if rule.Currency == "" {
+		rule.Currency = models.CurrencyIDR
+	}
+	if rule.DurationSeconds == 0 {
+		rule.DurationSeconds = models.DefaultAlertDurationSeconds
+	}
+	if err := validateAlertRule(rule); err != nil {
+		return err
+	}
+
+	rule.IsActive = true
+	return s.alertRuleRepo.Create(rule)
+}
+
+func (s *alertService) UpdateRule(rule *models.AlertRule) error {
+	existing, err := s.GetRule(rule.StreamerID, rule.ID)
+	if err != nil {
+		return err
+	}
+	if rule.Currency == "" {
+		rule.Currency = existing.Currency
+	}
+	if rule.DurationSeconds == 0 {
+		rule.DurationSeconds = existing.DurationSeconds
+	}
+	if err := validateAlertRule(rule); err != nil {
+		return err
+	}
+
+	return s.alertRuleRepo.Update(rule)
+}
+
+func (s *alertService) DeleteRule(streamerID, ruleID uint) error {
+	if _, err := s.GetRule(streamerID, ruleID); err != nil {
+		return err
+	}
+	return s.alertRuleRepo.Delete(ruleID)
+}
+
+func (s *alertService) GetRule(streamerID, ruleID uint) (*models.AlertRule, error) {
+	rule, err := s.alertRuleRepo.GetByID(ruleID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, service.ErrAlertRuleNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if rule.StreamerID != streamerID {
+		return nil, service.ErrAlertRuleNotFound
+	}
+	return rule, nil
+}
+
+func (s *alertService) GetRulesByStreamer(streamerID uint) ([]*models.AlertRule, error) {
+	return s.alertRuleRepo.GetByStreamerID(streamerID, false)
+}
+
+func (s *alertService) RenderAlert(donation *models.Donation) *models.OverlayAlert {
+	alert := models.NewOverlayAlert(donation)
+
+	// Sponsors' matches follow the donor's alert with the default match alert
+	if alert.MatchedBy != "" {
+		s.render(alert, nil)
+		return alert
+	}
+
+	// A failed lookup must not cost the streamer the alert, so fall back to the default
+	rules, err := s.alertRuleRepo.GetByStreamerID(donation.StreamerID, true)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load alert rules of streamer %d: %v\n", donation.StreamerID, err)
+	}
+
+	s.render(alert, matchAlertRule(rules, donation))
+	return alert
+}
+
+func (s *alertService) RenderTestAlert(streamerID uint, req *service.TestAlertRequest) (*models.OverlayAlert, error) {
+	donation := &models.Donation{
+		StreamerID:  streamerID,
+		DisplayName: req.DisplayName,
+		Amount:      req.Amount,
+		Currency:    models.SupportedCurrency(req.Currency),
+		Message:     req.Message,
+	}
+	if donation.DisplayName == "" {
+		donation.DisplayName = testAlertDisplayName
+	}
+
+	var rule *models.AlertRule
+	if req.RuleID != 0 {
+		var err error
+		if rule, err = s.GetRule(streamerID, req.RuleID); err != nil {
+			return nil, err
+		}
+		if donation.Currency == "" {
+			donation.Currency = rule.Currency
+		}
+		if donation.Amount == 0 {
+			donation.Amount = rule.MinAmount
+		}
+	}
+	if donation.Currency == "" {
+		donation.Currency = models.CurrencyIDR
+	}
+	if err := service.ValidateCurrency(donation.Currency); err != nil {
+		return nil, err
+	}
+	if donation.Amount < 0 {
+		return nil, errors.New("amount cannot be negative")
+	}
+
+	if rule == nil {
+		rules, err := s.alertRuleRepo.GetByStreamerID(streamerID, true)
+		if err != nil {
+			return nil, err
+		}
+		rule = matchAlertRule(rules, donation)
+	}
+
+	alert := models.NewOverlayAlert(donation)
+	alert.IsTest = true
+	s.render(alert, rule)
+	return alert, nil
+}
+
+// render fills in the alert's text, assets and duration from rule, or the defaults when
+// rule is nil
+func (s *alertService) render(alert *models.OverlayAlert, rule *models.AlertRule) {
+	template := models.DefaultAlertTemplate
+	if alert.MatchedBy != "" {
+		template = models.DefaultMatchAlertTemplate
+	}
+	if rule != nil {
+		template = rule.MessageTemplate
+		alert.RuleID = rule.ID
+		alert.SoundURL = rule.SoundURL
+		alert.ImageURL = rule.ImageURL
+		if rule.DurationSeconds > 0 {
+			alert.DurationSeconds = rule.DurationSeconds
+		}
+	}
+
+	amount := models.NewMoney(alert.Amount, alert.Currency)
+	alert.FormattedAmount = s.currencyService.FormatCurrency(amount.Major(), alert.Currency)
+	// {translation} falls back to the message when it needed no translation
+	translation := alert.TranslatedMessage
+	if translation == "" {
+		translation = alert.Message
+	}
+	// One pass, so placeholders the donor typed into their name or message stay as typed
+	alert.Text = strings.NewReplacer(
+		"{donor}", alert.DisplayName,
+		"{amount}", alert.FormattedAmount,
+		"{message}", alert.Message,
+		"{translation}", translation,
+	).Replace(template)
+	alert.ShowMessage = !strings.Contains(template, "{message}") && !strings.Contains(template, "{translation}")
+}
+
+// matchAlertRule picks the rule with the highest minimum that covers the donation in its
+// own currency, falling back to the amount of its exchange-rate snapshot. rules must be
+// ordered highest minimum first.
+func matchAlertRule(rules []*models.AlertRule, donation *models.Donation) *models.AlertRule {
+	amounts := []models.Money{donation.Money()}
+	if donation.HasRateSnapshot() && donation.ConvertedCurrency != donation.Currency {
+		amounts = append(amounts, models.NewMoney(donation.ConvertedAmount, donation.ConvertedCurrency))
+	}
+
+	for _, amount := range amounts {
+		for _, rule := range rules {
+			if rule.Matches(amount) {
+				return rule
+			}
+		}
+	}
+	return nil
+}
+
+func validateAlertRule(rule *models.AlertRule) error {
+	if rule.StreamerID == 0 {
+		return errors.New("streamer ID is required")
+	}
+	if len(rule.Name) > maxAlertRuleNameLength {
+		return fmt.Errorf("rule name cannot be longer than %d characters", maxAlertRuleNameLength)
+	}
+	if err := service.ValidateCurrency(rule.Currency); err != nil {
+		return err
+	}
+	if rule.MinAmount < 0 {
+		return errors.New("minimum amount cannot be negative")
+	}
+	if rule.MaxAmount != 0 && rule.MaxAmount <= rule.MinAmount {
+		return errors.New("maximum amount must be greater than the minimum amount")
+	}
+	if strings.TrimSpace(rule.MessageTemplate) == "" {
+		return errors.New("message template is required")
+	}
+	if len(rule.MessageTemplate) > maxAlertTemplateLength {
+		return fmt.Errorf("message template cannot be longer than %d characters", maxAlertTemplateLength)
+	}
+	if rule.DurationSeconds < 1 || rule.DurationSeconds > maxAlertDurationSeconds {
+		return fmt.Errorf("duration must be between 1 and %d seconds", maxAlertDurationSeconds)
+	}
+	if err := validateAlertAssetURL("sound URL", rule.SoundURL); err != nil {
+		return err
+	}
+	return validateAlertAssetURL("image URL", rule.ImageURL)
+}
+
+// validateAlertAssetURL accepts an empty reference or an absolute http(s) URL, which the
+// overlay loads directly
+func validateAlertAssetURL(field, assetURL string) error {
+	if assetURL == "" {
+		return nil
+	}
+	if len(assetURL) > maxAlertAssetURLLength {
+		return fmt.Errorf("%s cannot be longer than %d characters", field, maxAlertAssetURLLength)
+	}
+	parsed, err := url.Parse(assetURL)
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return fmt.Errorf("%s must be an http or https URL", field)
+	}
+	return nil
+}
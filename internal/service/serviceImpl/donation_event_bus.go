@@ -0,0 +1,122 @@
+package serviceImpl
+
+import (
+	"sync"
+
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+// donationEventBus is an in-memory DonationEventBus. Publishing never blocks:
+// a subscriber whose buffer is full is dropped with ErrSubscriberTooSlow so a
+// stalled client cannot hold up donation processing or other streams.
+type donationEventBus struct {
+	mu          sync.RWMutex
+	subscribers map[uint]map[*donationSubscription]struct{}
+	listeners   []service.DonationEventListener
+	bufferSize  int
+}
+
+type donationSubscription struct {
+	bus        *donationEventBus
+	streamerID uint
+	events     chan *service.DonationEvent
+	err        error
+	closed     bool
+}
+
+// NewDonationEventBus creates an in-memory event bus with the given per-subscriber buffer
+func NewDonationEventBus(bufferSize int) service.DonationEventBus {
+	if bufferSize <= 0 {
+		bufferSize = 100
+	}
+	return &donationEventBus{
+		subscribers: make(map[uint]map[*donationSubscription]struct{}),
+		bufferSize:  bufferSize,
+	}
+}
+
+func (b *donationEventBus) Publish(event *service.DonationEvent) {
+	if event == nil || event.Donation == nil {
+		return
+	}
+
+	var slow []*donationSubscription
+
+	b.mu.RLock()
+	for _, listener := range b.listeners {
+		go listener.HandleDonationEvent(event)
+	}
+	for sub := range b.subscribers[event.Donation.StreamerID] {
+		select {
+		case sub.events <- event:
+		default:
+			slow = append(slow, sub)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, sub := range slow {
+		b.remove(sub, service.ErrSubscriberTooSlow)
+	}
+}
+
+func (b *donationEventBus) Subscribe(streamerID uint) service.DonationSubscription {
+	sub := &donationSubscription{
+		bus:        b,
+		streamerID: streamerID,
+		events:     make(chan *service.DonationEvent, b.bufferSize),
+	}
+
+	b.mu.Lock()
+	if b.subscribers[streamerID] == nil {
+		b.subscribers[streamerID] = make(map[*donationSubscription]struct{})
+	}
+	b.subscribers[streamerID][sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub
+}
+
+func (b *donationEventBus) AddListener(listener service.DonationEventListener) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.listeners = append(b.listeners, listener)
+}
+
+func (b *donationEventBus) SubscriberCount(streamerID uint) int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.subscribers[streamerID])
+}
+
+// remove detaches a subscription and closes its channel exactly once
+func (b *donationEventBus) remove(sub *donationSubscription, reason error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if sub.closed {
+		return
+	}
+	sub.closed = true
+	sub.err = reason
+	close(sub.events)
+
+	delete(b.subscribers[sub.streamerID], sub)
+	if len(b.subscribers[sub.streamerID]) == 0 {
+		delete(b.subscribers, sub.streamerID)
+	}
+}
+
+func (s *donationSubscription) Events() <-chan *service.DonationEvent {
+	return s.events
+}
+
+func (s *donationSubscription) Err() error {
+	s.bus.mu.RLock()
+	defer s.bus.mu.RUnlock()
+	return s.err
+}
+
+func (s *donationSubscription) Close() {
+	s.bus.remove(s, nil)
+}
@@ -0,0 +1,108 @@
+package serviceImpl
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+// maxExpiryBatches caps how many batches one run works through, so a backlog is
+// drained over several runs instead of holding up a single one
+const maxExpiryBatches = 20
+
+type donationExpiryService struct {
+	donationRepo repository.DonationRepository
+	eventBus     service.DonationEventBus
+	windows      service.DonationExpiryWindows
+	batchSize    int
+}
+
+// NewDonationExpiryService creates the expiry service. eventBus is optional and
+// receives a donation_failed event for every expired donation.
+func NewDonationExpiryService(donationRepo repository.DonationRepository, eventBus service.DonationEventBus, windows service.DonationExpiryWindows, batchSize int) service.DonationExpiryService {
+	if batchSize <= 0 {
+		batchSize = 100
+	}
+	return &donationExpiryService{
+		donationRepo: donationRepo,
+		eventBus:     eventBus,
+		windows:      windows,
+		batchSize:    batchSize,
+	}
+}
+
+func (s *donationExpiryService) ExpirePendingDonations(now time.Time) (int, error) {
+	deadlines := make(map[models.PaymentProvider]time.Time, len(s.windows.Providers))
+	for provider, window := range s.windows.Providers {
+		deadlines[provider] = now.Add(-window)
+	}
+	defaultDeadline := now.Add(-s.windows.Default)
+
+	expired := 0
+	var errs []error
+	for batch := 0; batch < maxExpiryBatches; batch++ {
+		donations, err := s.donationRepo.GetExpiredPending(deadlines, defaultDeadline, s.batchSize)
+		if err != nil {
+			return expired, err
+		}
+
+		progressed := false
+		for _, donation := range donations {
+			ok, err := s.expire(donation)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("donation %d: %w", donation.ID, err))
+				continue
+			}
+			// A donation another replica expired first, or that was just paid, counts as
+			// progress too: it will not be returned again
+			progressed = true
+			if ok {
+				expired++
+			}
+		}
+
+		if len(donations) < s.batchSize || !progressed {
+			break
+		}
+	}
+
+	return expired, errors.Join(errs...)
+}
+
+// expire fails a single donation and reports whether this call was the one that did it
+func (s *donationExpiryService) expire(donation *models.Donation) (bool, error) {
+	// The status guard lets only one replica move the donation; the others see no rows
+	// updated and skip it, so the history entry and event are written once
+	updated, err := s.donationRepo.TransitionStatus(donation.ID, models.PaymentPending, models.PaymentFailed, &models.DonationStatusHistory{
+		Source: models.StatusSourceSystem,
+		Reason: service.DonationExpiredReason,
+	})
+	if err != nil || !updated {
+		return false, err
+	}
+
+	s.publishExpired(donation)
+	return true, nil
+}
+
+func (s *donationExpiryService) publishExpired(donation *models.Donation) {
+	if s.eventBus == nil {
+		return
+	}
+
+	snapshot := *donation
+	snapshot.Status = models.PaymentFailed
+	s.eventBus.Publish(&service.DonationEvent{
+		Type:      service.DonationEventFailed,
+		Donation:  &snapshot,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"status": string(models.PaymentFailed),
+			"reason": service.DonationExpiredReason,
+		},
+	})
+}
@@ -0,0 +1,342 @@
+package serviceImpl
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/spreadsheet"
+)
+
+const (
+	// maxExportRange caps the date range of one export
+	maxExportRange = 2 * 366 * 24 * time.Hour
+	// exportChunkSize is the size of each stored piece of a background export's file
+	exportChunkSize = 256 * 1024
+	exportSheetName = "Donations"
+)
+
+// donationExportColumns are the header row of every export
+var donationExportColumns = []interface{}{
+	"Date",
+	"Donation ID",
+	"Donor",
+	"Amount",
+	"Currency",
+	"Converted Amount",
+	"Converted Currency",
+	"Exchange Rate",
+	"Refunded Amount",
+	"Provider",
+	"Transaction ID",
+	"Status",
+}
+
+type donationExportService struct {
+	donationRepo    repository.DonationRepository
+	exportRepo      repository.DonationExportRepository
+	currencyService service.CurrencyService
+	syncMaxRows     int64
+	ttl             time.Duration
+}
+
+// NewDonationExportService creates the export service. Ranges with more than
+// syncMaxRows donations must be exported in the background; finished background
+// exports are kept for ttl.
+func NewDonationExportService(donationRepo repository.DonationRepository, exportRepo repository.DonationExportRepository, currencyService service.CurrencyService, syncMaxRows int64, ttl time.Duration) service.DonationExportService {
+	return newDonationExportService(donationRepo, exportRepo, currencyService, syncMaxRows, ttl)
+}
+
+// NewDonationExportWorker creates the worker that builds queued exports
+func NewDonationExportWorker(donationRepo repository.DonationRepository, exportRepo repository.DonationExportRepository, currencyService service.CurrencyService, ttl time.Duration) service.DonationExportWorker {
+	return newDonationExportService(donationRepo, exportRepo, currencyService, 0, ttl)
+}
+
+func newDonationExportService(donationRepo repository.DonationRepository, exportRepo repository.DonationExportRepository, currencyService service.CurrencyService, syncMaxRows int64, ttl time.Duration) *donationExportService {
+	if syncMaxRows <= 0 {
+		syncMaxRows = 5000
+	}
+	if ttl <= 0 {
+		ttl = 7 * 24 * time.Hour
+	}
+	return &donationExportService{
+		donationRepo:    donationRepo,
+		exportRepo:      exportRepo,
+		currencyService: currencyService,
+		syncMaxRows:     syncMaxRows,
+		ttl:             ttl,
+	}
+}
+
+func (s *donationExportService) ExportDonations(ctx context.Context, req *service.DonationExportRequest, w io.Writer) error {
+	if err := validateExportRequest(req); err != nil {
+		return err
+	}
+
+	count, err := s.donationRepo.CountByFilter(exportFilter(req.StreamerID, req.StartDate, req.EndDate))
+	if err != nil {
+		return err
+	}
+	if count > s.syncMaxRows {
+		return service.ErrExportTooLarge
+	}
+
+	_, err = s.writeExport(ctx, req.StreamerID, req.Format, req.StartDate, req.EndDate, req.Currency, w)
+	return err
+}
+
+func (s *donationExportService) CreateExport(req *service.DonationExportRequest) (*models.DonationExport, error) {
+	if err := validateExportRequest(req); err != nil {
+		return nil, err
+	}
+
+	export := &models.DonationExport{
+		StreamerID: req.StreamerID,
+		Format:     req.Format,
+		StartDate:  req.StartDate,
+		EndDate:    req.EndDate,
+		Currency:   req.Currency,
+		Status:     models.ExportPending,
+	}
+	if err := s.exportRepo.Create(export); err != nil {
+		return nil, err
+	}
+	return export, nil
+}
+
+func (s *donationExportService) GetExport(streamerID, exportID uint) (*models.DonationExport, error) {
+	export, err := s.exportRepo.GetByID(exportID)
+	if err != nil {
+		return nil, err
+	}
+	// Another streamer's export is reported as missing rather than forbidden
+	if export.StreamerID != streamerID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return export, nil
+}
+
+func (s *donationExportService) DownloadExport(ctx context.Context, streamerID, exportID uint, w io.Writer) error {
+	export, err := s.GetExport(streamerID, exportID)
+	if err != nil {
+		return err
+	}
+	if export.Status != models.ExportCompleted {
+		return service.ErrExportNotReady
+	}
+
+	return s.exportRepo.StreamChunks(export.ID, func(data []byte) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		_, err := w.Write(data)
+		return err
+	})
+}
+
+func (s *donationExportService) RunPendingExports(ctx context.Context) (int, error) {
+	completed := 0
+	var errs []error
+	for ctx.Err() == nil {
+		export, err := s.exportRepo.ClaimPending()
+		if err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if export == nil {
+			break
+		}
+
+		if err := s.runExport(ctx, export); err != nil {
+			errs = append(errs, fmt.Errorf("export %d: %w", export.ID, err))
+			continue
+		}
+		completed++
+	}
+	return completed, errors.Join(errs...)
+}
+
+func (s *donationExportService) RequeueStale(before time.Time) (int64, error) {
+	return s.exportRepo.RequeueStale(before)
+}
+
+func (s *donationExportService) PurgeExpired() (int64, error) {
+	return s.exportRepo.DeleteExpired(time.Now())
+}
+
+// runExport builds one claimed export into stored chunks and records the outcome
+func (s *donationExportService) runExport(ctx context.Context, export *models.DonationExport) error {
+	// A requeued export may have chunks from the attempt that was interrupted
+	if err := s.exportRepo.DeleteChunks(export.ID); err != nil {
+		return err
+	}
+
+	chunks := &exportChunkWriter{export: export, repo: s.exportRepo}
+	buffered := bufio.NewWriterSize(chunks, exportChunkSize)
+	rows, err := s.writeExport(ctx, export.StreamerID, export.Format, export.StartDate, export.EndDate, export.Currency, buffered)
+	if err == nil {
+		err = buffered.Flush()
+	}
+	if errors.Is(err, models.ErrExportLeaseLost) {
+		// Another worker owns the export now; its chunks and outcome are not ours to touch
+		return err
+	}
+	if err != nil {
+		if failErr := s.exportRepo.Fail(export, err.Error(), time.Now().Add(s.ttl)); failErr != nil {
+			return errors.Join(err, failErr)
+		}
+		return err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(s.ttl)
+	export.Status = models.ExportCompleted
+	export.RowCount = rows
+	export.FileSize = chunks.size
+	export.CompletedAt = &now
+	export.ExpiresAt = &expiresAt
+	return s.exportRepo.Complete(export)
+}
+
+// writeExport writes the streamer's donations in the range as a file in the given
+// format and returns the number of donation rows written
+func (s *donationExportService) writeExport(ctx context.Context, streamerID uint, format models.ExportFormat, start, end time.Time, currency models.SupportedCurrency, w io.Writer) (int, error) {
+	var sheet spreadsheet.RowWriter
+	if format == models.ExportXLSX {
+		xlsx, err := spreadsheet.NewXLSXWriter(w, exportSheetName)
+		if err != nil {
+			return 0, err
+		}
+		sheet = xlsx
+	} else {
+		sheet = spreadsheet.NewCSVWriter(w)
+	}
+
+	if err := sheet.WriteRow(donationExportColumns...); err != nil {
+		return 0, err
+	}
+
+	// Rates are looked up once per currency so every row of the file uses the same rate
+	rates := map[models.SupportedCurrency]float64{currency: 1}
+	rows := 0
+	err := s.donationRepo.StreamByFilter(exportFilter(streamerID, start, end), func(donation *models.Donation) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		rows++
+		return sheet.WriteRow(s.exportRow(ctx, donation, currency, rates)...)
+	})
+	if err != nil {
+		return rows, err
+	}
+	return rows, sheet.Close()
+}
+
+// exportRow lays out one donation in the order of donationExportColumns. The donation's
+// rate snapshot is used when it is in the export currency; otherwise today's rate is.
+// The converted amount is left empty when no exchange rate is available.
+func (s *donationExportService) exportRow(ctx context.Context, donation *models.Donation, currency models.SupportedCurrency, rates map[models.SupportedCurrency]float64) []interface{} {
+	donor := donation.DisplayName
+	if donation.IsAnonymous {
+		donor = anonymousDisplayName
+	}
+
+	var converted, exchangeRate interface{}
+	rate, ok := rates[donation.Currency]
+	if donation.HasRateSnapshot() && donation.ConvertedCurrency == currency {
+		rate, ok = donation.ExchangeRate, true
+	}
+	if !ok {
+		var err error
+		rate, err = s.currencyService.GetExchangeRate(ctx, donation.Currency, currency)
+		if err != nil || rate <= 0 {
+			fmt.Printf("Warning: No exchange rate from %s to %s for export: %v\n", donation.Currency, currency, err)
+			rate = 0
+		}
+		rates[donation.Currency] = rate
+	}
+	if rate > 0 {
+		if donation.HasRateSnapshot() && donation.ConvertedCurrency == currency {
+			converted = models.NewMoney(donation.ConvertedAmount, currency).Major()
+		} else {
+			converted = donation.Money().Convert(rate, currency).Major()
+		}
+		exchangeRate = rate
+	}
+
+	return []interface{}{
+		donation.CreatedAt,
+		int64(donation.ID),
+		donor,
+		donation.Money().Major(),
+		string(donation.Currency),
+		converted,
+		string(currency),
+		exchangeRate,
+		models.NewMoney(donation.RefundedAmount, donation.Currency).Major(),
+		string(donation.PaymentProvider),
+		donation.TransactionID,
+		string(donation.Status),
+	}
+}
+
+func validateExportRequest(req *service.DonationExportRequest) error {
+	if req.StreamerID == 0 {
+		return fmt.Errorf("%w: streamer ID is required", service.ErrInvalidExportRequest)
+	}
+	if !req.Format.IsValid() {
+		return fmt.Errorf("%w: unsupported format %q", service.ErrInvalidExportRequest, req.Format)
+	}
+	if req.StartDate.IsZero() || req.EndDate.IsZero() {
+		return fmt.Errorf("%w: start date and end date are required", service.ErrInvalidExportRequest)
+	}
+	if !req.EndDate.After(req.StartDate) {
+		return fmt.Errorf("%w: end date must be after start date", service.ErrInvalidExportRequest)
+	}
+	if req.EndDate.Sub(req.StartDate) > maxExportRange {
+		return fmt.Errorf("%w: range cannot exceed two years", service.ErrInvalidExportRequest)
+	}
+	if req.Currency == "" {
+		req.Currency = models.CurrencyIDR
+	}
+	return nil
+}
+
+func exportFilter(streamerID uint, start, end time.Time) models.DonationFilter {
+	return models.DonationFilter{
+		StreamerID: streamerID,
+		StartDate:  &start,
+		EndDate:    &end,
+	}
+}
+
+// exportChunkWriter stores everything written to it as numbered export chunks
+type exportChunkWriter struct {
+	export   *models.DonationExport
+	repo     repository.DonationExportRepository
+	sequence int
+	size     int64
+}
+
+func (c *exportChunkWriter) Write(p []byte) (int, error) {
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := c.repo.AppendChunk(c.export, &models.DonationExportChunk{
+		ExportID: c.export.ID,
+		Sequence: c.sequence,
+		Data:     data,
+	}); err != nil {
+		return 0, err
+	}
+	c.sequence++
+	c.size += int64(len(p))
+	return len(p), nil
+}
@@ -0,0 +1,178 @@
+package serviceImpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+type donationGoalService struct {
+	goalRepo        repository.DonationGoalRepository
+	currencyService service.CurrencyService
+}
+
+// NewDonationGoalService creates the goal service and, when an event bus is given,
+// registers it so completed donations advance goal progress automatically
+func NewDonationGoalService(goalRepo repository.DonationGoalRepository, currencyService service.CurrencyService, eventBus service.DonationEventBus) service.DonationGoalService {
+	s := &donationGoalService{
+		goalRepo:        goalRepo,
+		currencyService: currencyService,
+	}
+	if eventBus != nil {
+		eventBus.AddListener(s)
+	}
+	return s
+}
+
+func (s *donationGoalService) CreateGoal(goal *models.DonationGoal) error {
+	if goal.Currency == "" {
+		goal.Currency = models.CurrencyIDR
+	}
+	if err := validateDonationGoal(goal); err != nil {
+		return err
+	}
+
+	goal.CurrentAmount = 0
+	goal.CompletedAt = nil
+	goal.IsActive = true
+
+	return s.goalRepo.Create(goal)
+}
+
+func (s *donationGoalService) UpdateGoal(goal *models.DonationGoal) error {
+	existing, err := s.goalRepo.GetByID(goal.ID)
+	if err != nil {
+		return err
+	}
+	if existing.StreamerID != goal.StreamerID {
+		return errors.New("goal does not belong to this streamer")
+	}
+	if goal.Currency == "" {
+		goal.Currency = existing.Currency
+	}
+	if goal.Currency != existing.Currency && existing.CurrentAmount > 0 {
+		return errors.New("cannot change the currency of a goal that already has progress")
+	}
+	if err := validateDonationGoal(goal); err != nil {
+		return err
+	}
+
+	return s.goalRepo.Update(goal)
+}
+
+func (s *donationGoalService) DeleteGoal(streamerID, goalID uint) error {
+	goal, err := s.goalRepo.GetByID(goalID)
+	if err != nil {
+		return err
+	}
+	if goal.StreamerID != streamerID {
+		return errors.New("goal does not belong to this streamer")
+	}
+
+	return s.goalRepo.Delete(goalID)
+}
+
+func (s *donationGoalService) GetGoal(goalID uint) (*models.DonationGoal, error) {
+	return s.goalRepo.GetByID(goalID)
+}
+
+func (s *donationGoalService) GetGoalsByStreamer(streamerID uint, activeOnly bool) ([]*models.DonationGoal, error) {
+	return s.goalRepo.GetByStreamerID(streamerID, activeOnly)
+}
+
+// HandleDonationEvent advances goal progress when a donation completes
+func (s *donationGoalService) HandleDonationEvent(event *service.DonationEvent) {
+	if event.Type != service.DonationEventCompleted {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+
+	if err := s.ApplyDonation(ctx, event.Donation); err != nil {
+		fmt.Printf("Warning: Failed to apply donation %d to goals: %v\n", event.Donation.ID, err)
+	}
+}
+
+// ApplyDonation adds a completed donation to every open goal of its streamer, converting
+// the amount into each goal's currency. The donation's rate snapshot is used when the goal
+// is in the streamer's currency, so progress matches the streamer's totals.
+func (s *donationGoalService) ApplyDonation(ctx context.Context, donation *models.Donation) error {
+	if donation.Status != models.PaymentCompleted {
+		return nil
+	}
+
+	goals, err := s.goalRepo.GetByStreamerID(donation.StreamerID, true)
+	if err != nil {
+		return err
+	}
+
+	completedAt := donation.UpdatedAt
+	if donation.PaymentTime != nil {
+		completedAt = *donation.PaymentTime
+	}
+
+	currency := donation.Currency
+	if currency == "" {
+		currency = models.CurrencyIDR
+	}
+
+	var errs []error
+	for _, goal := range goals {
+		if !goal.Accepts(completedAt) {
+			continue
+		}
+
+		var amount int64
+		switch {
+		case goal.Currency == currency:
+			amount = donation.Amount
+		case donation.HasRateSnapshot() && goal.Currency == donation.ConvertedCurrency:
+			amount = donation.ConvertedAmount
+		default:
+			converted, err := s.currencyService.ConvertMoney(ctx, models.NewMoney(donation.Amount, currency), goal.Currency)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("goal %d: %w", goal.ID, err))
+				continue
+			}
+			amount = converted.Minor
+		}
+
+		_, err = s.goalRepo.AddContribution(&models.DonationGoalContribution{
+			GoalID:           goal.ID,
+			DonationID:       donation.ID,
+			Amount:           amount,
+			OriginalAmount:   donation.Amount,
+			OriginalCurrency: currency,
+		})
+		if err != nil {
+			errs = append(errs, fmt.Errorf("goal %d: %w", goal.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func validateDonationGoal(goal *models.DonationGoal) error {
+	if goal.StreamerID == 0 {
+		return errors.New("streamer ID is required")
+	}
+	if goal.Title == "" {
+		return errors.New("goal title is required")
+	}
+	if goal.TargetAmount <= 0 {
+		return errors.New("target amount must be greater than zero")
+	}
+	if err := service.ValidateCurrency(goal.Currency); err != nil {
+		return err
+	}
+	if goal.Deadline != nil && goal.Deadline.Before(time.Now()) && goal.ID == 0 {
+		return errors.New("deadline must be in the future")
+	}
+	return nil
+}
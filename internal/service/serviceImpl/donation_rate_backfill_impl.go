@@ -0,0 +1,122 @@
+package serviceImpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+type donationRateBackfill struct {
+	donationRepo    repository.DonationRepository
+	currencyService service.CurrencyService
+	batchSize       int
+}
+
+// NewDonationRateBackfill creates the service that snapshots exchange rates of donations
+// made before snapshots were recorded, or while no rate was available
+func NewDonationRateBackfill(donationRepo repository.DonationRepository, currencyService service.CurrencyService, batchSize int) service.DonationRateBackfill {
+	if batchSize <= 0 {
+		batchSize = 500
+	}
+	return &donationRateBackfill{
+		donationRepo:    donationRepo,
+		currencyService: currencyService,
+		batchSize:       batchSize,
+	}
+}
+
+func (s *donationRateBackfill) BackfillRateSnapshots(fallback models.SupportedCurrency) (int, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+	defer cancel()
+
+	// One quote per currency pair, so every backfilled donation in a pair shares a rate
+	quotes := map[[2]models.SupportedCurrency]*models.ExchangeRateQuote{}
+	streamerCurrencies := map[uint]models.SupportedCurrency{}
+	stored := 0
+	var errs []error
+	for {
+		donations, err := s.donationRepo.GetWithoutRateSnapshot(s.batchSize)
+		if err != nil {
+			return stored, err
+		}
+
+		progressed := false
+		for _, donation := range donations {
+			if donation.Currency == "" {
+				donation.Currency = models.CurrencyIDR
+			}
+
+			currency := donation.ConvertedCurrency
+			if currency == "" {
+				currency, err = s.streamerCurrency(donation.StreamerID, fallback, streamerCurrencies)
+				if err != nil {
+					errs = append(errs, fmt.Errorf("donation %d: %w", donation.ID, err))
+					continue
+				}
+			}
+
+			pair := [2]models.SupportedCurrency{donation.Currency, currency}
+			quote, ok := quotes[pair]
+			if !ok {
+				quote, err = s.currencyService.GetExchangeRateQuote(ctx, donation.Currency, currency)
+				if err != nil {
+					// The donation stays without a snapshot and is retried on the next run
+					errs = append(errs, fmt.Errorf("rate %s to %s: %w", donation.Currency, currency, err))
+					quote = nil
+				}
+				quotes[pair] = quote
+			}
+			if quote == nil {
+				continue
+			}
+
+			rateTime := quote.RetrievedAt
+			donation.ExchangeRate = quote.Rate
+			donation.ConvertedAmount = donation.Money().Convert(quote.Rate, currency).Minor
+			donation.ConvertedCurrency = currency
+			donation.RateSource = service.RateSourceBackfillPrefix + quote.Source
+			donation.RateTime = &rateTime
+
+			saved, err := s.donationRepo.SaveRateSnapshot(donation)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("donation %d: %w", donation.ID, err))
+				continue
+			}
+			// A donation another replica snapshotted first counts as progress too
+			progressed = true
+			if saved {
+				stored++
+			}
+		}
+
+		if len(donations) < s.batchSize || !progressed {
+			break
+		}
+	}
+
+	return stored, errors.Join(errs...)
+}
+
+// streamerCurrency picks the currency to snapshot a streamer's donations into: the
+// currency of their latest snapshot, which follows their primary currency, or fallback
+// for streamers who have none
+func (s *donationRateBackfill) streamerCurrency(streamerID uint, fallback models.SupportedCurrency, cache map[uint]models.SupportedCurrency) (models.SupportedCurrency, error) {
+	if currency, ok := cache[streamerID]; ok {
+		return currency, nil
+	}
+
+	currency, err := s.donationRepo.GetLatestSnapshotCurrency(streamerID)
+	if err != nil {
+		return "", err
+	}
+	if currency == "" {
+		currency = fallback
+	}
+	cache[streamerID] = currency
+	return currency, nil
+}
@@ -2,8 +2,12 @@ package serviceImpl
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/rzfd/mediashar/internal/models"
@@ -15,6 +19,11 @@ type donationService struct {
 	donationRepo    repository.DonationRepository
 	userRepo        repository.UserRepository
 	userAggregator  service.UserAggregatorService // User aggregator for cache + API
+	eventBus        service.DonationEventBus      // Optional, publishes real-time donation events
+	moderator       service.MessageModerator      // Optional, screens donation messages
+	currencyService service.CurrencyService       // Optional, snapshots exchange rates of new donations
+	riskEngine      service.RiskEngine            // Optional, screens requests from the gateway for fraud
+	translator      service.MessageTranslator     // Optional, translates messages into the streamer's language
 }
 
 func NewDonationService(donationRepo repository.DonationRepository, userRepo repository.UserRepository) service.DonationService {
@@ -25,11 +34,16 @@ func NewDonationService(donationRepo repository.DonationRepository, userRepo rep
 }
 
 // NewDonationServiceWithUserAggregator creates donation service with user aggregator (recommended)
-func NewDonationServiceWithUserAggregator(donationRepo repository.DonationRepository, userRepo repository.UserRepository, userAggregator service.UserAggregatorService) service.DonationService {
+func NewDonationServiceWithUserAggregator(donationRepo repository.DonationRepository, userRepo repository.UserRepository, userAggregator service.UserAggregatorService, eventBus service.DonationEventBus, moderator service.MessageModerator, currencyService service.CurrencyService, riskEngine service.RiskEngine, translator service.MessageTranslator) service.DonationService {
 	return &donationService{
-		donationRepo:   donationRepo,
-		userRepo:       userRepo,
-		userAggregator: userAggregator,
+		donationRepo:    donationRepo,
+		userRepo:        userRepo,
+		userAggregator:  userAggregator,
+		eventBus:        eventBus,
+		moderator:       moderator,
+		currencyService: currencyService,
+		riskEngine:      riskEngine,
+		translator:      translator,
 	}
 }
 
@@ -58,43 +72,194 @@ func (s *donationService) Create(donation *models.Donation) error {
 		return err
 	}
 
-	return s.donationRepo.Create(donation)
+	// A preset ConvertedCurrency names the streamer's currency to snapshot the rate into
+	if err := s.snapshotExchangeRate(donation, donation.ConvertedCurrency); err != nil {
+		fmt.Printf("Warning: Creating donation without an exchange-rate snapshot: %v\n", err)
+	}
+
+	if err := s.donationRepo.Create(donation); err != nil {
+		return err
+	}
+
+	s.publishEvent(service.DonationEventCreated, donation)
+	return nil
 }
 
 func (s *donationService) CreateDonation(req *service.CreateDonationRequest) (*models.Donation, error) {
-	// Validate inputs
-	if req.Amount <= 0 {
-		return nil, errors.New("donation amount must be greater than zero")
+	if err := validateCreateDonationRequest(req); err != nil {
+		return nil, err
 	}
 
+	assessment, err := s.assessRisk(req)
+	if err != nil {
+		return nil, err
+	}
+
+	donation := newDonation(req)
+	moderation, err := s.moderate(donation)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storeDonation(donation, req.StreamerCurrency, assessment, moderation); err != nil {
+		return nil, err
+	}
+	s.announceDonation(donation, req.StreamerLanguage)
+	return donation, nil
+}
+
+func (s *donationService) CreateSplitShares(reqs []*service.CreateDonationRequest) ([]*models.Donation, error) {
+	if len(reqs) == 0 {
+		return nil, errors.New("split donation has no shares")
+	}
+	var total int64
+	for _, req := range reqs {
+		if err := validateCreateDonationRequest(req); err != nil {
+			return nil, err
+		}
+		total += req.Amount
+	}
+
+	// The donor pays the split once, so it is screened once for its whole amount; the
+	// first share's streamer stands in for the split
+	whole := *reqs[0]
+	whole.Amount = total
+	assessment, err := s.assessRisk(&whole)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every streamer's rules see the message before any share is stored, so a rejection
+	// by one of them refuses the whole split
+	donations := make([]*models.Donation, len(reqs))
+	moderations := make([]*models.ModerationResult, len(reqs))
+	for i, req := range reqs {
+		donations[i] = newDonation(req)
+		if moderations[i], err = s.moderate(donations[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, req := range reqs {
+		var shareAssessment *models.RiskAssessment
+		if i == 0 {
+			shareAssessment = assessment
+		}
+		if err := s.storeDonation(donations[i], req.StreamerCurrency, shareAssessment, moderations[i]); err != nil {
+			s.failDonations(donations[:i], "split donation abandoned: a share's donation could not be created")
+			return nil, fmt.Errorf("failed to create donation for streamer %d: %w", req.StreamerID, err)
+		}
+	}
+
+	for i, req := range reqs {
+		s.announceDonation(donations[i], req.StreamerLanguage)
+	}
+	return donations, nil
+}
+
+func validateCreateDonationRequest(req *service.CreateDonationRequest) error {
+	if req.Amount <= 0 {
+		return errors.New("donation amount must be greater than zero")
+	}
 	if req.StreamerID == 0 {
-		return nil, errors.New("streamer ID is required")
+		return errors.New("streamer ID is required")
+	}
+	return nil
+}
+
+// assessRisk screens requests from donors before anything is stored; every attempt is
+// recorded, refused ones included, so the velocity rules see them. Requests without a
+// client are not screened and get no assessment.
+func (s *donationService) assessRisk(req *service.CreateDonationRequest) (*models.RiskAssessment, error) {
+	if s.riskEngine == nil || req.Client == nil {
+		return nil, nil
 	}
+	assessment, err := s.riskEngine.Assess(req)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case assessment.Decision == models.RiskBlock:
+		return nil, service.ErrDonationBlocked
+	case assessment.Decision == models.RiskChallenge && req.Client.UserID == 0:
+		return nil, service.ErrDonationChallenged
+	}
+	return assessment, nil
+}
 
-	// Create donation model
+func newDonation(req *service.CreateDonationRequest) *models.Donation {
 	donation := &models.Donation{
-		Amount:      req.Amount,
-		Currency:    models.SupportedCurrency(req.Currency),
-		Message:     req.Message,
-		StreamerID:  req.StreamerID,
-		DisplayName: req.DisplayName,
-		IsAnonymous: req.IsAnonymous,
-		Status:      models.PaymentPending,
+		Amount:        req.Amount,
+		Currency:      models.SupportedCurrency(req.Currency),
+		Message:       req.Message,
+		StreamerID:    req.StreamerID,
+		DisplayName:   req.DisplayName,
+		IsAnonymous:   req.IsAnonymous,
+		Status:        models.PaymentPending,
+		MessageStatus: models.MessageVisible,
+		// Recorded up front so the expiry worker applies the right payment window
+		PaymentProvider: req.PaymentProvider,
+		SplitDonationID: req.SplitDonationID,
 	}
 
 	// Set donator ID if provided (for non-anonymous donations)
 	if req.DonatorID != nil {
 		donation.DonatorID = *req.DonatorID
 	}
+	return donation
+}
+
+// moderate screens the message and display name against the streamer's moderation
+// rules. It returns the result when the message is held for review.
+func (s *donationService) moderate(donation *models.Donation) (*models.ModerationResult, error) {
+	if s.moderator == nil {
+		return nil, nil
+	}
+	result, err := s.moderator.ModerateDonation(donation.StreamerID, donation.Message, donation.DisplayName)
+	if err != nil {
+		return nil, err
+	}
+	if result.Action == models.ModerationReject {
+		return nil, service.ErrMessageRejected
+	}
+	donation.Message = result.Message
+	donation.DisplayName = result.DisplayName
+	if result.Action != models.ModerationHold {
+		return nil, nil
+	}
+	donation.MessageStatus = models.MessageHeld
+	return result, nil
+}
 
+// storeDonation stores a screened donation and links it to its risk assessment and
+// review, when it has them
+func (s *donationService) storeDonation(donation *models.Donation, streamerCurrency models.SupportedCurrency, assessment *models.RiskAssessment, moderation *models.ModerationResult) error {
 	// Ensure users exist before creating donation
 	if err := s.ensureUsersExist(donation); err != nil {
-		return nil, err
+		return err
+	}
+
+	if err := s.snapshotExchangeRate(donation, streamerCurrency); err != nil {
+		// Not worth losing the donation over; the rate backfill records the snapshot later
+		fmt.Printf("Warning: Creating donation without an exchange-rate snapshot: %v\n", err)
 	}
 
 	// Create donation in database
 	if err := s.donationRepo.Create(donation); err != nil {
-		return nil, err
+		return err
+	}
+
+	if assessment != nil {
+		if err := s.riskEngine.LinkDonation(assessment, donation.ID); err != nil {
+			fmt.Printf("Warning: Failed to link donation %d to risk assessment %d: %v\n", donation.ID, assessment.ID, err)
+		}
+	}
+
+	if moderation != nil {
+		if _, err := s.moderator.QueueForReview(donation, moderation); err != nil {
+			// The message stays hidden; log so it can be released by hand
+			fmt.Printf("Warning: Failed to queue donation %d message for review: %v\n", donation.ID, err)
+		}
 	}
 
 	// Populate user data for response
@@ -102,8 +267,153 @@ func (s *donationService) CreateDonation(req *service.CreateDonationRequest) (*m
 		// Log the error but don't fail the donation creation
 		fmt.Printf("Warning: Failed to populate user data: %v\n", err)
 	}
+	return nil
+}
 
-	return donation, nil
+// announceDonation publishes a stored donation and starts translating its message
+func (s *donationService) announceDonation(donation *models.Donation, streamerLanguage models.SupportedLanguage) {
+	s.publishEvent(service.DonationEventCreated, donation)
+
+	// Translation calls an outside service, so it runs after the donation is created and
+	// must never hold it up; subscribers get the translation in an update event
+	if s.translator != nil && streamerLanguage != "" && strings.TrimSpace(donation.Message) != "" {
+		go s.translateMessage(*donation, streamerLanguage)
+	}
+}
+
+// failDonations marks donations of a split that could not be created in full failed, so
+// none of them is left waiting for a payment that never comes
+func (s *donationService) failDonations(donations []*models.Donation, reason string) {
+	change := service.StatusChange{Source: models.StatusSourceSystem, Reason: reason}
+	for _, donation := range donations {
+		if err := s.UpdateStatus(donation.ID, models.PaymentFailed, change); err != nil {
+			fmt.Printf("Warning: Failed to mark donation %d failed: %v\n", donation.ID, err)
+		}
+	}
+}
+
+// translateMessage stores a translation of the donation's message into the streamer's
+// language and publishes the stored donation. Failures are only logged: the donation
+// keeps its original message.
+func (s *donationService) translateMessage(donation models.Donation, language models.SupportedLanguage) {
+	translation, err := s.translator.TranslateMessage(context.Background(), donation.Message, language)
+	if err != nil {
+		fmt.Printf("Warning: Failed to translate message of donation %d: %v\n", donation.ID, err)
+		return
+	}
+
+	donation.ApplyTranslation(translation)
+	if err := s.donationRepo.SaveTranslation(&donation); err != nil {
+		fmt.Printf("Warning: Failed to save message translation of donation %d: %v\n", donation.ID, err)
+		return
+	}
+	if !translation.Translated() || s.eventBus == nil {
+		return
+	}
+
+	// The donation may have been paid while translating, so publish it as stored
+	stored, err := s.donationRepo.GetByID(donation.ID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load donation %d after translating its message: %v\n", donation.ID, err)
+		return
+	}
+	s.eventBus.Publish(&service.DonationEvent{
+		Type:      service.DonationEventUpdated,
+		Donation:  stored,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"status":               string(stored.Status),
+			"translation_language": string(translation.Language),
+		},
+	})
+}
+
+// convertSnapshot converts an amount already at snapshot rates from the snapshot currency
+// into currency, looking each rate up once through the rates cache
+func (s *donationService) convertSnapshot(amount models.Money, to models.SupportedCurrency, rates map[models.SupportedCurrency]float64) (models.Money, error) {
+	from := amount.Currency
+	if amount.Minor == 0 {
+		return models.NewMoney(0, to), nil
+	}
+
+	rate, ok := rates[from]
+	if !ok {
+		if s.currencyService == nil {
+			return models.Money{}, fmt.Errorf("failed to convert %s to %s: %w", from, to, service.ErrExchangeRateUnavailable)
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var err error
+		rate, err = s.currencyService.GetExchangeRate(ctx, from, to)
+		if err != nil {
+			return models.Money{}, fmt.Errorf("failed to convert %s to %s: %w", from, to, err)
+		}
+		rates[from] = rate
+	}
+	return amount.Convert(rate, to), nil
+}
+
+// snapshotExchangeRate records the rate from the donation's currency into the streamer's
+// currency, so later totals use the rate of the day the donation was made. Without a
+// rate only the streamer's currency is recorded, for the backfill to snapshot into.
+func (s *donationService) snapshotExchangeRate(donation *models.Donation, streamerCurrency models.SupportedCurrency) error {
+	if donation.Currency == "" {
+		donation.Currency = models.CurrencyIDR
+	}
+	if streamerCurrency == "" {
+		streamerCurrency = models.CurrencyIDR
+	}
+	donation.ConvertedCurrency = streamerCurrency
+
+	quote := &models.ExchangeRateQuote{
+		From:        donation.Currency,
+		To:          streamerCurrency,
+		Rate:        1,
+		Source:      models.RateSourceIdentity,
+		RetrievedAt: time.Now(),
+	}
+	if donation.Currency != streamerCurrency {
+		if s.currencyService == nil {
+			return service.ErrExchangeRateUnavailable
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		var err error
+		quote, err = s.currencyService.GetExchangeRateQuote(ctx, donation.Currency, streamerCurrency)
+		if err != nil || quote.Rate <= 0 {
+			fmt.Printf("Warning: No exchange rate from %s to %s for donation: %v\n", donation.Currency, streamerCurrency, err)
+			return service.ErrExchangeRateUnavailable
+		}
+	}
+
+	donation.ExchangeRate = quote.Rate
+	donation.ConvertedAmount = donation.Money().Convert(quote.Rate, streamerCurrency).Minor
+	donation.ConvertedCurrency = streamerCurrency
+	donation.RateSource = quote.Source
+	donation.RateTime = &quote.RetrievedAt
+	return nil
+}
+
+// publishEvent notifies real-time subscribers about a donation change
+func (s *donationService) publishEvent(eventType service.DonationEventType, donation *models.Donation) {
+	if s.eventBus == nil || donation == nil {
+		return
+	}
+
+	// Publish a copy so subscribers never race with callers mutating the donation
+	snapshot := *donation
+	s.eventBus.Publish(&service.DonationEvent{
+		Type:      eventType,
+		Donation:  &snapshot,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"status": string(donation.Status),
+		},
+	})
 }
 
 // populateUserData fetches user data via User Aggregator (cache + API)
@@ -277,8 +587,119 @@ func (s *donationService) GetByStreamerID(streamerID uint, page, pageSize int) (
 	return donations, nil
 }
 
-func (s *donationService) UpdateStatus(id uint, status models.PaymentStatus) error {
-	return s.donationRepo.UpdateStatus(id, status)
+const (
+	defaultDonationPageSize = 20
+	maxDonationPageSize     = 100
+)
+
+func (s *donationService) ListDonations(req *service.DonationListRequest) (*models.DonationPage, error) {
+	sortBy := req.SortBy
+	if sortBy == "" {
+		sortBy = models.DonationSortByTime
+	}
+	if sortBy != models.DonationSortByTime && sortBy != models.DonationSortByAmount {
+		return nil, fmt.Errorf("unsupported sort field: %s", sortBy)
+	}
+
+	order := req.Order
+	if order == "" {
+		order = models.SortDescending
+	}
+	if order != models.SortDescending && order != models.SortAscending {
+		return nil, fmt.Errorf("unsupported sort order: %s", order)
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultDonationPageSize
+	}
+	if limit > maxDonationPageSize {
+		limit = maxDonationPageSize
+	}
+
+	query := &models.DonationListQuery{
+		Filter: req.Filter,
+		SortBy: sortBy,
+		Order:  order,
+		Limit:  limit + 1, // One extra row tells whether another page follows
+	}
+
+	if req.Cursor != "" {
+		cursor, err := decodeDonationCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if cursor.SortBy != sortBy || cursor.Order != order {
+			return nil, fmt.Errorf("%w: issued for a different sort order", service.ErrInvalidDonationCursor)
+		}
+		query.After = cursor
+	}
+
+	donations, err := s.donationRepo.ListPage(query)
+	if err != nil {
+		return nil, err
+	}
+
+	page := &models.DonationPage{Donations: donations}
+	if len(donations) > limit {
+		page.Donations = donations[:limit]
+		page.HasMore = true
+
+		last := page.Donations[limit-1]
+		page.NextCursor, err = encodeDonationCursor(&models.DonationCursor{
+			SortBy:    sortBy,
+			Order:     order,
+			CreatedAt: last.CreatedAt,
+			Amount:    last.Amount,
+			ID:        last.ID,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, donation := range page.Donations {
+		if err := s.populateUserData(donation); err != nil {
+			fmt.Printf("Warning: Failed to populate user data for donation ID %d: %v\n", donation.ID, err)
+		}
+	}
+
+	return page, nil
+}
+
+// Cursors are opaque to clients: base64 encoded JSON of the last row's sort key
+func encodeDonationCursor(cursor *models.DonationCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeDonationCursor(encoded string) (*models.DonationCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, service.ErrInvalidDonationCursor
+	}
+
+	var cursor models.DonationCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, service.ErrInvalidDonationCursor
+	}
+	return &cursor, nil
+}
+
+func (s *donationService) UpdateStatus(id uint, status models.PaymentStatus, change service.StatusChange) error {
+	donation, err := s.donationRepo.GetByID(id)
+	if err != nil {
+		return err
+	}
+
+	return s.transitionStatus(donation, status, change)
+}
+
+func (s *donationService) GetStatusHistory(donationID uint) ([]*models.DonationStatusHistory, error) {
+	return s.donationRepo.GetStatusHistory(donationID)
 }
 
 func (s *donationService) ProcessPayment(donationID uint, transactionID string, provider models.PaymentProvider) error {
@@ -286,16 +707,65 @@ func (s *donationService) ProcessPayment(donationID uint, transactionID string,
 	if err != nil {
 		return err
 	}
+	if !donation.Status.CanTransitionTo(models.PaymentCompleted) {
+		return &service.StatusTransitionError{DonationID: donation.ID, From: donation.Status, To: models.PaymentCompleted}
+	}
 
 	// Update donation with payment info
 	donation.TransactionID = transactionID
 	donation.PaymentProvider = provider
-	donation.Status = models.PaymentCompleted
 	
 	now := time.Now()
 	donation.PaymentTime = &now
 
-	return s.donationRepo.Update(donation)
+	if err := s.donationRepo.SavePaymentDetails(donation); err != nil {
+		return err
+	}
+
+	return s.transitionStatus(donation, models.PaymentCompleted, service.StatusChange{
+		Source: models.StatusSourceSystem,
+		Reason: "payment processed",
+	})
+}
+
+// transitionStatus applies a state-machine checked status change, records it in the
+// donation's history and notifies subscribers
+func (s *donationService) transitionStatus(donation *models.Donation, status models.PaymentStatus, change service.StatusChange) error {
+	if donation.Status == status {
+		return nil
+	}
+	if !donation.Status.CanTransitionTo(status) {
+		return &service.StatusTransitionError{DonationID: donation.ID, From: donation.Status, To: status}
+	}
+
+	updated, err := s.donationRepo.TransitionStatus(donation.ID, donation.Status, status, &models.DonationStatusHistory{
+		Source:  change.Source,
+		ActorID: change.ActorID,
+		Reason:  change.Reason,
+	})
+	if err != nil {
+		return err
+	}
+	if !updated {
+		// Another update won the race; report against the status it left behind
+		current, err := s.donationRepo.GetByID(donation.ID)
+		if err != nil {
+			return err
+		}
+		if current.Status == status {
+			return nil
+		}
+		return &service.StatusTransitionError{DonationID: donation.ID, From: current.Status, To: status}
+	}
+
+	updatedDonation, err := s.donationRepo.GetByID(donation.ID)
+	if err != nil {
+		fmt.Printf("Warning: Could not load donation %d for status event: %v\n", donation.ID, err)
+		return nil
+	}
+	s.publishEvent(service.DonationEventTypeForStatus(status), updatedDonation)
+
+	return nil
 }
 
 func (s *donationService) GetLatestDonations(limit int) ([]*models.Donation, error) {
@@ -314,6 +784,111 @@ func (s *donationService) GetLatestDonations(limit int) ([]*models.Donation, err
 	return donations, nil
 }
 
-func (s *donationService) GetTotalAmountByStreamer(streamerID uint) (float64, error) {
-	return s.donationRepo.GetTotalAmountByStreamer(streamerID)
-} 
\ No newline at end of file
+func (s *donationService) GetTotalAmountByStreamer(streamerID uint, currency models.SupportedCurrency) (int64, error) {
+	if currency == "" {
+		currency = models.CurrencyIDR
+	}
+
+	totals, err := s.donationRepo.GetTotalAmountByStreamer(streamerID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Snapshots in another currency, from before the streamer changed theirs or still
+	// waiting for a rate, are converted at today's rate
+	rates := map[models.SupportedCurrency]float64{currency: 1}
+	var total int64
+	for _, amount := range totals {
+		converted, err := s.convertSnapshot(amount, currency, rates)
+		if err != nil {
+			return 0, err
+		}
+		total += converted.Minor
+	}
+	return total, nil
+}
+
+// defaultStatsWindow is used when a stats request has no start date
+const defaultStatsWindow = 30 * 24 * time.Hour
+
+func (s *donationService) GetDonationStats(req *service.DonationStatsRequest) (*models.DonationStats, error) {
+	if req.StreamerID == 0 {
+		return nil, fmt.Errorf("%w: streamer ID is required", service.ErrInvalidDonationStats)
+	}
+
+	interval := req.Interval
+	if interval == "" {
+		interval = models.StatsIntervalDay
+	}
+	if !interval.IsValid() {
+		return nil, fmt.Errorf("%w: unsupported stats interval: %s", service.ErrInvalidDonationStats, interval)
+	}
+
+	end := req.EndDate
+	if end.IsZero() {
+		end = time.Now()
+	}
+	start := req.StartDate
+	if start.IsZero() {
+		start = end.Add(-defaultStatsWindow)
+	}
+	if !start.Before(end) {
+		return nil, fmt.Errorf("%w: start date must be before end date", service.ErrInvalidDonationStats)
+	}
+
+	buckets, err := s.donationRepo.GetStatsBuckets(req.StreamerID, interval, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		currency = models.CurrencyIDR
+	}
+
+	stats := &models.DonationStats{
+		StreamerID: req.StreamerID,
+		Interval:   interval,
+		StartDate:  start,
+		EndDate:    end,
+		Currency:   currency,
+		Buckets:    buckets,
+	}
+
+	// Snapshots are already in the streamer's currency unless it has since changed;
+	// only those are converted, at one rate per currency for the whole report
+	rates := map[models.SupportedCurrency]float64{currency: 1}
+	totals := make(map[models.SupportedCurrency]*models.CurrencyTotal)
+	for _, bucket := range buckets {
+		converted, err := s.convertSnapshot(models.NewMoney(bucket.ConvertedAmount, bucket.ConvertedCurrency), currency, rates)
+		if err != nil {
+			return nil, err
+		}
+		stats.TotalAmount += converted.Minor
+		stats.TotalDonations += bucket.Count
+
+		total, ok := totals[bucket.Currency]
+		if !ok {
+			total = &models.CurrencyTotal{Currency: bucket.Currency}
+			totals[bucket.Currency] = total
+			stats.CurrencyTotals = append(stats.CurrencyTotals, total)
+		}
+		total.TotalAmount += bucket.TotalAmount
+		total.ConvertedAmount += converted.Minor
+		total.Count += bucket.Count
+	}
+
+	sort.Slice(stats.CurrencyTotals, func(i, j int) bool {
+		return stats.CurrencyTotals[i].Currency < stats.CurrencyTotals[j].Currency
+	})
+	for _, total := range stats.CurrencyTotals {
+		if total.Count > 0 {
+			total.AverageAmount = models.NewMoney(total.TotalAmount, total.Currency).Div(total.Count).Minor
+		}
+	}
+	if stats.TotalDonations > 0 {
+		stats.AverageAmount = models.NewMoney(stats.TotalAmount, currency).Div(stats.TotalDonations).Minor
+	}
+
+	return stats, nil
+}
@@ -0,0 +1,43 @@
+package serviceImpl
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+type fakeDisbursementProvider struct{}
+
+// NewFakeDisbursementProvider creates a disbursement provider for development that sends
+// no money. Every payout is paid at once, except to account numbers ending in 000, which
+// fail so the failure path can be tried out.
+func NewFakeDisbursementProvider() service.DisbursementProvider {
+	return &fakeDisbursementProvider{}
+}
+
+func (p *fakeDisbursementProvider) Name() string {
+	return "fake"
+}
+
+func (p *fakeDisbursementProvider) Disburse(ctx context.Context, payout *models.Payout) (*service.DisbursementResult, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	reference := fmt.Sprintf("FAKE-%d-%d", payout.ID, time.Now().Unix())
+	if strings.HasSuffix(payout.AccountNumber, "000") {
+		return &service.DisbursementResult{
+			Reference:     reference,
+			Status:        models.PayoutFailed,
+			FailureReason: "account rejected the transfer (fake provider)",
+		}, nil
+	}
+	return &service.DisbursementResult{
+		Reference: reference,
+		Status:    models.PayoutPaid,
+	}, nil
+}
@@ -0,0 +1,92 @@
+package serviceImpl
+
+import (
+	"errors"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+	"gorm.io/gorm"
+)
+
+type idempotencyService struct {
+	repo repository.IdempotencyRepository
+	ttl  time.Duration
+}
+
+// NewIdempotencyService keeps each key for ttl after its first use
+func NewIdempotencyService(repo repository.IdempotencyRepository, ttl time.Duration) service.IdempotencyService {
+	return &idempotencyService{
+		repo: repo,
+		ttl:  ttl,
+	}
+}
+
+func (s *idempotencyService) Begin(scope, key, requestHash string) (*models.IdempotencyRecord, error) {
+	// Two attempts: the second runs after clearing an expired record for the same key
+	for attempt := 0; attempt < 2; attempt++ {
+		now := time.Now()
+		record := &models.IdempotencyRecord{
+			Scope:       scope,
+			Key:         key,
+			RequestHash: requestHash,
+			ExpiresAt:   now.Add(s.ttl),
+		}
+
+		created, err := s.repo.Create(record)
+		if err != nil {
+			return nil, err
+		}
+		if created {
+			return record, nil
+		}
+
+		existing, err := s.repo.Get(scope, key)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			// Released or purged between the insert and the lookup
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !existing.ExpiresAt.After(now) {
+			if err := s.repo.Delete(existing.ID); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		if existing.RequestHash != requestHash {
+			return nil, models.ErrIdempotencyKeyReused
+		}
+		if !existing.IsCompleted() {
+			return nil, models.ErrIdempotencyRequestInProgress
+		}
+		return existing, nil
+	}
+
+	return nil, models.ErrIdempotencyRequestInProgress
+}
+
+func (s *idempotencyService) Complete(record *models.IdempotencyRecord, statusCode int, contentType string, body []byte) error {
+	if err := s.repo.Complete(record.ID, statusCode, contentType, body); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	record.StatusCode = statusCode
+	record.ContentType = contentType
+	record.ResponseBody = body
+	record.CompletedAt = &now
+	return nil
+}
+
+func (s *idempotencyService) Release(record *models.IdempotencyRecord) error {
+	return s.repo.Delete(record.ID)
+}
+
+func (s *idempotencyService) PurgeExpired() (int64, error) {
+	return s.repo.DeleteExpired(time.Now())
+}
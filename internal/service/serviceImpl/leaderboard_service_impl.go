@@ -0,0 +1,180 @@
+package serviceImpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+const (
+	defaultLeaderboardLimit = 10
+	maxLeaderboardLimit     = 100
+	anonymousDisplayName    = "Anonymous"
+)
+
+type leaderboardService struct {
+	donationRepo    repository.DonationRepository
+	currencyService service.CurrencyService
+}
+
+func NewLeaderboardService(donationRepo repository.DonationRepository, currencyService service.CurrencyService) service.LeaderboardService {
+	return &leaderboardService{
+		donationRepo:    donationRepo,
+		currencyService: currencyService,
+	}
+}
+
+func (s *leaderboardService) GetLeaderboard(req *service.LeaderboardRequest) (*models.DonationLeaderboard, error) {
+	if req.StreamerID == 0 {
+		return nil, errors.New("streamer ID is required")
+	}
+
+	period := req.Period
+	if period == "" {
+		period = models.LeaderboardAllTime
+	}
+	if !period.IsValid() {
+		return nil, fmt.Errorf("unsupported leaderboard period: %s", period)
+	}
+
+	currency := req.Currency
+	if currency == "" {
+		currency = models.CurrencyIDR
+	}
+	if err := service.ValidateCurrency(currency); err != nil {
+		return nil, err
+	}
+
+	limit := req.Limit
+	if limit <= 0 {
+		limit = defaultLeaderboardLimit
+	}
+	if limit > maxLeaderboardLimit {
+		limit = maxLeaderboardLimit
+	}
+
+	start, end, err := leaderboardWindow(period, req.StartDate, req.EndDate, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	totals, err := s.donationRepo.GetDonorTotals(req.StreamerID, start, end, req.GroupAnonymous)
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := s.rankDonors(totals, currency)
+	if err != nil {
+		return nil, err
+	}
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	return &models.DonationLeaderboard{
+		StreamerID: req.StreamerID,
+		Period:     period,
+		Currency:   currency,
+		StartDate:  start,
+		EndDate:    end,
+		Entries:    entries,
+	}, nil
+}
+
+// rankDonors merges each donor's per-currency totals into the target currency and
+// orders them by total. Ties go to whoever donated first, then to the lower donator ID,
+// so the order is stable between requests.
+func (s *leaderboardService) rankDonors(totals []*models.DonorCurrencyTotal, currency models.SupportedCurrency) ([]*models.LeaderboardEntry, error) {
+	ctx := context.Background()
+	byDonor := make(map[uint]*models.LeaderboardEntry)
+	entries := []*models.LeaderboardEntry{}
+
+	for _, total := range totals {
+		amount := total.TotalAmount
+		if total.Currency != currency {
+			converted, err := s.currencyService.ConvertMoney(ctx, models.NewMoney(amount, total.Currency), currency)
+			if err != nil {
+				return nil, fmt.Errorf("failed to convert %s to %s: %w", total.Currency, currency, err)
+			}
+			amount = converted.Minor
+		}
+
+		entry, ok := byDonor[total.DonatorID]
+		if !ok {
+			entry = &models.LeaderboardEntry{
+				DonatorID:      total.DonatorID,
+				DisplayName:    total.DisplayName,
+				IsAnonymous:    total.IsAnonymous,
+				FirstDonatedAt: total.FirstDonatedAt,
+				LastDonatedAt:  total.LastDonatedAt,
+			}
+			if total.IsAnonymous {
+				entry.DisplayName = anonymousDisplayName
+			}
+			byDonor[total.DonatorID] = entry
+			entries = append(entries, entry)
+		}
+
+		entry.TotalAmount += amount
+		entry.DonationCount += total.Count
+		if total.FirstDonatedAt.Before(entry.FirstDonatedAt) {
+			entry.FirstDonatedAt = total.FirstDonatedAt
+		}
+		if total.LastDonatedAt.After(entry.LastDonatedAt) {
+			entry.LastDonatedAt = total.LastDonatedAt
+			if !total.IsAnonymous {
+				entry.DisplayName = total.DisplayName
+			}
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.TotalAmount != b.TotalAmount {
+			return a.TotalAmount > b.TotalAmount
+		}
+		if !a.FirstDonatedAt.Equal(b.FirstDonatedAt) {
+			return a.FirstDonatedAt.Before(b.FirstDonatedAt)
+		}
+		return a.DonatorID < b.DonatorID
+	})
+
+	for i, entry := range entries {
+		entry.Rank = i + 1
+	}
+	return entries, nil
+}
+
+// leaderboardWindow resolves the half-open time range a period covers. Monthly and
+// weekly leaderboards follow the calendar, matching the buckets used by donation stats.
+func leaderboardWindow(period models.LeaderboardPeriod, sessionStart, sessionEnd, now time.Time) (*time.Time, time.Time, error) {
+	switch period {
+	case models.LeaderboardMonthly:
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return &start, now, nil
+	case models.LeaderboardWeekly:
+		daysSinceMonday := (int(now.Weekday()) + 6) % 7
+		start := time.Date(now.Year(), now.Month(), now.Day()-daysSinceMonday, 0, 0, 0, 0, now.Location())
+		return &start, now, nil
+	case models.LeaderboardSession:
+		if sessionStart.IsZero() {
+			return nil, time.Time{}, errors.New("session leaderboards require the session start time")
+		}
+		end := sessionEnd
+		if end.IsZero() || end.After(now) {
+			end = now
+		}
+		if !sessionStart.Before(end) {
+			return nil, time.Time{}, errors.New("session start must be before its end")
+		}
+		return &sessionStart, end, nil
+	default:
+		return nil, now, nil
+	}
+}
@@ -0,0 +1,72 @@
+package serviceImpl
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+func TestLeaderboardWindow(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+	// A Wednesday
+	now := time.Date(2024, time.May, 15, 18, 30, 0, 0, jakarta)
+	date := func(year int, month time.Month, day, hour int) time.Time {
+		return time.Date(year, month, day, hour, 0, 0, 0, jakarta)
+	}
+
+	tests := []struct {
+		name         string
+		period       models.LeaderboardPeriod
+		sessionStart time.Time
+		sessionEnd   time.Time
+		now          time.Time
+		wantStart    *time.Time
+		wantEnd      time.Time
+		wantErr      bool
+	}{
+		{name: "all time has no start", period: models.LeaderboardAllTime, now: now, wantEnd: now},
+		{name: "monthly starts on the first", period: models.LeaderboardMonthly, now: now, wantStart: timePtr(date(2024, time.May, 1, 0)), wantEnd: now},
+		{name: "weekly starts on monday", period: models.LeaderboardWeekly, now: now, wantStart: timePtr(date(2024, time.May, 13, 0)), wantEnd: now},
+		{name: "weekly on a monday starts that day", period: models.LeaderboardWeekly, now: date(2024, time.May, 13, 9), wantStart: timePtr(date(2024, time.May, 13, 0)), wantEnd: date(2024, time.May, 13, 9)},
+		{name: "weekly on a sunday starts the monday before", period: models.LeaderboardWeekly, now: date(2024, time.May, 19, 23), wantStart: timePtr(date(2024, time.May, 13, 0)), wantEnd: date(2024, time.May, 19, 23)},
+		{name: "weekly across a month boundary", period: models.LeaderboardWeekly, now: date(2024, time.June, 1, 12), wantStart: timePtr(date(2024, time.May, 27, 0)), wantEnd: date(2024, time.June, 1, 12)},
+		{name: "ended session", period: models.LeaderboardSession, sessionStart: date(2024, time.May, 14, 19), sessionEnd: date(2024, time.May, 14, 23), now: now, wantStart: timePtr(date(2024, time.May, 14, 19)), wantEnd: date(2024, time.May, 14, 23)},
+		{name: "live session ends now", period: models.LeaderboardSession, sessionStart: date(2024, time.May, 15, 17), now: now, wantStart: timePtr(date(2024, time.May, 15, 17)), wantEnd: now},
+		{name: "session end in the future is capped at now", period: models.LeaderboardSession, sessionStart: date(2024, time.May, 15, 17), sessionEnd: date(2024, time.May, 15, 22), now: now, wantStart: timePtr(date(2024, time.May, 15, 17)), wantEnd: now},
+		{name: "session without a start", period: models.LeaderboardSession, now: now, wantErr: true},
+		{name: "session ending before it starts", period: models.LeaderboardSession, sessionStart: date(2024, time.May, 14, 23), sessionEnd: date(2024, time.May, 14, 19), now: now, wantErr: true},
+		{name: "session starting in the future", period: models.LeaderboardSession, sessionStart: date(2024, time.May, 16, 19), now: now, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := leaderboardWindow(tt.period, tt.sessionStart, tt.sessionEnd, tt.now)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("leaderboardWindow() = %v, %v, want an error", start, end)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("leaderboardWindow() error = %v", err)
+			}
+
+			switch {
+			case tt.wantStart == nil && start != nil:
+				t.Errorf("start = %v, want none", *start)
+			case tt.wantStart != nil && start == nil:
+				t.Errorf("start = none, want %v", *tt.wantStart)
+			case tt.wantStart != nil && !start.Equal(*tt.wantStart):
+				t.Errorf("start = %v, want %v", *start, *tt.wantStart)
+			}
+			if !end.Equal(tt.wantEnd) {
+				t.Errorf("end = %v, want %v", end, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func timePtr(t time.Time) *time.Time {
+	return &t
+}
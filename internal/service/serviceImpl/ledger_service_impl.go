@@ -0,0 +1,291 @@
+package serviceImpl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+type ledgerPoster struct {
+	ledgerRepo   repository.LedgerRepository
+	donationRepo repository.DonationRepository
+	refundRepo   repository.RefundRepository
+	fees         service.LedgerFeeSchedules
+	batchSize    int
+}
+
+// NewLedgerPoster creates the ledger poster and, when an event bus is given, registers it
+// so completed donations and refunds are posted as they happen. batchSize bounds how many
+// records a reconciliation loads at once.
+func NewLedgerPoster(ledgerRepo repository.LedgerRepository, donationRepo repository.DonationRepository, refundRepo repository.RefundRepository, fees service.LedgerFeeSchedules, eventBus service.DonationEventBus, batchSize int) service.LedgerPoster {
+	s := &ledgerPoster{
+		ledgerRepo:   ledgerRepo,
+		donationRepo: donationRepo,
+		refundRepo:   refundRepo,
+		fees:         fees,
+		batchSize:    batchSize,
+	}
+	if eventBus != nil {
+		eventBus.AddListener(s)
+	}
+	return s
+}
+
+// HandleDonationEvent posts completed donations and the refunds announced by the refund
+// service
+func (s *ledgerPoster) HandleDonationEvent(event *service.DonationEvent) {
+	switch {
+	case event.Type == service.DonationEventCompleted:
+		if _, err := s.PostPayment(event.Donation); err != nil {
+			fmt.Printf("Warning: Failed to post donation %d to the ledger: %v\n", event.Donation.ID, err)
+		}
+
+	case event.Type == service.DonationEventUpdated && event.Metadata["refund_id"] != "":
+		refundID, err := strconv.ParseUint(event.Metadata["refund_id"], 10, 32)
+		if err != nil {
+			fmt.Printf("Warning: Invalid refund ID %q in donation event: %v\n", event.Metadata["refund_id"], err)
+			return
+		}
+		refund, err := s.refundRepo.GetByID(uint(refundID))
+		if err != nil {
+			fmt.Printf("Warning: Failed to load refund %d for the ledger: %v\n", refundID, err)
+			return
+		}
+		if _, err := s.PostRefund(refund); err != nil {
+			fmt.Printf("Warning: Failed to post refund %d to the ledger: %v\n", refund.ID, err)
+		}
+	}
+}
+
+func (s *ledgerPoster) PostPayment(donation *models.Donation) (*models.LedgerEntry, error) {
+	if donation.Status != models.PaymentCompleted && donation.Status != models.PaymentRefunded {
+		return nil, fmt.Errorf("cannot post a %s donation to the ledger", donation.Status)
+	}
+
+	amount := donation.Money()
+	schedule := s.fees.For(donation.PaymentProvider)
+	platformFee := capFee(amount.BasisPoints(schedule.PlatformBasisPoints), amount)
+	providerFee := amount.BasisPoints(schedule.ProviderBasisPoints)
+	if schedule.ProviderFixed.Currency == amount.Currency {
+		providerFee = providerFee.Add(schedule.ProviderFixed)
+	}
+	providerFee = capFee(providerFee, amount)
+
+	entry := &models.LedgerEntry{
+		Reference:   models.LedgerEntryReference("donation", donation.ID, models.LedgerEntryPayment),
+		Type:        models.LedgerEntryPayment,
+		StreamerID:  donation.StreamerID,
+		DonationID:  donation.ID,
+		Currency:    amount.Currency,
+		Description: fmt.Sprintf("Donation #%d via %s", donation.ID, donation.PaymentProvider),
+	}
+	entry.Lines = nonZeroLines(
+		models.LedgerLine{Account: models.LedgerAccountProviderClearing, Provider: donation.PaymentProvider, Amount: amount.Minor - providerFee.Minor},
+		models.LedgerLine{Account: models.LedgerAccountProviderFee, Provider: donation.PaymentProvider, Amount: providerFee.Minor},
+		models.LedgerLine{Account: models.LedgerAccountPlatformFee, Amount: -platformFee.Minor},
+		models.LedgerLine{Account: models.LedgerAccountStreamerBalance, StreamerID: donation.StreamerID, Amount: -(amount.Minor - platformFee.Minor)},
+	)
+	return s.post(entry)
+}
+
+func (s *ledgerPoster) PostRefund(refund *models.DonationRefund) (*models.LedgerEntry, error) {
+	if refund.Status != models.RefundSucceeded {
+		return nil, fmt.Errorf("cannot post a %s refund to the ledger", refund.Status)
+	}
+
+	donation, err := s.donationRepo.GetByID(refund.DonationID)
+	if err != nil {
+		return nil, fmt.Errorf("donation %d of refund %d not found: %w", refund.DonationID, refund.ID, err)
+	}
+	// The platform fee returned is a share of the fee actually charged, so the payment
+	// has to be in the ledger first
+	payment, err := s.PostPayment(donation)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the platform fee in proportion to everything refunded so far, so rounding
+	// never leaves a fee behind on a fully refunded donation. Earlier refunds are the ones
+	// with a lower ID, whatever order they are posted in.
+	refunds, err := s.refundRepo.GetByDonationID(donation.ID)
+	if err != nil {
+		return nil, err
+	}
+	var refundedBefore int64
+	for _, earlier := range refunds {
+		if earlier.ID < refund.ID && earlier.Status == models.RefundSucceeded {
+			refundedBefore += earlier.Amount
+		}
+	}
+	if refundedBefore+refund.Amount > donation.Amount {
+		return nil, fmt.Errorf("refunds of donation %d exceed its amount", donation.ID)
+	}
+
+	platformFee := models.NewMoney(-sumLines(payment, models.LedgerAccountPlatformFee), donation.Currency)
+	feeReturned := platformFeeShare(platformFee, refundedBefore+refund.Amount, donation.Amount) -
+		platformFeeShare(platformFee, refundedBefore, donation.Amount)
+
+	entryType, label := models.LedgerEntryRefund, "Refund"
+	if refund.Chargeback {
+		entryType, label = models.LedgerEntryChargeback, "Chargeback"
+	}
+	entry := &models.LedgerEntry{
+		Reference:   models.LedgerEntryReference("refund", refund.ID, entryType),
+		Type:        entryType,
+		StreamerID:  donation.StreamerID,
+		DonationID:  donation.ID,
+		RefundID:    refund.ID,
+		Currency:    donation.Currency,
+		Description: fmt.Sprintf("%s #%d of donation #%d", label, refund.ID, donation.ID),
+	}
+	entry.Lines = nonZeroLines(
+		models.LedgerLine{Account: models.LedgerAccountProviderClearing, Provider: donation.PaymentProvider, Amount: -refund.Amount},
+		models.LedgerLine{Account: models.LedgerAccountPlatformFee, Amount: feeReturned},
+		models.LedgerLine{Account: models.LedgerAccountStreamerBalance, StreamerID: donation.StreamerID, Amount: refund.Amount - feeReturned},
+	)
+	return s.post(entry)
+}
+
+func (s *ledgerPoster) ReconcileLedger() (int, error) {
+	posted := 0
+	var errs []error
+
+	// Payments first, so refunds find the fees they return
+	for afterID := uint(0); ; {
+		donations, err := s.ledgerRepo.GetUnpostedPayments(afterID, s.batchSize)
+		if err != nil {
+			return posted, err
+		}
+		for _, donation := range donations {
+			afterID = donation.ID
+			if _, err := s.PostPayment(donation); err != nil {
+				errs = append(errs, fmt.Errorf("donation %d: %w", donation.ID, err))
+				continue
+			}
+			posted++
+		}
+		if len(donations) < s.batchSize {
+			break
+		}
+	}
+
+	for afterID := uint(0); ; {
+		refunds, err := s.ledgerRepo.GetUnpostedRefunds(afterID, s.batchSize)
+		if err != nil {
+			return posted, err
+		}
+		for _, refund := range refunds {
+			afterID = refund.ID
+			if _, err := s.PostRefund(refund); err != nil {
+				errs = append(errs, fmt.Errorf("refund %d: %w", refund.ID, err))
+				continue
+			}
+			posted++
+		}
+		if len(refunds) < s.batchSize {
+			break
+		}
+	}
+
+	return posted, errors.Join(errs...)
+}
+
+// post checks that an entry balances and stores it, returning the stored entry when the
+// same event was already posted
+func (s *ledgerPoster) post(entry *models.LedgerEntry) (*models.LedgerEntry, error) {
+	if err := balanceLedgerEntry(entry); err != nil {
+		return nil, err
+	}
+
+	posted, err := s.ledgerRepo.Post(entry)
+	if err != nil {
+		return nil, err
+	}
+	if posted {
+		return entry, nil
+	}
+
+	existing, err := s.ledgerRepo.GetByReference(entry.Reference)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, fmt.Errorf("ledger entry %s was neither posted nor found", entry.Reference)
+	}
+	return existing, err
+}
+
+type ledgerService struct {
+	ledgerRepo repository.LedgerRepository
+}
+
+func NewLedgerService(ledgerRepo repository.LedgerRepository) service.LedgerService {
+	return &ledgerService{ledgerRepo: ledgerRepo}
+}
+
+func (s *ledgerService) GetStreamerBalances(streamerID uint) ([]*models.StreamerBalance, error) {
+	return s.ledgerRepo.GetStreamerBalances(streamerID)
+}
+
+func (s *ledgerService) GetStreamerEntries(streamerID uint, page, pageSize int) ([]*models.LedgerEntry, error) {
+	return s.ledgerRepo.GetByStreamerID(streamerID, page, pageSize)
+}
+
+// balanceLedgerEntry puts every line in the entry's currency and checks that the lines
+// add up to zero
+func balanceLedgerEntry(entry *models.LedgerEntry) error {
+	var total int64
+	for i := range entry.Lines {
+		entry.Lines[i].Currency = entry.Currency
+		total += entry.Lines[i].Amount
+	}
+	if total != 0 || len(entry.Lines) == 0 {
+		return fmt.Errorf("%w: %s is off by %d", service.ErrUnbalancedLedgerEntry, entry.Reference, total)
+	}
+	return nil
+}
+
+// capFee keeps a fee between zero and the amount it is charged on
+func capFee(fee, amount models.Money) models.Money {
+	if fee.Minor > amount.Minor {
+		return amount
+	}
+	if fee.Minor < 0 {
+		return models.NewMoney(0, amount.Currency)
+	}
+	return fee
+}
+
+// platformFeeShare is the part of a donation's platform fee that belongs to refunded out
+// of its total amount
+func platformFeeShare(fee models.Money, refunded, total int64) int64 {
+	if refunded <= 0 || fee.Minor == 0 {
+		return 0
+	}
+	return fee.Allocate([]int64{refunded, total - refunded})[0].Minor
+}
+
+// sumLines adds up an entry's lines in one account
+func sumLines(entry *models.LedgerEntry, account models.LedgerAccount) int64 {
+	var total int64
+	for _, line := range entry.Lines {
+		if line.Account == account {
+			total += line.Amount
+		}
+	}
+	return total
+}
+
+// nonZeroLines drops lines that move nothing, such as a fee of zero
+func nonZeroLines(lines ...models.LedgerLine) []models.LedgerLine {
+	kept := lines[:0]
+	for _, line := range lines {
+		if line.Amount != 0 {
+			kept = append(kept, line)
+		}
+	}
+	return kept
+}
@@ -0,0 +1,373 @@
+package serviceImpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+// maxMatchRatioBasisPoints bounds a campaign's ratio at 10:1
+const maxMatchRatioBasisPoints = 10 * models.MatchRatioOneToOne
+
+type matchCampaignService struct {
+	campaignRepo    repository.MatchCampaignRepository
+	currencyService service.CurrencyService  // Optional, converts donations into a campaign's currency
+	eventBus        service.DonationEventBus // Optional, publishes the sponsors' donations
+}
+
+// NewMatchCampaignService creates the matching campaign service and, when an event bus is
+// given, registers it so completed donations are matched as they are published. The
+// sponsors' donations are published on the bus as completed donations in turn.
+func NewMatchCampaignService(campaignRepo repository.MatchCampaignRepository, currencyService service.CurrencyService, eventBus service.DonationEventBus) service.MatchCampaignService {
+	s := &matchCampaignService{
+		campaignRepo:    campaignRepo,
+		currencyService: currencyService,
+		eventBus:        eventBus,
+	}
+	if eventBus != nil {
+		eventBus.AddListener(s)
+	}
+	return s
+}
+
+// HandleDonationEvent matches completed donations, except the sponsors' own, and takes
+// back the matches of the donations the refund service refunded
+func (s *matchCampaignService) HandleDonationEvent(event *service.DonationEvent) {
+	if event.Donation.IsMatch() {
+		return
+	}
+
+	switch {
+	case event.Type == service.DonationEventCompleted:
+		if _, err := s.MatchDonation(event.Donation); err != nil {
+			fmt.Printf("Warning: Failed to match donation %d: %v\n", event.Donation.ID, err)
+		}
+
+	case event.Type == service.DonationEventUpdated && event.Metadata["refund_id"] != "":
+		if _, err := s.RefundMatches(event.Donation.ID); err != nil {
+			fmt.Printf("Warning: Failed to refund the matches of donation %d: %v\n", event.Donation.ID, err)
+		}
+	}
+}
+
+func (s *matchCampaignService) CreateCampaign(req *service.MatchCampaignRequest) (*models.MatchCampaign, error) {
+	campaign, err := newMatchCampaign(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.campaignRepo.Create(campaign); err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+func (s *matchCampaignService) UpdateCampaign(id uint, req *service.MatchCampaignRequest) (*models.MatchCampaign, error) {
+	if _, err := s.campaignRepo.GetByID(id); err != nil {
+		return nil, err
+	}
+
+	campaign, err := newMatchCampaign(req)
+	if err != nil {
+		return nil, err
+	}
+	campaign.ID = id
+	updated, err := s.campaignRepo.UpdateTerms(campaign)
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, service.ErrMatchCampaignSpent
+	}
+	return s.campaignRepo.GetByID(id)
+}
+
+func (s *matchCampaignService) GetCampaign(id uint) (*models.MatchCampaign, error) {
+	return s.campaignRepo.GetByID(id)
+}
+
+func (s *matchCampaignService) ListCampaigns(page, pageSize int) ([]*models.MatchCampaign, error) {
+	return s.campaignRepo.List(page, pageSize)
+}
+
+func (s *matchCampaignService) GetReport(id uint) (*models.MatchCampaignReport, error) {
+	campaign, err := s.campaignRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	totals, err := s.campaignRepo.GetStreamerTotals(id)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &models.MatchCampaignReport{
+		Campaign:  campaign,
+		Remaining: campaign.Remaining().Minor,
+		Streamers: totals,
+	}
+	for _, total := range totals {
+		report.DonationAmount += total.DonationAmount
+		report.MatchedAmount += total.MatchedAmount
+	}
+	return report, nil
+}
+
+func (s *matchCampaignService) MatchDonation(donation *models.Donation) ([]*models.Donation, error) {
+	if donation.Status != models.PaymentCompleted {
+		return nil, fmt.Errorf("cannot match a %s donation", donation.Status)
+	}
+	if donation.IsMatch() {
+		return nil, errors.New("a sponsor's match cannot be matched again")
+	}
+
+	// Campaigns match the donations completed within their window
+	completedAt := time.Now()
+	if donation.PaymentTime != nil {
+		completedAt = *donation.PaymentTime
+	}
+	campaigns, err := s.campaignRepo.ListRunning(completedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	var matches []*models.Donation
+	var errs []error
+	for _, campaign := range campaigns {
+		if !campaign.Covers(donation.StreamerID) {
+			continue
+		}
+		match, err := s.matchFromCampaign(campaign, donation, completedAt)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("campaign %d: %w", campaign.ID, err))
+			continue
+		}
+		if match != nil {
+			matches = append(matches, match)
+			s.publishMatch(match)
+		}
+	}
+	return matches, errors.Join(errs...)
+}
+
+// matchFromCampaign stores the campaign's match of a donation, or returns nil when the
+// campaign already matched it or has stopped running since it was listed
+func (s *matchCampaignService) matchFromCampaign(campaign *models.MatchCampaign, donation *models.Donation, completedAt time.Time) (*models.Donation, error) {
+	amount, err := s.amountIn(donation, campaign.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	var match *models.Donation
+	created, err := s.campaignRepo.CreateMatch(campaign.ID, donation.ID, func(locked *models.MatchCampaign) (*models.DonationMatch, *models.Donation) {
+		// The locked row has the spending of every match made since the campaign was listed
+		if !locked.IsRunning(completedAt) {
+			return nil, nil
+		}
+		matched := locked.MatchAmount(amount)
+		if !matched.IsPositive() {
+			return nil, nil
+		}
+
+		now := time.Now()
+		match = &models.Donation{
+			Amount:            matched.Minor,
+			Currency:          matched.Currency,
+			StreamerID:        donation.StreamerID,
+			DisplayName:       locked.SponsorName,
+			Status:            models.PaymentCompleted,
+			PaymentProvider:   models.PaymentProviderSponsor,
+			TransactionID:     "MATCH-" + strconv.FormatUint(uint64(locked.ID), 10) + "-" + strconv.FormatUint(uint64(donation.ID), 10),
+			PaymentTime:       &now,
+			MessageStatus:     models.MessageVisible,
+			MatchCampaignID:   locked.ID,
+			MatchedDonationID: donation.ID,
+		}
+		snapshotMatchRate(match, donation)
+
+		return &models.DonationMatch{
+			StreamerID:     donation.StreamerID,
+			Currency:       matched.Currency,
+			DonationAmount: amount,
+			Amount:         matched.Minor,
+		}, match
+	})
+	if err != nil || !created {
+		return nil, err
+	}
+	return match, nil
+}
+
+func (s *matchCampaignService) RefundMatches(donationID uint) ([]*models.DonationRefund, error) {
+	sponsors := make(map[uint]*models.Donation)
+	refunds, err := s.campaignRepo.RefundMatches(donationID, func(match *models.DonationMatch, donation, sponsor *models.Donation) *models.DonationRefund {
+		owed := match.RefundOwed(donation.RefundedAmount, donation.Amount) - match.RefundedAmount
+		amount := min(owed, sponsor.Amount-sponsor.RefundedAmount)
+		if amount <= 0 {
+			return nil
+		}
+
+		sponsors[sponsor.ID] = sponsor
+		now := time.Now()
+		return &models.DonationRefund{
+			Amount:      amount,
+			Currency:    sponsor.Currency,
+			Reason:      fmt.Sprintf("Matched donation #%d refunded", donation.ID),
+			Status:      models.RefundSucceeded,
+			Provider:    sponsor.PaymentProvider,
+			ProcessedAt: &now,
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, refund := range refunds {
+		s.publishMatchRefund(sponsors[refund.DonationID], refund)
+	}
+	return refunds, nil
+}
+
+// amountIn returns the donation's amount in a campaign's currency, preferring the
+// donation's own exchange-rate snapshot
+func (s *matchCampaignService) amountIn(donation *models.Donation, currency models.SupportedCurrency) (int64, error) {
+	switch {
+	case donation.Currency == currency:
+		return donation.Amount, nil
+	case donation.HasRateSnapshot() && donation.ConvertedCurrency == currency:
+		return donation.ConvertedAmount, nil
+	case s.currencyService == nil:
+		return 0, service.ErrExchangeRateUnavailable
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	rate, err := s.currencyService.GetExchangeRate(ctx, donation.Currency, currency)
+	if err != nil || rate <= 0 {
+		return 0, fmt.Errorf("%w: %v", service.ErrExchangeRateUnavailable, err)
+	}
+	return donation.Money().Convert(rate, currency).Minor, nil
+}
+
+// snapshotMatchRate gives a match the exchange-rate snapshot into the streamer's
+// currency that the donor's donation has. Matches in any other currency are left to the
+// rate backfill.
+func snapshotMatchRate(match, donation *models.Donation) {
+	if !donation.HasRateSnapshot() {
+		return
+	}
+
+	switch match.Currency {
+	case donation.ConvertedCurrency:
+		now := time.Now()
+		match.ExchangeRate = 1
+		match.RateSource = models.RateSourceIdentity
+		match.RateTime = &now
+	case donation.Currency:
+		match.ExchangeRate = donation.ExchangeRate
+		match.RateSource = donation.RateSource
+		match.RateTime = donation.RateTime
+	default:
+		return
+	}
+	match.ConvertedCurrency = donation.ConvertedCurrency
+	match.ConvertedAmount = match.Money().Convert(match.ExchangeRate, match.ConvertedCurrency).Minor
+}
+
+// publishMatch announces a sponsor's donation like any completed donation, so it reaches
+// the streamer's balance, goals and overlays
+func (s *matchCampaignService) publishMatch(match *models.Donation) {
+	if s.eventBus == nil {
+		return
+	}
+
+	snapshot := *match
+	s.eventBus.Publish(&service.DonationEvent{
+		Type:      service.DonationEventCompleted,
+		Donation:  &snapshot,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"status":              string(match.Status),
+			"match_campaign_id":   strconv.FormatUint(uint64(match.MatchCampaignID), 10),
+			"matched_donation_id": strconv.FormatUint(uint64(match.MatchedDonationID), 10),
+		},
+	})
+}
+
+// publishMatchRefund announces the refund of a sponsor's donation like the refund service
+// does, so it is taken back from the streamer's balance, goals and overlays
+func (s *matchCampaignService) publishMatchRefund(match *models.Donation, refund *models.DonationRefund) {
+	if s.eventBus == nil {
+		return
+	}
+
+	snapshot := *match
+	s.eventBus.Publish(&service.DonationEvent{
+		Type:      service.DonationEventUpdated,
+		Donation:  &snapshot,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"status":              string(match.Status),
+			"refund_id":           strconv.FormatUint(uint64(refund.ID), 10),
+			"refund_amount":       strconv.FormatInt(refund.Amount, 10),
+			"refunded_amount":     strconv.FormatInt(match.RefundedAmount, 10),
+			"chargeback":          "false",
+			"match_campaign_id":   strconv.FormatUint(uint64(match.MatchCampaignID), 10),
+			"matched_donation_id": strconv.FormatUint(uint64(match.MatchedDonationID), 10),
+		},
+	})
+}
+
+// newMatchCampaign validates a campaign's terms
+func newMatchCampaign(req *service.MatchCampaignRequest) (*models.MatchCampaign, error) {
+	campaign := &models.MatchCampaign{
+		Name:             strings.TrimSpace(req.Name),
+		SponsorName:      strings.TrimSpace(req.SponsorName),
+		SponsorUserID:    req.SponsorUserID,
+		Currency:         req.Currency,
+		RatioBasisPoints: req.RatioBasisPoints,
+		MaxMatchAmount:   req.MaxMatchAmount,
+		Budget:           req.Budget,
+		StartsAt:         req.StartsAt,
+		EndsAt:           req.EndsAt,
+		IsActive:         req.IsActive,
+	}
+	if campaign.Currency == "" {
+		campaign.Currency = models.CurrencyIDR
+	}
+
+	seen := make(map[uint]bool, len(req.StreamerIDs))
+	for _, streamerID := range req.StreamerIDs {
+		if streamerID != 0 && !seen[streamerID] {
+			seen[streamerID] = true
+			campaign.StreamerIDs = append(campaign.StreamerIDs, streamerID)
+		}
+	}
+
+	switch {
+	case campaign.Name == "" || len(campaign.Name) > 100:
+		return nil, fmt.Errorf("%w: name is required and at most 100 characters", service.ErrInvalidMatchCampaign)
+	case campaign.SponsorName == "" || len(campaign.SponsorName) > 100:
+		return nil, fmt.Errorf("%w: sponsor name is required and at most 100 characters", service.ErrInvalidMatchCampaign)
+	case len(campaign.StreamerIDs) == 0:
+		return nil, fmt.Errorf("%w: at least one streamer is required", service.ErrInvalidMatchCampaign)
+	case campaign.RatioBasisPoints <= 0 || campaign.RatioBasisPoints > maxMatchRatioBasisPoints:
+		return nil, fmt.Errorf("%w: ratio must be between 1 and %d basis points", service.ErrInvalidMatchCampaign, maxMatchRatioBasisPoints)
+	case campaign.MaxMatchAmount < 0:
+		return nil, fmt.Errorf("%w: per-donation cap cannot be negative", service.ErrInvalidMatchCampaign)
+	case campaign.Budget <= 0:
+		return nil, fmt.Errorf("%w: budget must be greater than zero", service.ErrInvalidMatchCampaign)
+	case campaign.StartsAt.IsZero() || !campaign.EndsAt.After(campaign.StartsAt):
+		return nil, fmt.Errorf("%w: the campaign must end after it starts", service.ErrInvalidMatchCampaign)
+	}
+	if err := service.ValidateCurrency(campaign.Currency); err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrInvalidMatchCampaign, err)
+	}
+	return campaign, nil
+}
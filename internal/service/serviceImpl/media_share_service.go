@@ -1,14 +1,19 @@
 package serviceImpl
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/url"
 	"regexp"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
 	"github.com/rzfd/mediashar/internal/repository/repositoryImpl"
+	"github.com/rzfd/mediashar/internal/service"
 )
 
 type MediaShareService interface {
@@ -22,15 +27,48 @@ type MediaShareService interface {
 	ApproveMedia(streamerID, mediaID uint) error
 	RejectMedia(streamerID, mediaID uint) error
 	GetMediaStats(streamerID uint) (map[string]int64, error)
-	ValidateMediaShare(streamerID uint, donationAmount float64, mediaType models.MediaShareType) error
+	ValidateMediaShare(streamerID uint, donationAmount int64, mediaType models.MediaShareType) error
+
+	// Playback: the media player overlay plays approved media in approval order and
+	// marks each one played when it finishes
+	GetNextInQueue(streamerID uint) (*models.MediaQueueItem, error)
+	MarkMediaPlayed(streamerID, mediaID uint) error
+
+	// SubscribeQueue delivers the streamer's queue updates until cancel is called.
+	// Updates are dropped for subscribers that fall behind.
+	SubscribeQueue(streamerID uint) (updates <-chan *models.MediaQueueUpdate, cancel func())
 }
 
+// mediaQueueBufferSize is how many queue updates a subscriber may fall behind by
+const mediaQueueBufferSize = 32
+
 type mediaShareService struct {
 	repo repositoryImpl.MediaShareRepository
+
+	// Optional, translate messages into the streamer's primary language
+	translator   service.MessageTranslator
+	languageRepo repository.LanguageRepository
+
+	mu          sync.Mutex
+	subscribers map[uint]map[chan *models.MediaQueueUpdate]struct{}
 }
 
 func NewMediaShareService(repo repositoryImpl.MediaShareRepository) MediaShareService {
-	return &mediaShareService{repo: repo}
+	return &mediaShareService{
+		repo:        repo,
+		subscribers: make(map[uint]map[chan *models.MediaQueueUpdate]struct{}),
+	}
+}
+
+// NewMediaShareServiceWithTranslator creates a media share service that translates
+// messages into the streamer's primary language, read from languageRepo
+func NewMediaShareServiceWithTranslator(repo repositoryImpl.MediaShareRepository, translator service.MessageTranslator, languageRepo repository.LanguageRepository) MediaShareService {
+	return &mediaShareService{
+		repo:         repo,
+		translator:   translator,
+		languageRepo: languageRepo,
+		subscribers:  make(map[uint]map[chan *models.MediaQueueUpdate]struct{}),
+	}
 }
 
 // Settings methods
@@ -105,6 +143,17 @@ func (s *mediaShareService) SubmitMediaShare(donationID, streamerID, donatorID u
 	if err := s.repo.Create(mediaShare); err != nil {
 		return nil, err
 	}
+
+	eventType := models.MediaQueueNewSubmission
+	if mediaShare.Status == models.MediaShareStatusApproved {
+		eventType = models.MediaQueueApproved
+	}
+	s.publishQueueUpdate(mediaShare, eventType)
+
+	// Translation must not hold up the submission; the queue gets it in a later update
+	if s.translator != nil && s.languageRepo != nil && strings.TrimSpace(mediaShare.Message) != "" {
+		go s.translateMessage(*mediaShare)
+	}
 	
 	return &models.MediaShareResponse{
 		ID:        mediaShare.ID,
@@ -144,7 +193,7 @@ func (s *mediaShareService) ApproveMedia(streamerID, mediaID uint) error {
 		return errors.New("unauthorized: media does not belong to this streamer")
 	}
 	
-	return s.repo.UpdateStatus(mediaID, models.MediaShareStatusApproved)
+	return s.updateStatus(media, models.MediaShareStatusApproved, models.MediaQueueApproved)
 }
 
 func (s *mediaShareService) RejectMedia(streamerID, mediaID uint) error {
@@ -158,14 +207,59 @@ func (s *mediaShareService) RejectMedia(streamerID, mediaID uint) error {
 		return errors.New("unauthorized: media does not belong to this streamer")
 	}
 	
-	return s.repo.UpdateStatus(mediaID, models.MediaShareStatusRejected)
+	return s.updateStatus(media, models.MediaShareStatusRejected, models.MediaQueueRejected)
+}
+
+func (s *mediaShareService) GetNextInQueue(streamerID uint) (*models.MediaQueueItem, error) {
+	return s.repo.GetNextApproved(streamerID)
+}
+
+func (s *mediaShareService) MarkMediaPlayed(streamerID, mediaID uint) error {
+	media, err := s.repo.GetByID(mediaID)
+	if err != nil {
+		return err
+	}
+
+	if media.StreamerID != streamerID {
+		return errors.New("unauthorized: media does not belong to this streamer")
+	}
+	if media.Status != models.MediaShareStatusApproved {
+		return fmt.Errorf("only approved media can be played, media is %s", media.Status)
+	}
+
+	return s.updateStatus(media, models.MediaShareStatusPlayed, models.MediaQueuePlayed)
+}
+
+func (s *mediaShareService) SubscribeQueue(streamerID uint) (<-chan *models.MediaQueueUpdate, func()) {
+	updates := make(chan *models.MediaQueueUpdate, mediaQueueBufferSize)
+
+	s.mu.Lock()
+	if s.subscribers[streamerID] == nil {
+		s.subscribers[streamerID] = make(map[chan *models.MediaQueueUpdate]struct{})
+	}
+	s.subscribers[streamerID][updates] = struct{}{}
+	s.mu.Unlock()
+
+	var once sync.Once
+	cancel := func() {
+		once.Do(func() {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+			delete(s.subscribers[streamerID], updates)
+			if len(s.subscribers[streamerID]) == 0 {
+				delete(s.subscribers, streamerID)
+			}
+			close(updates)
+		})
+	}
+	return updates, cancel
 }
 
 func (s *mediaShareService) GetMediaStats(streamerID uint) (map[string]int64, error) {
 	return s.repo.GetStatsByStreamerID(streamerID)
 }
 
-func (s *mediaShareService) ValidateMediaShare(streamerID uint, donationAmount float64, mediaType models.MediaShareType) error {
+func (s *mediaShareService) ValidateMediaShare(streamerID uint, donationAmount int64, mediaType models.MediaShareType) error {
 	settings, err := s.repo.GetSettingsByStreamerID(streamerID)
 	if err != nil {
 		return err
@@ -175,6 +269,88 @@ func (s *mediaShareService) ValidateMediaShare(streamerID uint, donationAmount f
 }
 
 // Helper methods
+
+// updateStatus stores the media's new status and tells queue subscribers about it
+func (s *mediaShareService) updateStatus(media *models.MediaShare, status models.MediaShareStatus, eventType models.MediaQueueEventType) error {
+	if err := s.repo.UpdateStatus(media.ID, status); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	media.Status = status
+	media.ProcessedAt = &now
+	s.publishQueueUpdate(media, eventType)
+	return nil
+}
+
+// translateMessage stores a translation of the media's message into the streamer's
+// language and tells queue subscribers about it. Failures are only logged.
+func (s *mediaShareService) translateMessage(media models.MediaShare) {
+	ctx := context.Background()
+	preference, err := s.languageRepo.GetUserLanguagePreference(ctx, media.StreamerID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to fetch language preference of streamer %d: %v\n", media.StreamerID, err)
+		return
+	}
+
+	translation, err := s.translator.TranslateMessage(ctx, media.Message, preference.PrimaryLanguage)
+	if err != nil {
+		fmt.Printf("Warning: Failed to translate message of media share %d: %v\n", media.ID, err)
+		return
+	}
+
+	media.ApplyTranslation(translation)
+	if err := s.repo.SaveTranslation(&media); err != nil {
+		fmt.Printf("Warning: Failed to save message translation of media share %d: %v\n", media.ID, err)
+		return
+	}
+	if !translation.Translated() {
+		return
+	}
+
+	// The media may have been approved or played while translating
+	stored, err := s.repo.GetByID(media.ID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load media share %d after translating its message: %v\n", media.ID, err)
+		return
+	}
+	s.publishQueueUpdate(stored, models.MediaQueueTranslated)
+}
+
+func (s *mediaShareService) publishQueueUpdate(media *models.MediaShare, eventType models.MediaQueueEventType) {
+	update := &models.MediaQueueUpdate{
+		EventType: eventType,
+		Item: &models.MediaQueueItem{
+			ID:             media.ID,
+			Type:           media.Type,
+			URL:            media.URL,
+			Title:          media.Title,
+			Message:        media.Message,
+			Status:         media.Status,
+			DonatorName:    media.DonatorName,
+			DonationAmount: media.DonationAmount,
+			Currency:       media.Currency,
+			Thumbnail:      media.Thumbnail,
+			Duration:       media.Duration,
+			SubmittedAt:    media.CreatedAt,
+			ProcessedAt:    media.ProcessedAt,
+
+			TranslatedMessage:   media.TranslatedMessage,
+			TranslationLanguage: media.TranslationLanguage,
+		},
+		Timestamp: time.Now(),
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for updates := range s.subscribers[media.StreamerID] {
+		select {
+		case updates <- update:
+		default:
+			fmt.Printf("Warning: Dropping media queue update for slow subscriber of streamer %d\n", media.StreamerID)
+		}
+	}
+}
 func (s *mediaShareService) validateURL(mediaURL string, mediaType models.MediaShareType) error {
 	parsedURL, err := url.Parse(mediaURL)
 	if err != nil {
@@ -244,14 +420,15 @@ func (s *mediaShareService) isValidTikTokURL(u *url.URL) bool {
 	return pathRegex.MatchString(u.Path)
 }
 
-func (s *mediaShareService) validateMediaShareWithSettings(settings *models.MediaShareSettings, donationAmount float64, mediaType models.MediaShareType) error {
+func (s *mediaShareService) validateMediaShareWithSettings(settings *models.MediaShareSettings, donationAmount int64, mediaType models.MediaShareType) error {
 	if !settings.MediaShareEnabled {
 		return errors.New("media share is disabled for this streamer")
 	}
 	
 	if donationAmount < settings.MinDonationAmount {
-		return fmt.Errorf("donation amount (%.2f) is below minimum required (%.2f)", 
-			donationAmount, settings.MinDonationAmount)
+		currency := models.SupportedCurrency(settings.Currency)
+		return fmt.Errorf("donation amount (%s) is below minimum required (%s)", 
+			models.NewMoney(donationAmount, currency), models.NewMoney(settings.MinDonationAmount, currency))
 	}
 	
 	switch mediaType {
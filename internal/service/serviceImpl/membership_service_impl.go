@@ -0,0 +1,342 @@
+package serviceImpl
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+// membershipBatchSize bounds how many memberships one renewal pass handles per step
+const membershipBatchSize = 100
+
+// membershipRenewalRetryAfter is how long a claimed renewal may go without a recorded
+// donation before another pass issues it again, e.g. after a replica crashed mid-way
+const membershipRenewalRetryAfter = 5 * time.Minute
+
+type membershipService struct {
+	membershipRepo  repository.MembershipRepository
+	donationService service.DonationService
+	gracePeriod     time.Duration
+}
+
+// NewMembershipService creates the membership service. Every period is paid by a
+// regular donation created through the donation service, so renewals use the same
+// Midtrans/QRIS payment flow as one-off donations. gracePeriod is how long a member
+// keeps access while a payment is outstanding.
+func NewMembershipService(membershipRepo repository.MembershipRepository, donationService service.DonationService, gracePeriod time.Duration) service.MembershipService {
+	return &membershipService{
+		membershipRepo:  membershipRepo,
+		donationService: donationService,
+		gracePeriod:     gracePeriod,
+	}
+}
+
+func (s *membershipService) CreateTier(tier *models.MembershipTier) error {
+	if tier.Currency == "" {
+		tier.Currency = models.CurrencyIDR
+	}
+	if err := validateMembershipTier(tier); err != nil {
+		return err
+	}
+
+	tier.IsActive = true
+	return s.membershipRepo.CreateTier(tier)
+}
+
+func (s *membershipService) UpdateTier(tier *models.MembershipTier) error {
+	existing, err := s.membershipRepo.GetTierByID(tier.ID)
+	if err != nil {
+		return err
+	}
+	if existing.StreamerID != tier.StreamerID {
+		return errors.New("tier does not belong to this streamer")
+	}
+	if tier.Currency == "" {
+		tier.Currency = existing.Currency
+	}
+	if err := validateMembershipTier(tier); err != nil {
+		return err
+	}
+
+	return s.membershipRepo.UpdateTier(tier)
+}
+
+func (s *membershipService) GetTier(tierID uint) (*models.MembershipTier, error) {
+	return s.membershipRepo.GetTierByID(tierID)
+}
+
+func (s *membershipService) GetTiersByStreamer(streamerID uint, activeOnly bool) ([]*models.MembershipTier, error) {
+	return s.membershipRepo.GetTiersByStreamerID(streamerID, activeOnly)
+}
+
+func (s *membershipService) Subscribe(req *service.SubscribeRequest) (*models.Membership, *models.Donation, error) {
+	tier, err := s.membershipRepo.GetTierByID(req.TierID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("tier not found: %w", err)
+	}
+	if !tier.IsActive {
+		return nil, nil, errors.New("tier is no longer available")
+	}
+	if tier.StreamerID == req.MemberID {
+		return nil, nil, errors.New("cannot subscribe to your own tier")
+	}
+
+	current, err := s.membershipRepo.GetCurrent(req.MemberID, tier.StreamerID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if current != nil {
+		return nil, nil, errors.New("already a member of this streamer")
+	}
+
+	now := time.Now()
+	deadline := now.Add(s.gracePeriod)
+	membership := &models.Membership{
+		TierID:         tier.ID,
+		StreamerID:     tier.StreamerID,
+		MemberID:       req.MemberID,
+		DisplayName:    req.DisplayName,
+		Status:         models.MembershipPending,
+		GracePeriodEnd: &deadline,
+	}
+	if err := s.membershipRepo.Create(membership); err != nil {
+		return nil, nil, err
+	}
+	membership.Tier = *tier
+
+	donation, err := s.issueDonation(membership, now, now.AddDate(0, 1, 0))
+	if err != nil {
+		membership.Status = models.MembershipLapsed
+		membership.GracePeriodEnd = nil
+		if updateErr := s.membershipRepo.Update(membership); updateErr != nil {
+			fmt.Printf("Warning: Failed to mark membership %d lapsed: %v\n", membership.ID, updateErr)
+		}
+		return nil, nil, err
+	}
+
+	return membership, donation, nil
+}
+
+func (s *membershipService) Cancel(memberID, membershipID uint) (*models.Membership, error) {
+	membership, err := s.membershipRepo.GetByID(membershipID)
+	if err != nil {
+		return nil, err
+	}
+	if membership.MemberID != memberID {
+		return nil, errors.New("membership does not belong to this user")
+	}
+
+	now := time.Now()
+	switch membership.Status {
+	case models.MembershipActive:
+		// Already paid for: keep the perks until the period runs out
+		membership.CancelAtPeriodEnd = true
+	case models.MembershipPending, models.MembershipPastDue:
+		membership.Status = models.MembershipCancelled
+		membership.PendingDonationID = 0
+		membership.GracePeriodEnd = nil
+	default:
+		return nil, fmt.Errorf("cannot cancel a %s membership", membership.Status)
+	}
+	membership.CancelledAt = &now
+
+	if err := s.membershipRepo.Update(membership); err != nil {
+		return nil, err
+	}
+	return membership, nil
+}
+
+func (s *membershipService) GetMembership(membershipID uint) (*models.Membership, error) {
+	return s.membershipRepo.GetByID(membershipID)
+}
+
+func (s *membershipService) GetMembershipsByMember(memberID uint) ([]*models.Membership, error) {
+	return s.membershipRepo.GetByMemberID(memberID)
+}
+
+func (s *membershipService) GetMembershipsByStreamer(streamerID uint, page, pageSize int) ([]*models.Membership, error) {
+	return s.membershipRepo.GetByStreamerID(streamerID, page, pageSize)
+}
+
+func (s *membershipService) GetPayments(membershipID uint) ([]*models.MembershipPayment, error) {
+	return s.membershipRepo.GetPaymentsByMembershipID(membershipID)
+}
+
+func (s *membershipService) ProcessRenewals(now time.Time) error {
+	var errs []error
+
+	awaiting, err := s.membershipRepo.GetAwaitingPayment(membershipBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, membership := range awaiting {
+		if err := s.settlePayment(membership, now); err != nil {
+			errs = append(errs, fmt.Errorf("membership %d: %w", membership.ID, err))
+		}
+	}
+
+	due, err := s.membershipRepo.ClaimDueForRenewal(now, membershipBatchSize, func(membership *models.Membership) {
+		s.claimRenewal(membership, now)
+	})
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+
+	// Claimed earlier but never recorded: the key makes issuing again return the same donation
+	stalled, err := s.membershipRepo.GetAwaitingDonation(now.Add(-membershipRenewalRetryAfter), membershipBatchSize)
+	if err != nil {
+		return errors.Join(append(errs, err)...)
+	}
+
+	for _, membership := range append(due, stalled...) {
+		if membership.Status != models.MembershipPastDue {
+			continue
+		}
+		if _, err := s.issueDonation(membership, *membership.CurrentPeriodStart, *membership.CurrentPeriodEnd); err != nil {
+			errs = append(errs, fmt.Errorf("membership %d: %w", membership.ID, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// settlePayment applies the outcome of a membership's outstanding donation
+func (s *membershipService) settlePayment(membership *models.Membership, now time.Time) error {
+	payment, err := s.membershipRepo.GetPaymentByDonationID(membership.PendingDonationID)
+	if err != nil {
+		return err
+	}
+
+	donation, err := s.donationService.GetByID(membership.PendingDonationID)
+	if err != nil {
+		return err
+	}
+
+	switch donation.Status {
+	case models.PaymentCompleted:
+		paidAt := now
+		if donation.PaymentTime != nil {
+			paidAt = *donation.PaymentTime
+		}
+
+		// The first period starts when it is paid; renewals continue the schedule
+		if membership.CurrentPeriodEnd == nil {
+			payment.PeriodStart = paidAt
+			payment.PeriodEnd = paidAt.AddDate(0, 1, 0)
+		}
+		payment.Status = models.PaymentCompleted
+
+		membership.Status = models.MembershipActive
+		membership.CurrentPeriodStart = &payment.PeriodStart
+		membership.CurrentPeriodEnd = &payment.PeriodEnd
+		membership.GracePeriodEnd = nil
+		membership.PendingDonationID = 0
+
+	case models.PaymentFailed:
+		payment.Status = models.PaymentFailed
+
+		membership.Status = models.MembershipLapsed
+		membership.GracePeriodEnd = nil
+		membership.PendingDonationID = 0
+
+	default:
+		if membership.GracePeriodEnd == nil || now.Before(*membership.GracePeriodEnd) {
+			return nil
+		}
+
+		// Grace period ran out without a payment
+		membership.Status = models.MembershipLapsed
+		membership.GracePeriodEnd = nil
+		membership.PendingDonationID = 0
+	}
+
+	if err := s.membershipRepo.UpdatePayment(payment); err != nil {
+		return err
+	}
+	return s.membershipRepo.Update(membership)
+}
+
+// claimRenewal moves a membership whose current period has ended into its next
+// period, unpaid, or cancels it. It runs while the membership is locked, so only one
+// worker bills each period.
+func (s *membershipService) claimRenewal(membership *models.Membership, now time.Time) {
+	if membership.CancelAtPeriodEnd || !membership.Tier.IsActive {
+		membership.Status = models.MembershipCancelled
+		if membership.CancelledAt == nil {
+			membership.CancelledAt = &now
+		}
+		return
+	}
+
+	periodStart := *membership.CurrentPeriodEnd
+	periodEnd := periodStart.AddDate(0, 1, 0)
+	graceEnd := periodStart.Add(s.gracePeriod)
+	membership.Status = models.MembershipPastDue
+	membership.CurrentPeriodStart = &periodStart
+	membership.CurrentPeriodEnd = &periodEnd
+	membership.GracePeriodEnd = &graceEnd
+}
+
+// issueDonation creates the donation paying for one membership period and
+// records it as the membership's outstanding payment. The donation is keyed by the
+// membership and period, so issuing the same period again cannot charge twice.
+func (s *membershipService) issueDonation(membership *models.Membership, periodStart, periodEnd time.Time) (*models.Donation, error) {
+	tier := membership.Tier
+	memberID := membership.MemberID
+
+	donation, err := s.donationService.CreateDonation(&service.CreateDonationRequest{
+		Amount:         tier.MonthlyPrice,
+		Currency:       string(tier.Currency),
+		Message:        fmt.Sprintf("%s membership", tier.Name),
+		StreamerID:     membership.StreamerID,
+		DonatorID:      &memberID,
+		DisplayName:    membership.DisplayName,
+		IdempotencyKey: fmt.Sprintf("membership-%d-%d", membership.ID, periodStart.Unix()),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create membership donation: %w", err)
+	}
+
+	// A retried period may already have its payment from before the interruption
+	if _, err := s.membershipRepo.GetPaymentByDonationID(donation.ID); err == nil {
+		membership.PendingDonationID = donation.ID
+		return donation, s.membershipRepo.Update(membership)
+	}
+
+	payment := &models.MembershipPayment{
+		MembershipID: membership.ID,
+		DonationID:   donation.ID,
+		Amount:       tier.MonthlyPrice,
+		Currency:     tier.Currency,
+		PeriodStart:  periodStart,
+		PeriodEnd:    periodEnd,
+		Status:       models.PaymentPending,
+	}
+	if err := s.membershipRepo.CreatePayment(payment); err != nil {
+		return nil, err
+	}
+
+	membership.PendingDonationID = donation.ID
+	if err := s.membershipRepo.Update(membership); err != nil {
+		return nil, err
+	}
+
+	return donation, nil
+}
+
+func validateMembershipTier(tier *models.MembershipTier) error {
+	if tier.StreamerID == 0 {
+		return errors.New("streamer ID is required")
+	}
+	if tier.Name == "" {
+		return errors.New("tier name is required")
+	}
+	if tier.MonthlyPrice <= 0 {
+		return errors.New("monthly price must be greater than zero")
+	}
+	return service.ValidateCurrency(tier.Currency)
+}
@@ -0,0 +1,230 @@
+package serviceImpl
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// Violation names reported by the message filter
+const (
+	violationBannedWord    = "banned_word"
+	violationBannedPattern = "banned_pattern"
+	violationBlockedTerm   = "blocked_term"
+	violationMaxLength     = "max_length"
+)
+
+// leetspeak maps look-alike characters to the letters they usually stand in for
+var leetspeak = map[rune]rune{
+	'0': 'o', '1': 'i', '3': 'e', '4': 'a', '5': 's', '7': 't', '8': 'b', '9': 'g',
+	'@': 'a', '$': 's', '!': 'i', '|': 'l', '+': 't',
+}
+
+// isLeetSymbol reports whether r is punctuation that leetspeak also uses as a letter
+func isLeetSymbol(r rune) bool {
+	_, ok := leetspeak[r]
+	return ok && !unicode.IsDigit(r)
+}
+
+// Limits on streamer-supplied rules
+const (
+	maxPatternLength = 200
+	maxFilterRules   = 500
+)
+
+var (
+	linkPattern    = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+|\b[a-z0-9-]+(?:\.[a-z0-9-]+)*\.(?:com|net|org|io|gg|tv|id|co|me|xyz|ly|link|app|site)\b(?:/\S*)?`)
+	repeatedSpaces = regexp.MustCompile(`\s{2,}`)
+)
+
+// filterRule is a compiled banned word, pattern or blocklist entry
+type filterRule struct {
+	pattern   *regexp.Regexp
+	violation string
+	// Patterns are matched against the text as written as well as its normalized form,
+	// so rules such as phone numbers are not defeated by the leetspeak mapping
+	matchOriginal bool
+}
+
+// messageFilter applies moderation rules to donation text
+type messageFilter struct {
+	rules      []filterRule
+	stripLinks bool
+	maxLength  int
+}
+
+func newMessageFilter(settings *models.ModerationSettings, blocked []*models.BlockedTerm) (*messageFilter, error) {
+	filter := &messageFilter{stripLinks: settings.StripLinks}
+
+	if settings.Enabled {
+		filter.maxLength = settings.MaxMessageLength
+		for _, word := range settings.BannedWords {
+			if rule, ok := compileWordRule(word, violationBannedWord); ok {
+				filter.rules = append(filter.rules, rule)
+			}
+		}
+		for _, pattern := range settings.BannedPatterns {
+			rule, err := compilePatternRule(pattern, violationBannedPattern)
+			if err != nil {
+				return nil, err
+			}
+			filter.rules = append(filter.rules, rule)
+		}
+	}
+
+	// The global blocklist applies even when a streamer turns their own rules off
+	for _, term := range blocked {
+		if term.IsPattern {
+			rule, err := compilePatternRule(term.Term, violationBlockedTerm)
+			if err != nil {
+				return nil, err
+			}
+			filter.rules = append(filter.rules, rule)
+		} else if rule, ok := compileWordRule(term.Term, violationBlockedTerm); ok {
+			filter.rules = append(filter.rules, rule)
+		}
+	}
+
+	return filter, nil
+}
+
+// compileWordRule matches a word in normalized text, allowing stretched letters and
+// separators between them, so "sluuur", "s.l.u.u.r" and "5lur" all match "slur"
+func compileWordRule(word, violation string) (filterRule, bool) {
+	var parts []string
+	for _, r := range normalizeText(word) {
+		if unicode.IsSpace(r) {
+			continue
+		}
+		letter := regexp.QuoteMeta(string(r))
+		parts = append(parts, letter+`(?:[\W_]*`+letter+`)*`)
+	}
+	if len(parts) == 0 {
+		return filterRule{}, false
+	}
+
+	pattern := regexp.MustCompile(`\b` + strings.Join(parts, `[\W_]*`) + `\b`)
+	return filterRule{pattern: pattern, violation: violation}, true
+}
+
+func compilePatternRule(pattern, violation string) (filterRule, error) {
+	compiled, err := regexp.Compile("(?i)" + pattern)
+	if err != nil {
+		return filterRule{}, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+	}
+	return filterRule{pattern: compiled, violation: violation, matchOriginal: true}, nil
+}
+
+// validateFilterRules checks streamer-supplied rules before they are saved
+func validateFilterRules(words, patterns []string) error {
+	if len(words)+len(patterns) > maxFilterRules {
+		return fmt.Errorf("at most %d banned words and patterns are allowed", maxFilterRules)
+	}
+	for _, pattern := range patterns {
+		if len(pattern) > maxPatternLength {
+			return fmt.Errorf("pattern %q is longer than %d characters", pattern, maxPatternLength)
+		}
+		if _, err := compilePatternRule(pattern, violationBannedPattern); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// normalizeText lowercases text and undoes leetspeak one rune at a time, so rune
+// positions in the result line up with the original
+func normalizeText(text string) string {
+	return strings.Map(normalizeRune, text)
+}
+
+func normalizeRune(r rune) rune {
+	r = unicode.ToLower(r)
+	if mapped, ok := leetspeak[r]; ok {
+		return mapped
+	}
+	return r
+}
+
+// sanitize strips links and tidies whitespace
+func (f *messageFilter) sanitize(text string) string {
+	if f.stripLinks {
+		text = linkPattern.ReplaceAllString(text, "")
+	}
+	return strings.TrimSpace(repeatedSpaces.ReplaceAllString(text, " "))
+}
+
+// mask replaces every rule match with asterisks and reports which rules matched
+func (f *messageFilter) mask(text string) (string, []string) {
+	if text == "" {
+		return text, nil
+	}
+
+	runes := []rune(text)
+	masked := make([]bool, len(runes))
+	normalized := normalizeText(text)
+	// Symbols such as "!" are also plain punctuation, so words are matched a second
+	// time with them left alone: "anjing!" must not read as "anjingi"
+	normalizedLetters := strings.Map(func(r rune) rune {
+		if isLeetSymbol(r) {
+			return r
+		}
+		return normalizeRune(r)
+	}, text)
+	var violations []string
+
+	markMatches := func(source string, pattern *regexp.Regexp) bool {
+		matches := pattern.FindAllStringIndex(source, -1)
+		for _, match := range matches {
+			start := utf8.RuneCountInString(source[:match[0]])
+			end := start + utf8.RuneCountInString(source[match[0]:match[1]])
+			for i := start; i < end && i < len(runes); i++ {
+				masked[i] = true
+			}
+		}
+		return len(matches) > 0
+	}
+
+	for _, rule := range f.rules {
+		matched := markMatches(normalized, rule.pattern)
+		if markMatches(normalizedLetters, rule.pattern) {
+			matched = true
+		}
+		if rule.matchOriginal && markMatches(text, rule.pattern) {
+			matched = true
+		}
+		if matched {
+			violations = appendViolation(violations, rule.violation)
+		}
+	}
+
+	for i, r := range runes {
+		if masked[i] && !unicode.IsSpace(r) {
+			runes[i] = '*'
+		}
+	}
+	return string(runes), violations
+}
+
+func (f *messageFilter) exceedsMaxLength(text string) bool {
+	return f.maxLength > 0 && utf8.RuneCountInString(text) > f.maxLength
+}
+
+func (f *messageFilter) truncate(text string) string {
+	if !f.exceedsMaxLength(text) {
+		return text
+	}
+	return string([]rune(text)[:f.maxLength])
+}
+
+func appendViolation(violations []string, violation string) []string {
+	for _, existing := range violations {
+		if existing == violation {
+			return violations
+		}
+	}
+	return append(violations, violation)
+}
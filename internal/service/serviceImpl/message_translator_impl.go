@@ -0,0 +1,71 @@
+package serviceImpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+type messageTranslator struct {
+	languageService service.LanguageService
+	timeout         time.Duration // Limits detection and translation together
+}
+
+// NewMessageTranslator translates messages through the language service, giving up on a
+// message after timeout
+func NewMessageTranslator(languageService service.LanguageService, timeout time.Duration) service.MessageTranslator {
+	return &messageTranslator{
+		languageService: languageService,
+		timeout:         timeout,
+	}
+}
+
+func (t *messageTranslator) TranslateMessage(ctx context.Context, message string, language models.SupportedLanguage) (*models.MessageTranslation, error) {
+	if strings.TrimSpace(message) == "" {
+		return nil, errors.New("message is empty")
+	}
+
+	supported, err := t.languageService.GetSupportedLanguages(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !containsLanguage(supported, language) {
+		return nil, fmt.Errorf("unsupported language %q", language)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	detected, _, err := t.languageService.DetectLanguage(ctx, message)
+	if err != nil {
+		return nil, fmt.Errorf("failed to detect message language: %w", err)
+	}
+
+	translation := &models.MessageTranslation{SourceLanguage: detected, Language: language}
+	if detected == language {
+		return translation, nil
+	}
+
+	text, err := t.languageService.TranslateText(ctx, message, detected, language)
+	if err != nil {
+		return nil, fmt.Errorf("failed to translate message from %s to %s: %w", detected, language, err)
+	}
+	if text = strings.TrimSpace(text); text != "" && text != message {
+		translation.Text = text
+	}
+	return translation, nil
+}
+
+func containsLanguage(languages []models.SupportedLanguage, language models.SupportedLanguage) bool {
+	for _, l := range languages {
+		if l == language {
+			return true
+		}
+	}
+	return false
+}
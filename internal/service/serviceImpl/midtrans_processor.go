@@ -0,0 +1,108 @@
+package serviceImpl
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/midtrans/midtrans-go"
+	"github.com/midtrans/midtrans-go/coreapi"
+	"github.com/midtrans/midtrans-go/snap"
+	"github.com/rzfd/mediashar/configs"
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+// midtransProcessor adapts the Midtrans APIs to the generic PaymentProcessor interface
+type midtransProcessor struct {
+	coreClient coreapi.Client
+	snapClient snap.Client
+}
+
+func NewMidtransPaymentProcessor(config *configs.Config) service.PaymentProcessor {
+	env := midtrans.Sandbox
+	if config.Midtrans.Environment == "production" {
+		env = midtrans.Production
+	}
+
+	p := &midtransProcessor{}
+	p.coreClient.New(config.Midtrans.ServerKey, env)
+	p.snapClient.New(config.Midtrans.ServerKey, env)
+	return p
+}
+
+// midtransAmount returns the amount in whole rupiah, the only unit Midtrans charges in
+func midtransAmount(amount models.Money) (int64, error) {
+	if amount.Currency != models.CurrencyIDR {
+		return 0, fmt.Errorf("midtrans only accepts IDR amounts, got %s", amount.Currency)
+	}
+	return amount.Minor, nil
+}
+
+// parseMidtransAmount reads a gross amount as Midtrans reports it ("10000.00") exactly
+func parseMidtransAmount(grossAmount string) (models.Money, error) {
+	return models.ParseMoney(strings.TrimSuffix(grossAmount, ".00"), models.CurrencyIDR)
+}
+
+func (p *midtransProcessor) ProcessPayment(amount models.Money, description string) (string, error) {
+	grossAmount, err := midtransAmount(amount)
+	if err != nil {
+		return "", err
+	}
+
+	orderID := fmt.Sprintf("PAYMENT-%d", time.Now().UnixNano())
+
+	_, err = p.snapClient.CreateTransaction(&snap.Request{
+		TransactionDetails: midtrans.TransactionDetails{
+			OrderID:  orderID,
+			GrossAmt: grossAmount,
+		},
+		Items: &[]midtrans.ItemDetails{
+			{
+				ID:    "donation",
+				Price: grossAmount,
+				Qty:   1,
+				Name:  description,
+			},
+		},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to create Midtrans transaction: %w", err)
+	}
+
+	return orderID, nil
+}
+
+func (p *midtransProcessor) VerifyPayment(transactionID string) (bool, error) {
+	status, err := p.coreClient.CheckTransaction(transactionID)
+	if err != nil {
+		return false, fmt.Errorf("failed to check Midtrans transaction: %w", err)
+	}
+
+	return status.TransactionStatus == "capture" || status.TransactionStatus == "settlement", nil
+}
+
+func (p *midtransProcessor) RefundPayment(transactionID, refundKey string, amount models.Money, reason string) (string, error) {
+	refundAmount, err := midtransAmount(amount)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := p.coreClient.RefundTransaction(transactionID, &coreapi.RefundReq{
+		RefundKey: refundKey,
+		Amount:    refundAmount,
+		Reason:    reason,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to refund Midtrans transaction: %w", err)
+	}
+	if resp.StatusCode != "200" {
+		return "", fmt.Errorf("midtrans refund rejected: %s", resp.StatusMessage)
+	}
+
+	if resp.RefundChargebackID != 0 {
+		return strconv.Itoa(resp.RefundChargebackID), nil
+	}
+	return resp.RefundKey, nil
+}
@@ -3,6 +3,7 @@ package serviceImpl
 import (
 	"crypto/sha512"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"time"
 
@@ -14,12 +15,17 @@ import (
 )
 
 type midtransService struct {
-	config          *configs.Config
-	snapClient      snap.Client
-	donationService service.DonationService
+	config               *configs.Config
+	snapClient           snap.Client
+	donationService      service.DonationService
+	splitDonationService service.SplitDonationService
+	refundService        service.RefundService
 }
 
-func NewMidtransService(config *configs.Config, donationService service.DonationService) service.MidtransService {
+// NewMidtransService creates the Midtrans service. Notifications for split donation
+// orders are settled through splitDonationService, all others through donationService;
+// chargebacks are recorded through refundService.
+func NewMidtransService(config *configs.Config, donationService service.DonationService, splitDonationService service.SplitDonationService, refundService service.RefundService) service.MidtransService {
 	// Initialize Midtrans client
 	var env midtrans.EnvironmentType
 	if config.Midtrans.Environment == "production" {
@@ -32,15 +38,19 @@ func NewMidtransService(config *configs.Config, donationService service.Donation
 	snapClient.New(config.Midtrans.ServerKey, env)
 
 	return &midtransService{
-		config:          config,
-		snapClient:      snapClient,
-		donationService: donationService,
+		config:               config,
+		snapClient:           snapClient,
+		donationService:      donationService,
+		splitDonationService: splitDonationService,
+		refundService:        refundService,
 	}
 }
 
 func (s *midtransService) CreateSnapTransaction(req *service.MidtransPaymentRequest) (*service.MidtransPaymentResponse, error) {
-	// Convert amount to integer (Midtrans expects amount in smallest currency unit)
-	amount := int64(req.Amount)
+	amount, err := midtransAmount(req.Amount)
+	if err != nil {
+		return nil, err
+	}
 
 	// Create Snap request
 	snapReq := &snap.Request{
@@ -90,13 +100,12 @@ func (s *midtransService) ProcessDonationPayment(donation *models.Donation) (*se
 	}
 
 	req := &service.MidtransPaymentRequest{
-		OrderID:      orderID,
-		Amount:       donation.Amount,
-		Currency:     string(donation.Currency),
-		CustomerName: customerName,
+		OrderID:       orderID,
+		Amount:        donation.Money(),
+		CustomerName:  customerName,
 		CustomerEmail: customerEmail,
-		Description:  fmt.Sprintf("Donation to %s", donation.Streamer.Username),
-		CallbackURL:  "https://yourdomain.com/donation/success", // Replace with your domain
+		Description:   fmt.Sprintf("Donation to %s", donation.Streamer.Username),
+		CallbackURL:   "https://yourdomain.com/donation/success", // Replace with your domain
 	}
 
 	response, err := s.CreateSnapTransaction(req)
@@ -121,31 +130,140 @@ func (s *midtransService) HandleNotification(notification *service.MidtransNotif
 		return fmt.Errorf("invalid signature")
 	}
 
+	switch notification.TransactionStatus {
+	case "chargeback":
+		return s.handleChargeback(notification)
+	case "partial_chargeback":
+		// The notification does not say how much was taken back
+		fmt.Printf("Warning: Partial chargeback on Midtrans order %s must be recorded by hand\n", notification.OrderID)
+		return nil
+	}
+
+	newStatus := midtransPaymentStatus(notification.TransactionStatus)
+	if models.IsSplitOrderID(notification.OrderID) {
+		return s.handleSplitNotification(notification, newStatus)
+	}
+
 	// Find donation by order ID
 	donation, err := s.donationService.GetByTransactionID(notification.OrderID)
 	if err != nil {
 		return fmt.Errorf("donation not found: %w", err)
 	}
 
-	// Update donation status based on transaction status
-	var newStatus models.PaymentStatus
-	switch notification.TransactionStatus {
+	// Only settle a donation when Midtrans charged exactly its amount
+	if newStatus == models.PaymentCompleted {
+		if err := checkMidtransGrossAmount(notification, donation.Money()); err != nil {
+			return err
+		}
+	}
+
+	// Update donation status; notifications that arrive after a newer state are stale
+	err = s.donationService.UpdateStatus(donation.ID, newStatus, service.StatusChange{
+		Source: models.StatusSourceWebhook,
+		Reason: "midtrans: " + notification.TransactionStatus,
+	})
+	if errors.Is(err, service.ErrInvalidStatusTransition) {
+		fmt.Printf("Warning: Ignoring stale Midtrans notification for order %s: %v\n", notification.OrderID, err)
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to update donation status: %w", err)
+	}
+
+	return nil
+}
+
+// handleSplitNotification settles every share of a split donation paid with one order
+func (s *midtransService) handleSplitNotification(notification *service.MidtransNotification, newStatus models.PaymentStatus) error {
+	split, err := s.splitDonationService.GetSplitDonationByTransactionID(notification.OrderID)
+	if err != nil {
+		return fmt.Errorf("split donation not found: %w", err)
+	}
+
+	if newStatus == models.PaymentCompleted {
+		if err := checkMidtransGrossAmount(notification, split.Money()); err != nil {
+			return err
+		}
+	}
+
+	return s.splitDonationService.SettlePayment(split.ID, newStatus, service.StatusChange{
+		Source: models.StatusSourceWebhook,
+		Reason: "midtrans: " + notification.TransactionStatus,
+	})
+}
+
+// handleChargeback records a full chargeback of every donation paid with the order, so
+// the amount comes off the streamers' balances
+func (s *midtransService) handleChargeback(notification *service.MidtransNotification) error {
+	var donationIDs []uint
+	if models.IsSplitOrderID(notification.OrderID) {
+		split, err := s.splitDonationService.GetSplitDonationByTransactionID(notification.OrderID)
+		if err != nil {
+			return fmt.Errorf("split donation not found: %w", err)
+		}
+		for _, share := range split.Shares {
+			if share.DonationID != 0 {
+				donationIDs = append(donationIDs, share.DonationID)
+			}
+		}
+	} else {
+		donation, err := s.donationService.GetByTransactionID(notification.OrderID)
+		if err != nil {
+			return fmt.Errorf("donation not found: %w", err)
+		}
+		donationIDs = append(donationIDs, donation.ID)
+	}
+
+	var failed []error
+	for _, donationID := range donationIDs {
+		donation, err := s.donationService.GetByID(donationID)
+		if err != nil {
+			failed = append(failed, fmt.Errorf("donation %d: %w", donationID, err))
+			continue
+		}
+		// Already refunded or charged back in full, e.g. by an earlier notification
+		if donation.Status != models.PaymentCompleted {
+			continue
+		}
+
+		_, _, err = s.refundService.RefundDonation(&service.RefundDonationRequest{
+			DonationID: donationID,
+			Reason:     "midtrans: chargeback",
+			Chargeback: true,
+			Source:     models.StatusSourceWebhook,
+		})
+		if err != nil {
+			failed = append(failed, fmt.Errorf("donation %d: %w", donationID, err))
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf("failed to record chargeback of order %s: %w", notification.OrderID, errors.Join(failed...))
+	}
+	return nil
+}
+
+// midtransPaymentStatus maps a Midtrans transaction status to a donation status
+func midtransPaymentStatus(transactionStatus string) models.PaymentStatus {
+	switch transactionStatus {
 	case "capture", "settlement":
-		newStatus = models.PaymentCompleted
-	case "pending":
-		newStatus = models.PaymentPending
+		return models.PaymentCompleted
 	case "deny", "expire", "cancel":
-		newStatus = models.PaymentFailed
+		return models.PaymentFailed
 	default:
-		newStatus = models.PaymentPending
+		return models.PaymentPending
 	}
+}
 
-	// Update donation status
-	err = s.donationService.UpdateStatus(donation.ID, newStatus)
+// checkMidtransGrossAmount only lets a payment settle when Midtrans charged exactly the
+// expected amount
+func checkMidtransGrossAmount(notification *service.MidtransNotification, expected models.Money) error {
+	paid, err := parseMidtransAmount(notification.GrossAmount)
 	if err != nil {
-		return fmt.Errorf("failed to update donation status: %w", err)
+		return fmt.Errorf("invalid gross amount: %w", err)
+	}
+	if paid != expected {
+		return fmt.Errorf("gross amount %s IDR does not match donation amount %s %s", paid, expected, expected.Currency)
 	}
-
 	return nil
 }
 
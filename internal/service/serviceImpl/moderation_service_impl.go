@@ -0,0 +1,281 @@
+package serviceImpl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+const (
+	defaultMaxMessageLength = 255
+	maxMessageLengthLimit   = 1000
+	maxBlockedTermLength    = 255
+)
+
+type moderationService struct {
+	moderationRepo repository.ModerationRepository
+	donationRepo   repository.DonationRepository
+	eventBus       service.DonationEventBus
+}
+
+// NewModerationService creates the moderation service. eventBus is optional and is used to
+// tell overlays when a held message is approved or rejected.
+func NewModerationService(moderationRepo repository.ModerationRepository, donationRepo repository.DonationRepository, eventBus service.DonationEventBus) service.ModerationService {
+	return &moderationService{
+		moderationRepo: moderationRepo,
+		donationRepo:   donationRepo,
+		eventBus:       eventBus,
+	}
+}
+
+// NewMessageModerator creates the moderator the donation service consults while creating donations
+func NewMessageModerator(moderationRepo repository.ModerationRepository) service.MessageModerator {
+	return &moderationService{moderationRepo: moderationRepo}
+}
+
+// defaultModerationSettings is what applies to streamers who have not configured moderation
+func defaultModerationSettings(streamerID uint) *models.ModerationSettings {
+	return &models.ModerationSettings{
+		StreamerID:       streamerID,
+		Enabled:          true,
+		BannedWords:      []string{},
+		BannedPatterns:   []string{},
+		StripLinks:       true,
+		MaxMessageLength: defaultMaxMessageLength,
+		Action:           models.ModerationMask,
+	}
+}
+
+func (s *moderationService) GetSettings(streamerID uint) (*models.ModerationSettings, error) {
+	settings, err := s.moderationRepo.GetSettings(streamerID)
+	if err != nil {
+		return nil, err
+	}
+	if settings == nil {
+		return defaultModerationSettings(streamerID), nil
+	}
+	return settings, nil
+}
+
+func (s *moderationService) UpdateSettings(settings *models.ModerationSettings) (*models.ModerationSettings, error) {
+	if settings.StreamerID == 0 {
+		return nil, errors.New("streamer ID is required")
+	}
+	if settings.Action == "" {
+		settings.Action = models.ModerationMask
+	}
+	if !settings.Action.IsValid() {
+		return nil, fmt.Errorf("invalid moderation action %q", settings.Action)
+	}
+	if settings.MaxMessageLength < 0 || settings.MaxMessageLength > maxMessageLengthLimit {
+		return nil, fmt.Errorf("max message length must be between 0 and %d", maxMessageLengthLimit)
+	}
+
+	settings.BannedWords = cleanTerms(settings.BannedWords)
+	settings.BannedPatterns = cleanTerms(settings.BannedPatterns)
+	if err := validateFilterRules(settings.BannedWords, settings.BannedPatterns); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.moderationRepo.GetSettings(settings.StreamerID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		settings.ID = existing.ID
+		settings.CreatedAt = existing.CreatedAt
+	}
+
+	if err := s.moderationRepo.SaveSettings(settings); err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+// cleanTerms trims terms and drops blanks and duplicates
+func cleanTerms(terms []string) []string {
+	cleaned := make([]string, 0, len(terms))
+	seen := make(map[string]bool, len(terms))
+	for _, term := range terms {
+		term = strings.TrimSpace(term)
+		if term == "" || seen[term] {
+			continue
+		}
+		seen[term] = true
+		cleaned = append(cleaned, term)
+	}
+	return cleaned
+}
+
+func (s *moderationService) ModerateDonation(streamerID uint, message, displayName string) (*models.ModerationResult, error) {
+	settings, err := s.GetSettings(streamerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load moderation settings: %w", err)
+	}
+	blocked, err := s.moderationRepo.ListBlockedTerms()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load blocked terms: %w", err)
+	}
+
+	filter, err := newMessageFilter(settings, blocked)
+	if err != nil {
+		return nil, err
+	}
+
+	message = filter.sanitize(message)
+	displayName = filter.sanitize(displayName)
+
+	maskedMessage, violations := filter.mask(message)
+	maskedName, nameViolations := filter.mask(displayName)
+	for _, violation := range nameViolations {
+		violations = appendViolation(violations, violation)
+	}
+	if filter.exceedsMaxLength(message) {
+		violations = appendViolation(violations, violationMaxLength)
+	}
+
+	result := &models.ModerationResult{
+		Message:           message,
+		DisplayName:       displayName,
+		ReviewMessage:     message,
+		ReviewDisplayName: displayName,
+		Violations:        violations,
+	}
+	if len(violations) == 0 {
+		return result, nil
+	}
+
+	result.Action = settings.Action
+	switch settings.Action {
+	case models.ModerationHold:
+		// The message stays hidden until reviewed; the name is shown masked meanwhile
+		result.Message = ""
+		result.DisplayName = maskedName
+	case models.ModerationReject:
+		result.Message = ""
+		result.DisplayName = ""
+	default:
+		result.Action = models.ModerationMask
+		result.Message = filter.truncate(maskedMessage)
+		result.DisplayName = maskedName
+	}
+	return result, nil
+}
+
+func (s *moderationService) QueueForReview(donation *models.Donation, result *models.ModerationResult) (*models.MessageReview, error) {
+	review := &models.MessageReview{
+		DonationID:          donation.ID,
+		StreamerID:          donation.StreamerID,
+		OriginalMessage:     result.ReviewMessage,
+		OriginalDisplayName: result.ReviewDisplayName,
+		Message:             result.ReviewMessage,
+		DisplayName:         result.ReviewDisplayName,
+		Violations:          result.Violations,
+		Status:              models.ReviewPending,
+	}
+	if err := s.moderationRepo.CreateReview(review); err != nil {
+		return nil, err
+	}
+	return review, nil
+}
+
+func (s *moderationService) GetReviewQueue(streamerID uint, status models.MessageReviewStatus, page, pageSize int) ([]*models.MessageReview, int64, error) {
+	if page < 1 {
+		page = 1
+	}
+	if pageSize < 1 || pageSize > 100 {
+		pageSize = 20
+	}
+	return s.moderationRepo.GetReviewsByStreamerID(streamerID, status, page, pageSize)
+}
+
+func (s *moderationService) ApproveMessage(streamerID, reviewID uint) (*models.MessageReview, error) {
+	return s.resolveReview(streamerID, reviewID, models.ReviewApproved)
+}
+
+func (s *moderationService) RejectMessage(streamerID, reviewID uint) (*models.MessageReview, error) {
+	return s.resolveReview(streamerID, reviewID, models.ReviewRejected)
+}
+
+func (s *moderationService) resolveReview(streamerID, reviewID uint, status models.MessageReviewStatus) (*models.MessageReview, error) {
+	review, err := s.moderationRepo.GetReviewByID(reviewID)
+	if err == nil && review.StreamerID != streamerID {
+		// Other streamers' reviews look the same as missing ones
+		err = gorm.ErrRecordNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("message review not found: %w", err)
+	}
+
+	resolved, err := s.moderationRepo.ResolveReview(review, status)
+	if err != nil {
+		return nil, err
+	}
+	if !resolved {
+		return nil, service.ErrReviewAlreadyResolved
+	}
+
+	s.publishReview(review)
+	return review, nil
+}
+
+// publishReview tells real-time subscribers that a held message was approved or rejected
+func (s *moderationService) publishReview(review *models.MessageReview) {
+	if s.eventBus == nil {
+		return
+	}
+
+	donation, err := s.donationRepo.GetByID(review.DonationID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load donation %d after message review: %v\n", review.DonationID, err)
+		return
+	}
+
+	s.eventBus.Publish(&service.DonationEvent{
+		Type:      service.DonationEventUpdated,
+		Donation:  donation,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"status":         string(donation.Status),
+			"message_status": string(donation.MessageStatus),
+			"review_id":      strconv.FormatUint(uint64(review.ID), 10),
+		},
+	})
+}
+
+func (s *moderationService) ListBlockedTerms() ([]*models.BlockedTerm, error) {
+	return s.moderationRepo.ListBlockedTerms()
+}
+
+func (s *moderationService) AddBlockedTerm(term string, isPattern bool) (*models.BlockedTerm, error) {
+	term = strings.TrimSpace(term)
+	if term == "" {
+		return nil, errors.New("term is required")
+	}
+	if len(term) > maxBlockedTermLength {
+		return nil, fmt.Errorf("term must be at most %d characters", maxBlockedTermLength)
+	}
+	if isPattern {
+		if _, err := compilePatternRule(term, violationBlockedTerm); err != nil {
+			return nil, err
+		}
+	}
+
+	blocked := &models.BlockedTerm{Term: term, IsPattern: isPattern}
+	if err := s.moderationRepo.CreateBlockedTerm(blocked); err != nil {
+		return nil, err
+	}
+	return blocked, nil
+}
+
+func (s *moderationService) RemoveBlockedTerm(id uint) error {
+	return s.moderationRepo.DeleteBlockedTerm(id)
+}
@@ -0,0 +1,290 @@
+package serviceImpl
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+const (
+	// overlayTokenBytes is the entropy of an overlay token; it is hex encoded in URLs
+	overlayTokenBytes = 24
+	// overlayEventBufferSize is how many events an overlay connection may fall behind by
+	overlayEventBufferSize = 32
+	// overlayLeaderboardSize is how many donors the leaderboard overlay shows
+	overlayLeaderboardSize = 10
+	// overlayRefreshDelay gives the donation service time to apply a completed donation
+	// to goals, which happens asynchronously, before the overlays re-read them
+	overlayRefreshDelay = 2 * time.Second
+	// overlayTranslationWindow is how long after payment a translation that arrives late
+	// still updates the donation's alert; later ones would only touch a long-gone alert
+	overlayTranslationWindow = 30 * time.Second
+)
+
+type overlayService struct {
+	overlayRepo        repository.OverlayRepository
+	currencyRepo       repository.CurrencyRepository
+	donationEvents     service.DonationEventSource
+	goalService        service.DonationGoalService
+	leaderboardService service.LeaderboardService
+	mediaShareService  MediaShareService
+	alertService       service.AlertService
+
+	mu sync.Mutex
+	// alertSubscribers holds the alert channel of each connected overlay, by streamer
+	alertSubscribers map[uint]map[chan *models.OverlayAlert]struct{}
+}
+
+// NewOverlayService creates the overlay service. Donation alerts come from donationEvents
+// and are rendered with the streamer's alert rules; goals and the leaderboard are re-read
+// after each completed or updated donation.
+func NewOverlayService(overlayRepo repository.OverlayRepository, currencyRepo repository.CurrencyRepository, donationEvents service.DonationEventSource, goalService service.DonationGoalService, leaderboardService service.LeaderboardService, mediaShareService MediaShareService, alertService service.AlertService) service.OverlayService {
+	return &overlayService{
+		overlayRepo:        overlayRepo,
+		currencyRepo:       currencyRepo,
+		donationEvents:     donationEvents,
+		goalService:        goalService,
+		leaderboardService: leaderboardService,
+		mediaShareService:  mediaShareService,
+		alertService:       alertService,
+		alertSubscribers:   make(map[uint]map[chan *models.OverlayAlert]struct{}),
+	}
+}
+
+func (s *overlayService) GetToken(streamerID uint) (*models.OverlayToken, error) {
+	token, err := s.overlayRepo.GetByStreamerID(streamerID)
+	if err != nil {
+		return nil, err
+	}
+	if token != nil {
+		return token, nil
+	}
+	return s.RotateToken(streamerID)
+}
+
+func (s *overlayService) RotateToken(streamerID uint) (*models.OverlayToken, error) {
+	secret, err := generateOverlayToken()
+	if err != nil {
+		return nil, err
+	}
+
+	token, err := s.overlayRepo.GetByStreamerID(streamerID)
+	if err != nil {
+		return nil, err
+	}
+	if token == nil {
+		token = &models.OverlayToken{StreamerID: streamerID}
+	}
+	token.Token = secret
+
+	if err := s.overlayRepo.Save(token); err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+func (s *overlayService) ResolveToken(token string) (uint, error) {
+	if token == "" {
+		return 0, service.ErrInvalidOverlayToken
+	}
+
+	overlayToken, err := s.overlayRepo.GetByToken(token)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, service.ErrInvalidOverlayToken
+	}
+	if err != nil {
+		return 0, err
+	}
+	return overlayToken.StreamerID, nil
+}
+
+func (s *overlayService) Subscribe(ctx context.Context, streamerID uint) (<-chan *models.OverlayEvent, error) {
+	donationEvents, err := s.donationEvents.SubscribeDonationEvents(ctx, streamerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to donation events: %w", err)
+	}
+	queueUpdates, cancelQueue := s.mediaShareService.SubscribeQueue(streamerID)
+	published := s.subscribeAlerts(streamerID)
+
+	events := make(chan *models.OverlayEvent, overlayEventBufferSize)
+	go func() {
+		defer close(events)
+		defer cancelQueue()
+		defer s.unsubscribeAlerts(streamerID, published)
+
+		send := func(eventType models.OverlayEventType, data interface{}) bool {
+			select {
+			case events <- &models.OverlayEvent{Type: eventType, Data: data, Timestamp: time.Now()}:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+
+		if !s.sendGoals(streamerID, send) || !s.sendLeaderboard(streamerID, send) || !s.sendMedia(streamerID, nil, send) {
+			return
+		}
+
+		var refresh <-chan time.Time
+		for {
+			select {
+			case event, ok := <-donationEvents:
+				if !ok {
+					return
+				}
+				if event.Type == service.DonationEventCompleted && !send(models.OverlayEventAlert, s.alertService.RenderAlert(event.Donation)) {
+					return
+				}
+				// Translation runs in the background and may finish after the donation was paid
+				if isLateTranslation(event) {
+					alert := s.alertService.RenderAlert(event.Donation)
+					alert.Update = true
+					if !send(models.OverlayEventAlert, alert) {
+						return
+					}
+				}
+				// Completions and refunds both move goals and the leaderboard
+				if event.Type == service.DonationEventCompleted || event.Type == service.DonationEventUpdated {
+					refresh = time.After(overlayRefreshDelay)
+				}
+
+			case <-refresh:
+				refresh = nil
+				if !s.sendGoals(streamerID, send) || !s.sendLeaderboard(streamerID, send) {
+					return
+				}
+
+			case alert := <-published:
+				if !send(models.OverlayEventAlert, alert) {
+					return
+				}
+
+			case update, ok := <-queueUpdates:
+				if !ok {
+					return
+				}
+				if !s.sendMedia(streamerID, update, send) {
+					return
+				}
+
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	return events, nil
+}
+
+// isLateTranslation reports whether event carries a message translation for a donation
+// whose alert was sent moments ago, before the translation was stored
+func isLateTranslation(event *service.DonationEvent) bool {
+	if event.Type != service.DonationEventUpdated || event.Metadata["translation_language"] == "" {
+		return false
+	}
+	donation := event.Donation
+	return donation.Status == models.PaymentCompleted && donation.PaymentTime != nil &&
+		time.Since(*donation.PaymentTime) < overlayTranslationWindow
+}
+
+func (s *overlayService) MarkMediaPlayed(streamerID, mediaID uint) error {
+	return s.mediaShareService.MarkMediaPlayed(streamerID, mediaID)
+}
+
+func (s *overlayService) PublishAlert(streamerID uint, alert *models.OverlayAlert) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delivered := 0
+	for alerts := range s.alertSubscribers[streamerID] {
+		select {
+		case alerts <- alert:
+			delivered++
+		default:
+			fmt.Printf("Warning: Dropping alert for slow overlay of streamer %d\n", streamerID)
+		}
+	}
+	return delivered
+}
+
+func (s *overlayService) subscribeAlerts(streamerID uint) chan *models.OverlayAlert {
+	alerts := make(chan *models.OverlayAlert, overlayEventBufferSize)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.alertSubscribers[streamerID] == nil {
+		s.alertSubscribers[streamerID] = make(map[chan *models.OverlayAlert]struct{})
+	}
+	s.alertSubscribers[streamerID][alerts] = struct{}{}
+	return alerts
+}
+
+func (s *overlayService) unsubscribeAlerts(streamerID uint, alerts chan *models.OverlayAlert) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.alertSubscribers[streamerID], alerts)
+	if len(s.alertSubscribers[streamerID]) == 0 {
+		delete(s.alertSubscribers, streamerID)
+	}
+}
+
+// overlaySendFunc delivers an overlay event, reporting false once the subscriber is gone
+type overlaySendFunc func(eventType models.OverlayEventType, data interface{}) bool
+
+// sendGoals sends the streamer's active goals. Lookup failures are logged and skipped
+// so that one unavailable service does not take the other overlays down.
+func (s *overlayService) sendGoals(streamerID uint, send overlaySendFunc) bool {
+	goals, err := s.goalService.GetGoalsByStreamer(streamerID, true)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load goals of streamer %d for overlay: %v\n", streamerID, err)
+		return true
+	}
+	return send(models.OverlayEventGoals, goals)
+}
+
+func (s *overlayService) sendLeaderboard(streamerID uint, send overlaySendFunc) bool {
+	req := &service.LeaderboardRequest{
+		StreamerID: streamerID,
+		Period:     models.LeaderboardAllTime,
+		Limit:      overlayLeaderboardSize,
+	}
+	if preference, err := s.currencyRepo.GetUserCurrencyPreference(context.Background(), streamerID); err == nil {
+		req.Currency = preference.PrimaryCurrency
+	} else {
+		fmt.Printf("Warning: Failed to fetch currency preference of streamer %d: %v\n", streamerID, err)
+	}
+
+	leaderboard, err := s.leaderboardService.GetLeaderboard(req)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load leaderboard of streamer %d for overlay: %v\n", streamerID, err)
+		return true
+	}
+	return send(models.OverlayEventLeaderboard, leaderboard)
+}
+
+func (s *overlayService) sendMedia(streamerID uint, update *models.MediaQueueUpdate, send overlaySendFunc) bool {
+	nowPlaying, err := s.mediaShareService.GetNextInQueue(streamerID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to load media queue of streamer %d for overlay: %v\n", streamerID, err)
+		return true
+	}
+	return send(models.OverlayEventMedia, &models.OverlayMedia{NowPlaying: nowPlaying, Update: update})
+}
+
+func generateOverlayToken() (string, error) {
+	secret := make([]byte, overlayTokenBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate overlay token: %w", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
@@ -0,0 +1,322 @@
+package serviceImpl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+// maxPayoutAccounts bounds how many accounts a streamer can register
+const maxPayoutAccounts = 5
+
+type payoutService struct {
+	payoutRepo repository.PayoutRepository
+	provider   service.DisbursementProvider
+	minimum    models.Money
+}
+
+// NewPayoutService creates the payout service. Withdrawals are made in the minimum's
+// currency and must be at least that amount. provider may be nil, in which case admins
+// transfer approved payouts themselves.
+func NewPayoutService(payoutRepo repository.PayoutRepository, provider service.DisbursementProvider, minimum models.Money) service.PayoutService {
+	return &payoutService{
+		payoutRepo: payoutRepo,
+		provider:   provider,
+		minimum:    minimum,
+	}
+}
+
+func (s *payoutService) CreateAccount(req *service.CreatePayoutAccountRequest) (*models.PayoutAccount, error) {
+	channel, ok := models.GetPayoutChannel(req.Channel)
+	if !ok {
+		return nil, fmt.Errorf("%w: unsupported bank or e-wallet %q", service.ErrInvalidPayoutRequest, req.Channel)
+	}
+	number, err := channel.NormalizeAccountNumber(req.AccountNumber)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", service.ErrInvalidPayoutRequest, err)
+	}
+	name := strings.TrimSpace(req.AccountName)
+	if name == "" || len(name) > 100 {
+		return nil, fmt.Errorf("%w: account name is required and at most 100 characters", service.ErrInvalidPayoutRequest)
+	}
+
+	accounts, err := s.payoutRepo.GetAccountsByStreamerID(req.StreamerID)
+	if err != nil {
+		return nil, err
+	}
+	if len(accounts) >= maxPayoutAccounts {
+		return nil, fmt.Errorf("%w: at most %d payout accounts can be registered", service.ErrInvalidPayoutRequest, maxPayoutAccounts)
+	}
+	for _, existing := range accounts {
+		if existing.Channel == channel.Code && existing.AccountNumber == number {
+			return nil, fmt.Errorf("%w: this account is already registered", service.ErrInvalidPayoutRequest)
+		}
+	}
+
+	account := &models.PayoutAccount{
+		StreamerID:    req.StreamerID,
+		Method:        channel.Method,
+		Channel:       channel.Code,
+		AccountNumber: number,
+		AccountName:   name,
+	}
+	if err := s.payoutRepo.CreateAccount(account); err != nil {
+		return nil, err
+	}
+	return account, nil
+}
+
+func (s *payoutService) GetAccounts(streamerID uint) ([]*models.PayoutAccount, error) {
+	return s.payoutRepo.GetAccountsByStreamerID(streamerID)
+}
+
+func (s *payoutService) DeleteAccount(streamerID, accountID uint) error {
+	if _, err := s.getOwnAccount(streamerID, accountID); err != nil {
+		return err
+	}
+	return s.payoutRepo.DeleteAccount(accountID)
+}
+
+func (s *payoutService) RequestPayout(req *service.RequestPayoutRequest) (*models.Payout, error) {
+	currency := req.Currency
+	if currency == "" {
+		currency = s.minimum.Currency
+	}
+	if currency != s.minimum.Currency {
+		return nil, fmt.Errorf("%w: payouts are only made in %s", service.ErrInvalidPayoutRequest, s.minimum.Currency)
+	}
+	if req.Amount < s.minimum.Minor {
+		return nil, fmt.Errorf("%w: the minimum payout is %s %s", service.ErrInvalidPayoutRequest, s.minimum, s.minimum.Currency)
+	}
+
+	account, err := s.getOwnAccount(req.StreamerID, req.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	payout := &models.Payout{
+		StreamerID:    req.StreamerID,
+		AccountID:     account.ID,
+		Method:        account.Method,
+		Channel:       account.Channel,
+		AccountNumber: account.AccountNumber,
+		AccountName:   account.AccountName,
+		Amount:        req.Amount,
+		Currency:      currency,
+		Status:        models.PayoutRequested,
+	}
+	var holdErr error
+	err = s.payoutRepo.Create(payout, func(payout *models.Payout) *models.LedgerEntry {
+		entry := payoutEntry(payout)
+		holdErr = balanceLedgerEntry(entry)
+		return entry
+	})
+	if holdErr != nil {
+		return nil, holdErr
+	}
+	if errors.Is(err, models.ErrInsufficientBalance) {
+		return nil, fmt.Errorf("%w: %v", service.ErrInvalidPayoutRequest, err)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+func (s *payoutService) GetPayout(id uint) (*models.Payout, error) {
+	return s.payoutRepo.GetByID(id)
+}
+
+func (s *payoutService) GetStreamerPayouts(streamerID uint, page, pageSize int) ([]*models.Payout, error) {
+	return s.payoutRepo.GetByStreamerID(streamerID, page, pageSize)
+}
+
+func (s *payoutService) ListPayouts(status models.PayoutStatus, page, pageSize int) ([]*models.Payout, error) {
+	return s.payoutRepo.List(status, page, pageSize)
+}
+
+func (s *payoutService) ApprovePayout(id, adminID uint) (*models.Payout, error) {
+	payout, err := s.payoutRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	payout.ReviewedBy = adminID
+	payout.ReviewedAt = &now
+	if err := s.transition(payout, models.PayoutProcessing); err != nil {
+		return nil, err
+	}
+	if s.provider == nil {
+		return payout, nil
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	result, err := s.provider.Disburse(ctx, payout)
+	if err != nil {
+		// The transfer may or may not have gone out, so only a person can settle it
+		fmt.Printf("Warning: Disbursement of payout %d through %s failed, leaving it processing: %v\n", payout.ID, s.provider.Name(), err)
+		return payout, nil
+	}
+
+	payout.ProviderReference = result.Reference
+	switch result.Status {
+	case models.PayoutPaid:
+		return s.complete(payout, result.Reference)
+	case models.PayoutFailed:
+		return s.fail(payout, result.FailureReason)
+	default:
+		if _, err := s.payoutRepo.TransitionStatus(payout, models.PayoutProcessing, nil); err != nil {
+			return nil, err
+		}
+		return payout, nil
+	}
+}
+
+func (s *payoutService) RejectPayout(id, adminID uint, reason string) (*models.Payout, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		return nil, fmt.Errorf("%w: a reason is required to reject a payout", service.ErrInvalidPayoutRequest)
+	}
+
+	payout, err := s.payoutRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	payout.ReviewedBy = adminID
+	payout.ReviewedAt = &now
+	payout.FailureReason = reason
+	if err := s.transition(payout, models.PayoutRejected); err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+func (s *payoutService) CompletePayout(id uint, providerReference string) (*models.Payout, error) {
+	payout, err := s.payoutRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.complete(payout, providerReference)
+}
+
+func (s *payoutService) FailPayout(id uint, reason string) (*models.Payout, error) {
+	payout, err := s.payoutRepo.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	return s.fail(payout, reason)
+}
+
+func (s *payoutService) complete(payout *models.Payout, providerReference string) (*models.Payout, error) {
+	if reference := strings.TrimSpace(providerReference); reference != "" {
+		payout.ProviderReference = reference
+	}
+	now := time.Now()
+	payout.PaidAt = &now
+	if err := s.transition(payout, models.PayoutPaid); err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+func (s *payoutService) fail(payout *models.Payout, reason string) (*models.Payout, error) {
+	reason = strings.TrimSpace(reason)
+	if reason == "" {
+		reason = "disbursement failed"
+	}
+	payout.FailureReason = reason
+	if err := s.transition(payout, models.PayoutFailed); err != nil {
+		return nil, err
+	}
+	return payout, nil
+}
+
+// transition moves a payout to next, giving its amount back to the streamer's balance in
+// the same transaction when next ends it without paying
+func (s *payoutService) transition(payout *models.Payout, next models.PayoutStatus) error {
+	from := payout.Status
+	if !from.CanTransitionTo(next) {
+		return fmt.Errorf("%w: payout %d is %s", service.ErrPayoutStatusConflict, payout.ID, from)
+	}
+
+	payout.Status = next
+	var reversal *models.LedgerEntry
+	if next.ReturnsBalance() {
+		reversal = payoutReversalEntry(payout, next)
+		if err := balanceLedgerEntry(reversal); err != nil {
+			payout.Status = from
+			return err
+		}
+	}
+
+	transitioned, err := s.payoutRepo.TransitionStatus(payout, from, reversal)
+	if err != nil {
+		payout.Status = from
+		return err
+	}
+	if !transitioned {
+		payout.Status = from
+		return fmt.Errorf("%w: payout %d changed while it was being updated", service.ErrPayoutStatusConflict, payout.ID)
+	}
+	return nil
+}
+
+// getOwnAccount returns one of the streamer's payout accounts, treating other streamers'
+// accounts as not found
+func (s *payoutService) getOwnAccount(streamerID, accountID uint) (*models.PayoutAccount, error) {
+	account, err := s.payoutRepo.GetAccountByID(accountID)
+	if err != nil {
+		return nil, err
+	}
+	if account.StreamerID != streamerID {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return account, nil
+}
+
+// payoutEntry builds the ledger entry that holds a payout's amount from the streamer's
+// balance
+func payoutEntry(payout *models.Payout) *models.LedgerEntry {
+	description := fmt.Sprintf("Payout #%d to %s %s", payout.ID, payout.Channel, maskAccountNumber(payout.AccountNumber))
+	return payoutLedgerEntry(payout, models.LedgerEntryPayout, payout.Amount, description)
+}
+
+// payoutReversalEntry builds the ledger entry that gives a payout's amount back to the
+// streamer's balance when the payout ends in status without being paid
+func payoutReversalEntry(payout *models.Payout, status models.PayoutStatus) *models.LedgerEntry {
+	description := fmt.Sprintf("Payout #%d %s, returned to balance", payout.ID, status)
+	return payoutLedgerEntry(payout, models.LedgerEntryPayoutReversal, -payout.Amount, description)
+}
+
+func payoutLedgerEntry(payout *models.Payout, entryType models.LedgerEntryType, amount int64, description string) *models.LedgerEntry {
+	return &models.LedgerEntry{
+		Reference:   models.LedgerEntryReference("payout", payout.ID, entryType),
+		Type:        entryType,
+		StreamerID:  payout.StreamerID,
+		PayoutID:    payout.ID,
+		Currency:    payout.Currency,
+		Description: description,
+		Lines: []models.LedgerLine{
+			{Account: models.LedgerAccountStreamerBalance, StreamerID: payout.StreamerID, Amount: amount},
+			{Account: models.LedgerAccountPayouts, StreamerID: payout.StreamerID, Amount: -amount},
+		},
+	}
+}
+
+// maskAccountNumber keeps only the last four digits of an account number
+func maskAccountNumber(number string) string {
+	if len(number) <= 4 {
+		return number
+	}
+	return strings.Repeat("*", len(number)-4) + number[len(number)-4:]
+}
@@ -28,10 +28,15 @@ func NewQRISService(merchantID, merchantName string, donationService service.Don
 func (s *qrisService) GenerateQRIS(donation *models.Donation) (*service.QRISResponse, error) {
 	// Generate transaction ID
 	transactionID := fmt.Sprintf("DON-%d-%d", donation.ID, time.Now().Unix())
-	
+
+	return s.GenerateQRISPayment(donation.Money(), transactionID, donation.Message)
+}
+
+// GenerateQRISPayment generates QRIS string and QR code for an amount under a given transaction ID
+func (s *qrisService) GenerateQRISPayment(amount models.Money, transactionID, description string) (*service.QRISResponse, error) {
 	// Create QRIS string (simplified format)
 	// In production, use proper QRIS format according to Bank Indonesia specification
-	qrisString := s.generateQRISString(donation.Amount, transactionID, donation.Message)
+	qrisString := s.generateQRISString(amount, transactionID, description)
 	
 	// Generate QR code image
 	qrCode, err := qrcode.Encode(qrisString, qrcode.Medium, 256)
@@ -49,13 +54,13 @@ func (s *qrisService) GenerateQRIS(donation *models.Donation) (*service.QRISResp
 		QRISString:    qrisString,
 		QRCodeBase64:  qrCodeBase64,
 		ExpiryTime:    expiryTime,
-		Amount:        donation.Amount,
+		Amount:        amount.Minor,
 		TransactionID: transactionID,
 	}, nil
 }
 
 // generateQRISString creates QRIS format string
-func (s *qrisService) generateQRISString(amount float64, transactionID, description string) string {
+func (s *qrisService) generateQRISString(amount models.Money, transactionID, description string) string {
 	// Simplified QRIS format - in production use proper EMV QR Code specification
 	// This is a basic implementation for demonstration
 	
@@ -70,7 +75,7 @@ func (s *qrisService) generateQRISString(amount float64, transactionID, descript
 		s.merchantID)
 	
 	// Transaction Amount
-	amountStr := fmt.Sprintf("%.2f", amount)
+	amountStr := amount.String()
 	transactionAmount := fmt.Sprintf("54%02d%s", len(amountStr), amountStr)
 	
 	// Country Code
@@ -0,0 +1,375 @@
+package serviceImpl
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/pdf"
+)
+
+// defaultReceiptTimezone is used for donors without a timezone preference, e.g. guests
+const defaultReceiptTimezone = "Asia/Jakarta"
+
+// receiptLabelKeys are the translation keys of a receipt's text
+var receiptLabelKeys = []string{
+	"receipt.title",
+	"receipt.thanks",
+	"receipt.donor",
+	"receipt.streamer",
+	"donation.amount",
+	"receipt.refunded",
+	"receipt.currency",
+	"receipt.date",
+	"receipt.transaction_id",
+	"receipt.verification_code",
+	"receipt.verify_hint",
+	"donation.anonymous",
+}
+
+// indonesianMonths are the month names of Indonesian dates
+var indonesianMonths = [...]string{
+	"Januari", "Februari", "Maret", "April", "Mei", "Juni",
+	"Juli", "Agustus", "September", "Oktober", "November", "Desember",
+}
+
+type receiptService struct {
+	donationService service.DonationService
+	userService     service.UserService
+	languageService service.LanguageService
+	languageRepo    repository.LanguageRepository // Donors' language and timezone preferences
+	currencyService service.CurrencyService
+	signingKey      []byte
+}
+
+// NewReceiptService creates the receipt service. signingKey signs verification codes;
+// changing it invalidates the codes of every receipt already issued.
+func NewReceiptService(donationService service.DonationService, userService service.UserService, languageService service.LanguageService, languageRepo repository.LanguageRepository, currencyService service.CurrencyService, signingKey []byte) service.ReceiptService {
+	return &receiptService{
+		donationService: donationService,
+		userService:     userService,
+		languageService: languageService,
+		languageRepo:    languageRepo,
+		currencyService: currencyService,
+		signingKey:      signingKey,
+	}
+}
+
+func (s *receiptService) GetReceipt(donationID uint, language models.SupportedLanguage) (*models.DonationReceipt, error) {
+	donation, err := s.donationService.GetByID(donationID)
+	if err != nil {
+		return nil, err
+	}
+	if donation.Status != models.PaymentCompleted {
+		return nil, service.ErrReceiptUnavailable
+	}
+
+	preference := s.donorPreference(donation.DonatorID)
+	if service.ValidateLanguage(language) != nil {
+		language = preference.PrimaryLanguage
+	}
+	if service.ValidateLanguage(language) != nil {
+		language = models.LanguageIndonesian
+	}
+	location := loadReceiptLocation(preference.Timezone)
+
+	labels := s.labels(language)
+	paidAt := receiptPaidAt(donation).In(location)
+	receipt := &models.DonationReceipt{
+		DonationID:       donation.ID,
+		VerificationCode: s.verificationCode(donation),
+		Language:         language,
+		Timezone:         location.String(),
+		DonatorID:        donation.DonatorID,
+		DonorName:        donation.DisplayName,
+		StreamerID:       donation.StreamerID,
+		StreamerName:     s.streamerName(donation.StreamerID),
+		Amount:           donation.Amount,
+		Currency:         donation.Currency,
+		FormattedAmount:  s.formatAmount(donation.Amount, donation.Currency),
+		RefundedAmount:   donation.RefundedAmount,
+		PaidAt:           paidAt,
+		FormattedDate:    formatReceiptDate(paidAt, language),
+		TransactionID:    donation.TransactionID,
+		Labels:           labels,
+	}
+	if donation.IsAnonymous || receipt.DonorName == "" {
+		receipt.DonorName = labels["donation.anonymous"]
+	}
+	if donation.RefundedAmount > 0 {
+		receipt.FormattedRefund = s.formatAmount(donation.RefundedAmount, donation.Currency)
+	}
+	return receipt, nil
+}
+
+func (s *receiptService) RenderReceipt(receipt *models.DonationReceipt, format models.ReceiptFormat, w io.Writer) error {
+	switch format {
+	case models.ReceiptHTML:
+		return receiptTemplate.Execute(w, receiptPage{
+			Receipt: receipt,
+			Rows:    receiptRows(receipt),
+			Hint:    receiptVerifyHint(receipt),
+		})
+	case models.ReceiptPDF:
+		_, err := renderReceiptPDF(receipt).WriteTo(w)
+		return err
+	default:
+		return fmt.Errorf("unsupported receipt format: %s", format)
+	}
+}
+
+func (s *receiptService) VerifyReceipt(code string) (*models.ReceiptVerification, error) {
+	code = normalizeReceiptCode(code)
+	id, _, ok := strings.Cut(code, "-")
+	donationID, err := strconv.ParseUint(id, 10, 32)
+	if !ok || err != nil {
+		return nil, service.ErrInvalidReceiptCode
+	}
+
+	donation, err := s.donationService.GetByID(uint(donationID))
+	if errors.Is(err, service.ErrDonationNotFound) {
+		return nil, service.ErrInvalidReceiptCode
+	}
+	if err != nil {
+		return nil, err
+	}
+	// The code signs the receipt's details, so it stops matching if any of them change
+	if !hmac.Equal([]byte(code), []byte(s.verificationCode(donation))) {
+		return nil, service.ErrInvalidReceiptCode
+	}
+
+	return &models.ReceiptVerification{
+		Valid:           donation.Status == models.PaymentCompleted,
+		DonationID:      donation.ID,
+		Status:          donation.Status,
+		StreamerName:    s.streamerName(donation.StreamerID),
+		Amount:          donation.Amount,
+		Currency:        donation.Currency,
+		FormattedAmount: s.formatAmount(donation.Amount, donation.Currency),
+		RefundedAmount:  donation.RefundedAmount,
+		TransactionID:   donation.TransactionID,
+		PaidAt:          receiptPaidAt(donation).UTC(),
+	}, nil
+}
+
+// verificationCode signs a donation's receipt details as "<donation ID>-XXXX-XXXX-XXXX-XXXX"
+func (s *receiptService) verificationCode(donation *models.Donation) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	fmt.Fprintf(mac, "%d|%s|%d|%s|%d", donation.ID, donation.TransactionID, donation.Amount, donation.Currency, receiptPaidAt(donation).Unix())
+	sum := base32.StdEncoding.EncodeToString(mac.Sum(nil)[:10])
+	return fmt.Sprintf("%d-%s-%s-%s-%s", donation.ID, sum[0:4], sum[4:8], sum[8:12], sum[12:16])
+}
+
+// donorPreference returns the donor's language and timezone preferences, or the defaults
+// for guests and when they cannot be read
+func (s *receiptService) donorPreference(donatorID uint) *models.UserLanguagePreference {
+	defaults := &models.UserLanguagePreference{
+		PrimaryLanguage: models.LanguageIndonesian,
+		Timezone:        defaultReceiptTimezone,
+	}
+	if donatorID == 0 || s.languageRepo == nil {
+		return defaults
+	}
+
+	preference, err := s.languageRepo.GetUserLanguagePreference(context.Background(), donatorID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to fetch language preference of donor %d: %v\n", donatorID, err)
+		return defaults
+	}
+	return preference
+}
+
+// labels looks up the receipt's text in a language. Translations stored with the language
+// service come first, then the built-in defaults of the language and of English.
+func (s *receiptService) labels(language models.SupportedLanguage) map[string]string {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	defaults := service.GetDefaultTranslations()
+	labels := make(map[string]string, len(receiptLabelKeys))
+	for _, key := range receiptLabelKeys {
+		if translation, err := s.languageService.GetTranslation(ctx, key, language); err == nil && translation != "" {
+			labels[key] = translation
+		} else if translation, ok := defaults[language][key]; ok {
+			labels[key] = translation
+		} else {
+			labels[key] = defaults[models.LanguageEnglish][key]
+		}
+	}
+	return labels
+}
+
+func (s *receiptService) streamerName(streamerID uint) string {
+	streamer, err := s.userService.GetByID(streamerID)
+	if err != nil {
+		fmt.Printf("Warning: Failed to fetch streamer %d for a receipt: %v\n", streamerID, err)
+		return "#" + strconv.FormatUint(uint64(streamerID), 10)
+	}
+	if streamer.FullName != "" {
+		return streamer.FullName
+	}
+	return streamer.Username
+}
+
+func (s *receiptService) formatAmount(amount int64, currency models.SupportedCurrency) string {
+	return s.currencyService.FormatCurrency(models.NewMoney(amount, currency).Major(), currency)
+}
+
+// receiptPaidAt is when the donation was paid, or created for donations completed before
+// payment times were recorded
+func receiptPaidAt(donation *models.Donation) time.Time {
+	if donation.PaymentTime != nil {
+		return *donation.PaymentTime
+	}
+	return donation.CreatedAt
+}
+
+// loadReceiptLocation loads a timezone, falling back to Western Indonesia Time
+func loadReceiptLocation(name string) *time.Location {
+	if location, err := time.LoadLocation(name); err == nil && name != "" {
+		return location
+	}
+	if location, err := time.LoadLocation(defaultReceiptTimezone); err == nil {
+		return location
+	}
+	return time.FixedZone("WIB", 7*60*60)
+}
+
+// formatReceiptDate writes a date the way readers of the language expect it
+func formatReceiptDate(t time.Time, language models.SupportedLanguage) string {
+	switch language {
+	case models.LanguageEnglish:
+		return t.Format("January 2, 2006, 3:04 PM MST")
+	case models.LanguageMandarin:
+		return t.Format("2006年1月2日 15:04 MST")
+	default:
+		return fmt.Sprintf("%d %s %d, %s", t.Day(), indonesianMonths[t.Month()-1], t.Year(), t.Format("15:04 MST"))
+	}
+}
+
+// normalizeReceiptCode accepts codes retyped in lower case or with spaces
+func normalizeReceiptCode(code string) string {
+	return strings.ToUpper(strings.ReplaceAll(code, " ", ""))
+}
+
+// receiptRow is a labelled line of a rendered receipt
+type receiptRow struct {
+	Label  string
+	Value  string
+	Strong bool
+}
+
+func receiptRows(receipt *models.DonationReceipt) []receiptRow {
+	rows := []receiptRow{
+		{Label: receipt.Labels["receipt.donor"], Value: receipt.DonorName},
+		{Label: receipt.Labels["receipt.streamer"], Value: receipt.StreamerName},
+		{Label: receipt.Labels["donation.amount"], Value: receipt.FormattedAmount, Strong: true},
+	}
+	if receipt.FormattedRefund != "" {
+		rows = append(rows, receiptRow{Label: receipt.Labels["receipt.refunded"], Value: receipt.FormattedRefund})
+	}
+	return append(rows,
+		receiptRow{Label: receipt.Labels["receipt.currency"], Value: string(receipt.Currency)},
+		receiptRow{Label: receipt.Labels["receipt.date"], Value: receipt.FormattedDate},
+		receiptRow{Label: receipt.Labels["receipt.transaction_id"], Value: receipt.TransactionID},
+		receiptRow{Label: receipt.Labels["receipt.verification_code"], Value: receipt.VerificationCode, Strong: true},
+	)
+}
+
+func receiptVerifyHint(receipt *models.DonationReceipt) string {
+	if receipt.VerifyURL == "" {
+		return ""
+	}
+	return service.FormatPlaceholders(receipt.Labels["receipt.verify_hint"], map[string]interface{}{"url": receipt.VerifyURL})
+}
+
+type receiptPage struct {
+	Receipt *models.DonationReceipt
+	Rows    []receiptRow
+	Hint    string
+}
+
+var receiptTemplate = template.Must(template.New("receipt").Parse(`<!DOCTYPE html>
+<html lang="{{.Receipt.Language}}">
+<head>
+<meta charset="utf-8">
+<title>{{index .Receipt.Labels "receipt.title"}} #{{.Receipt.DonationID}}</title>
+<style>
+body { margin: 0; background: #f4f4f5; font-family: "Helvetica Neue", Arial, "PingFang SC", "Microsoft YaHei", sans-serif; color: #18181b; }
+.receipt { max-width: 560px; margin: 40px auto; background: #fff; border-radius: 8px; overflow: hidden; box-shadow: 0 1px 3px rgba(0, 0, 0, .1); }
+header { background: #f4f4f5; padding: 32px 40px 24px; }
+h1 { margin: 0 0 8px; font-size: 24px; }
+header p { margin: 0; color: #52525b; }
+table { width: 100%; border-collapse: collapse; margin: 16px 0; }
+th, td { padding: 12px 40px; border-bottom: 1px solid #e4e4e7; text-align: left; vertical-align: top; }
+th { width: 35%; font-weight: normal; color: #71717a; font-size: 14px; }
+td.strong { font-weight: bold; }
+footer { padding: 8px 40px 32px; color: #71717a; font-size: 13px; word-break: break-all; }
+@media print { body { background: #fff; } .receipt { box-shadow: none; margin: 0 auto; } }
+</style>
+</head>
+<body>
+<main class="receipt">
+<header>
+<h1>{{index .Receipt.Labels "receipt.title"}}</h1>
+<p>{{index .Receipt.Labels "receipt.thanks"}}</p>
+</header>
+<table>
+{{range .Rows}}<tr><th>{{.Label}}</th><td{{if .Strong}} class="strong"{{end}}>{{.Value}}</td></tr>
+{{end}}</table>
+{{if .Hint}}<footer>{{.Hint}}</footer>{{end}}
+</main>
+</body>
+</html>
+`))
+
+// receiptValueLength keeps PDF values within the page, which cannot wrap text
+const receiptValueLength = 48
+
+func renderReceiptPDF(receipt *models.DonationReceipt) *pdf.Document {
+	doc := pdf.New()
+	page := doc.AddPage()
+
+	const left, valueLeft = 56.0, 210.0
+	top := pdf.PageHeight
+
+	page.FillRect(0, top-120, pdf.PageWidth, 120, 0.95)
+	page.Text(left, top-72, 22, pdf.Bold, 0, receipt.Labels["receipt.title"])
+	page.Text(left, top-96, 11, pdf.Regular, 0.35, receipt.Labels["receipt.thanks"])
+
+	y := top - 170
+	for _, row := range receiptRows(receipt) {
+		font := pdf.Regular
+		if row.Strong {
+			font = pdf.Bold
+		}
+		page.Text(left, y, 10, pdf.Regular, 0.45, row.Label)
+		page.Text(valueLeft, y, 12, font, 0, truncateRunes(row.Value, receiptValueLength))
+		page.Line(left, y-14, pdf.PageWidth-left, y-14, 0.5, 0.85)
+		y -= 36
+	}
+
+	if hint := receiptVerifyHint(receipt); hint != "" {
+		page.Text(left, 72, 9, pdf.Regular, 0.45, truncateRunes(hint, 110))
+	}
+	return doc
+}
+
+func truncateRunes(s string, n int) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	return string(runes[:n-1]) + "…"
+}
@@ -0,0 +1,263 @@
+package serviceImpl
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+const (
+	// refundReconcileAfter is how long a refund may stay pending before reconciliation
+	// retries it, well past the time a refund request can take
+	refundReconcileAfter = 10 * time.Minute
+	// refundReconcileBatchSize is how many stale refunds one reconciliation run handles
+	refundReconcileBatchSize = 100
+)
+
+type refundService struct {
+	refundRepo   repository.RefundRepository
+	donationRepo repository.DonationRepository
+	processors   map[models.PaymentProvider]service.PaymentProcessor
+	eventBus     service.DonationEventBus
+}
+
+// NewRefundService creates the refund service. processors maps each payment provider
+// to the processor that can refund its transactions; providers without one only
+// accept manual refunds.
+func NewRefundService(refundRepo repository.RefundRepository, donationRepo repository.DonationRepository, processors map[models.PaymentProvider]service.PaymentProcessor, eventBus service.DonationEventBus) service.RefundService {
+	return &refundService{
+		refundRepo:   refundRepo,
+		donationRepo: donationRepo,
+		processors:   processors,
+		eventBus:     eventBus,
+	}
+}
+
+func (s *refundService) RefundDonation(req *service.RefundDonationRequest) (*models.DonationRefund, *models.Donation, error) {
+	donation, err := s.donationRepo.GetByID(req.DonationID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil, service.ErrDonationNotFound
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to load donation: %w", err)
+	}
+	if donation.Status != models.PaymentCompleted {
+		return nil, nil, fmt.Errorf("%w: it is %s", service.ErrRefundNotAllowed, donation.Status)
+	}
+
+	remaining := donation.Amount - donation.RefundedAmount
+	amount := req.Amount
+	if amount == 0 {
+		amount = remaining
+	}
+	if amount <= 0 {
+		return nil, nil, service.ErrInvalidRefundAmount
+	}
+	if amount > remaining {
+		return nil, nil, fmt.Errorf("%w: at most %s %s", service.ErrRefundExceedsRefundable, models.NewMoney(remaining, donation.Currency), donation.Currency)
+	}
+
+	// Chargebacks have already been taken back by the provider, like manual refunds
+	var processor service.PaymentProcessor
+	if !req.Manual && !req.Chargeback {
+		processor = s.processors[donation.PaymentProvider]
+		if processor == nil {
+			return nil, nil, fmt.Errorf("%w: refunds are not supported for payment provider %q", service.ErrRefundNotAllowed, donation.PaymentProvider)
+		}
+		if donation.TransactionID == "" {
+			return nil, nil, fmt.Errorf("%w: donation has no provider transaction to refund", service.ErrRefundNotAllowed)
+		}
+	}
+
+	// Reserve the amount first so concurrent refunds cannot exceed the donation
+	reserved, err := s.donationRepo.ReserveRefund(donation.ID, amount)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !reserved {
+		return nil, nil, fmt.Errorf("%w: donation was refunded concurrently", service.ErrRefundExceedsRefundable)
+	}
+
+	refund := &models.DonationRefund{
+		DonationID:  donation.ID,
+		Amount:      amount,
+		Currency:    donation.Currency,
+		Reason:      req.Reason,
+		Status:      models.RefundPending,
+		Provider:    donation.PaymentProvider,
+		RequestedBy: req.RequestedBy,
+		Chargeback:  req.Chargeback,
+		Manual:      req.Manual,
+	}
+	if err := s.refundRepo.Create(refund); err != nil {
+		s.releaseRefund(donation.ID, amount)
+		return nil, nil, err
+	}
+
+	if processor != nil {
+		providerRefundID, err := processor.RefundPayment(donation.TransactionID, refundKey(refund), models.NewMoney(amount, donation.Currency), req.Reason)
+		if err != nil {
+			s.failRefund(refund, err)
+			return refund, donation, fmt.Errorf("%w: %v", service.ErrRefundProviderFailed, err)
+		}
+		refund.ProviderRefundID = providerRefundID
+	}
+
+	source := req.Source
+	if source == "" {
+		source = models.StatusSourceUser
+	}
+	donation.RefundedAmount += amount
+	if err := s.completeRefund(refund, donation, source); err != nil {
+		// The money may already be back with the donor, so the reservation stays and the
+		// refund is left pending for ReconcilePendingRefunds to record
+		fmt.Printf("Warning: Failed to record refund %d, leaving it for reconciliation: %v\n", refund.ID, err)
+		donation.RefundedAmount -= amount
+		return refund, donation, nil
+	}
+
+	return refund, donation, nil
+}
+
+func (s *refundService) ReconcilePendingRefunds(now time.Time) (int, error) {
+	refunds, err := s.refundRepo.GetStalePending(now.Add(-refundReconcileAfter), refundReconcileBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	reconciled := 0
+	var errs []error
+	for _, refund := range refunds {
+		if err := s.reconcileRefund(refund); err != nil {
+			errs = append(errs, fmt.Errorf("refund %d: %w", refund.ID, err))
+			continue
+		}
+		reconciled++
+	}
+	return reconciled, errors.Join(errs...)
+}
+
+// reconcileRefund finishes a stale pending refund. Its amount is still reserved on the
+// donation, and the provider applies a retried refund key only once, so asking the
+// provider again either returns the refund it already made or makes it now.
+func (s *refundService) reconcileRefund(refund *models.DonationRefund) error {
+	donation, err := s.donationRepo.GetByID(refund.DonationID)
+	if err != nil {
+		return fmt.Errorf("failed to load donation %d: %w", refund.DonationID, err)
+	}
+
+	if !refund.Manual && !refund.Chargeback {
+		processor := s.processors[refund.Provider]
+		if processor == nil {
+			return fmt.Errorf("no processor for payment provider %q", refund.Provider)
+		}
+		providerRefundID, err := processor.RefundPayment(donation.TransactionID, refundKey(refund), models.NewMoney(refund.Amount, refund.Currency), refund.Reason)
+		if err != nil {
+			s.failRefund(refund, err)
+			return nil
+		}
+		refund.ProviderRefundID = providerRefundID
+	}
+
+	return s.completeRefund(refund, donation, models.StatusSourceSystem)
+}
+
+// completeRefund records a refund as succeeded, refunds the donation once nothing of it
+// is left and announces the refund. donation.RefundedAmount must include the refund.
+func (s *refundService) completeRefund(refund *models.DonationRefund, donation *models.Donation, source models.StatusChangeSource) error {
+	now := time.Now()
+	refund.Status = models.RefundSucceeded
+	refund.ProcessedAt = &now
+	finished, err := s.refundRepo.Finish(refund)
+	if err != nil {
+		refund.Status = models.RefundPending
+		refund.ProcessedAt = nil
+		return err
+	}
+	if !finished {
+		// Reconciliation got there first and did the rest
+		return nil
+	}
+
+	if donation.RefundedAmount >= donation.Amount && donation.Status == models.PaymentCompleted {
+		reason := "fully refunded"
+		if refund.Chargeback {
+			reason = "fully charged back"
+		}
+		updated, err := s.donationRepo.TransitionStatus(donation.ID, models.PaymentCompleted, models.PaymentRefunded, &models.DonationStatusHistory{
+			Source:  source,
+			ActorID: refund.RequestedBy,
+			Reason:  reason,
+		})
+		if err != nil {
+			fmt.Printf("Warning: Failed to mark fully refunded donation %d as refunded: %v\n", donation.ID, err)
+		}
+		if updated {
+			donation.Status = models.PaymentRefunded
+		}
+	}
+
+	s.publishRefund(donation, refund)
+	return nil
+}
+
+// failRefund records a refund the provider refused and gives its reservation back. The
+// reservation is kept while the refund cannot be marked failed, so reconciliation can
+// retry it.
+func (s *refundService) failRefund(refund *models.DonationRefund, cause error) {
+	now := time.Now()
+	refund.Status = models.RefundFailed
+	refund.FailureReason = cause.Error()
+	refund.ProcessedAt = &now
+	finished, err := s.refundRepo.Finish(refund)
+	if err != nil {
+		fmt.Printf("Warning: Failed to record failed refund %d: %v\n", refund.ID, err)
+		return
+	}
+	if finished {
+		s.releaseRefund(refund.DonationID, refund.Amount)
+	}
+}
+
+func (s *refundService) GetRefundsByDonation(donationID uint) ([]*models.DonationRefund, error) {
+	return s.refundRepo.GetByDonationID(donationID)
+}
+
+// refundKey identifies a refund to its provider, so retrying it never refunds twice
+func refundKey(refund *models.DonationRefund) string {
+	return "REFUND-" + strconv.FormatUint(uint64(refund.ID), 10)
+}
+
+func (s *refundService) releaseRefund(donationID uint, amount int64) {
+	if err := s.donationRepo.ReleaseRefund(donationID, amount); err != nil {
+		fmt.Printf("Warning: Failed to release refund reservation for donation %d: %v\n", donationID, err)
+	}
+}
+
+// publishRefund tells real-time subscribers that a donation's net amount changed
+func (s *refundService) publishRefund(donation *models.Donation, refund *models.DonationRefund) {
+	if s.eventBus == nil {
+		return
+	}
+
+	snapshot := *donation
+	s.eventBus.Publish(&service.DonationEvent{
+		Type:      service.DonationEventUpdated,
+		Donation:  &snapshot,
+		Timestamp: time.Now(),
+		Metadata: map[string]string{
+			"status":          string(donation.Status),
+			"refund_id":       strconv.FormatUint(uint64(refund.ID), 10),
+			"refund_amount":   strconv.FormatInt(refund.Amount, 10),
+			"refunded_amount": strconv.FormatInt(donation.RefundedAmount, 10),
+			"chargeback":      strconv.FormatBool(refund.Chargeback),
+		},
+	})
+}
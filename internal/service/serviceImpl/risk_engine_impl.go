@@ -0,0 +1,190 @@
+package serviceImpl
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+	"github.com/rzfd/mediashar/pkg/metrics"
+)
+
+// DefaultRiskRules are tuned against card testing: bursts of tiny donations from one donor
+// or address, usually paid from abroad
+var DefaultRiskRules = service.RiskRules{
+	DonorVelocity:    service.RiskVelocityRule{Max: 5, Window: 10 * time.Minute, Points: 40},
+	IPVelocity:       service.RiskVelocityRule{Max: 10, Window: 10 * time.Minute, Points: 50},
+	StreamerVelocity: service.RiskVelocityRule{Max: 60, Window: time.Minute, Points: 30},
+	TinyAmounts:      service.RiskVelocityRule{Max: 3, Window: 10 * time.Minute, Points: 50},
+	TinyAmountThresholds: map[models.SupportedCurrency]int64{
+		models.CurrencyIDR: 10000,
+		models.CurrencyUSD: 100,
+		models.CurrencyEUR: 100,
+		models.CurrencySGD: 150,
+		models.CurrencyMYR: 500,
+		models.CurrencyCNY: 700,
+		models.CurrencyJPY: 150,
+	},
+	CountryMismatchPoints: 30,
+	ChallengeScore:        40,
+	BlockScore:            80,
+}
+
+type riskEngine struct {
+	riskRepo repository.RiskRepository
+	rules    service.RiskRules
+}
+
+func NewRiskEngine(riskRepo repository.RiskRepository, rules service.RiskRules) service.RiskEngine {
+	return &riskEngine{
+		riskRepo: riskRepo,
+		rules:    rules,
+	}
+}
+
+func (e *riskEngine) Assess(req *service.CreateDonationRequest) (*models.RiskAssessment, error) {
+	// A split donation is one payment, so its shares share the first share's decision
+	if req.SplitDonationID != 0 {
+		assessment, err := e.riskRepo.GetBySplitDonationID(req.SplitDonationID)
+		if err == nil {
+			return assessment, nil
+		}
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, err
+		}
+	}
+
+	client := req.Client
+	if client == nil {
+		client = &service.DonationClient{}
+	}
+	currency := models.SupportedCurrency(req.Currency)
+	if currency == "" {
+		currency = models.CurrencyIDR
+	}
+	threshold := e.rules.TinyAmountThresholds[currency]
+
+	assessment := &models.RiskAssessment{
+		StreamerID:      req.StreamerID,
+		DonatorID:       client.UserID,
+		IPAddress:       client.IPAddress,
+		Country:         client.Country,
+		Amount:          req.Amount,
+		Currency:        currency,
+		TinyAmount:      threshold > 0 && req.Amount < threshold,
+		PaymentProvider: req.PaymentProvider,
+		SplitDonationID: req.SplitDonationID,
+		Reasons:         []models.RiskReason{},
+	}
+
+	now := time.Now()
+	if assessment.DonatorID != 0 {
+		err := e.checkVelocity(assessment, models.RiskRuleDonorVelocity, e.rules.DonorVelocity, now,
+			repository.RiskAttemptFilter{DonatorID: assessment.DonatorID}, "by this donor")
+		if err != nil {
+			return nil, err
+		}
+	}
+	if assessment.IPAddress != "" {
+		err := e.checkVelocity(assessment, models.RiskRuleIPVelocity, e.rules.IPVelocity, now,
+			repository.RiskAttemptFilter{IPAddress: assessment.IPAddress}, "from this IP address")
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := e.checkVelocity(assessment, models.RiskRuleStreamerVelocity, e.rules.StreamerVelocity, now,
+		repository.RiskAttemptFilter{StreamerID: assessment.StreamerID}, "to this streamer")
+	if err != nil {
+		return nil, err
+	}
+
+	// Tiny amounts are counted per donor when signed in, otherwise per address
+	if assessment.TinyAmount {
+		filter := repository.RiskAttemptFilter{DonatorID: assessment.DonatorID, TinyOnly: true}
+		source := "for tiny amounts by this donor"
+		if assessment.DonatorID == 0 {
+			filter = repository.RiskAttemptFilter{IPAddress: assessment.IPAddress, TinyOnly: true}
+			source = "for tiny amounts from this IP address"
+		}
+		if filter.DonatorID != 0 || filter.IPAddress != "" {
+			if err := e.checkVelocity(assessment, models.RiskRuleTinyAmounts, e.rules.TinyAmounts, now, filter, source); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if e.rules.CountryMismatchPoints > 0 && assessment.Country != "" && !currency.IsLocalTo(assessment.Country) {
+		assessment.AddReason(models.RiskRuleCountryMismatch, e.rules.CountryMismatchPoints,
+			fmt.Sprintf("%s donation from %s", currency, assessment.Country))
+	}
+
+	switch {
+	case e.rules.BlockScore > 0 && assessment.Score >= e.rules.BlockScore:
+		assessment.Decision = models.RiskBlock
+	case e.rules.ChallengeScore > 0 && assessment.Score >= e.rules.ChallengeScore:
+		assessment.Decision = models.RiskChallenge
+	default:
+		assessment.Decision = models.RiskAllow
+	}
+
+	if err := e.riskRepo.Create(assessment); err != nil {
+		return nil, err
+	}
+
+	if m := metrics.GetMetrics(); m != nil {
+		rules := make([]string, len(assessment.Reasons))
+		for i, reason := range assessment.Reasons {
+			rules[i] = string(reason.Rule)
+		}
+		m.RecordRiskDecision("donation-service", string(assessment.Decision), rules)
+	}
+
+	return assessment, nil
+}
+
+// checkVelocity adds the rule's points when this request would be more than the rule
+// allows within its window. Counts and inserts are not serialized, so a burst of
+// concurrent requests may each see the count from just before the others.
+func (e *riskEngine) checkVelocity(assessment *models.RiskAssessment, rule models.RiskRule, limit service.RiskVelocityRule, now time.Time, filter repository.RiskAttemptFilter, source string) error {
+	if limit.Points <= 0 || limit.Max <= 0 || limit.Window <= 0 {
+		return nil
+	}
+
+	earlier, err := e.riskRepo.CountSince(filter, now.Add(-limit.Window))
+	if err != nil {
+		return fmt.Errorf("failed to count %s requests: %w", rule, err)
+	}
+	if attempts := earlier + 1; attempts > int64(limit.Max) {
+		assessment.AddReason(rule, limit.Points,
+			fmt.Sprintf("%d requests %s within %s, limit %d", attempts, source, limit.Window, limit.Max))
+	}
+	return nil
+}
+
+func (e *riskEngine) LinkDonation(assessment *models.RiskAssessment, donationID uint) error {
+	// Later shares of a split donation reuse an assessment already linked to the first
+	if assessment == nil || assessment.DonationID != 0 {
+		return nil
+	}
+	if err := e.riskRepo.SetDonationID(assessment.ID, donationID); err != nil {
+		return err
+	}
+	assessment.DonationID = donationID
+	return nil
+}
+
+type riskReviewService struct {
+	riskRepo repository.RiskRepository
+}
+
+func NewRiskReviewService(riskRepo repository.RiskRepository) service.RiskReviewService {
+	return &riskReviewService{riskRepo: riskRepo}
+}
+
+func (s *riskReviewService) ListAssessments(decision models.RiskDecision, page, pageSize int) ([]*models.RiskAssessment, error) {
+	return s.riskRepo.List(decision, page, pageSize)
+}
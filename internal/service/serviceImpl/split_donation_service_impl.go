@@ -0,0 +1,313 @@
+package serviceImpl
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/rzfd/mediashar/internal/models"
+	"github.com/rzfd/mediashar/internal/repository"
+	"github.com/rzfd/mediashar/internal/service"
+)
+
+// maxSplitShares caps how many streamers one split donation can pay
+const maxSplitShares = 10
+
+type splitDonationService struct {
+	splitRepo       repository.SplitDonationRepository
+	donationService service.DonationService
+}
+
+func NewSplitDonationService(splitRepo repository.SplitDonationRepository, donationService service.DonationService) service.SplitDonationService {
+	return &splitDonationService{
+		splitRepo:       splitRepo,
+		donationService: donationService,
+	}
+}
+
+func (s *splitDonationService) CreateSplitDonation(req *service.CreateSplitDonationRequest) (*models.SplitDonation, error) {
+	split, err := newSplitDonation(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.splitRepo.Create(split); err != nil {
+		return nil, err
+	}
+
+	// The transaction ID embeds the split's ID, so it is only known once stored
+	split.TransactionID = models.SplitOrderID(split.ID, time.Now())
+	if err := s.splitRepo.Update(split); err != nil {
+		return nil, err
+	}
+
+	// The shares are screened together, so the donor's one payment counts once against
+	// the risk rules however many streamers it is split between
+	reqs := make([]*service.CreateDonationRequest, len(split.Shares))
+	for i, share := range split.Shares {
+		reqs[i] = &service.CreateDonationRequest{
+			Amount:          share.Amount,
+			Currency:        string(split.Currency),
+			Message:         split.Message,
+			StreamerID:      share.StreamerID,
+			DonatorID:       req.DonatorID,
+			DisplayName:     split.DisplayName,
+			IsAnonymous:     split.IsAnonymous,
+			PaymentProvider: split.PaymentProvider,
+			SplitDonationID: split.ID,
+			Client:          req.Client,
+		}
+	}
+	donations, err := s.donationService.CreateSplitShares(reqs)
+	if err != nil {
+		s.abandon(split, "its shares' donations could not be created")
+		return nil, err
+	}
+	if len(donations) != len(split.Shares) {
+		s.abandon(split, "its shares' donations could not be created")
+		return nil, fmt.Errorf("created %d donations for the %d shares of split donation %d", len(donations), len(split.Shares), split.ID)
+	}
+
+	for i := range split.Shares {
+		share := &split.Shares[i]
+		share.DonationID = donations[i].ID
+		share.Donation = donations[i]
+		if err := s.splitRepo.UpdateShare(share); err != nil {
+			s.abandon(split, "a share's donation could not be recorded")
+			return nil, err
+		}
+	}
+
+	return split, nil
+}
+
+func (s *splitDonationService) GetSplitDonation(id uint, withDonations bool) (*models.SplitDonation, error) {
+	split, err := s.splitRepo.GetByID(id)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, service.ErrSplitDonationNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !withDonations {
+		return split, nil
+	}
+
+	for i := range split.Shares {
+		share := &split.Shares[i]
+		if share.DonationID == 0 {
+			continue
+		}
+		donation, err := s.donationService.GetByID(share.DonationID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load donation %d of split donation %d: %w", share.DonationID, split.ID, err)
+		}
+		share.Donation = donation
+		split.RefundedAmount += donation.RefundedAmount
+	}
+	return split, nil
+}
+
+func (s *splitDonationService) GetSplitDonationByTransactionID(transactionID string) (*models.SplitDonation, error) {
+	split, err := s.splitRepo.GetByTransactionID(transactionID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, service.ErrSplitDonationNotFound
+	}
+	return split, err
+}
+
+func (s *splitDonationService) GetSplitDonationsByStreamer(streamerID uint, page, pageSize int) ([]*models.SplitDonation, error) {
+	return s.splitRepo.GetByStreamerID(streamerID, page, pageSize)
+}
+
+func (s *splitDonationService) GetSplitDonationsByDonator(donatorID uint, page, pageSize int) ([]*models.SplitDonation, error) {
+	return s.splitRepo.GetByDonatorID(donatorID, page, pageSize)
+}
+
+func (s *splitDonationService) SettlePayment(splitID uint, status models.PaymentStatus, change service.StatusChange) error {
+	split, err := s.GetSplitDonation(splitID, false)
+	if err != nil {
+		return err
+	}
+	// The split only moves once all of its shares did, so a repeat has nothing left to do
+	if split.Status == status {
+		return nil
+	}
+
+	switch status {
+	case models.PaymentPending:
+		return nil
+
+	case models.PaymentCompleted:
+		// Every share must be paid before the split counts as paid, so a failure here is
+		// returned and the provider's retry picks up the shares that are left
+		var failed []error
+		for _, share := range split.Shares {
+			if share.DonationID == 0 {
+				continue
+			}
+			err := s.donationService.ProcessPayment(share.DonationID, split.TransactionID, split.PaymentProvider)
+			if errors.Is(err, service.ErrInvalidStatusTransition) {
+				// Already paid by an earlier notification, or expired before the payment
+				// arrived, in which case the share has to be refunded by hand
+				fmt.Printf("Warning: Share donation %d of split donation %d was not marked paid: %v\n", share.DonationID, split.ID, err)
+				continue
+			}
+			if err != nil {
+				failed = append(failed, fmt.Errorf("donation %d: %w", share.DonationID, err))
+			}
+		}
+		if len(failed) > 0 {
+			return fmt.Errorf("failed to settle split donation %d: %w", split.ID, errors.Join(failed...))
+		}
+
+	case models.PaymentFailed:
+		s.failShares(split, change)
+
+	default:
+		return fmt.Errorf("split donations cannot move to %s", status)
+	}
+
+	updated, err := s.splitRepo.TransitionStatus(split.ID, models.PaymentPending, status)
+	if err != nil {
+		return err
+	}
+	if !updated {
+		fmt.Printf("Warning: Ignoring stale %s payment outcome for split donation %d\n", status, split.ID)
+	}
+	return nil
+}
+
+// abandon fails a split donation whose shares could not all be created, so the donations
+// that were created are not left waiting for a payment that will never be asked for
+func (s *splitDonationService) abandon(split *models.SplitDonation, reason string) {
+	s.failShares(split, service.StatusChange{Source: models.StatusSourceSystem, Reason: "split donation abandoned: " + reason})
+	if _, err := s.splitRepo.TransitionStatus(split.ID, models.PaymentPending, models.PaymentFailed); err != nil {
+		fmt.Printf("Warning: Failed to mark split donation %d failed: %v\n", split.ID, err)
+	}
+}
+
+// failShares marks the pending donations of a split's shares failed. Donations that
+// already moved on are left alone.
+func (s *splitDonationService) failShares(split *models.SplitDonation, change service.StatusChange) {
+	for _, share := range split.Shares {
+		if share.DonationID == 0 {
+			continue
+		}
+		err := s.donationService.UpdateStatus(share.DonationID, models.PaymentFailed, change)
+		if err != nil && !errors.Is(err, service.ErrInvalidStatusTransition) {
+			fmt.Printf("Warning: Failed to mark share donation %d of split donation %d failed: %v\n", share.DonationID, split.ID, err)
+		}
+	}
+}
+
+// newSplitDonation validates a request and divides its amount between the shares
+func newSplitDonation(req *service.CreateSplitDonationRequest) (*models.SplitDonation, error) {
+	split := &models.SplitDonation{
+		DisplayName:     req.DisplayName,
+		IsAnonymous:     req.IsAnonymous,
+		Message:         req.Message,
+		Amount:          req.Amount,
+		Currency:        models.SupportedCurrency(req.Currency),
+		Mode:            req.Mode,
+		Status:          models.PaymentPending,
+		PaymentProvider: req.PaymentProvider,
+	}
+	if req.DonatorID != nil {
+		split.DonatorID = *req.DonatorID
+	}
+	if split.Currency == "" {
+		split.Currency = models.CurrencyIDR
+	}
+	if split.Mode == "" {
+		split.Mode = models.SplitModeEqual
+	}
+
+	if err := service.ValidateCurrency(split.Currency); err != nil {
+		return nil, err
+	}
+	if split.Amount <= 0 {
+		return nil, errors.New("donation amount must be greater than zero")
+	}
+	switch split.PaymentProvider {
+	case models.PaymentProviderMidtrans:
+		if split.Currency != models.CurrencyIDR {
+			return nil, fmt.Errorf("midtrans only accepts IDR amounts, got %s", split.Currency)
+		}
+	case models.PaymentProviderQRIS:
+	default:
+		return nil, fmt.Errorf("split donations are paid with %s or %s", models.PaymentProviderMidtrans, models.PaymentProviderQRIS)
+	}
+	if len(req.Shares) < 2 || len(req.Shares) > maxSplitShares {
+		return nil, fmt.Errorf("a split donation needs between 2 and %d streamers", maxSplitShares)
+	}
+
+	seen := make(map[uint]bool, len(req.Shares))
+	for _, share := range req.Shares {
+		if share.StreamerID == 0 {
+			return nil, errors.New("streamer ID is required for every share")
+		}
+		if seen[share.StreamerID] {
+			return nil, fmt.Errorf("streamer %d is listed more than once", share.StreamerID)
+		}
+		seen[share.StreamerID] = true
+	}
+
+	basisPoints, err := splitBasisPoints(split.Mode, req.Shares)
+	if err != nil {
+		return nil, err
+	}
+
+	amounts := split.Money().Allocate(basisPoints)
+	for i, share := range req.Shares {
+		if !amounts[i].IsPositive() {
+			return nil, fmt.Errorf("%s %s is too small to split this way", split.Money(), split.Currency)
+		}
+		split.Shares = append(split.Shares, models.SplitDonationShare{
+			StreamerID:  share.StreamerID,
+			BasisPoints: basisPoints[i],
+			Amount:      amounts[i].Minor,
+		})
+	}
+	return split, nil
+}
+
+// splitBasisPoints returns each share's part of 100% in basis points: as equal as
+// possible, earlier shares taking the odd basis points, or the requested percentages,
+// which must add up to exactly 100%
+func splitBasisPoints(mode models.SplitMode, shares []service.SplitShareRequest) ([]int64, error) {
+	weights := make([]int64, len(shares))
+	switch mode {
+	case models.SplitModeEqual:
+		n := int64(len(shares))
+		for i := range weights {
+			weights[i] = models.SplitBasisPointsTotal / n
+			if int64(i) < models.SplitBasisPointsTotal%n {
+				weights[i]++
+			}
+		}
+
+	case models.SplitModePercentage:
+		var total int64
+		for i, share := range shares {
+			basisPoints := math.Round(share.Percentage * 100)
+			if basisPoints <= 0 {
+				return nil, fmt.Errorf("percentage of streamer %d must be greater than zero", share.StreamerID)
+			}
+			if math.Abs(share.Percentage*100-basisPoints) > 1e-6 {
+				return nil, fmt.Errorf("percentage of streamer %d can have at most two decimals", share.StreamerID)
+			}
+			weights[i] = int64(basisPoints)
+			total += weights[i]
+		}
+		if total != models.SplitBasisPointsTotal {
+			return nil, fmt.Errorf("percentages must add up to 100, got %.2f", float64(total)/100)
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported split mode %q", mode)
+	}
+	return weights, nil
+}
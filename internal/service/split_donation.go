@@ -0,0 +1,49 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// ErrSplitDonationNotFound is returned when no split donation matches an ID or transaction ID
+var ErrSplitDonationNotFound = errors.New("split donation not found")
+
+// SplitShareRequest names one streamer of a split donation. Percentage is only used by
+// percentage splits and may have up to two decimals; the percentages must add up to 100.
+type SplitShareRequest struct {
+	StreamerID uint    `json:"streamer_id"`
+	Percentage float64 `json:"percentage"`
+}
+
+type CreateSplitDonationRequest struct {
+	Amount          int64                  `json:"amount"` // Minor units of Currency, divided between the shares
+	Currency        string                 `json:"currency"`
+	Message         string                 `json:"message"`
+	DonatorID       *uint                  `json:"donator_id,omitempty"`
+	DisplayName     string                 `json:"display_name"`
+	IsAnonymous     bool                   `json:"is_anonymous"`
+	Mode            models.SplitMode       `json:"mode"`
+	Shares          []SplitShareRequest    `json:"shares"`
+	PaymentProvider models.PaymentProvider `json:"payment_provider"`
+	Client          *DonationClient        `json:"-"` // Passed on to every share for fraud screening
+}
+
+// SplitDonationService divides one payment between several streamers. Each streamer's
+// share is a regular donation in the donation service, so it shows up in that streamer's
+// donations, stats and overlays and is refunded on its own.
+type SplitDonationService interface {
+	// CreateSplitDonation divides the amount between the streamers and creates a pending
+	// donation for each share. The returned split carries the transaction ID to pay with.
+	CreateSplitDonation(req *CreateSplitDonationRequest) (*models.SplitDonation, error)
+	// GetSplitDonation returns a split donation; withDonations also loads each share's
+	// donation and the split's refunded amount
+	GetSplitDonation(id uint, withDonations bool) (*models.SplitDonation, error)
+	GetSplitDonationByTransactionID(transactionID string) (*models.SplitDonation, error)
+	GetSplitDonationsByStreamer(streamerID uint, page, pageSize int) ([]*models.SplitDonation, error)
+	GetSplitDonationsByDonator(donatorID uint, page, pageSize int) ([]*models.SplitDonation, error)
+
+	// SettlePayment applies the outcome of the split's payment to the split and every
+	// share's donation. Repeating an outcome is a no-op, so provider retries are safe.
+	SettlePayment(splitID uint, status models.PaymentStatus, change StatusChange) error
+}
@@ -0,0 +1,14 @@
+package service
+
+import (
+	"context"
+
+	"github.com/rzfd/mediashar/internal/models"
+)
+
+// MessageTranslator translates donors' messages for streamers who read another language
+type MessageTranslator interface {
+	// TranslateMessage detects the message's language and translates it into language
+	// when the two differ
+	TranslateMessage(ctx context.Context, message string, language models.SupportedLanguage) (*models.MessageTranslation, error)
+}
@@ -0,0 +1,20 @@
+-- Migration: Add Donation Listing Indexes
+-- Description: Composite indexes backing keyset (cursor) pagination of donation listings.
+-- Run against donation_db. Each index matches an ORDER BY of DonationRepository.ListPage,
+-- with id as the tie-breaker, so pages are read straight from the index.
+
+CREATE INDEX IF NOT EXISTS idx_donations_streamer_created
+    ON donations (streamer_id, created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_donations_streamer_amount
+    ON donations (streamer_id, amount DESC, id DESC)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_donations_donator_created
+    ON donations (donator_id, created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_donations_created
+    ON donations (created_at DESC, id DESC)
+    WHERE deleted_at IS NULL;
@@ -0,0 +1,47 @@
+-- Migration: Convert Amounts To Minor Units
+-- Description: Money columns move from floating point to bigint minor units of their
+-- currency (cents for USD, whole rupiah for IDR and yen for JPY), see models.Money.
+-- Each float column is scaled by its row's currency and retyped in one transaction.
+-- Columns that are already bigint are skipped, so the migration is safe to run again and
+-- against any of donation_db, gateway_db or payment_db. The services also apply it on
+-- startup, before GORM's AutoMigrate would retype the columns without scaling them.
+-- Every listed table is rewritten; run it in a quiet period on large databases.
+
+DO $$
+DECLARE
+    target RECORD;
+BEGIN
+    FOR target IN
+        SELECT * FROM (VALUES
+            ('donations', 'amount', 'currency'),
+            ('donations', 'refunded_amount', 'currency'),
+            ('donations', 'converted_amount', 'converted_currency'),
+            ('donation_refunds', 'amount', 'currency'),
+            ('donation_goals', 'target_amount', 'currency'),
+            ('donation_goals', 'current_amount', 'currency'),
+            ('donation_goal_contributions', 'amount',
+                '(SELECT g.currency FROM donation_goals g WHERE g.id = donation_goal_contributions.goal_id)'),
+            ('donation_goal_contributions', 'original_amount', 'original_currency'),
+            ('media_shares', 'donation_amount', 'currency'),
+            ('media_share_settings', 'min_donation_amount', 'currency'),
+            ('membership_tiers', 'monthly_price', 'currency'),
+            ('membership_payments', 'amount', 'currency')
+        ) AS t(table_name, column_name, currency)
+    LOOP
+        IF EXISTS (
+            SELECT 1 FROM information_schema.columns c
+            WHERE c.table_schema = current_schema()
+              AND c.table_name = target.table_name
+              AND c.column_name = target.column_name
+              AND c.data_type IN ('double precision', 'real', 'numeric')
+        ) THEN
+            -- Currencies without decimals keep their value; every other currency has two
+            EXECUTE format(
+                'UPDATE %I SET %I = ROUND(%I * CASE WHEN COALESCE(NULLIF(%s, ''''), ''IDR'') IN (''IDR'', ''JPY'') THEN 1 ELSE 100 END)',
+                target.table_name, target.column_name, target.column_name, target.currency);
+            EXECUTE format(
+                'ALTER TABLE %I ALTER COLUMN %I TYPE bigint USING ROUND(%I)::bigint',
+                target.table_name, target.column_name, target.column_name);
+        END IF;
+    END LOOP;
+END $$;
@@ -0,0 +1,16 @@
+// Package migrations embeds the SQL migrations that services apply themselves on startup
+package migrations
+
+import _ "embed"
+
+// AmountsToMinorUnits rescales float money columns to bigint minor units. It must run
+// before AutoMigrate, which would otherwise change the column types without scaling.
+//
+//go:embed convert_amounts_to_minor_units.sql
+var AmountsToMinorUnits string
+
+// LedgerImmutable makes the ledger tables append-only and checks that every entry
+// balances. It must run after AutoMigrate has created the tables.
+//
+//go:embed ledger_immutable.sql
+var LedgerImmutable string
@@ -29,6 +29,8 @@ type Metrics struct {
 	DonationsTotal        *prometheus.CounterVec
 	DonationAmount        *prometheus.HistogramVec
 	PaymentsProcessed     *prometheus.CounterVec
+	RiskDecisionsTotal    *prometheus.CounterVec
+	RiskRulesTotal        *prometheus.CounterVec
 	
 	// User metrics
 	UserRegistrationsTotal *prometheus.CounterVec
@@ -152,6 +154,20 @@ func NewMetrics(serviceName string) *Metrics {
 			},
 			[]string{"service", "provider", "status"},
 		),
+		RiskDecisionsTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "donation_risk_decisions_total",
+				Help: "Total number of donation requests screened, by fraud screening decision",
+			},
+			[]string{"service", "decision"},
+		),
+		RiskRulesTotal: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "donation_risk_rules_total",
+				Help: "Total number of times each fraud screening rule fired",
+			},
+			[]string{"service", "rule"},
+		),
 		
 		// User metrics
 		UserRegistrationsTotal: prometheus.NewCounterVec(
@@ -323,6 +339,8 @@ func NewMetrics(serviceName string) *Metrics {
 		m.DonationsTotal,
 		m.DonationAmount,
 		m.PaymentsProcessed,
+		m.RiskDecisionsTotal,
+		m.RiskRulesTotal,
 		m.UserRegistrationsTotal,
 		m.TotalUsersRegistered,
 		m.ActiveUsersTotal,
@@ -382,6 +400,14 @@ func (m *Metrics) RecordPayment(serviceName, provider, status string) {
 	m.PaymentsProcessed.WithLabelValues(serviceName, provider, status).Inc()
 }
 
+// RecordRiskDecision records a fraud screening decision and the rules that led to it
+func (m *Metrics) RecordRiskDecision(serviceName, decision string, rules []string) {
+	m.RiskDecisionsTotal.WithLabelValues(serviceName, decision).Inc()
+	for _, rule := range rules {
+		m.RiskRulesTotal.WithLabelValues(serviceName, rule).Inc()
+	}
+}
+
 // RecordUserRegistration records user registration metrics
 func (m *Metrics) RecordUserRegistration(serviceName, platform, status string) {
 	m.UserRegistrationsTotal.WithLabelValues(serviceName, platform, status).Inc()
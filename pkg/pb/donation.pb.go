@@ -90,6 +90,7 @@ const (
 	PaymentProvider_PAYMENT_PROVIDER_STRIPE      PaymentProvider = 3
 	PaymentProvider_PAYMENT_PROVIDER_QRIS        PaymentProvider = 4
 	PaymentProvider_PAYMENT_PROVIDER_CRYPTO      PaymentProvider = 5
+	PaymentProvider_PAYMENT_PROVIDER_SPONSOR     PaymentProvider = 6 // Paid by a matching campaign's sponsor
 )
 
 // Enum value maps for PaymentProvider.
@@ -101,6 +102,7 @@ var (
 		3: "PAYMENT_PROVIDER_STRIPE",
 		4: "PAYMENT_PROVIDER_QRIS",
 		5: "PAYMENT_PROVIDER_CRYPTO",
+		6: "PAYMENT_PROVIDER_SPONSOR",
 	}
 	PaymentProvider_value = map[string]int32{
 		"PAYMENT_PROVIDER_UNSPECIFIED": 0,
@@ -109,6 +111,7 @@ var (
 		"PAYMENT_PROVIDER_STRIPE":      3,
 		"PAYMENT_PROVIDER_QRIS":        4,
 		"PAYMENT_PROVIDER_CRYPTO":      5,
+		"PAYMENT_PROVIDER_SPONSOR":     6,
 	}
 )
 
@@ -139,6 +142,113 @@ func (PaymentProvider) EnumDescriptor() ([]byte, []int) {
 	return file_proto_donation_proto_rawDescGZIP(), []int{1}
 }
 
+type StatusChangeSource int32
+
+const (
+	StatusChangeSource_STATUS_CHANGE_SOURCE_UNSPECIFIED StatusChangeSource = 0
+	StatusChangeSource_STATUS_CHANGE_SOURCE_WEBHOOK     StatusChangeSource = 1
+	StatusChangeSource_STATUS_CHANGE_SOURCE_ADMIN       StatusChangeSource = 2
+	StatusChangeSource_STATUS_CHANGE_SOURCE_USER        StatusChangeSource = 3
+	StatusChangeSource_STATUS_CHANGE_SOURCE_SYSTEM      StatusChangeSource = 4
+)
+
+// Enum value maps for StatusChangeSource.
+var (
+	StatusChangeSource_name = map[int32]string{
+		0: "STATUS_CHANGE_SOURCE_UNSPECIFIED",
+		1: "STATUS_CHANGE_SOURCE_WEBHOOK",
+		2: "STATUS_CHANGE_SOURCE_ADMIN",
+		3: "STATUS_CHANGE_SOURCE_USER",
+		4: "STATUS_CHANGE_SOURCE_SYSTEM",
+	}
+	StatusChangeSource_value = map[string]int32{
+		"STATUS_CHANGE_SOURCE_UNSPECIFIED": 0,
+		"STATUS_CHANGE_SOURCE_WEBHOOK":     1,
+		"STATUS_CHANGE_SOURCE_ADMIN":       2,
+		"STATUS_CHANGE_SOURCE_USER":        3,
+		"STATUS_CHANGE_SOURCE_SYSTEM":      4,
+	}
+)
+
+func (x StatusChangeSource) Enum() *StatusChangeSource {
+	p := new(StatusChangeSource)
+	*p = x
+	return p
+}
+
+func (x StatusChangeSource) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatusChangeSource) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[2].Descriptor()
+}
+
+func (StatusChangeSource) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[2]
+}
+
+func (x StatusChangeSource) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatusChangeSource.Descriptor instead.
+func (StatusChangeSource) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{2}
+}
+
+type RefundStatus int32
+
+const (
+	RefundStatus_REFUND_STATUS_UNSPECIFIED RefundStatus = 0
+	RefundStatus_REFUND_STATUS_PENDING     RefundStatus = 1
+	RefundStatus_REFUND_STATUS_SUCCEEDED   RefundStatus = 2
+	RefundStatus_REFUND_STATUS_FAILED      RefundStatus = 3
+)
+
+// Enum value maps for RefundStatus.
+var (
+	RefundStatus_name = map[int32]string{
+		0: "REFUND_STATUS_UNSPECIFIED",
+		1: "REFUND_STATUS_PENDING",
+		2: "REFUND_STATUS_SUCCEEDED",
+		3: "REFUND_STATUS_FAILED",
+	}
+	RefundStatus_value = map[string]int32{
+		"REFUND_STATUS_UNSPECIFIED": 0,
+		"REFUND_STATUS_PENDING":     1,
+		"REFUND_STATUS_SUCCEEDED":   2,
+		"REFUND_STATUS_FAILED":      3,
+	}
+)
+
+func (x RefundStatus) Enum() *RefundStatus {
+	p := new(RefundStatus)
+	*p = x
+	return p
+}
+
+func (x RefundStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RefundStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[3].Descriptor()
+}
+
+func (RefundStatus) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[3]
+}
+
+func (x RefundStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RefundStatus.Descriptor instead.
+func (RefundStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{3}
+}
+
 type EventType int32
 
 const (
@@ -147,6 +257,7 @@ const (
 	EventType_EVENT_TYPE_DONATION_COMPLETED EventType = 2
 	EventType_EVENT_TYPE_DONATION_FAILED    EventType = 3
 	EventType_EVENT_TYPE_PAYMENT_VERIFIED   EventType = 4
+	EventType_EVENT_TYPE_DONATION_UPDATED   EventType = 5
 )
 
 // Enum value maps for EventType.
@@ -157,6 +268,7 @@ var (
 		2: "EVENT_TYPE_DONATION_COMPLETED",
 		3: "EVENT_TYPE_DONATION_FAILED",
 		4: "EVENT_TYPE_PAYMENT_VERIFIED",
+		5: "EVENT_TYPE_DONATION_UPDATED",
 	}
 	EventType_value = map[string]int32{
 		"EVENT_TYPE_UNSPECIFIED":        0,
@@ -164,6 +276,7 @@ var (
 		"EVENT_TYPE_DONATION_COMPLETED": 2,
 		"EVENT_TYPE_DONATION_FAILED":    3,
 		"EVENT_TYPE_PAYMENT_VERIFIED":   4,
+		"EVENT_TYPE_DONATION_UPDATED":   5,
 	}
 )
 
@@ -173,109 +286,6651 @@ func (x EventType) Enum() *EventType {
 	return p
 }
 
-func (x EventType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x EventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (EventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[4].Descriptor()
+}
+
+func (EventType) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[4]
+}
+
+func (x EventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use EventType.Descriptor instead.
+func (EventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{4}
+}
+
+type StatsInterval int32
+
+const (
+	StatsInterval_STATS_INTERVAL_UNSPECIFIED StatsInterval = 0
+	StatsInterval_STATS_INTERVAL_DAY         StatsInterval = 1
+	StatsInterval_STATS_INTERVAL_WEEK        StatsInterval = 2
+	StatsInterval_STATS_INTERVAL_MONTH       StatsInterval = 3
+)
+
+// Enum value maps for StatsInterval.
+var (
+	StatsInterval_name = map[int32]string{
+		0: "STATS_INTERVAL_UNSPECIFIED",
+		1: "STATS_INTERVAL_DAY",
+		2: "STATS_INTERVAL_WEEK",
+		3: "STATS_INTERVAL_MONTH",
+	}
+	StatsInterval_value = map[string]int32{
+		"STATS_INTERVAL_UNSPECIFIED": 0,
+		"STATS_INTERVAL_DAY":         1,
+		"STATS_INTERVAL_WEEK":        2,
+		"STATS_INTERVAL_MONTH":       3,
+	}
+)
+
+func (x StatsInterval) Enum() *StatsInterval {
+	p := new(StatsInterval)
+	*p = x
+	return p
+}
+
+func (x StatsInterval) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (StatsInterval) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[5].Descriptor()
+}
+
+func (StatsInterval) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[5]
+}
+
+func (x StatsInterval) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use StatsInterval.Descriptor instead.
+func (StatsInterval) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{5}
+}
+
+type DonationSortField int32
+
+const (
+	DonationSortField_DONATION_SORT_FIELD_UNSPECIFIED DonationSortField = 0
+	DonationSortField_DONATION_SORT_FIELD_TIME        DonationSortField = 1
+	DonationSortField_DONATION_SORT_FIELD_AMOUNT      DonationSortField = 2
+)
+
+// Enum value maps for DonationSortField.
+var (
+	DonationSortField_name = map[int32]string{
+		0: "DONATION_SORT_FIELD_UNSPECIFIED",
+		1: "DONATION_SORT_FIELD_TIME",
+		2: "DONATION_SORT_FIELD_AMOUNT",
+	}
+	DonationSortField_value = map[string]int32{
+		"DONATION_SORT_FIELD_UNSPECIFIED": 0,
+		"DONATION_SORT_FIELD_TIME":        1,
+		"DONATION_SORT_FIELD_AMOUNT":      2,
+	}
+)
+
+func (x DonationSortField) Enum() *DonationSortField {
+	p := new(DonationSortField)
+	*p = x
+	return p
+}
+
+func (x DonationSortField) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DonationSortField) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[6].Descriptor()
+}
+
+func (DonationSortField) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[6]
+}
+
+func (x DonationSortField) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DonationSortField.Descriptor instead.
+func (DonationSortField) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{6}
+}
+
+type SortOrder int32
+
+const (
+	SortOrder_SORT_ORDER_UNSPECIFIED SortOrder = 0
+	SortOrder_SORT_ORDER_DESC        SortOrder = 1
+	SortOrder_SORT_ORDER_ASC         SortOrder = 2
+)
+
+// Enum value maps for SortOrder.
+var (
+	SortOrder_name = map[int32]string{
+		0: "SORT_ORDER_UNSPECIFIED",
+		1: "SORT_ORDER_DESC",
+		2: "SORT_ORDER_ASC",
+	}
+	SortOrder_value = map[string]int32{
+		"SORT_ORDER_UNSPECIFIED": 0,
+		"SORT_ORDER_DESC":        1,
+		"SORT_ORDER_ASC":         2,
+	}
+)
+
+func (x SortOrder) Enum() *SortOrder {
+	p := new(SortOrder)
+	*p = x
+	return p
+}
+
+func (x SortOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SortOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[7].Descriptor()
+}
+
+func (SortOrder) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[7]
+}
+
+func (x SortOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SortOrder.Descriptor instead.
+func (SortOrder) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{7}
+}
+
+type AnonymityFilter int32
+
+const (
+	AnonymityFilter_ANONYMITY_FILTER_ANY     AnonymityFilter = 0
+	AnonymityFilter_ANONYMITY_FILTER_ONLY    AnonymityFilter = 1
+	AnonymityFilter_ANONYMITY_FILTER_EXCLUDE AnonymityFilter = 2
+)
+
+// Enum value maps for AnonymityFilter.
+var (
+	AnonymityFilter_name = map[int32]string{
+		0: "ANONYMITY_FILTER_ANY",
+		1: "ANONYMITY_FILTER_ONLY",
+		2: "ANONYMITY_FILTER_EXCLUDE",
+	}
+	AnonymityFilter_value = map[string]int32{
+		"ANONYMITY_FILTER_ANY":     0,
+		"ANONYMITY_FILTER_ONLY":    1,
+		"ANONYMITY_FILTER_EXCLUDE": 2,
+	}
+)
+
+func (x AnonymityFilter) Enum() *AnonymityFilter {
+	p := new(AnonymityFilter)
+	*p = x
+	return p
+}
+
+func (x AnonymityFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AnonymityFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[8].Descriptor()
+}
+
+func (AnonymityFilter) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[8]
+}
+
+func (x AnonymityFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AnonymityFilter.Descriptor instead.
+func (AnonymityFilter) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{8}
+}
+
+type LeaderboardPeriod int32
+
+const (
+	LeaderboardPeriod_LEADERBOARD_PERIOD_UNSPECIFIED LeaderboardPeriod = 0
+	LeaderboardPeriod_LEADERBOARD_PERIOD_ALL_TIME    LeaderboardPeriod = 1
+	LeaderboardPeriod_LEADERBOARD_PERIOD_MONTHLY     LeaderboardPeriod = 2
+	LeaderboardPeriod_LEADERBOARD_PERIOD_WEEKLY      LeaderboardPeriod = 3
+	LeaderboardPeriod_LEADERBOARD_PERIOD_SESSION     LeaderboardPeriod = 4
+)
+
+// Enum value maps for LeaderboardPeriod.
+var (
+	LeaderboardPeriod_name = map[int32]string{
+		0: "LEADERBOARD_PERIOD_UNSPECIFIED",
+		1: "LEADERBOARD_PERIOD_ALL_TIME",
+		2: "LEADERBOARD_PERIOD_MONTHLY",
+		3: "LEADERBOARD_PERIOD_WEEKLY",
+		4: "LEADERBOARD_PERIOD_SESSION",
+	}
+	LeaderboardPeriod_value = map[string]int32{
+		"LEADERBOARD_PERIOD_UNSPECIFIED": 0,
+		"LEADERBOARD_PERIOD_ALL_TIME":    1,
+		"LEADERBOARD_PERIOD_MONTHLY":     2,
+		"LEADERBOARD_PERIOD_WEEKLY":      3,
+		"LEADERBOARD_PERIOD_SESSION":     4,
+	}
+)
+
+func (x LeaderboardPeriod) Enum() *LeaderboardPeriod {
+	p := new(LeaderboardPeriod)
+	*p = x
+	return p
+}
+
+func (x LeaderboardPeriod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LeaderboardPeriod) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[9].Descriptor()
+}
+
+func (LeaderboardPeriod) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[9]
+}
+
+func (x LeaderboardPeriod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LeaderboardPeriod.Descriptor instead.
+func (LeaderboardPeriod) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{9}
+}
+
+type MessageStatus int32
+
+const (
+	MessageStatus_MESSAGE_STATUS_UNSPECIFIED MessageStatus = 0
+	MessageStatus_MESSAGE_STATUS_VISIBLE     MessageStatus = 1
+	MessageStatus_MESSAGE_STATUS_HELD        MessageStatus = 2
+	MessageStatus_MESSAGE_STATUS_REJECTED    MessageStatus = 3
+)
+
+// Enum value maps for MessageStatus.
+var (
+	MessageStatus_name = map[int32]string{
+		0: "MESSAGE_STATUS_UNSPECIFIED",
+		1: "MESSAGE_STATUS_VISIBLE",
+		2: "MESSAGE_STATUS_HELD",
+		3: "MESSAGE_STATUS_REJECTED",
+	}
+	MessageStatus_value = map[string]int32{
+		"MESSAGE_STATUS_UNSPECIFIED": 0,
+		"MESSAGE_STATUS_VISIBLE":     1,
+		"MESSAGE_STATUS_HELD":        2,
+		"MESSAGE_STATUS_REJECTED":    3,
+	}
+)
+
+func (x MessageStatus) Enum() *MessageStatus {
+	p := new(MessageStatus)
+	*p = x
+	return p
+}
+
+func (x MessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[10].Descriptor()
+}
+
+func (MessageStatus) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[10]
+}
+
+func (x MessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageStatus.Descriptor instead.
+func (MessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{10}
+}
+
+type ModerationAction int32
+
+const (
+	ModerationAction_MODERATION_ACTION_UNSPECIFIED ModerationAction = 0
+	ModerationAction_MODERATION_ACTION_MASK        ModerationAction = 1
+	ModerationAction_MODERATION_ACTION_HOLD        ModerationAction = 2
+	ModerationAction_MODERATION_ACTION_REJECT      ModerationAction = 3
+)
+
+// Enum value maps for ModerationAction.
+var (
+	ModerationAction_name = map[int32]string{
+		0: "MODERATION_ACTION_UNSPECIFIED",
+		1: "MODERATION_ACTION_MASK",
+		2: "MODERATION_ACTION_HOLD",
+		3: "MODERATION_ACTION_REJECT",
+	}
+	ModerationAction_value = map[string]int32{
+		"MODERATION_ACTION_UNSPECIFIED": 0,
+		"MODERATION_ACTION_MASK":        1,
+		"MODERATION_ACTION_HOLD":        2,
+		"MODERATION_ACTION_REJECT":      3,
+	}
+)
+
+func (x ModerationAction) Enum() *ModerationAction {
+	p := new(ModerationAction)
+	*p = x
+	return p
+}
+
+func (x ModerationAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ModerationAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[11].Descriptor()
+}
+
+func (ModerationAction) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[11]
+}
+
+func (x ModerationAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ModerationAction.Descriptor instead.
+func (ModerationAction) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{11}
+}
+
+type MessageReviewStatus int32
+
+const (
+	MessageReviewStatus_MESSAGE_REVIEW_STATUS_UNSPECIFIED MessageReviewStatus = 0
+	MessageReviewStatus_MESSAGE_REVIEW_STATUS_PENDING     MessageReviewStatus = 1
+	MessageReviewStatus_MESSAGE_REVIEW_STATUS_APPROVED    MessageReviewStatus = 2
+	MessageReviewStatus_MESSAGE_REVIEW_STATUS_REJECTED    MessageReviewStatus = 3
+)
+
+// Enum value maps for MessageReviewStatus.
+var (
+	MessageReviewStatus_name = map[int32]string{
+		0: "MESSAGE_REVIEW_STATUS_UNSPECIFIED",
+		1: "MESSAGE_REVIEW_STATUS_PENDING",
+		2: "MESSAGE_REVIEW_STATUS_APPROVED",
+		3: "MESSAGE_REVIEW_STATUS_REJECTED",
+	}
+	MessageReviewStatus_value = map[string]int32{
+		"MESSAGE_REVIEW_STATUS_UNSPECIFIED": 0,
+		"MESSAGE_REVIEW_STATUS_PENDING":     1,
+		"MESSAGE_REVIEW_STATUS_APPROVED":    2,
+		"MESSAGE_REVIEW_STATUS_REJECTED":    3,
+	}
+)
+
+func (x MessageReviewStatus) Enum() *MessageReviewStatus {
+	p := new(MessageReviewStatus)
+	*p = x
+	return p
+}
+
+func (x MessageReviewStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageReviewStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[12].Descriptor()
+}
+
+func (MessageReviewStatus) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[12]
+}
+
+func (x MessageReviewStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageReviewStatus.Descriptor instead.
+func (MessageReviewStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{12}
+}
+
+type ExportFormat int32
+
+const (
+	ExportFormat_EXPORT_FORMAT_UNSPECIFIED ExportFormat = 0
+	ExportFormat_EXPORT_FORMAT_CSV         ExportFormat = 1
+	ExportFormat_EXPORT_FORMAT_XLSX        ExportFormat = 2
+)
+
+// Enum value maps for ExportFormat.
+var (
+	ExportFormat_name = map[int32]string{
+		0: "EXPORT_FORMAT_UNSPECIFIED",
+		1: "EXPORT_FORMAT_CSV",
+		2: "EXPORT_FORMAT_XLSX",
+	}
+	ExportFormat_value = map[string]int32{
+		"EXPORT_FORMAT_UNSPECIFIED": 0,
+		"EXPORT_FORMAT_CSV":         1,
+		"EXPORT_FORMAT_XLSX":        2,
+	}
+)
+
+func (x ExportFormat) Enum() *ExportFormat {
+	p := new(ExportFormat)
+	*p = x
+	return p
+}
+
+func (x ExportFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ExportFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[13].Descriptor()
+}
+
+func (ExportFormat) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[13]
+}
+
+func (x ExportFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ExportFormat.Descriptor instead.
+func (ExportFormat) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{13}
+}
+
+type DonationExportStatus int32
+
+const (
+	DonationExportStatus_DONATION_EXPORT_STATUS_UNSPECIFIED DonationExportStatus = 0
+	DonationExportStatus_DONATION_EXPORT_STATUS_PENDING     DonationExportStatus = 1
+	DonationExportStatus_DONATION_EXPORT_STATUS_RUNNING     DonationExportStatus = 2
+	DonationExportStatus_DONATION_EXPORT_STATUS_COMPLETED   DonationExportStatus = 3
+	DonationExportStatus_DONATION_EXPORT_STATUS_FAILED      DonationExportStatus = 4
+)
+
+// Enum value maps for DonationExportStatus.
+var (
+	DonationExportStatus_name = map[int32]string{
+		0: "DONATION_EXPORT_STATUS_UNSPECIFIED",
+		1: "DONATION_EXPORT_STATUS_PENDING",
+		2: "DONATION_EXPORT_STATUS_RUNNING",
+		3: "DONATION_EXPORT_STATUS_COMPLETED",
+		4: "DONATION_EXPORT_STATUS_FAILED",
+	}
+	DonationExportStatus_value = map[string]int32{
+		"DONATION_EXPORT_STATUS_UNSPECIFIED": 0,
+		"DONATION_EXPORT_STATUS_PENDING":     1,
+		"DONATION_EXPORT_STATUS_RUNNING":     2,
+		"DONATION_EXPORT_STATUS_COMPLETED":   3,
+		"DONATION_EXPORT_STATUS_FAILED":      4,
+	}
+)
+
+func (x DonationExportStatus) Enum() *DonationExportStatus {
+	p := new(DonationExportStatus)
+	*p = x
+	return p
+}
+
+func (x DonationExportStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DonationExportStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[14].Descriptor()
+}
+
+func (DonationExportStatus) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[14]
+}
+
+func (x DonationExportStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DonationExportStatus.Descriptor instead.
+func (DonationExportStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{14}
+}
+
+type NotificationType int32
+
+const (
+	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED       NotificationType = 0
+	NotificationType_NOTIFICATION_TYPE_DONATION_RECEIVED NotificationType = 1
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_COMPLETED NotificationType = 2
+	NotificationType_NOTIFICATION_TYPE_PAYMENT_FAILED    NotificationType = 3
+)
+
+// Enum value maps for NotificationType.
+var (
+	NotificationType_name = map[int32]string{
+		0: "NOTIFICATION_TYPE_UNSPECIFIED",
+		1: "NOTIFICATION_TYPE_DONATION_RECEIVED",
+		2: "NOTIFICATION_TYPE_PAYMENT_COMPLETED",
+		3: "NOTIFICATION_TYPE_PAYMENT_FAILED",
+	}
+	NotificationType_value = map[string]int32{
+		"NOTIFICATION_TYPE_UNSPECIFIED":       0,
+		"NOTIFICATION_TYPE_DONATION_RECEIVED": 1,
+		"NOTIFICATION_TYPE_PAYMENT_COMPLETED": 2,
+		"NOTIFICATION_TYPE_PAYMENT_FAILED":    3,
+	}
+)
+
+func (x NotificationType) Enum() *NotificationType {
+	p := new(NotificationType)
+	*p = x
+	return p
+}
+
+func (x NotificationType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[15].Descriptor()
+}
+
+func (NotificationType) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[15]
+}
+
+func (x NotificationType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use NotificationType.Descriptor instead.
+func (NotificationType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{15}
+}
+
+type LedgerEntryType int32
+
+const (
+	LedgerEntryType_LEDGER_ENTRY_TYPE_UNSPECIFIED     LedgerEntryType = 0
+	LedgerEntryType_LEDGER_ENTRY_TYPE_PAYMENT         LedgerEntryType = 1
+	LedgerEntryType_LEDGER_ENTRY_TYPE_REFUND          LedgerEntryType = 2
+	LedgerEntryType_LEDGER_ENTRY_TYPE_CHARGEBACK      LedgerEntryType = 3
+	LedgerEntryType_LEDGER_ENTRY_TYPE_PAYOUT          LedgerEntryType = 4
+	LedgerEntryType_LEDGER_ENTRY_TYPE_PAYOUT_REVERSAL LedgerEntryType = 5
+)
+
+// Enum value maps for LedgerEntryType.
+var (
+	LedgerEntryType_name = map[int32]string{
+		0: "LEDGER_ENTRY_TYPE_UNSPECIFIED",
+		1: "LEDGER_ENTRY_TYPE_PAYMENT",
+		2: "LEDGER_ENTRY_TYPE_REFUND",
+		3: "LEDGER_ENTRY_TYPE_CHARGEBACK",
+		4: "LEDGER_ENTRY_TYPE_PAYOUT",
+		5: "LEDGER_ENTRY_TYPE_PAYOUT_REVERSAL",
+	}
+	LedgerEntryType_value = map[string]int32{
+		"LEDGER_ENTRY_TYPE_UNSPECIFIED":     0,
+		"LEDGER_ENTRY_TYPE_PAYMENT":         1,
+		"LEDGER_ENTRY_TYPE_REFUND":          2,
+		"LEDGER_ENTRY_TYPE_CHARGEBACK":      3,
+		"LEDGER_ENTRY_TYPE_PAYOUT":          4,
+		"LEDGER_ENTRY_TYPE_PAYOUT_REVERSAL": 5,
+	}
+)
+
+func (x LedgerEntryType) Enum() *LedgerEntryType {
+	p := new(LedgerEntryType)
+	*p = x
+	return p
+}
+
+func (x LedgerEntryType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerEntryType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[16].Descriptor()
+}
+
+func (LedgerEntryType) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[16]
+}
+
+func (x LedgerEntryType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerEntryType.Descriptor instead.
+func (LedgerEntryType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{16}
+}
+
+type LedgerAccount int32
+
+const (
+	LedgerAccount_LEDGER_ACCOUNT_UNSPECIFIED       LedgerAccount = 0
+	LedgerAccount_LEDGER_ACCOUNT_PROVIDER_CLEARING LedgerAccount = 1
+	LedgerAccount_LEDGER_ACCOUNT_PROVIDER_FEE      LedgerAccount = 2
+	LedgerAccount_LEDGER_ACCOUNT_PLATFORM_FEE      LedgerAccount = 3
+	LedgerAccount_LEDGER_ACCOUNT_STREAMER_BALANCE  LedgerAccount = 4
+	LedgerAccount_LEDGER_ACCOUNT_PAYOUTS           LedgerAccount = 5
+)
+
+// Enum value maps for LedgerAccount.
+var (
+	LedgerAccount_name = map[int32]string{
+		0: "LEDGER_ACCOUNT_UNSPECIFIED",
+		1: "LEDGER_ACCOUNT_PROVIDER_CLEARING",
+		2: "LEDGER_ACCOUNT_PROVIDER_FEE",
+		3: "LEDGER_ACCOUNT_PLATFORM_FEE",
+		4: "LEDGER_ACCOUNT_STREAMER_BALANCE",
+		5: "LEDGER_ACCOUNT_PAYOUTS",
+	}
+	LedgerAccount_value = map[string]int32{
+		"LEDGER_ACCOUNT_UNSPECIFIED":       0,
+		"LEDGER_ACCOUNT_PROVIDER_CLEARING": 1,
+		"LEDGER_ACCOUNT_PROVIDER_FEE":      2,
+		"LEDGER_ACCOUNT_PLATFORM_FEE":      3,
+		"LEDGER_ACCOUNT_STREAMER_BALANCE":  4,
+		"LEDGER_ACCOUNT_PAYOUTS":           5,
+	}
+)
+
+func (x LedgerAccount) Enum() *LedgerAccount {
+	p := new(LedgerAccount)
+	*p = x
+	return p
+}
+
+func (x LedgerAccount) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LedgerAccount) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[17].Descriptor()
+}
+
+func (LedgerAccount) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[17]
+}
+
+func (x LedgerAccount) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LedgerAccount.Descriptor instead.
+func (LedgerAccount) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{17}
+}
+
+type PayoutStatus int32
+
+const (
+	PayoutStatus_PAYOUT_STATUS_UNSPECIFIED PayoutStatus = 0
+	PayoutStatus_PAYOUT_STATUS_REQUESTED   PayoutStatus = 1
+	PayoutStatus_PAYOUT_STATUS_PROCESSING  PayoutStatus = 2
+	PayoutStatus_PAYOUT_STATUS_PAID        PayoutStatus = 3
+	PayoutStatus_PAYOUT_STATUS_FAILED      PayoutStatus = 4
+	PayoutStatus_PAYOUT_STATUS_REJECTED    PayoutStatus = 5
+)
+
+// Enum value maps for PayoutStatus.
+var (
+	PayoutStatus_name = map[int32]string{
+		0: "PAYOUT_STATUS_UNSPECIFIED",
+		1: "PAYOUT_STATUS_REQUESTED",
+		2: "PAYOUT_STATUS_PROCESSING",
+		3: "PAYOUT_STATUS_PAID",
+		4: "PAYOUT_STATUS_FAILED",
+		5: "PAYOUT_STATUS_REJECTED",
+	}
+	PayoutStatus_value = map[string]int32{
+		"PAYOUT_STATUS_UNSPECIFIED": 0,
+		"PAYOUT_STATUS_REQUESTED":   1,
+		"PAYOUT_STATUS_PROCESSING":  2,
+		"PAYOUT_STATUS_PAID":        3,
+		"PAYOUT_STATUS_FAILED":      4,
+		"PAYOUT_STATUS_REJECTED":    5,
+	}
+)
+
+func (x PayoutStatus) Enum() *PayoutStatus {
+	p := new(PayoutStatus)
+	*p = x
+	return p
+}
+
+func (x PayoutStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PayoutStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[18].Descriptor()
+}
+
+func (PayoutStatus) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[18]
+}
+
+func (x PayoutStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PayoutStatus.Descriptor instead.
+func (PayoutStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{18}
+}
+
+type RiskDecision int32
+
+const (
+	RiskDecision_RISK_DECISION_UNSPECIFIED RiskDecision = 0
+	RiskDecision_RISK_DECISION_ALLOW       RiskDecision = 1
+	RiskDecision_RISK_DECISION_CHALLENGE   RiskDecision = 2
+	RiskDecision_RISK_DECISION_BLOCK       RiskDecision = 3
+)
+
+// Enum value maps for RiskDecision.
+var (
+	RiskDecision_name = map[int32]string{
+		0: "RISK_DECISION_UNSPECIFIED",
+		1: "RISK_DECISION_ALLOW",
+		2: "RISK_DECISION_CHALLENGE",
+		3: "RISK_DECISION_BLOCK",
+	}
+	RiskDecision_value = map[string]int32{
+		"RISK_DECISION_UNSPECIFIED": 0,
+		"RISK_DECISION_ALLOW":       1,
+		"RISK_DECISION_CHALLENGE":   2,
+		"RISK_DECISION_BLOCK":       3,
+	}
+)
+
+func (x RiskDecision) Enum() *RiskDecision {
+	p := new(RiskDecision)
+	*p = x
+	return p
+}
+
+func (x RiskDecision) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (RiskDecision) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_donation_proto_enumTypes[19].Descriptor()
+}
+
+func (RiskDecision) Type() protoreflect.EnumType {
+	return &file_proto_donation_proto_enumTypes[19]
+}
+
+func (x RiskDecision) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use RiskDecision.Descriptor instead.
+func (RiskDecision) EnumDescriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{19}
+}
+
+// Messages
+// Money amounts are int64 minor units of their currency (cents for USD, rupiah for IDR)
+type CreateDonationRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Amount           int64                  `protobuf:"varint,11,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency         string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Message          string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	StreamerId       uint32                 `protobuf:"varint,4,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	DonatorId        uint32                 `protobuf:"varint,5,opt,name=donator_id,json=donatorId,proto3" json:"donator_id,omitempty"`
+	DisplayName      string                 `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	IsAnonymous      bool                   `protobuf:"varint,7,opt,name=is_anonymous,json=isAnonymous,proto3" json:"is_anonymous,omitempty"`
+	PaymentMethod    string                 `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	IdempotencyKey   string                 `protobuf:"bytes,9,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`        // Replays the original response when a create is retried
+	StreamerCurrency string                 `protobuf:"bytes,10,opt,name=streamer_currency,json=streamerCurrency,proto3" json:"streamer_currency,omitempty"` // Streamer's primary currency for the rate snapshot (IDR when empty)
+	SplitDonationId  uint32                 `protobuf:"varint,12,opt,name=split_donation_id,json=splitDonationId,proto3" json:"split_donation_id,omitempty"` // Gateway split donation this is one streamer's share of
+	// Where the request came from, for fraud screening; requests without an IP are not screened
+	ClientIp         string `protobuf:"bytes,13,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	ClientCountry    string `protobuf:"bytes,14,opt,name=client_country,json=clientCountry,proto3" json:"client_country,omitempty"`          // ISO 3166-1 alpha-2, empty when unknown
+	ClientUserId     uint32 `protobuf:"varint,15,opt,name=client_user_id,json=clientUserId,proto3" json:"client_user_id,omitempty"`          // Signed-in donor, also for anonymous donations
+	StreamerLanguage string `protobuf:"bytes,16,opt,name=streamer_language,json=streamerLanguage,proto3" json:"streamer_language,omitempty"` // Streamer's primary language; messages in others are translated into it
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateDonationRequest) Reset() {
+	*x = CreateDonationRequest{}
+	mi := &file_proto_donation_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDonationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDonationRequest) ProtoMessage() {}
+
+func (x *CreateDonationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDonationRequest.ProtoReflect.Descriptor instead.
+func (*CreateDonationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CreateDonationRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateDonationRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CreateDonationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *CreateDonationRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *CreateDonationRequest) GetDonatorId() uint32 {
+	if x != nil {
+		return x.DonatorId
+	}
+	return 0
+}
+
+func (x *CreateDonationRequest) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *CreateDonationRequest) GetIsAnonymous() bool {
+	if x != nil {
+		return x.IsAnonymous
+	}
+	return false
+}
+
+func (x *CreateDonationRequest) GetPaymentMethod() string {
+	if x != nil {
+		return x.PaymentMethod
+	}
+	return ""
+}
+
+func (x *CreateDonationRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+func (x *CreateDonationRequest) GetStreamerCurrency() string {
+	if x != nil {
+		return x.StreamerCurrency
+	}
+	return ""
+}
+
+func (x *CreateDonationRequest) GetSplitDonationId() uint32 {
+	if x != nil {
+		return x.SplitDonationId
+	}
+	return 0
+}
+
+func (x *CreateDonationRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *CreateDonationRequest) GetClientCountry() string {
+	if x != nil {
+		return x.ClientCountry
+	}
+	return ""
+}
+
+func (x *CreateDonationRequest) GetClientUserId() uint32 {
+	if x != nil {
+		return x.ClientUserId
+	}
+	return 0
+}
+
+func (x *CreateDonationRequest) GetStreamerLanguage() string {
+	if x != nil {
+		return x.StreamerLanguage
+	}
+	return ""
+}
+
+type CreateDonationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PaymentUrl    string                 `protobuf:"bytes,3,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	QrCodeBase64  string                 `protobuf:"bytes,4,opt,name=qr_code_base64,json=qrCodeBase64,proto3" json:"qr_code_base64,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Donation      *Donation              `protobuf:"bytes,6,opt,name=donation,proto3" json:"donation,omitempty"` // As stored, after message moderation
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDonationResponse) Reset() {
+	*x = CreateDonationResponse{}
+	mi := &file_proto_donation_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDonationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDonationResponse) ProtoMessage() {}
+
+func (x *CreateDonationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDonationResponse.ProtoReflect.Descriptor instead.
+func (*CreateDonationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateDonationResponse) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
+}
+
+func (x *CreateDonationResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *CreateDonationResponse) GetPaymentUrl() string {
+	if x != nil {
+		return x.PaymentUrl
+	}
+	return ""
+}
+
+func (x *CreateDonationResponse) GetQrCodeBase64() string {
+	if x != nil {
+		return x.QrCodeBase64
+	}
+	return ""
+}
+
+func (x *CreateDonationResponse) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CreateDonationResponse) GetDonation() *Donation {
+	if x != nil {
+		return x.Donation
+	}
+	return nil
+}
+
+type CreateSplitSharesRequest struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Shares        []*CreateDonationRequest `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"` // Idempotency keys are ignored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSplitSharesRequest) Reset() {
+	*x = CreateSplitSharesRequest{}
+	mi := &file_proto_donation_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSplitSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSplitSharesRequest) ProtoMessage() {}
+
+func (x *CreateSplitSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSplitSharesRequest.ProtoReflect.Descriptor instead.
+func (*CreateSplitSharesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CreateSplitSharesRequest) GetShares() []*CreateDonationRequest {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type CreateSplitSharesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Donations     []*Donation            `protobuf:"bytes,1,rep,name=donations,proto3" json:"donations,omitempty"` // In the order of the shares, as stored
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateSplitSharesResponse) Reset() {
+	*x = CreateSplitSharesResponse{}
+	mi := &file_proto_donation_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateSplitSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSplitSharesResponse) ProtoMessage() {}
+
+func (x *CreateSplitSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSplitSharesResponse.ProtoReflect.Descriptor instead.
+func (*CreateSplitSharesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *CreateSplitSharesResponse) GetDonations() []*Donation {
+	if x != nil {
+		return x.Donations
+	}
+	return nil
+}
+
+type GetDonationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationRequest) Reset() {
+	*x = GetDonationRequest{}
+	mi := &file_proto_donation_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationRequest) ProtoMessage() {}
+
+func (x *GetDonationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetDonationRequest) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
+}
+
+type GetDonationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Donation      *Donation              `protobuf:"bytes,1,opt,name=donation,proto3" json:"donation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationResponse) Reset() {
+	*x = GetDonationResponse{}
+	mi := &file_proto_donation_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationResponse) ProtoMessage() {}
+
+func (x *GetDonationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationResponse.ProtoReflect.Descriptor instead.
+func (*GetDonationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetDonationResponse) GetDonation() *Donation {
+	if x != nil {
+		return x.Donation
+	}
+	return nil
+}
+
+type GetDonationByTransactionIDRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationByTransactionIDRequest) Reset() {
+	*x = GetDonationByTransactionIDRequest{}
+	mi := &file_proto_donation_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationByTransactionIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationByTransactionIDRequest) ProtoMessage() {}
+
+func (x *GetDonationByTransactionIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationByTransactionIDRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationByTransactionIDRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GetDonationByTransactionIDRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+type GetDonationsByStreamerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationsByStreamerRequest) Reset() {
+	*x = GetDonationsByStreamerRequest{}
+	mi := &file_proto_donation_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationsByStreamerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationsByStreamerRequest) ProtoMessage() {}
+
+func (x *GetDonationsByStreamerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationsByStreamerRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationsByStreamerRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetDonationsByStreamerRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *GetDonationsByStreamerRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDonationsByStreamerRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetDonationsByDonatorRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DonatorId     uint32                 `protobuf:"varint,1,opt,name=donator_id,json=donatorId,proto3" json:"donator_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationsByDonatorRequest) Reset() {
+	*x = GetDonationsByDonatorRequest{}
+	mi := &file_proto_donation_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationsByDonatorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationsByDonatorRequest) ProtoMessage() {}
+
+func (x *GetDonationsByDonatorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationsByDonatorRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationsByDonatorRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetDonationsByDonatorRequest) GetDonatorId() uint32 {
+	if x != nil {
+		return x.DonatorId
+	}
+	return 0
+}
+
+func (x *GetDonationsByDonatorRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDonationsByDonatorRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetDonationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationsRequest) Reset() {
+	*x = GetDonationsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationsRequest) ProtoMessage() {}
+
+func (x *GetDonationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationsRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetDonationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetDonationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetLatestDonationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Limit         int32                  `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLatestDonationsRequest) Reset() {
+	*x = GetLatestDonationsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLatestDonationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLatestDonationsRequest) ProtoMessage() {}
+
+func (x *GetLatestDonationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLatestDonationsRequest.ProtoReflect.Descriptor instead.
+func (*GetLatestDonationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetLatestDonationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetDonationsListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Donations     []*Donation            `protobuf:"bytes,1,rep,name=donations,proto3" json:"donations,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	CurrentPage   int32                  `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
+	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationsListResponse) Reset() {
+	*x = GetDonationsListResponse{}
+	mi := &file_proto_donation_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationsListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationsListResponse) ProtoMessage() {}
+
+func (x *GetDonationsListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationsListResponse.ProtoReflect.Descriptor instead.
+func (*GetDonationsListResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetDonationsListResponse) GetDonations() []*Donation {
+	if x != nil {
+		return x.Donations
+	}
+	return nil
+}
+
+func (x *GetDonationsListResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *GetDonationsListResponse) GetCurrentPage() int32 {
+	if x != nil {
+		return x.CurrentPage
+	}
+	return 0
+}
+
+func (x *GetDonationsListResponse) GetTotalPages() int32 {
+	if x != nil {
+		return x.TotalPages
+	}
+	return 0
+}
+
+// Zero values match everything; the date range is over creation time, end exclusive
+type DonationFilter struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	DonatorId     uint32                 `protobuf:"varint,2,opt,name=donator_id,json=donatorId,proto3" json:"donator_id,omitempty"`
+	StartDate     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	MinAmount     int64                  `protobuf:"varint,11,opt,name=min_amount,json=minAmount,proto3" json:"min_amount,omitempty"`
+	MaxAmount     int64                  `protobuf:"varint,12,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	Status        PaymentStatus          `protobuf:"varint,7,opt,name=status,proto3,enum=donation.PaymentStatus" json:"status,omitempty"`
+	Currency      string                 `protobuf:"bytes,8,opt,name=currency,proto3" json:"currency,omitempty"`
+	Provider      PaymentProvider        `protobuf:"varint,9,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
+	Anonymity     AnonymityFilter        `protobuf:"varint,10,opt,name=anonymity,proto3,enum=donation.AnonymityFilter" json:"anonymity,omitempty"`
+	ParticipantId uint32                 `protobuf:"varint,13,opt,name=participant_id,json=participantId,proto3" json:"participant_id,omitempty"` // Donations this user made or received
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DonationFilter) Reset() {
+	*x = DonationFilter{}
+	mi := &file_proto_donation_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DonationFilter) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DonationFilter) ProtoMessage() {}
+
+func (x *DonationFilter) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DonationFilter.ProtoReflect.Descriptor instead.
+func (*DonationFilter) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DonationFilter) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *DonationFilter) GetDonatorId() uint32 {
+	if x != nil {
+		return x.DonatorId
+	}
+	return 0
+}
+
+func (x *DonationFilter) GetStartDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *DonationFilter) GetEndDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *DonationFilter) GetMinAmount() int64 {
+	if x != nil {
+		return x.MinAmount
+	}
+	return 0
+}
+
+func (x *DonationFilter) GetMaxAmount() int64 {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return 0
+}
+
+func (x *DonationFilter) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *DonationFilter) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DonationFilter) GetProvider() PaymentProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+func (x *DonationFilter) GetAnonymity() AnonymityFilter {
+	if x != nil {
+		return x.Anonymity
+	}
+	return AnonymityFilter_ANONYMITY_FILTER_ANY
+}
+
+func (x *DonationFilter) GetParticipantId() uint32 {
+	if x != nil {
+		return x.ParticipantId
+	}
+	return 0
+}
+
+// cursor is the next_cursor of the previous page and must be reused with the same sorting
+type ListDonationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Filter        *DonationFilter        `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	SortBy        DonationSortField      `protobuf:"varint,2,opt,name=sort_by,json=sortBy,proto3,enum=donation.DonationSortField" json:"sort_by,omitempty"`
+	Order         SortOrder              `protobuf:"varint,3,opt,name=order,proto3,enum=donation.SortOrder" json:"order,omitempty"`
+	Cursor        string                 `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDonationsRequest) Reset() {
+	*x = ListDonationsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDonationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDonationsRequest) ProtoMessage() {}
+
+func (x *ListDonationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDonationsRequest.ProtoReflect.Descriptor instead.
+func (*ListDonationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ListDonationsRequest) GetFilter() *DonationFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ListDonationsRequest) GetSortBy() DonationSortField {
+	if x != nil {
+		return x.SortBy
+	}
+	return DonationSortField_DONATION_SORT_FIELD_UNSPECIFIED
+}
+
+func (x *ListDonationsRequest) GetOrder() SortOrder {
+	if x != nil {
+		return x.Order
+	}
+	return SortOrder_SORT_ORDER_UNSPECIFIED
+}
+
+func (x *ListDonationsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *ListDonationsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListDonationsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Donations     []*Donation            `protobuf:"bytes,1,rep,name=donations,proto3" json:"donations,omitempty"`
+	NextCursor    string                 `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDonationsResponse) Reset() {
+	*x = ListDonationsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDonationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDonationsResponse) ProtoMessage() {}
+
+func (x *ListDonationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDonationsResponse.ProtoReflect.Descriptor instead.
+func (*ListDonationsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ListDonationsResponse) GetDonations() []*Donation {
+	if x != nil {
+		return x.Donations
+	}
+	return nil
+}
+
+func (x *ListDonationsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *ListDonationsResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+type UpdateDonationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	Status        PaymentStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=donation.PaymentStatus" json:"status,omitempty"`
+	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Source        StatusChangeSource     `protobuf:"varint,4,opt,name=source,proto3,enum=donation.StatusChangeSource" json:"source,omitempty"`
+	ActorId       uint32                 `protobuf:"varint,5,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDonationStatusRequest) Reset() {
+	*x = UpdateDonationStatusRequest{}
+	mi := &file_proto_donation_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDonationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDonationStatusRequest) ProtoMessage() {}
+
+func (x *UpdateDonationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDonationStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDonationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UpdateDonationStatusRequest) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
+}
+
+func (x *UpdateDonationStatusRequest) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *UpdateDonationStatusRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *UpdateDonationStatusRequest) GetSource() StatusChangeSource {
+	if x != nil {
+		return x.Source
+	}
+	return StatusChangeSource_STATUS_CHANGE_SOURCE_UNSPECIFIED
+}
+
+func (x *UpdateDonationStatusRequest) GetActorId() uint32 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *UpdateDonationStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UpdateDonationStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDonationStatusResponse) Reset() {
+	*x = UpdateDonationStatusResponse{}
+	mi := &file_proto_donation_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDonationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDonationStatusResponse) ProtoMessage() {}
+
+func (x *UpdateDonationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDonationStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDonationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UpdateDonationStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UpdateDonationStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetDonationStatusHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationStatusHistoryRequest) Reset() {
+	*x = GetDonationStatusHistoryRequest{}
+	mi := &file_proto_donation_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationStatusHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationStatusHistoryRequest) ProtoMessage() {}
+
+func (x *GetDonationStatusHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationStatusHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationStatusHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDonationStatusHistoryRequest) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
+}
+
+type GetDonationStatusHistoryResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	History       []*DonationStatusChange `protobuf:"bytes,1,rep,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationStatusHistoryResponse) Reset() {
+	*x = GetDonationStatusHistoryResponse{}
+	mi := &file_proto_donation_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationStatusHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationStatusHistoryResponse) ProtoMessage() {}
+
+func (x *GetDonationStatusHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationStatusHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDonationStatusHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetDonationStatusHistoryResponse) GetHistory() []*DonationStatusChange {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+type ProcessDonationPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Provider      PaymentProvider        `protobuf:"varint,3,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessDonationPaymentRequest) Reset() {
+	*x = ProcessDonationPaymentRequest{}
+	mi := &file_proto_donation_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessDonationPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessDonationPaymentRequest) ProtoMessage() {}
+
+func (x *ProcessDonationPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessDonationPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ProcessDonationPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ProcessDonationPaymentRequest) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
+}
+
+func (x *ProcessDonationPaymentRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ProcessDonationPaymentRequest) GetProvider() PaymentProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+type ProcessDonationPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessDonationPaymentResponse) Reset() {
+	*x = ProcessDonationPaymentResponse{}
+	mi := &file_proto_donation_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessDonationPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessDonationPaymentResponse) ProtoMessage() {}
+
+func (x *ProcessDonationPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessDonationPaymentResponse.ProtoReflect.Descriptor instead.
+func (*ProcessDonationPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ProcessDonationPaymentResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ProcessDonationPaymentResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// total_amount sums the donations' snapshot converted_amount, net of refunds
+type GetStreamerDonationTotalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"` // Currency of the total, IDR when empty
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStreamerDonationTotalRequest) Reset() {
+	*x = GetStreamerDonationTotalRequest{}
+	mi := &file_proto_donation_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStreamerDonationTotalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamerDonationTotalRequest) ProtoMessage() {}
+
+func (x *GetStreamerDonationTotalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamerDonationTotalRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamerDonationTotalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetStreamerDonationTotalRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *GetStreamerDonationTotalRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetStreamerDonationTotalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TotalAmount   int64                  `protobuf:"varint,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStreamerDonationTotalResponse) Reset() {
+	*x = GetStreamerDonationTotalResponse{}
+	mi := &file_proto_donation_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStreamerDonationTotalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamerDonationTotalResponse) ProtoMessage() {}
+
+func (x *GetStreamerDonationTotalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamerDonationTotalResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamerDonationTotalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetStreamerDonationTotalResponse) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+type ProcessPaymentRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DonationId     uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	Provider       PaymentProvider        `protobuf:"varint,2,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
+	PaymentData    map[string]string      `protobuf:"bytes,3,rep,name=payment_data,json=paymentData,proto3" json:"payment_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	IdempotencyKey string                 `protobuf:"bytes,4,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"` // Replays the original response when a payment is retried
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ProcessPaymentRequest) Reset() {
+	*x = ProcessPaymentRequest{}
+	mi := &file_proto_donation_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPaymentRequest) ProtoMessage() {}
+
+func (x *ProcessPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPaymentRequest.ProtoReflect.Descriptor instead.
+func (*ProcessPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ProcessPaymentRequest) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
+}
+
+func (x *ProcessPaymentRequest) GetProvider() PaymentProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+func (x *ProcessPaymentRequest) GetPaymentData() map[string]string {
+	if x != nil {
+		return x.PaymentData
+	}
+	return nil
+}
+
+func (x *ProcessPaymentRequest) GetIdempotencyKey() string {
+	if x != nil {
+		return x.IdempotencyKey
+	}
+	return ""
+}
+
+type ProcessPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	PaymentUrl    string                 `protobuf:"bytes,2,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
+	QrCode        string                 `protobuf:"bytes,3,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
+	Status        PaymentStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=donation.PaymentStatus" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ProcessPaymentResponse) Reset() {
+	*x = ProcessPaymentResponse{}
+	mi := &file_proto_donation_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ProcessPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessPaymentResponse) ProtoMessage() {}
+
+func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessPaymentResponse.ProtoReflect.Descriptor instead.
+func (*ProcessPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ProcessPaymentResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *ProcessPaymentResponse) GetPaymentUrl() string {
+	if x != nil {
+		return x.PaymentUrl
+	}
+	return ""
+}
+
+func (x *ProcessPaymentResponse) GetQrCode() string {
+	if x != nil {
+		return x.QrCode
+	}
+	return ""
+}
+
+func (x *ProcessPaymentResponse) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+type VerifyPaymentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Provider      PaymentProvider        `protobuf:"varint,2,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPaymentRequest) Reset() {
+	*x = VerifyPaymentRequest{}
+	mi := &file_proto_donation_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPaymentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPaymentRequest) ProtoMessage() {}
+
+func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPaymentRequest.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *VerifyPaymentRequest) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *VerifyPaymentRequest) GetProvider() PaymentProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+type VerifyPaymentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsVerified    bool                   `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
+	Status        PaymentStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=donation.PaymentStatus" json:"status,omitempty"`
+	Amount        int64                  `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyPaymentResponse) Reset() {
+	*x = VerifyPaymentResponse{}
+	mi := &file_proto_donation_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyPaymentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyPaymentResponse) ProtoMessage() {}
+
+func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyPaymentResponse.ProtoReflect.Descriptor instead.
+func (*VerifyPaymentResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *VerifyPaymentResponse) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *VerifyPaymentResponse) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *VerifyPaymentResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type HandleWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      PaymentProvider        `protobuf:"varint,1,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
+	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleWebhookRequest) Reset() {
+	*x = HandleWebhookRequest{}
+	mi := &file_proto_donation_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleWebhookRequest) ProtoMessage() {}
+
+func (x *HandleWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleWebhookRequest.ProtoReflect.Descriptor instead.
+func (*HandleWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *HandleWebhookRequest) GetProvider() PaymentProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+func (x *HandleWebhookRequest) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *HandleWebhookRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+type HandleWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HandleWebhookResponse) Reset() {
+	*x = HandleWebhookResponse{}
+	mi := &file_proto_donation_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HandleWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleWebhookResponse) ProtoMessage() {}
+
+func (x *HandleWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleWebhookResponse.ProtoReflect.Descriptor instead.
+func (*HandleWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *HandleWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *HandleWebhookResponse) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *HandleWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type StreamDonationEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDonationEventsRequest) Reset() {
+	*x = StreamDonationEventsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDonationEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDonationEventsRequest) ProtoMessage() {}
+
+func (x *StreamDonationEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDonationEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamDonationEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *StreamDonationEventsRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+type DonationEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          EventType              `protobuf:"varint,1,opt,name=type,proto3,enum=donation.EventType" json:"type,omitempty"`
+	Donation      *Donation              `protobuf:"bytes,2,opt,name=donation,proto3" json:"donation,omitempty"`
+	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DonationEvent) Reset() {
+	*x = DonationEvent{}
+	mi := &file_proto_donation_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DonationEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DonationEvent) ProtoMessage() {}
+
+func (x *DonationEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DonationEvent.ProtoReflect.Descriptor instead.
+func (*DonationEvent) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DonationEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *DonationEvent) GetDonation() *Donation {
+	if x != nil {
+		return x.Donation
+	}
+	return nil
+}
+
+func (x *DonationEvent) GetTimestamp() *timestamp.Timestamp {
+	if x != nil {
+		return x.Timestamp
+	}
+	return nil
+}
+
+func (x *DonationEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type SendNotificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Type          NotificationType       `protobuf:"varint,2,opt,name=type,proto3,enum=donation.NotificationType" json:"type,omitempty"`
+	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	Data          map[string]string      `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendNotificationRequest) Reset() {
+	*x = SendNotificationRequest{}
+	mi := &file_proto_donation_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationRequest) ProtoMessage() {}
+
+func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
+func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *SendNotificationRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SendNotificationRequest) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+}
+
+func (x *SendNotificationRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *SendNotificationRequest) GetData() map[string]string {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type SendNotificationResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	NotificationId string                 `protobuf:"bytes,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SendNotificationResponse) Reset() {
+	*x = SendNotificationResponse{}
+	mi := &file_proto_donation_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendNotificationResponse) ProtoMessage() {}
+
+func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
+func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *SendNotificationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SendNotificationResponse) GetNotificationId() string {
+	if x != nil {
+		return x.NotificationId
+	}
+	return ""
+}
+
+type SubscribeEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	EventTypes    []EventType            `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=donation.EventType" json:"event_types,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeEventsRequest) Reset() {
+	*x = SubscribeEventsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SubscribeEventsRequest) GetUserId() uint32 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubscribeEventsRequest) GetEventTypes() []EventType {
+	if x != nil {
+		return x.EventTypes
+	}
+	return nil
+}
+
+type GetDonationStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	StartDate     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Interval      StatsInterval          `protobuf:"varint,4,opt,name=interval,proto3,enum=donation.StatsInterval" json:"interval,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"` // Currency of the overall totals (IDR when empty)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationStatsRequest) Reset() {
+	*x = GetDonationStatsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationStatsRequest) ProtoMessage() {}
+
+func (x *GetDonationStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetDonationStatsRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *GetDonationStatsRequest) GetStartDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetDonationStatsRequest) GetEndDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetDonationStatsRequest) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetDonationStatsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetDonationStatsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	TotalAmount    int64                  `protobuf:"varint,10,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalDonations int32                  `protobuf:"varint,2,opt,name=total_donations,json=totalDonations,proto3" json:"total_donations,omitempty"`
+	AverageAmount  int64                  `protobuf:"varint,11,opt,name=average_amount,json=averageAmount,proto3" json:"average_amount,omitempty"`
+	DailyStats     []*DonationStat        `protobuf:"bytes,4,rep,name=daily_stats,json=dailyStats,proto3" json:"daily_stats,omitempty"`
+	CurrencyStats  []*CurrencyStat        `protobuf:"bytes,5,rep,name=currency_stats,json=currencyStats,proto3" json:"currency_stats,omitempty"`
+	Interval       StatsInterval          `protobuf:"varint,6,opt,name=interval,proto3,enum=donation.StatsInterval" json:"interval,omitempty"`
+	StartDate      *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Currency       string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDonationStatsResponse) Reset() {
+	*x = GetDonationStatsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationStatsResponse) ProtoMessage() {}
+
+func (x *GetDonationStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDonationStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetDonationStatsResponse) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *GetDonationStatsResponse) GetTotalDonations() int32 {
+	if x != nil {
+		return x.TotalDonations
+	}
+	return 0
+}
+
+func (x *GetDonationStatsResponse) GetAverageAmount() int64 {
+	if x != nil {
+		return x.AverageAmount
+	}
+	return 0
+}
+
+func (x *GetDonationStatsResponse) GetDailyStats() []*DonationStat {
+	if x != nil {
+		return x.DailyStats
+	}
+	return nil
+}
+
+func (x *GetDonationStatsResponse) GetCurrencyStats() []*CurrencyStat {
+	if x != nil {
+		return x.CurrencyStats
+	}
+	return nil
+}
+
+func (x *GetDonationStatsResponse) GetInterval() StatsInterval {
+	if x != nil {
+		return x.Interval
+	}
+	return StatsInterval_STATS_INTERVAL_UNSPECIFIED
+}
+
+func (x *GetDonationStatsResponse) GetStartDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetDonationStatsResponse) GetEndDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetDonationStatsResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// One bucket of completed donations; date is the start of the period (YYYY-MM-DD).
+// converted_amount is amount at the donations' snapshot rates into converted_currency.
+type DonationStat struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Date              string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	Amount            int64                  `protobuf:"varint,7,opt,name=amount,proto3" json:"amount,omitempty"`
+	Count             int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	Currency          string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	ConvertedAmount   int64                  `protobuf:"varint,8,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"`
+	ConvertedCurrency string                 `protobuf:"bytes,6,opt,name=converted_currency,json=convertedCurrency,proto3" json:"converted_currency,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *DonationStat) Reset() {
+	*x = DonationStat{}
+	mi := &file_proto_donation_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DonationStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DonationStat) ProtoMessage() {}
+
+func (x *DonationStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DonationStat.ProtoReflect.Descriptor instead.
+func (*DonationStat) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *DonationStat) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *DonationStat) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DonationStat) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *DonationStat) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *DonationStat) GetConvertedAmount() int64 {
+	if x != nil {
+		return x.ConvertedAmount
+	}
+	return 0
+}
+
+func (x *DonationStat) GetConvertedCurrency() string {
+	if x != nil {
+		return x.ConvertedCurrency
+	}
+	return ""
+}
+
+// converted_amount is in the currency of the stats response
+type CurrencyStat struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Currency        string                 `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	TotalAmount     int64                  `protobuf:"varint,6,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	TotalDonations  int32                  `protobuf:"varint,3,opt,name=total_donations,json=totalDonations,proto3" json:"total_donations,omitempty"`
+	AverageAmount   int64                  `protobuf:"varint,7,opt,name=average_amount,json=averageAmount,proto3" json:"average_amount,omitempty"`
+	ConvertedAmount int64                  `protobuf:"varint,8,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CurrencyStat) Reset() {
+	*x = CurrencyStat{}
+	mi := &file_proto_donation_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CurrencyStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CurrencyStat) ProtoMessage() {}
+
+func (x *CurrencyStat) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CurrencyStat.ProtoReflect.Descriptor instead.
+func (*CurrencyStat) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CurrencyStat) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *CurrencyStat) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *CurrencyStat) GetTotalDonations() int32 {
+	if x != nil {
+		return x.TotalDonations
+	}
+	return 0
+}
+
+func (x *CurrencyStat) GetAverageAmount() int64 {
+	if x != nil {
+		return x.AverageAmount
+	}
+	return 0
+}
+
+func (x *CurrencyStat) GetConvertedAmount() int64 {
+	if x != nil {
+		return x.ConvertedAmount
+	}
+	return 0
+}
+
+// amount 0 refunds whatever has not been refunded yet; manual records a refund
+// already made outside the payment provider and chargeback one the provider took back
+type RefundDonationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RequestedBy   uint32                 `protobuf:"varint,4,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	Manual        bool                   `protobuf:"varint,5,opt,name=manual,proto3" json:"manual,omitempty"`
+	Chargeback    bool                   `protobuf:"varint,7,opt,name=chargeback,proto3" json:"chargeback,omitempty"`
+	Source        string                 `protobuf:"bytes,8,opt,name=source,proto3" json:"source,omitempty"` // Status change source recorded when the donation becomes refunded
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundDonationRequest) Reset() {
+	*x = RefundDonationRequest{}
+	mi := &file_proto_donation_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundDonationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundDonationRequest) ProtoMessage() {}
+
+func (x *RefundDonationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundDonationRequest.ProtoReflect.Descriptor instead.
+func (*RefundDonationRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *RefundDonationRequest) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
+}
+
+func (x *RefundDonationRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RefundDonationRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RefundDonationRequest) GetRequestedBy() uint32 {
+	if x != nil {
+		return x.RequestedBy
+	}
+	return 0
+}
+
+func (x *RefundDonationRequest) GetManual() bool {
+	if x != nil {
+		return x.Manual
+	}
+	return false
+}
+
+func (x *RefundDonationRequest) GetChargeback() bool {
+	if x != nil {
+		return x.Chargeback
+	}
+	return false
+}
+
+func (x *RefundDonationRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type RefundDonationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refund        *DonationRefund        `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund,omitempty"`
+	Donation      *Donation              `protobuf:"bytes,2,opt,name=donation,proto3" json:"donation,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefundDonationResponse) Reset() {
+	*x = RefundDonationResponse{}
+	mi := &file_proto_donation_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefundDonationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefundDonationResponse) ProtoMessage() {}
+
+func (x *RefundDonationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefundDonationResponse.ProtoReflect.Descriptor instead.
+func (*RefundDonationResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *RefundDonationResponse) GetRefund() *DonationRefund {
+	if x != nil {
+		return x.Refund
+	}
+	return nil
+}
+
+func (x *RefundDonationResponse) GetDonation() *Donation {
+	if x != nil {
+		return x.Donation
+	}
+	return nil
+}
+
+type ListDonationRefundsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDonationRefundsRequest) Reset() {
+	*x = ListDonationRefundsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDonationRefundsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDonationRefundsRequest) ProtoMessage() {}
+
+func (x *ListDonationRefundsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDonationRefundsRequest.ProtoReflect.Descriptor instead.
+func (*ListDonationRefundsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListDonationRefundsRequest) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
+}
+
+type ListDonationRefundsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Refunds       []*DonationRefund      `protobuf:"bytes,1,rep,name=refunds,proto3" json:"refunds,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDonationRefundsResponse) Reset() {
+	*x = ListDonationRefundsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDonationRefundsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDonationRefundsResponse) ProtoMessage() {}
+
+func (x *ListDonationRefundsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDonationRefundsResponse.ProtoReflect.Descriptor instead.
+func (*ListDonationRefundsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListDonationRefundsResponse) GetRefunds() []*DonationRefund {
+	if x != nil {
+		return x.Refunds
+	}
+	return nil
+}
+
+// start_date and end_date bound a session leaderboard and are ignored for other periods;
+// totals are converted to currency (IDR when empty)
+type GetDonationLeaderboardRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId     uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Period         LeaderboardPeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=donation.LeaderboardPeriod" json:"period,omitempty"`
+	StartDate      *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate        *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Currency       string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Limit          int32                  `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	GroupAnonymous bool                   `protobuf:"varint,7,opt,name=group_anonymous,json=groupAnonymous,proto3" json:"group_anonymous,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDonationLeaderboardRequest) Reset() {
+	*x = GetDonationLeaderboardRequest{}
+	mi := &file_proto_donation_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationLeaderboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationLeaderboardRequest) ProtoMessage() {}
+
+func (x *GetDonationLeaderboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationLeaderboardRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *GetDonationLeaderboardRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *GetDonationLeaderboardRequest) GetPeriod() LeaderboardPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return LeaderboardPeriod_LEADERBOARD_PERIOD_UNSPECIFIED
+}
+
+func (x *GetDonationLeaderboardRequest) GetStartDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetDonationLeaderboardRequest) GetEndDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetDonationLeaderboardRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetDonationLeaderboardRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetDonationLeaderboardRequest) GetGroupAnonymous() bool {
+	if x != nil {
+		return x.GroupAnonymous
+	}
+	return false
+}
+
+type GetDonationLeaderboardResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Period        LeaderboardPeriod      `protobuf:"varint,2,opt,name=period,proto3,enum=donation.LeaderboardPeriod" json:"period,omitempty"`
+	Currency      string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	StartDate     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Entries       []*LeaderboardEntry    `protobuf:"bytes,6,rep,name=entries,proto3" json:"entries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationLeaderboardResponse) Reset() {
+	*x = GetDonationLeaderboardResponse{}
+	mi := &file_proto_donation_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationLeaderboardResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationLeaderboardResponse) ProtoMessage() {}
+
+func (x *GetDonationLeaderboardResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationLeaderboardResponse.ProtoReflect.Descriptor instead.
+func (*GetDonationLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDonationLeaderboardResponse) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *GetDonationLeaderboardResponse) GetPeriod() LeaderboardPeriod {
+	if x != nil {
+		return x.Period
+	}
+	return LeaderboardPeriod_LEADERBOARD_PERIOD_UNSPECIFIED
+}
+
+func (x *GetDonationLeaderboardResponse) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *GetDonationLeaderboardResponse) GetStartDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *GetDonationLeaderboardResponse) GetEndDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *GetDonationLeaderboardResponse) GetEntries() []*LeaderboardEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+// Anonymous donations share a single entry with donator_id 0
+type LeaderboardEntry struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Rank           int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	DonatorId      uint32                 `protobuf:"varint,2,opt,name=donator_id,json=donatorId,proto3" json:"donator_id,omitempty"`
+	DisplayName    string                 `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	IsAnonymous    bool                   `protobuf:"varint,4,opt,name=is_anonymous,json=isAnonymous,proto3" json:"is_anonymous,omitempty"`
+	TotalAmount    int64                  `protobuf:"varint,9,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	DonationCount  int32                  `protobuf:"varint,6,opt,name=donation_count,json=donationCount,proto3" json:"donation_count,omitempty"`
+	FirstDonatedAt *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=first_donated_at,json=firstDonatedAt,proto3" json:"first_donated_at,omitempty"`
+	LastDonatedAt  *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=last_donated_at,json=lastDonatedAt,proto3" json:"last_donated_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LeaderboardEntry) Reset() {
+	*x = LeaderboardEntry{}
+	mi := &file_proto_donation_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LeaderboardEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LeaderboardEntry) ProtoMessage() {}
+
+func (x *LeaderboardEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LeaderboardEntry.ProtoReflect.Descriptor instead.
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *LeaderboardEntry) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetDonatorId() uint32 {
+	if x != nil {
+		return x.DonatorId
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *LeaderboardEntry) GetIsAnonymous() bool {
+	if x != nil {
+		return x.IsAnonymous
+	}
+	return false
+}
+
+func (x *LeaderboardEntry) GetTotalAmount() int64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetDonationCount() int32 {
+	if x != nil {
+		return x.DonationCount
+	}
+	return 0
+}
+
+func (x *LeaderboardEntry) GetFirstDonatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.FirstDonatedAt
+	}
+	return nil
+}
+
+func (x *LeaderboardEntry) GetLastDonatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.LastDonatedAt
+	}
+	return nil
+}
+
+type CreateDonationGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *DonationGoal          `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDonationGoalRequest) Reset() {
+	*x = CreateDonationGoalRequest{}
+	mi := &file_proto_donation_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDonationGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDonationGoalRequest) ProtoMessage() {}
+
+func (x *CreateDonationGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDonationGoalRequest.ProtoReflect.Descriptor instead.
+func (*CreateDonationGoalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateDonationGoalRequest) GetGoal() *DonationGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type UpdateDonationGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *DonationGoal          `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDonationGoalRequest) Reset() {
+	*x = UpdateDonationGoalRequest{}
+	mi := &file_proto_donation_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDonationGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDonationGoalRequest) ProtoMessage() {}
+
+func (x *UpdateDonationGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDonationGoalRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDonationGoalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *UpdateDonationGoalRequest) GetGoal() *DonationGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type DonationGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goal          *DonationGoal          `protobuf:"bytes,1,opt,name=goal,proto3" json:"goal,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DonationGoalResponse) Reset() {
+	*x = DonationGoalResponse{}
+	mi := &file_proto_donation_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DonationGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DonationGoalResponse) ProtoMessage() {}
+
+func (x *DonationGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DonationGoalResponse.ProtoReflect.Descriptor instead.
+func (*DonationGoalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DonationGoalResponse) GetGoal() *DonationGoal {
+	if x != nil {
+		return x.Goal
+	}
+	return nil
+}
+
+type DeleteDonationGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	GoalId        uint32                 `protobuf:"varint,2,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDonationGoalRequest) Reset() {
+	*x = DeleteDonationGoalRequest{}
+	mi := &file_proto_donation_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDonationGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDonationGoalRequest) ProtoMessage() {}
+
+func (x *DeleteDonationGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDonationGoalRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDonationGoalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DeleteDonationGoalRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *DeleteDonationGoalRequest) GetGoalId() uint32 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+type DeleteDonationGoalResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDonationGoalResponse) Reset() {
+	*x = DeleteDonationGoalResponse{}
+	mi := &file_proto_donation_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDonationGoalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDonationGoalResponse) ProtoMessage() {}
+
+func (x *DeleteDonationGoalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDonationGoalResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDonationGoalResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DeleteDonationGoalResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// The range is half-open: start_date inclusive, end_date exclusive. Converted amounts
+// use currency (IDR when empty).
+type ExportDonationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,2,opt,name=format,proto3,enum=donation.ExportFormat" json:"format,omitempty"`
+	StartDate     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDonationsRequest) Reset() {
+	*x = ExportDonationsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDonationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDonationsRequest) ProtoMessage() {}
+
+func (x *ExportDonationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDonationsRequest.ProtoReflect.Descriptor instead.
+func (*ExportDonationsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *ExportDonationsRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *ExportDonationsRequest) GetFormat() ExportFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
+}
+
+func (x *ExportDonationsRequest) GetStartDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
+}
+
+func (x *ExportDonationsRequest) GetEndDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
+}
+
+func (x *ExportDonationsRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+// A piece of an export file; concatenating the chunks in order gives the file
+type ExportChunk struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportChunk) Reset() {
+	*x = ExportChunk{}
+	mi := &file_proto_donation_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportChunk) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportChunk) ProtoMessage() {}
+
+func (x *ExportChunk) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportChunk.ProtoReflect.Descriptor instead.
+func (*ExportChunk) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *ExportChunk) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetDonationExportRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	ExportId      uint32                 `protobuf:"varint,2,opt,name=export_id,json=exportId,proto3" json:"export_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationExportRequest) Reset() {
+	*x = GetDonationExportRequest{}
+	mi := &file_proto_donation_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationExportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationExportRequest) ProtoMessage() {}
+
+func (x *GetDonationExportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationExportRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationExportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetDonationExportRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *GetDonationExportRequest) GetExportId() uint32 {
+	if x != nil {
+		return x.ExportId
+	}
+	return 0
+}
+
+type DonationExportResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Export        *DonationExport        `protobuf:"bytes,1,opt,name=export,proto3" json:"export,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DonationExportResponse) Reset() {
+	*x = DonationExportResponse{}
+	mi := &file_proto_donation_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DonationExportResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DonationExportResponse) ProtoMessage() {}
+
+func (x *DonationExportResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DonationExportResponse.ProtoReflect.Descriptor instead.
+func (*DonationExportResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *DonationExportResponse) GetExport() *DonationExport {
+	if x != nil {
+		return x.Export
+	}
+	return nil
+}
+
+type GetDonationGoalRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GoalId        uint32                 `protobuf:"varint,1,opt,name=goal_id,json=goalId,proto3" json:"goal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDonationGoalRequest) Reset() {
+	*x = GetDonationGoalRequest{}
+	mi := &file_proto_donation_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDonationGoalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDonationGoalRequest) ProtoMessage() {}
+
+func (x *GetDonationGoalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDonationGoalRequest.ProtoReflect.Descriptor instead.
+func (*GetDonationGoalRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetDonationGoalRequest) GetGoalId() uint32 {
+	if x != nil {
+		return x.GoalId
+	}
+	return 0
+}
+
+type ListDonationGoalsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	ActiveOnly    bool                   `protobuf:"varint,2,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDonationGoalsRequest) Reset() {
+	*x = ListDonationGoalsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDonationGoalsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDonationGoalsRequest) ProtoMessage() {}
+
+func (x *ListDonationGoalsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDonationGoalsRequest.ProtoReflect.Descriptor instead.
+func (*ListDonationGoalsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *ListDonationGoalsRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *ListDonationGoalsRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+type ListDonationGoalsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Goals         []*DonationGoal        `protobuf:"bytes,1,rep,name=goals,proto3" json:"goals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDonationGoalsResponse) Reset() {
+	*x = ListDonationGoalsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDonationGoalsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDonationGoalsResponse) ProtoMessage() {}
+
+func (x *ListDonationGoalsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDonationGoalsResponse.ProtoReflect.Descriptor instead.
+func (*ListDonationGoalsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListDonationGoalsResponse) GetGoals() []*DonationGoal {
+	if x != nil {
+		return x.Goals
+	}
+	return nil
+}
+
+type GetModerationSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetModerationSettingsRequest) Reset() {
+	*x = GetModerationSettingsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetModerationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetModerationSettingsRequest) ProtoMessage() {}
+
+func (x *GetModerationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetModerationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetModerationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetModerationSettingsRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+type UpdateModerationSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *ModerationSettings    `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateModerationSettingsRequest) Reset() {
+	*x = UpdateModerationSettingsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateModerationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateModerationSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateModerationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateModerationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateModerationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateModerationSettingsRequest) GetSettings() *ModerationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ModerationSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Settings      *ModerationSettings    `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ModerationSettingsResponse) Reset() {
+	*x = ModerationSettingsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ModerationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModerationSettingsResponse) ProtoMessage() {}
+
+func (x *ModerationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ModerationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*ModerationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ModerationSettingsResponse) GetSettings() *ModerationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type ListMessageReviewsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Status        MessageReviewStatus    `protobuf:"varint,2,opt,name=status,proto3,enum=donation.MessageReviewStatus" json:"status,omitempty"` // Unspecified lists every status
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageReviewsRequest) Reset() {
+	*x = ListMessageReviewsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageReviewsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageReviewsRequest) ProtoMessage() {}
+
+func (x *ListMessageReviewsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageReviewsRequest.ProtoReflect.Descriptor instead.
+func (*ListMessageReviewsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ListMessageReviewsRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *ListMessageReviewsRequest) GetStatus() MessageReviewStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MessageReviewStatus_MESSAGE_REVIEW_STATUS_UNSPECIFIED
+}
+
+func (x *ListMessageReviewsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMessageReviewsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListMessageReviewsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Reviews       []*MessageReview       `protobuf:"bytes,1,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMessageReviewsResponse) Reset() {
+	*x = ListMessageReviewsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMessageReviewsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMessageReviewsResponse) ProtoMessage() {}
+
+func (x *ListMessageReviewsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMessageReviewsResponse.ProtoReflect.Descriptor instead.
+func (*ListMessageReviewsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ListMessageReviewsResponse) GetReviews() []*MessageReview {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+func (x *ListMessageReviewsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListMessageReviewsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMessageReviewsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ResolveMessageReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	ReviewId      uint32                 `protobuf:"varint,2,opt,name=review_id,json=reviewId,proto3" json:"review_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResolveMessageReviewRequest) Reset() {
+	*x = ResolveMessageReviewRequest{}
+	mi := &file_proto_donation_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResolveMessageReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResolveMessageReviewRequest) ProtoMessage() {}
+
+func (x *ResolveMessageReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResolveMessageReviewRequest.ProtoReflect.Descriptor instead.
+func (*ResolveMessageReviewRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ResolveMessageReviewRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *ResolveMessageReviewRequest) GetReviewId() uint32 {
+	if x != nil {
+		return x.ReviewId
+	}
+	return 0
+}
+
+type MessageReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Review        *MessageReview         `protobuf:"bytes,1,opt,name=review,proto3" json:"review,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MessageReviewResponse) Reset() {
+	*x = MessageReviewResponse{}
+	mi := &file_proto_donation_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MessageReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MessageReviewResponse) ProtoMessage() {}
+
+func (x *MessageReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MessageReviewResponse.ProtoReflect.Descriptor instead.
+func (*MessageReviewResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *MessageReviewResponse) GetReview() *MessageReview {
+	if x != nil {
+		return x.Review
+	}
+	return nil
+}
+
+type ListBlockedTermsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedTermsRequest) Reset() {
+	*x = ListBlockedTermsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedTermsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedTermsRequest) ProtoMessage() {}
+
+func (x *ListBlockedTermsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedTermsRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedTermsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{64}
+}
+
+type ListBlockedTermsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Terms         []*BlockedTerm         `protobuf:"bytes,1,rep,name=terms,proto3" json:"terms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListBlockedTermsResponse) Reset() {
+	*x = ListBlockedTermsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListBlockedTermsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListBlockedTermsResponse) ProtoMessage() {}
+
+func (x *ListBlockedTermsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListBlockedTermsResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedTermsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListBlockedTermsResponse) GetTerms() []*BlockedTerm {
+	if x != nil {
+		return x.Terms
+	}
+	return nil
+}
+
+type AddBlockedTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          string                 `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	IsPattern     bool                   `protobuf:"varint,2,opt,name=is_pattern,json=isPattern,proto3" json:"is_pattern,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddBlockedTermRequest) Reset() {
+	*x = AddBlockedTermRequest{}
+	mi := &file_proto_donation_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddBlockedTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddBlockedTermRequest) ProtoMessage() {}
+
+func (x *AddBlockedTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddBlockedTermRequest.ProtoReflect.Descriptor instead.
+func (*AddBlockedTermRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AddBlockedTermRequest) GetTerm() string {
+	if x != nil {
+		return x.Term
+	}
+	return ""
+}
+
+func (x *AddBlockedTermRequest) GetIsPattern() bool {
+	if x != nil {
+		return x.IsPattern
+	}
+	return false
+}
+
+type BlockedTermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Term          *BlockedTerm           `protobuf:"bytes,1,opt,name=term,proto3" json:"term,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BlockedTermResponse) Reset() {
+	*x = BlockedTermResponse{}
+	mi := &file_proto_donation_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BlockedTermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockedTermResponse) ProtoMessage() {}
+
+func (x *BlockedTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockedTermResponse.ProtoReflect.Descriptor instead.
+func (*BlockedTermResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *BlockedTermResponse) GetTerm() *BlockedTerm {
+	if x != nil {
+		return x.Term
+	}
+	return nil
+}
+
+type RemoveBlockedTermRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBlockedTermRequest) Reset() {
+	*x = RemoveBlockedTermRequest{}
+	mi := &file_proto_donation_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBlockedTermRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBlockedTermRequest) ProtoMessage() {}
+
+func (x *RemoveBlockedTermRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBlockedTermRequest.ProtoReflect.Descriptor instead.
+func (*RemoveBlockedTermRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *RemoveBlockedTermRequest) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RemoveBlockedTermResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveBlockedTermResponse) Reset() {
+	*x = RemoveBlockedTermResponse{}
+	mi := &file_proto_donation_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveBlockedTermResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveBlockedTermResponse) ProtoMessage() {}
+
+func (x *RemoveBlockedTermResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveBlockedTermResponse.ProtoReflect.Descriptor instead.
+func (*RemoveBlockedTermResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RemoveBlockedTermResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type GetStreamerBalanceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStreamerBalanceRequest) Reset() {
+	*x = GetStreamerBalanceRequest{}
+	mi := &file_proto_donation_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStreamerBalanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamerBalanceRequest) ProtoMessage() {}
+
+func (x *GetStreamerBalanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamerBalanceRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamerBalanceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetStreamerBalanceRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+type GetStreamerBalanceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Balances      []*StreamerBalance     `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"` // One per currency the streamer has been paid in
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetStreamerBalanceResponse) Reset() {
+	*x = GetStreamerBalanceResponse{}
+	mi := &file_proto_donation_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetStreamerBalanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamerBalanceResponse) ProtoMessage() {}
+
+func (x *GetStreamerBalanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamerBalanceResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamerBalanceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *GetStreamerBalanceResponse) GetBalances() []*StreamerBalance {
+	if x != nil {
+		return x.Balances
+	}
+	return nil
+}
+
+type ListLedgerEntriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLedgerEntriesRequest) Reset() {
+	*x = ListLedgerEntriesRequest{}
+	mi := &file_proto_donation_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerEntriesRequest) ProtoMessage() {}
+
+func (x *ListLedgerEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ListLedgerEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *ListLedgerEntriesRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *ListLedgerEntriesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLedgerEntriesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListLedgerEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entries       []*LedgerEntry         `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLedgerEntriesResponse) Reset() {
+	*x = ListLedgerEntriesResponse{}
+	mi := &file_proto_donation_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLedgerEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLedgerEntriesResponse) ProtoMessage() {}
+
+func (x *ListLedgerEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLedgerEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ListLedgerEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ListLedgerEntriesResponse) GetEntries() []*LedgerEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ListLedgerEntriesResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListLedgerEntriesResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type CreatePayoutAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Channel       string                 `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,3,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountName   string                 `protobuf:"bytes,4,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePayoutAccountRequest) Reset() {
+	*x = CreatePayoutAccountRequest{}
+	mi := &file_proto_donation_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePayoutAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePayoutAccountRequest) ProtoMessage() {}
+
+func (x *CreatePayoutAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePayoutAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreatePayoutAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *CreatePayoutAccountRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *CreatePayoutAccountRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *CreatePayoutAccountRequest) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *CreatePayoutAccountRequest) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
+}
+
+type ListPayoutAccountsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutAccountsRequest) Reset() {
+	*x = ListPayoutAccountsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutAccountsRequest) ProtoMessage() {}
+
+func (x *ListPayoutAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutAccountsRequest.ProtoReflect.Descriptor instead.
+func (*ListPayoutAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *ListPayoutAccountsRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+type ListPayoutAccountsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Accounts      []*PayoutAccount       `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutAccountsResponse) Reset() {
+	*x = ListPayoutAccountsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutAccountsResponse) ProtoMessage() {}
+
+func (x *ListPayoutAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutAccountsResponse.ProtoReflect.Descriptor instead.
+func (*ListPayoutAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListPayoutAccountsResponse) GetAccounts() []*PayoutAccount {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type DeletePayoutAccountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	AccountId     uint32                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePayoutAccountRequest) Reset() {
+	*x = DeletePayoutAccountRequest{}
+	mi := &file_proto_donation_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePayoutAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePayoutAccountRequest) ProtoMessage() {}
+
+func (x *DeletePayoutAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePayoutAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeletePayoutAccountRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *DeletePayoutAccountRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *DeletePayoutAccountRequest) GetAccountId() uint32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+type DeletePayoutAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePayoutAccountResponse) Reset() {
+	*x = DeletePayoutAccountResponse{}
+	mi := &file_proto_donation_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePayoutAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePayoutAccountResponse) ProtoMessage() {}
+
+func (x *DeletePayoutAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePayoutAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeletePayoutAccountResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *DeletePayoutAccountResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type RequestPayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	AccountId     uint32                 `protobuf:"varint,2,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency      string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPayoutRequest) Reset() {
+	*x = RequestPayoutRequest{}
+	mi := &file_proto_donation_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPayoutRequest) ProtoMessage() {}
+
+func (x *RequestPayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPayoutRequest.ProtoReflect.Descriptor instead.
+func (*RequestPayoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *RequestPayoutRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *RequestPayoutRequest) GetAccountId() uint32 {
+	if x != nil {
+		return x.AccountId
+	}
+	return 0
+}
+
+func (x *RequestPayoutRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RequestPayoutRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+type GetPayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      uint32                 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPayoutRequest) Reset() {
+	*x = GetPayoutRequest{}
+	mi := &file_proto_donation_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPayoutRequest) ProtoMessage() {}
+
+func (x *GetPayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPayoutRequest.ProtoReflect.Descriptor instead.
+func (*GetPayoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *GetPayoutRequest) GetPayoutId() uint32 {
+	if x != nil {
+		return x.PayoutId
+	}
+	return 0
+}
+
+// Lists a streamer's payouts newest first, or with streamer_id 0 every payout in
+// status (all when unspecified) oldest first
+type ListPayoutsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Status        PayoutStatus           `protobuf:"varint,2,opt,name=status,proto3,enum=donation.PayoutStatus" json:"status,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutsRequest) Reset() {
+	*x = ListPayoutsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsRequest) ProtoMessage() {}
+
+func (x *ListPayoutsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsRequest.ProtoReflect.Descriptor instead.
+func (*ListPayoutsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListPayoutsRequest) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *ListPayoutsRequest) GetStatus() PayoutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PayoutStatus_PAYOUT_STATUS_UNSPECIFIED
+}
+
+func (x *ListPayoutsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPayoutsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListPayoutsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Payouts       []*Payout              `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPayoutsResponse) Reset() {
+	*x = ListPayoutsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPayoutsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPayoutsResponse) ProtoMessage() {}
+
+func (x *ListPayoutsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPayoutsResponse.ProtoReflect.Descriptor instead.
+func (*ListPayoutsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ListPayoutsResponse) GetPayouts() []*Payout {
+	if x != nil {
+		return x.Payouts
+	}
+	return nil
+}
+
+func (x *ListPayoutsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListPayoutsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListRiskAssessmentsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Decision      RiskDecision           `protobuf:"varint,1,opt,name=decision,proto3,enum=donation.RiskDecision" json:"decision,omitempty"` // All decisions when unspecified
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskAssessmentsRequest) Reset() {
+	*x = ListRiskAssessmentsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskAssessmentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskAssessmentsRequest) ProtoMessage() {}
+
+func (x *ListRiskAssessmentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskAssessmentsRequest.ProtoReflect.Descriptor instead.
+func (*ListRiskAssessmentsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ListRiskAssessmentsRequest) GetDecision() RiskDecision {
+	if x != nil {
+		return x.Decision
+	}
+	return RiskDecision_RISK_DECISION_UNSPECIFIED
+}
+
+func (x *ListRiskAssessmentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRiskAssessmentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListRiskAssessmentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Assessments   []*RiskAssessment      `protobuf:"bytes,1,rep,name=assessments,proto3" json:"assessments,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRiskAssessmentsResponse) Reset() {
+	*x = ListRiskAssessmentsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRiskAssessmentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRiskAssessmentsResponse) ProtoMessage() {}
+
+func (x *ListRiskAssessmentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRiskAssessmentsResponse.ProtoReflect.Descriptor instead.
+func (*ListRiskAssessmentsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ListRiskAssessmentsResponse) GetAssessments() []*RiskAssessment {
+	if x != nil {
+		return x.Assessments
+	}
+	return nil
+}
+
+func (x *ListRiskAssessmentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListRiskAssessmentsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type MatchCampaignRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Name             string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	SponsorName      string                 `protobuf:"bytes,2,opt,name=sponsor_name,json=sponsorName,proto3" json:"sponsor_name,omitempty"`
+	SponsorUserId    uint32                 `protobuf:"varint,3,opt,name=sponsor_user_id,json=sponsorUserId,proto3" json:"sponsor_user_id,omitempty"` // 0 when the sponsor has no account
+	StreamerIds      []uint32               `protobuf:"varint,4,rep,packed,name=streamer_ids,json=streamerIds,proto3" json:"streamer_ids,omitempty"`
+	Currency         string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	RatioBasisPoints int64                  `protobuf:"varint,6,opt,name=ratio_basis_points,json=ratioBasisPoints,proto3" json:"ratio_basis_points,omitempty"` // 10000 matches one to one
+	MaxMatchAmount   int64                  `protobuf:"varint,7,opt,name=max_match_amount,json=maxMatchAmount,proto3" json:"max_match_amount,omitempty"`       // Per donation, 0 for no cap
+	Budget           int64                  `protobuf:"varint,8,opt,name=budget,proto3" json:"budget,omitempty"`
+	StartsAt         *timestamp.Timestamp   `protobuf:"bytes,9,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt           *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsActive         bool                   `protobuf:"varint,11,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MatchCampaignRequest) Reset() {
+	*x = MatchCampaignRequest{}
+	mi := &file_proto_donation_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MatchCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MatchCampaignRequest) ProtoMessage() {}
+
+func (x *MatchCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchCampaignRequest.ProtoReflect.Descriptor instead.
+func (*MatchCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *MatchCampaignRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MatchCampaignRequest) GetSponsorName() string {
+	if x != nil {
+		return x.SponsorName
+	}
+	return ""
+}
+
+func (x *MatchCampaignRequest) GetSponsorUserId() uint32 {
+	if x != nil {
+		return x.SponsorUserId
+	}
+	return 0
+}
+
+func (x *MatchCampaignRequest) GetStreamerIds() []uint32 {
+	if x != nil {
+		return x.StreamerIds
+	}
+	return nil
+}
+
+func (x *MatchCampaignRequest) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *MatchCampaignRequest) GetRatioBasisPoints() int64 {
+	if x != nil {
+		return x.RatioBasisPoints
+	}
+	return 0
+}
+
+func (x *MatchCampaignRequest) GetMaxMatchAmount() int64 {
+	if x != nil {
+		return x.MaxMatchAmount
+	}
+	return 0
+}
+
+func (x *MatchCampaignRequest) GetBudget() int64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
+}
+
+func (x *MatchCampaignRequest) GetStartsAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *MatchCampaignRequest) GetEndsAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *MatchCampaignRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type UpdateMatchCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    uint32                 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	Campaign      *MatchCampaignRequest  `protobuf:"bytes,2,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateMatchCampaignRequest) Reset() {
+	*x = UpdateMatchCampaignRequest{}
+	mi := &file_proto_donation_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateMatchCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateMatchCampaignRequest) ProtoMessage() {}
+
+func (x *UpdateMatchCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateMatchCampaignRequest.ProtoReflect.Descriptor instead.
+func (*UpdateMatchCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *UpdateMatchCampaignRequest) GetCampaignId() uint32 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+func (x *UpdateMatchCampaignRequest) GetCampaign() *MatchCampaignRequest {
+	if x != nil {
+		return x.Campaign
+	}
+	return nil
+}
+
+type GetMatchCampaignRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CampaignId    uint32                 `protobuf:"varint,1,opt,name=campaign_id,json=campaignId,proto3" json:"campaign_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMatchCampaignRequest) Reset() {
+	*x = GetMatchCampaignRequest{}
+	mi := &file_proto_donation_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMatchCampaignRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMatchCampaignRequest) ProtoMessage() {}
+
+func (x *GetMatchCampaignRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMatchCampaignRequest.ProtoReflect.Descriptor instead.
+func (*GetMatchCampaignRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetMatchCampaignRequest) GetCampaignId() uint32 {
+	if x != nil {
+		return x.CampaignId
+	}
+	return 0
+}
+
+type ListMatchCampaignsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchCampaignsRequest) Reset() {
+	*x = ListMatchCampaignsRequest{}
+	mi := &file_proto_donation_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchCampaignsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchCampaignsRequest) ProtoMessage() {}
+
+func (x *ListMatchCampaignsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchCampaignsRequest.ProtoReflect.Descriptor instead.
+func (*ListMatchCampaignsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ListMatchCampaignsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMatchCampaignsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListMatchCampaignsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Campaigns     []*MatchCampaign       `protobuf:"bytes,1,rep,name=campaigns,proto3" json:"campaigns,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListMatchCampaignsResponse) Reset() {
+	*x = ListMatchCampaignsResponse{}
+	mi := &file_proto_donation_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListMatchCampaignsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListMatchCampaignsResponse) ProtoMessage() {}
+
+func (x *ListMatchCampaignsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListMatchCampaignsResponse.ProtoReflect.Descriptor instead.
+func (*ListMatchCampaignsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *ListMatchCampaignsResponse) GetCampaigns() []*MatchCampaign {
+	if x != nil {
+		return x.Campaigns
+	}
+	return nil
+}
+
+func (x *ListMatchCampaignsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListMatchCampaignsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ApprovePayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      uint32                 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	AdminId       uint32                 `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApprovePayoutRequest) Reset() {
+	*x = ApprovePayoutRequest{}
+	mi := &file_proto_donation_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApprovePayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApprovePayoutRequest) ProtoMessage() {}
+
+func (x *ApprovePayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApprovePayoutRequest.ProtoReflect.Descriptor instead.
+func (*ApprovePayoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *ApprovePayoutRequest) GetPayoutId() uint32 {
+	if x != nil {
+		return x.PayoutId
+	}
+	return 0
+}
+
+func (x *ApprovePayoutRequest) GetAdminId() uint32 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+type RejectPayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      uint32                 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	AdminId       uint32                 `protobuf:"varint,2,opt,name=admin_id,json=adminId,proto3" json:"admin_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RejectPayoutRequest) Reset() {
+	*x = RejectPayoutRequest{}
+	mi := &file_proto_donation_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RejectPayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RejectPayoutRequest) ProtoMessage() {}
+
+func (x *RejectPayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RejectPayoutRequest.ProtoReflect.Descriptor instead.
+func (*RejectPayoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *RejectPayoutRequest) GetPayoutId() uint32 {
+	if x != nil {
+		return x.PayoutId
+	}
+	return 0
+}
+
+func (x *RejectPayoutRequest) GetAdminId() uint32 {
+	if x != nil {
+		return x.AdminId
+	}
+	return 0
+}
+
+func (x *RejectPayoutRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CompletePayoutRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId          uint32                 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	ProviderReference string                 `protobuf:"bytes,2,opt,name=provider_reference,json=providerReference,proto3" json:"provider_reference,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CompletePayoutRequest) Reset() {
+	*x = CompletePayoutRequest{}
+	mi := &file_proto_donation_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompletePayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompletePayoutRequest) ProtoMessage() {}
+
+func (x *CompletePayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompletePayoutRequest.ProtoReflect.Descriptor instead.
+func (*CompletePayoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *CompletePayoutRequest) GetPayoutId() uint32 {
+	if x != nil {
+		return x.PayoutId
+	}
+	return 0
+}
+
+func (x *CompletePayoutRequest) GetProviderReference() string {
+	if x != nil {
+		return x.ProviderReference
+	}
+	return ""
+}
+
+type FailPayoutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PayoutId      uint32                 `protobuf:"varint,1,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FailPayoutRequest) Reset() {
+	*x = FailPayoutRequest{}
+	mi := &file_proto_donation_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FailPayoutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FailPayoutRequest) ProtoMessage() {}
+
+func (x *FailPayoutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FailPayoutRequest.ProtoReflect.Descriptor instead.
+func (*FailPayoutRequest) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *FailPayoutRequest) GetPayoutId() uint32 {
+	if x != nil {
+		return x.PayoutId
+	}
+	return 0
+}
+
+func (x *FailPayoutRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// Data models
+type Donation struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Amount          int64                  `protobuf:"varint,22,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency        string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Message         string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	StreamerId      uint32                 `protobuf:"varint,5,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	DonatorId       uint32                 `protobuf:"varint,6,opt,name=donator_id,json=donatorId,proto3" json:"donator_id,omitempty"`
+	DisplayName     string                 `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	IsAnonymous     bool                   `protobuf:"varint,8,opt,name=is_anonymous,json=isAnonymous,proto3" json:"is_anonymous,omitempty"`
+	Status          PaymentStatus          `protobuf:"varint,9,opt,name=status,proto3,enum=donation.PaymentStatus" json:"status,omitempty"`
+	PaymentProvider PaymentProvider        `protobuf:"varint,10,opt,name=payment_provider,json=paymentProvider,proto3,enum=donation.PaymentProvider" json:"payment_provider,omitempty"`
+	TransactionId   string                 `protobuf:"bytes,11,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	CreatedAt       *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PaymentTime     *timestamp.Timestamp   `protobuf:"bytes,14,opt,name=payment_time,json=paymentTime,proto3" json:"payment_time,omitempty"`
+	RefundedAmount  int64                  `protobuf:"varint,23,opt,name=refunded_amount,json=refundedAmount,proto3" json:"refunded_amount,omitempty"`
+	MessageStatus   MessageStatus          `protobuf:"varint,16,opt,name=message_status,json=messageStatus,proto3,enum=donation.MessageStatus" json:"message_status,omitempty"`
+	// Exchange-rate snapshot into the streamer's currency, taken when the donation was made
+	ExchangeRate      float64              `protobuf:"fixed64,17,opt,name=exchange_rate,json=exchangeRate,proto3" json:"exchange_rate,omitempty"`
+	ConvertedAmount   int64                `protobuf:"varint,24,opt,name=converted_amount,json=convertedAmount,proto3" json:"converted_amount,omitempty"`
+	ConvertedCurrency string               `protobuf:"bytes,19,opt,name=converted_currency,json=convertedCurrency,proto3" json:"converted_currency,omitempty"`
+	RateSource        string               `protobuf:"bytes,20,opt,name=rate_source,json=rateSource,proto3" json:"rate_source,omitempty"`
+	RateTime          *timestamp.Timestamp `protobuf:"bytes,21,opt,name=rate_time,json=rateTime,proto3" json:"rate_time,omitempty"`
+	SplitDonationId   uint32               `protobuf:"varint,25,opt,name=split_donation_id,json=splitDonationId,proto3" json:"split_donation_id,omitempty"` // 0 unless the donation is a share of a split donation
+	// Message translation into the streamer's language, added shortly after creation
+	MessageLanguage     string `protobuf:"bytes,26,opt,name=message_language,json=messageLanguage,proto3" json:"message_language,omitempty"`       // Detected language of message, empty until detected
+	TranslatedMessage   string `protobuf:"bytes,27,opt,name=translated_message,json=translatedMessage,proto3" json:"translated_message,omitempty"` // Empty when the message is already in the streamer's language
+	TranslationLanguage string `protobuf:"bytes,28,opt,name=translation_language,json=translationLanguage,proto3" json:"translation_language,omitempty"`
+	// Matching: a sponsor's donation names its campaign and the donation it matches
+	MatchCampaignId   uint32 `protobuf:"varint,29,opt,name=match_campaign_id,json=matchCampaignId,proto3" json:"match_campaign_id,omitempty"`
+	MatchedDonationId uint32 `protobuf:"varint,30,opt,name=matched_donation_id,json=matchedDonationId,proto3" json:"matched_donation_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Donation) Reset() {
+	*x = Donation{}
+	mi := &file_proto_donation_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Donation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Donation) ProtoMessage() {}
+
+func (x *Donation) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Donation.ProtoReflect.Descriptor instead.
+func (*Donation) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *Donation) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Donation) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Donation) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
+func (x *Donation) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *Donation) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *Donation) GetDonatorId() uint32 {
+	if x != nil {
+		return x.DonatorId
+	}
+	return 0
+}
+
+func (x *Donation) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
+	}
+	return ""
+}
+
+func (x *Donation) GetIsAnonymous() bool {
+	if x != nil {
+		return x.IsAnonymous
+	}
+	return false
+}
+
+func (x *Donation) GetStatus() PaymentStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+}
+
+func (x *Donation) GetPaymentProvider() PaymentProvider {
+	if x != nil {
+		return x.PaymentProvider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+func (x *Donation) GetTransactionId() string {
+	if x != nil {
+		return x.TransactionId
+	}
+	return ""
+}
+
+func (x *Donation) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Donation) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+func (x *Donation) GetPaymentTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.PaymentTime
+	}
+	return nil
+}
+
+func (x *Donation) GetRefundedAmount() int64 {
+	if x != nil {
+		return x.RefundedAmount
+	}
+	return 0
 }
 
-func (EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_donation_proto_enumTypes[2].Descriptor()
+func (x *Donation) GetMessageStatus() MessageStatus {
+	if x != nil {
+		return x.MessageStatus
+	}
+	return MessageStatus_MESSAGE_STATUS_UNSPECIFIED
 }
 
-func (EventType) Type() protoreflect.EnumType {
-	return &file_proto_donation_proto_enumTypes[2]
+func (x *Donation) GetExchangeRate() float64 {
+	if x != nil {
+		return x.ExchangeRate
+	}
+	return 0
 }
 
-func (x EventType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *Donation) GetConvertedAmount() int64 {
+	if x != nil {
+		return x.ConvertedAmount
+	}
+	return 0
 }
 
-// Deprecated: Use EventType.Descriptor instead.
-func (EventType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{2}
+func (x *Donation) GetConvertedCurrency() string {
+	if x != nil {
+		return x.ConvertedCurrency
+	}
+	return ""
 }
 
-type NotificationType int32
-
-const (
-	NotificationType_NOTIFICATION_TYPE_UNSPECIFIED       NotificationType = 0
-	NotificationType_NOTIFICATION_TYPE_DONATION_RECEIVED NotificationType = 1
-	NotificationType_NOTIFICATION_TYPE_PAYMENT_COMPLETED NotificationType = 2
-	NotificationType_NOTIFICATION_TYPE_PAYMENT_FAILED    NotificationType = 3
-)
-
-// Enum value maps for NotificationType.
-var (
-	NotificationType_name = map[int32]string{
-		0: "NOTIFICATION_TYPE_UNSPECIFIED",
-		1: "NOTIFICATION_TYPE_DONATION_RECEIVED",
-		2: "NOTIFICATION_TYPE_PAYMENT_COMPLETED",
-		3: "NOTIFICATION_TYPE_PAYMENT_FAILED",
+func (x *Donation) GetRateSource() string {
+	if x != nil {
+		return x.RateSource
 	}
-	NotificationType_value = map[string]int32{
-		"NOTIFICATION_TYPE_UNSPECIFIED":       0,
-		"NOTIFICATION_TYPE_DONATION_RECEIVED": 1,
-		"NOTIFICATION_TYPE_PAYMENT_COMPLETED": 2,
-		"NOTIFICATION_TYPE_PAYMENT_FAILED":    3,
+	return ""
+}
+
+func (x *Donation) GetRateTime() *timestamp.Timestamp {
+	if x != nil {
+		return x.RateTime
 	}
-)
+	return nil
+}
 
-func (x NotificationType) Enum() *NotificationType {
-	p := new(NotificationType)
-	*p = x
-	return p
+func (x *Donation) GetSplitDonationId() uint32 {
+	if x != nil {
+		return x.SplitDonationId
+	}
+	return 0
 }
 
-func (x NotificationType) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *Donation) GetMessageLanguage() string {
+	if x != nil {
+		return x.MessageLanguage
+	}
+	return ""
 }
 
-func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_donation_proto_enumTypes[3].Descriptor()
+func (x *Donation) GetTranslatedMessage() string {
+	if x != nil {
+		return x.TranslatedMessage
+	}
+	return ""
 }
 
-func (NotificationType) Type() protoreflect.EnumType {
-	return &file_proto_donation_proto_enumTypes[3]
+func (x *Donation) GetTranslationLanguage() string {
+	if x != nil {
+		return x.TranslationLanguage
+	}
+	return ""
 }
 
-func (x NotificationType) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *Donation) GetMatchCampaignId() uint32 {
+	if x != nil {
+		return x.MatchCampaignId
+	}
+	return 0
 }
 
-// Deprecated: Use NotificationType.Descriptor instead.
-func (NotificationType) EnumDescriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{3}
+func (x *Donation) GetMatchedDonationId() uint32 {
+	if x != nil {
+		return x.MatchedDonationId
+	}
+	return 0
 }
 
-// Messages
-type CreateDonationRequest struct {
+type DonationStatusChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Amount        float64                `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	StreamerId    uint32                 `protobuf:"varint,4,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
-	DonatorId     uint32                 `protobuf:"varint,5,opt,name=donator_id,json=donatorId,proto3" json:"donator_id,omitempty"`
-	DisplayName   string                 `protobuf:"bytes,6,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	IsAnonymous   bool                   `protobuf:"varint,7,opt,name=is_anonymous,json=isAnonymous,proto3" json:"is_anonymous,omitempty"`
-	PaymentMethod string                 `protobuf:"bytes,8,opt,name=payment_method,json=paymentMethod,proto3" json:"payment_method,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DonationId    uint32                 `protobuf:"varint,2,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	FromStatus    PaymentStatus          `protobuf:"varint,3,opt,name=from_status,json=fromStatus,proto3,enum=donation.PaymentStatus" json:"from_status,omitempty"`
+	ToStatus      PaymentStatus          `protobuf:"varint,4,opt,name=to_status,json=toStatus,proto3,enum=donation.PaymentStatus" json:"to_status,omitempty"`
+	Source        StatusChangeSource     `protobuf:"varint,5,opt,name=source,proto3,enum=donation.StatusChangeSource" json:"source,omitempty"`
+	ActorId       uint32                 `protobuf:"varint,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateDonationRequest) Reset() {
-	*x = CreateDonationRequest{}
-	mi := &file_proto_donation_proto_msgTypes[0]
+func (x *DonationStatusChange) Reset() {
+	*x = DonationStatusChange{}
+	mi := &file_proto_donation_proto_msgTypes[95]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDonationRequest) String() string {
+func (x *DonationStatusChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDonationRequest) ProtoMessage() {}
+func (*DonationStatusChange) ProtoMessage() {}
 
-func (x *CreateDonationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[0]
+func (x *DonationStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[95]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -286,93 +6941,101 @@ func (x *CreateDonationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDonationRequest.ProtoReflect.Descriptor instead.
-func (*CreateDonationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use DonationStatusChange.ProtoReflect.Descriptor instead.
+func (*DonationStatusChange) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *CreateDonationRequest) GetAmount() float64 {
+func (x *DonationStatusChange) GetId() uint32 {
 	if x != nil {
-		return x.Amount
+		return x.Id
 	}
 	return 0
 }
 
-func (x *CreateDonationRequest) GetCurrency() string {
+func (x *DonationStatusChange) GetDonationId() uint32 {
 	if x != nil {
-		return x.Currency
+		return x.DonationId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateDonationRequest) GetMessage() string {
+func (x *DonationStatusChange) GetFromStatus() PaymentStatus {
 	if x != nil {
-		return x.Message
+		return x.FromStatus
 	}
-	return ""
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
 }
 
-func (x *CreateDonationRequest) GetStreamerId() uint32 {
+func (x *DonationStatusChange) GetToStatus() PaymentStatus {
 	if x != nil {
-		return x.StreamerId
+		return x.ToStatus
 	}
-	return 0
+	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
 }
 
-func (x *CreateDonationRequest) GetDonatorId() uint32 {
+func (x *DonationStatusChange) GetSource() StatusChangeSource {
 	if x != nil {
-		return x.DonatorId
+		return x.Source
 	}
-	return 0
+	return StatusChangeSource_STATUS_CHANGE_SOURCE_UNSPECIFIED
 }
 
-func (x *CreateDonationRequest) GetDisplayName() string {
+func (x *DonationStatusChange) GetActorId() uint32 {
 	if x != nil {
-		return x.DisplayName
+		return x.ActorId
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateDonationRequest) GetIsAnonymous() bool {
+func (x *DonationStatusChange) GetReason() string {
 	if x != nil {
-		return x.IsAnonymous
+		return x.Reason
 	}
-	return false
+	return ""
 }
 
-func (x *CreateDonationRequest) GetPaymentMethod() string {
+func (x *DonationStatusChange) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.PaymentMethod
+		return x.CreatedAt
 	}
-	return ""
-}
-
-type CreateDonationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
-	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	PaymentUrl    string                 `protobuf:"bytes,3,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
-	QrCodeBase64  string                 `protobuf:"bytes,4,opt,name=qr_code_base64,json=qrCodeBase64,proto3" json:"qr_code_base64,omitempty"`
-	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	return nil
 }
 
-func (x *CreateDonationResponse) Reset() {
-	*x = CreateDonationResponse{}
-	mi := &file_proto_donation_proto_msgTypes[1]
+type DonationRefund struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DonationId       uint32                 `protobuf:"varint,2,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	Amount           int64                  `protobuf:"varint,13,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency         string                 `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Reason           string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	Status           RefundStatus           `protobuf:"varint,6,opt,name=status,proto3,enum=donation.RefundStatus" json:"status,omitempty"`
+	Provider         PaymentProvider        `protobuf:"varint,7,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
+	ProviderRefundId string                 `protobuf:"bytes,8,opt,name=provider_refund_id,json=providerRefundId,proto3" json:"provider_refund_id,omitempty"`
+	RequestedBy      uint32                 `protobuf:"varint,9,opt,name=requested_by,json=requestedBy,proto3" json:"requested_by,omitempty"`
+	FailureReason    string                 `protobuf:"bytes,10,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	ProcessedAt      *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
+	CreatedAt        *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Chargeback       bool                   `protobuf:"varint,14,opt,name=chargeback,proto3" json:"chargeback,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DonationRefund) Reset() {
+	*x = DonationRefund{}
+	mi := &file_proto_donation_proto_msgTypes[96]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateDonationResponse) String() string {
+func (x *DonationRefund) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateDonationResponse) ProtoMessage() {}
+func (*DonationRefund) ProtoMessage() {}
 
-func (x *CreateDonationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[1]
+func (x *DonationRefund) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[96]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -383,68 +7046,136 @@ func (x *CreateDonationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateDonationResponse.ProtoReflect.Descriptor instead.
-func (*CreateDonationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use DonationRefund.ProtoReflect.Descriptor instead.
+func (*DonationRefund) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *CreateDonationResponse) GetDonationId() uint32 {
+func (x *DonationRefund) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DonationRefund) GetDonationId() uint32 {
 	if x != nil {
 		return x.DonationId
 	}
 	return 0
 }
 
-func (x *CreateDonationResponse) GetTransactionId() string {
+func (x *DonationRefund) GetAmount() int64 {
 	if x != nil {
-		return x.TransactionId
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *DonationRefund) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *CreateDonationResponse) GetPaymentUrl() string {
+func (x *DonationRefund) GetReason() string {
 	if x != nil {
-		return x.PaymentUrl
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *CreateDonationResponse) GetQrCodeBase64() string {
+func (x *DonationRefund) GetStatus() RefundStatus {
 	if x != nil {
-		return x.QrCodeBase64
+		return x.Status
+	}
+	return RefundStatus_REFUND_STATUS_UNSPECIFIED
+}
+
+func (x *DonationRefund) GetProvider() PaymentProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+func (x *DonationRefund) GetProviderRefundId() string {
+	if x != nil {
+		return x.ProviderRefundId
 	}
 	return ""
 }
 
-func (x *CreateDonationResponse) GetExpiresAt() *timestamp.Timestamp {
+func (x *DonationRefund) GetRequestedBy() uint32 {
 	if x != nil {
-		return x.ExpiresAt
+		return x.RequestedBy
+	}
+	return 0
+}
+
+func (x *DonationRefund) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
+	}
+	return ""
+}
+
+func (x *DonationRefund) GetProcessedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ProcessedAt
 	}
 	return nil
 }
 
-type GetDonationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DonationRefund) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetDonationRequest) Reset() {
-	*x = GetDonationRequest{}
-	mi := &file_proto_donation_proto_msgTypes[2]
+func (x *DonationRefund) GetChargeback() bool {
+	if x != nil {
+		return x.Chargeback
+	}
+	return false
+}
+
+type DonationGoal struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StreamerId      uint32                 `protobuf:"varint,2,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Title           string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	TargetAmount    int64                  `protobuf:"varint,14,opt,name=target_amount,json=targetAmount,proto3" json:"target_amount,omitempty"`
+	CurrentAmount   int64                  `protobuf:"varint,15,opt,name=current_amount,json=currentAmount,proto3" json:"current_amount,omitempty"`
+	Currency        string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Deadline        *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=deadline,proto3" json:"deadline,omitempty"`
+	IsActive        bool                   `protobuf:"varint,9,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CompletedAt     *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ProgressPercent float64                `protobuf:"fixed64,11,opt,name=progress_percent,json=progressPercent,proto3" json:"progress_percent,omitempty"`
+	CreatedAt       *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DonationGoal) Reset() {
+	*x = DonationGoal{}
+	mi := &file_proto_donation_proto_msgTypes[97]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDonationRequest) String() string {
+func (x *DonationGoal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDonationRequest) ProtoMessage() {}
+func (*DonationGoal) ProtoMessage() {}
 
-func (x *GetDonationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[2]
+func (x *DonationGoal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[97]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -455,86 +7186,137 @@ func (x *GetDonationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDonationRequest.ProtoReflect.Descriptor instead.
-func (*GetDonationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use DonationGoal.ProtoReflect.Descriptor instead.
+func (*DonationGoal) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *DonationGoal) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DonationGoal) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *DonationGoal) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *DonationGoal) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *DonationGoal) GetTargetAmount() int64 {
+	if x != nil {
+		return x.TargetAmount
+	}
+	return 0
 }
 
-func (x *GetDonationRequest) GetDonationId() uint32 {
+func (x *DonationGoal) GetCurrentAmount() int64 {
 	if x != nil {
-		return x.DonationId
+		return x.CurrentAmount
 	}
 	return 0
 }
 
-type GetDonationResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Donation      *Donation              `protobuf:"bytes,1,opt,name=donation,proto3" json:"donation,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DonationGoal) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (x *GetDonationResponse) Reset() {
-	*x = GetDonationResponse{}
-	mi := &file_proto_donation_proto_msgTypes[3]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DonationGoal) GetDeadline() *timestamp.Timestamp {
+	if x != nil {
+		return x.Deadline
+	}
+	return nil
 }
 
-func (x *GetDonationResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DonationGoal) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
 }
 
-func (*GetDonationResponse) ProtoMessage() {}
+func (x *DonationGoal) GetCompletedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
 
-func (x *GetDonationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[3]
+func (x *DonationGoal) GetProgressPercent() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ProgressPercent
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetDonationResponse.ProtoReflect.Descriptor instead.
-func (*GetDonationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{3}
+func (x *DonationGoal) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *GetDonationResponse) GetDonation() *Donation {
+func (x *DonationGoal) GetUpdatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Donation
+		return x.UpdatedAt
 	}
 	return nil
 }
 
-type GetDonationsByStreamerRequest struct {
+type DonationExport struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StreamerId    uint32                 `protobuf:"varint,2,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Format        ExportFormat           `protobuf:"varint,3,opt,name=format,proto3,enum=donation.ExportFormat" json:"format,omitempty"`
+	StartDate     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate       *timestamp.Timestamp   `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Currency      string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status        DonationExportStatus   `protobuf:"varint,7,opt,name=status,proto3,enum=donation.DonationExportStatus" json:"status,omitempty"`
+	RowCount      int32                  `protobuf:"varint,8,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	FileSize      int64                  `protobuf:"varint,9,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	Error         string                 `protobuf:"bytes,10,opt,name=error,proto3" json:"error,omitempty"`
+	FileName      string                 `protobuf:"bytes,11,opt,name=file_name,json=fileName,proto3" json:"file_name,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CompletedAt   *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	ExpiresAt     *timestamp.Timestamp   `protobuf:"bytes,14,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDonationsByStreamerRequest) Reset() {
-	*x = GetDonationsByStreamerRequest{}
-	mi := &file_proto_donation_proto_msgTypes[4]
+func (x *DonationExport) Reset() {
+	*x = DonationExport{}
+	mi := &file_proto_donation_proto_msgTypes[98]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDonationsByStreamerRequest) String() string {
+func (x *DonationExport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDonationsByStreamerRequest) ProtoMessage() {}
+func (*DonationExport) ProtoMessage() {}
 
-func (x *GetDonationsByStreamerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[4]
+func (x *DonationExport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[98]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -545,124 +7327,138 @@ func (x *GetDonationsByStreamerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDonationsByStreamerRequest.ProtoReflect.Descriptor instead.
-func (*GetDonationsByStreamerRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DonationExport.ProtoReflect.Descriptor instead.
+func (*DonationExport) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{98}
 }
 
-func (x *GetDonationsByStreamerRequest) GetStreamerId() uint32 {
+func (x *DonationExport) GetId() uint32 {
 	if x != nil {
-		return x.StreamerId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetDonationsByStreamerRequest) GetPage() int32 {
+func (x *DonationExport) GetStreamerId() uint32 {
 	if x != nil {
-		return x.Page
+		return x.StreamerId
 	}
 	return 0
 }
 
-func (x *GetDonationsByStreamerRequest) GetPageSize() int32 {
+func (x *DonationExport) GetFormat() ExportFormat {
 	if x != nil {
-		return x.PageSize
+		return x.Format
 	}
-	return 0
+	return ExportFormat_EXPORT_FORMAT_UNSPECIFIED
 }
 
-type GetDonationsListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Donations     []*Donation            `protobuf:"bytes,1,rep,name=donations,proto3" json:"donations,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	CurrentPage   int32                  `protobuf:"varint,3,opt,name=current_page,json=currentPage,proto3" json:"current_page,omitempty"`
-	TotalPages    int32                  `protobuf:"varint,4,opt,name=total_pages,json=totalPages,proto3" json:"total_pages,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DonationExport) GetStartDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartDate
+	}
+	return nil
 }
 
-func (x *GetDonationsListResponse) Reset() {
-	*x = GetDonationsListResponse{}
-	mi := &file_proto_donation_proto_msgTypes[5]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *DonationExport) GetEndDate() *timestamp.Timestamp {
+	if x != nil {
+		return x.EndDate
+	}
+	return nil
 }
 
-func (x *GetDonationsListResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *DonationExport) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (*GetDonationsListResponse) ProtoMessage() {}
+func (x *DonationExport) GetStatus() DonationExportStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DonationExportStatus_DONATION_EXPORT_STATUS_UNSPECIFIED
+}
 
-func (x *GetDonationsListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[5]
+func (x *DonationExport) GetRowCount() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RowCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetDonationsListResponse.ProtoReflect.Descriptor instead.
-func (*GetDonationsListResponse) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{5}
+func (x *DonationExport) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
 }
 
-func (x *GetDonationsListResponse) GetDonations() []*Donation {
+func (x *DonationExport) GetError() string {
 	if x != nil {
-		return x.Donations
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
-func (x *GetDonationsListResponse) GetTotalCount() int32 {
+func (x *DonationExport) GetFileName() string {
 	if x != nil {
-		return x.TotalCount
+		return x.FileName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetDonationsListResponse) GetCurrentPage() int32 {
+func (x *DonationExport) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.CurrentPage
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *GetDonationsListResponse) GetTotalPages() int32 {
+func (x *DonationExport) GetCompletedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.TotalPages
+		return x.CompletedAt
 	}
-	return 0
+	return nil
 }
 
-type UpdateDonationStatusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
-	Status        PaymentStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=donation.PaymentStatus" json:"status,omitempty"`
-	TransactionId string                 `protobuf:"bytes,3,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *DonationExport) GetExpiresAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (x *UpdateDonationStatusRequest) Reset() {
-	*x = UpdateDonationStatusRequest{}
-	mi := &file_proto_donation_proto_msgTypes[6]
+type ModerationSettings struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId       uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Enabled          bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	BannedWords      []string               `protobuf:"bytes,3,rep,name=banned_words,json=bannedWords,proto3" json:"banned_words,omitempty"`
+	BannedPatterns   []string               `protobuf:"bytes,4,rep,name=banned_patterns,json=bannedPatterns,proto3" json:"banned_patterns,omitempty"`
+	StripLinks       bool                   `protobuf:"varint,5,opt,name=strip_links,json=stripLinks,proto3" json:"strip_links,omitempty"`
+	MaxMessageLength int32                  `protobuf:"varint,6,opt,name=max_message_length,json=maxMessageLength,proto3" json:"max_message_length,omitempty"`
+	Action           ModerationAction       `protobuf:"varint,7,opt,name=action,proto3,enum=donation.ModerationAction" json:"action,omitempty"`
+	UpdatedAt        *timestamp.Timestamp   `protobuf:"bytes,8,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ModerationSettings) Reset() {
+	*x = ModerationSettings{}
+	mi := &file_proto_donation_proto_msgTypes[99]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDonationStatusRequest) String() string {
+func (x *ModerationSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDonationStatusRequest) ProtoMessage() {}
+func (*ModerationSettings) ProtoMessage() {}
 
-func (x *UpdateDonationStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[6]
+func (x *ModerationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[99]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -673,55 +7469,99 @@ func (x *UpdateDonationStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDonationStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDonationStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ModerationSettings.ProtoReflect.Descriptor instead.
+func (*ModerationSettings) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{99}
 }
 
-func (x *UpdateDonationStatusRequest) GetDonationId() uint32 {
+func (x *ModerationSettings) GetStreamerId() uint32 {
 	if x != nil {
-		return x.DonationId
+		return x.StreamerId
 	}
 	return 0
 }
 
-func (x *UpdateDonationStatusRequest) GetStatus() PaymentStatus {
+func (x *ModerationSettings) GetEnabled() bool {
 	if x != nil {
-		return x.Status
+		return x.Enabled
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return false
 }
 
-func (x *UpdateDonationStatusRequest) GetTransactionId() string {
+func (x *ModerationSettings) GetBannedWords() []string {
 	if x != nil {
-		return x.TransactionId
+		return x.BannedWords
 	}
-	return ""
+	return nil
 }
 
-type UpdateDonationStatusResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ModerationSettings) GetBannedPatterns() []string {
+	if x != nil {
+		return x.BannedPatterns
+	}
+	return nil
 }
 
-func (x *UpdateDonationStatusResponse) Reset() {
-	*x = UpdateDonationStatusResponse{}
-	mi := &file_proto_donation_proto_msgTypes[7]
+func (x *ModerationSettings) GetStripLinks() bool {
+	if x != nil {
+		return x.StripLinks
+	}
+	return false
+}
+
+func (x *ModerationSettings) GetMaxMessageLength() int32 {
+	if x != nil {
+		return x.MaxMessageLength
+	}
+	return 0
+}
+
+func (x *ModerationSettings) GetAction() ModerationAction {
+	if x != nil {
+		return x.Action
+	}
+	return ModerationAction_MODERATION_ACTION_UNSPECIFIED
+}
+
+func (x *ModerationSettings) GetUpdatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return nil
+}
+
+type MessageReview struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Id                  uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	DonationId          uint32                 `protobuf:"varint,2,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	StreamerId          uint32                 `protobuf:"varint,3,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	OriginalMessage     string                 `protobuf:"bytes,4,opt,name=original_message,json=originalMessage,proto3" json:"original_message,omitempty"`
+	OriginalDisplayName string                 `protobuf:"bytes,5,opt,name=original_display_name,json=originalDisplayName,proto3" json:"original_display_name,omitempty"`
+	Message             string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	DisplayName         string                 `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	Violations          []string               `protobuf:"bytes,8,rep,name=violations,proto3" json:"violations,omitempty"`
+	Status              MessageReviewStatus    `protobuf:"varint,9,opt,name=status,proto3,enum=donation.MessageReviewStatus" json:"status,omitempty"`
+	ReviewedAt          *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	CreatedAt           *timestamp.Timestamp   `protobuf:"bytes,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MessageReview) Reset() {
+	*x = MessageReview{}
+	mi := &file_proto_donation_proto_msgTypes[100]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDonationStatusResponse) String() string {
+func (x *MessageReview) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDonationStatusResponse) ProtoMessage() {}
+func (*MessageReview) ProtoMessage() {}
 
-func (x *UpdateDonationStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[7]
+func (x *MessageReview) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[100]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -732,110 +7572,113 @@ func (x *UpdateDonationStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDonationStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDonationStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use MessageReview.ProtoReflect.Descriptor instead.
+func (*MessageReview) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{100}
 }
 
-func (x *UpdateDonationStatusResponse) GetSuccess() bool {
+func (x *MessageReview) GetId() uint32 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *UpdateDonationStatusResponse) GetMessage() string {
+func (x *MessageReview) GetDonationId() uint32 {
 	if x != nil {
-		return x.Message
+		return x.DonationId
 	}
-	return ""
+	return 0
 }
 
-type ProcessPaymentRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DonationId    uint32                 `protobuf:"varint,1,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
-	Provider      PaymentProvider        `protobuf:"varint,2,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
-	PaymentData   map[string]string      `protobuf:"bytes,3,rep,name=payment_data,json=paymentData,proto3" json:"payment_data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MessageReview) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
 }
 
-func (x *ProcessPaymentRequest) Reset() {
-	*x = ProcessPaymentRequest{}
-	mi := &file_proto_donation_proto_msgTypes[8]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MessageReview) GetOriginalMessage() string {
+	if x != nil {
+		return x.OriginalMessage
+	}
+	return ""
 }
 
-func (x *ProcessPaymentRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MessageReview) GetOriginalDisplayName() string {
+	if x != nil {
+		return x.OriginalDisplayName
+	}
+	return ""
 }
 
-func (*ProcessPaymentRequest) ProtoMessage() {}
-
-func (x *ProcessPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[8]
+func (x *MessageReview) GetMessage() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Message
+	}
+	return ""
+}
+
+func (x *MessageReview) GetDisplayName() string {
+	if x != nil {
+		return x.DisplayName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ProcessPaymentRequest.ProtoReflect.Descriptor instead.
-func (*ProcessPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{8}
+func (x *MessageReview) GetViolations() []string {
+	if x != nil {
+		return x.Violations
+	}
+	return nil
 }
 
-func (x *ProcessPaymentRequest) GetDonationId() uint32 {
+func (x *MessageReview) GetStatus() MessageReviewStatus {
 	if x != nil {
-		return x.DonationId
+		return x.Status
 	}
-	return 0
+	return MessageReviewStatus_MESSAGE_REVIEW_STATUS_UNSPECIFIED
 }
 
-func (x *ProcessPaymentRequest) GetProvider() PaymentProvider {
+func (x *MessageReview) GetReviewedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Provider
+		return x.ReviewedAt
 	}
-	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+	return nil
 }
 
-func (x *ProcessPaymentRequest) GetPaymentData() map[string]string {
+func (x *MessageReview) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.PaymentData
+		return x.CreatedAt
 	}
 	return nil
 }
 
-type ProcessPaymentResponse struct {
+type BlockedTerm struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	PaymentUrl    string                 `protobuf:"bytes,2,opt,name=payment_url,json=paymentUrl,proto3" json:"payment_url,omitempty"`
-	QrCode        string                 `protobuf:"bytes,3,opt,name=qr_code,json=qrCode,proto3" json:"qr_code,omitempty"`
-	Status        PaymentStatus          `protobuf:"varint,4,opt,name=status,proto3,enum=donation.PaymentStatus" json:"status,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Term          string                 `protobuf:"bytes,2,opt,name=term,proto3" json:"term,omitempty"`
+	IsPattern     bool                   `protobuf:"varint,3,opt,name=is_pattern,json=isPattern,proto3" json:"is_pattern,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ProcessPaymentResponse) Reset() {
-	*x = ProcessPaymentResponse{}
-	mi := &file_proto_donation_proto_msgTypes[9]
+func (x *BlockedTerm) Reset() {
+	*x = BlockedTerm{}
+	mi := &file_proto_donation_proto_msgTypes[101]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ProcessPaymentResponse) String() string {
+func (x *BlockedTerm) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ProcessPaymentResponse) ProtoMessage() {}
+func (*BlockedTerm) ProtoMessage() {}
 
-func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[9]
+func (x *BlockedTerm) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[101]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -846,62 +7689,65 @@ func (x *ProcessPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ProcessPaymentResponse.ProtoReflect.Descriptor instead.
-func (*ProcessPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use BlockedTerm.ProtoReflect.Descriptor instead.
+func (*BlockedTerm) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{101}
 }
 
-func (x *ProcessPaymentResponse) GetTransactionId() string {
+func (x *BlockedTerm) GetId() uint32 {
 	if x != nil {
-		return x.TransactionId
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *ProcessPaymentResponse) GetPaymentUrl() string {
+func (x *BlockedTerm) GetTerm() string {
 	if x != nil {
-		return x.PaymentUrl
+		return x.Term
 	}
 	return ""
 }
 
-func (x *ProcessPaymentResponse) GetQrCode() string {
+func (x *BlockedTerm) GetIsPattern() bool {
 	if x != nil {
-		return x.QrCode
+		return x.IsPattern
 	}
-	return ""
+	return false
 }
 
-func (x *ProcessPaymentResponse) GetStatus() PaymentStatus {
+func (x *BlockedTerm) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.CreatedAt
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return nil
 }
 
-type VerifyPaymentRequest struct {
+type StreamerBalance struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	TransactionId string                 `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Provider      PaymentProvider        `protobuf:"varint,2,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
+	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+	Balance       int64                  `protobuf:"varint,3,opt,name=balance,proto3" json:"balance,omitempty"`
+	Credited      int64                  `protobuf:"varint,4,opt,name=credited,proto3" json:"credited,omitempty"`
+	Debited       int64                  `protobuf:"varint,5,opt,name=debited,proto3" json:"debited,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyPaymentRequest) Reset() {
-	*x = VerifyPaymentRequest{}
-	mi := &file_proto_donation_proto_msgTypes[10]
+func (x *StreamerBalance) Reset() {
+	*x = StreamerBalance{}
+	mi := &file_proto_donation_proto_msgTypes[102]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyPaymentRequest) String() string {
+func (x *StreamerBalance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyPaymentRequest) ProtoMessage() {}
+func (*StreamerBalance) ProtoMessage() {}
 
-func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[10]
+func (x *StreamerBalance) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[102]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -912,49 +7758,78 @@ func (x *VerifyPaymentRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyPaymentRequest.ProtoReflect.Descriptor instead.
-func (*VerifyPaymentRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use StreamerBalance.ProtoReflect.Descriptor instead.
+func (*StreamerBalance) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{102}
 }
 
-func (x *VerifyPaymentRequest) GetTransactionId() string {
+func (x *StreamerBalance) GetStreamerId() uint32 {
 	if x != nil {
-		return x.TransactionId
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *StreamerBalance) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-func (x *VerifyPaymentRequest) GetProvider() PaymentProvider {
+func (x *StreamerBalance) GetBalance() int64 {
 	if x != nil {
-		return x.Provider
+		return x.Balance
 	}
-	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+	return 0
 }
 
-type VerifyPaymentResponse struct {
+func (x *StreamerBalance) GetCredited() int64 {
+	if x != nil {
+		return x.Credited
+	}
+	return 0
+}
+
+func (x *StreamerBalance) GetDebited() int64 {
+	if x != nil {
+		return x.Debited
+	}
+	return 0
+}
+
+type LedgerEntry struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsVerified    bool                   `protobuf:"varint,1,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`
-	Status        PaymentStatus          `protobuf:"varint,2,opt,name=status,proto3,enum=donation.PaymentStatus" json:"status,omitempty"`
-	Amount        float64                `protobuf:"fixed64,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Type          LedgerEntryType        `protobuf:"varint,3,opt,name=type,proto3,enum=donation.LedgerEntryType" json:"type,omitempty"`
+	StreamerId    uint32                 `protobuf:"varint,4,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	DonationId    uint32                 `protobuf:"varint,5,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	RefundId      uint32                 `protobuf:"varint,6,opt,name=refund_id,json=refundId,proto3" json:"refund_id,omitempty"`
+	Currency      string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`
+	Lines         []*LedgerLine          `protobuf:"bytes,9,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PayoutId      uint32                 `protobuf:"varint,11,opt,name=payout_id,json=payoutId,proto3" json:"payout_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *VerifyPaymentResponse) Reset() {
-	*x = VerifyPaymentResponse{}
-	mi := &file_proto_donation_proto_msgTypes[11]
+func (x *LedgerEntry) Reset() {
+	*x = LedgerEntry{}
+	mi := &file_proto_donation_proto_msgTypes[103]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *VerifyPaymentResponse) String() string {
+func (x *LedgerEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VerifyPaymentResponse) ProtoMessage() {}
+func (*LedgerEntry) ProtoMessage() {}
 
-func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[11]
+func (x *LedgerEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[103]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,116 +7840,116 @@ func (x *VerifyPaymentResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VerifyPaymentResponse.ProtoReflect.Descriptor instead.
-func (*VerifyPaymentResponse) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use LedgerEntry.ProtoReflect.Descriptor instead.
+func (*LedgerEntry) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{103}
 }
 
-func (x *VerifyPaymentResponse) GetIsVerified() bool {
+func (x *LedgerEntry) GetId() uint32 {
 	if x != nil {
-		return x.IsVerified
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *VerifyPaymentResponse) GetStatus() PaymentStatus {
+func (x *LedgerEntry) GetReference() string {
 	if x != nil {
-		return x.Status
+		return x.Reference
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return ""
 }
 
-func (x *VerifyPaymentResponse) GetAmount() float64 {
+func (x *LedgerEntry) GetType() LedgerEntryType {
 	if x != nil {
-		return x.Amount
+		return x.Type
 	}
-	return 0
+	return LedgerEntryType_LEDGER_ENTRY_TYPE_UNSPECIFIED
 }
 
-type HandleWebhookRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Provider      PaymentProvider        `protobuf:"varint,1,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
-	Payload       []byte                 `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	Headers       map[string]string      `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LedgerEntry) GetStreamerId() uint32 {
+	if x != nil {
+		return x.StreamerId
+	}
+	return 0
 }
 
-func (x *HandleWebhookRequest) Reset() {
-	*x = HandleWebhookRequest{}
-	mi := &file_proto_donation_proto_msgTypes[12]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LedgerEntry) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
 }
 
-func (x *HandleWebhookRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LedgerEntry) GetRefundId() uint32 {
+	if x != nil {
+		return x.RefundId
+	}
+	return 0
 }
 
-func (*HandleWebhookRequest) ProtoMessage() {}
-
-func (x *HandleWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[12]
+func (x *LedgerEntry) GetCurrency() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Currency
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use HandleWebhookRequest.ProtoReflect.Descriptor instead.
-func (*HandleWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{12}
+func (x *LedgerEntry) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
 }
 
-func (x *HandleWebhookRequest) GetProvider() PaymentProvider {
+func (x *LedgerEntry) GetLines() []*LedgerLine {
 	if x != nil {
-		return x.Provider
+		return x.Lines
 	}
-	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+	return nil
 }
 
-func (x *HandleWebhookRequest) GetPayload() []byte {
+func (x *LedgerEntry) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Payload
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *HandleWebhookRequest) GetHeaders() map[string]string {
+func (x *LedgerEntry) GetPayoutId() uint32 {
 	if x != nil {
-		return x.Headers
+		return x.PayoutId
 	}
-	return nil
+	return 0
 }
 
-type HandleWebhookResponse struct {
+// amount is a debit when positive and a credit when negative
+type LedgerLine struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	TransactionId string                 `protobuf:"bytes,2,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Account       LedgerAccount          `protobuf:"varint,2,opt,name=account,proto3,enum=donation.LedgerAccount" json:"account,omitempty"`
+	StreamerId    uint32                 `protobuf:"varint,3,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Provider      PaymentProvider        `protobuf:"varint,4,opt,name=provider,proto3,enum=donation.PaymentProvider" json:"provider,omitempty"`
+	Currency      string                 `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount        int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *HandleWebhookResponse) Reset() {
-	*x = HandleWebhookResponse{}
-	mi := &file_proto_donation_proto_msgTypes[13]
+func (x *LedgerLine) Reset() {
+	*x = LedgerLine{}
+	mi := &file_proto_donation_proto_msgTypes[104]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *HandleWebhookResponse) String() string {
+func (x *LedgerLine) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandleWebhookResponse) ProtoMessage() {}
+func (*LedgerLine) ProtoMessage() {}
 
-func (x *HandleWebhookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[13]
+func (x *LedgerLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[104]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1085,54 +7960,81 @@ func (x *HandleWebhookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandleWebhookResponse.ProtoReflect.Descriptor instead.
-func (*HandleWebhookResponse) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use LedgerLine.ProtoReflect.Descriptor instead.
+func (*LedgerLine) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{104}
 }
 
-func (x *HandleWebhookResponse) GetSuccess() bool {
+func (x *LedgerLine) GetId() uint32 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *HandleWebhookResponse) GetTransactionId() string {
+func (x *LedgerLine) GetAccount() LedgerAccount {
 	if x != nil {
-		return x.TransactionId
+		return x.Account
 	}
-	return ""
+	return LedgerAccount_LEDGER_ACCOUNT_UNSPECIFIED
 }
 
-func (x *HandleWebhookResponse) GetMessage() string {
+func (x *LedgerLine) GetStreamerId() uint32 {
 	if x != nil {
-		return x.Message
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *LedgerLine) GetProvider() PaymentProvider {
+	if x != nil {
+		return x.Provider
+	}
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+}
+
+func (x *LedgerLine) GetCurrency() string {
+	if x != nil {
+		return x.Currency
 	}
 	return ""
 }
 
-type StreamDonationEventsRequest struct {
+func (x *LedgerLine) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type PayoutAccount struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Id            uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StreamerId    uint32                 `protobuf:"varint,2,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	Method        string                 `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
+	AccountNumber string                 `protobuf:"bytes,5,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountName   string                 `protobuf:"bytes,6,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	CreatedAt     *timestamp.Timestamp   `protobuf:"bytes,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamDonationEventsRequest) Reset() {
-	*x = StreamDonationEventsRequest{}
-	mi := &file_proto_donation_proto_msgTypes[14]
+func (x *PayoutAccount) Reset() {
+	*x = PayoutAccount{}
+	mi := &file_proto_donation_proto_msgTypes[105]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamDonationEventsRequest) String() string {
+func (x *PayoutAccount) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamDonationEventsRequest) ProtoMessage() {}
+func (*PayoutAccount) ProtoMessage() {}
 
-func (x *StreamDonationEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[14]
+func (x *PayoutAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[105]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,43 +8045,97 @@ func (x *StreamDonationEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamDonationEventsRequest.ProtoReflect.Descriptor instead.
-func (*StreamDonationEventsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PayoutAccount.ProtoReflect.Descriptor instead.
+func (*PayoutAccount) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{105}
 }
 
-func (x *StreamDonationEventsRequest) GetStreamerId() uint32 {
+func (x *PayoutAccount) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *PayoutAccount) GetStreamerId() uint32 {
 	if x != nil {
 		return x.StreamerId
 	}
 	return 0
 }
 
-type DonationEvent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Type          EventType              `protobuf:"varint,1,opt,name=type,proto3,enum=donation.EventType" json:"type,omitempty"`
-	Donation      *Donation              `protobuf:"bytes,2,opt,name=donation,proto3" json:"donation,omitempty"`
-	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	Metadata      map[string]string      `protobuf:"bytes,4,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *PayoutAccount) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *PayoutAccount) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *PayoutAccount) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
+}
+
+func (x *PayoutAccount) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
 }
 
-func (x *DonationEvent) Reset() {
-	*x = DonationEvent{}
-	mi := &file_proto_donation_proto_msgTypes[15]
+func (x *PayoutAccount) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type Payout struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Id                uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StreamerId        uint32                 `protobuf:"varint,2,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	AccountId         uint32                 `protobuf:"varint,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Method            string                 `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	Channel           string                 `protobuf:"bytes,5,opt,name=channel,proto3" json:"channel,omitempty"`
+	AccountNumber     string                 `protobuf:"bytes,6,opt,name=account_number,json=accountNumber,proto3" json:"account_number,omitempty"`
+	AccountName       string                 `protobuf:"bytes,7,opt,name=account_name,json=accountName,proto3" json:"account_name,omitempty"`
+	Amount            int64                  `protobuf:"varint,8,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency          string                 `protobuf:"bytes,9,opt,name=currency,proto3" json:"currency,omitempty"`
+	Status            PayoutStatus           `protobuf:"varint,10,opt,name=status,proto3,enum=donation.PayoutStatus" json:"status,omitempty"`
+	ReviewedBy        uint32                 `protobuf:"varint,11,opt,name=reviewed_by,json=reviewedBy,proto3" json:"reviewed_by,omitempty"`
+	ReviewedAt        *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`
+	ProviderReference string                 `protobuf:"bytes,13,opt,name=provider_reference,json=providerReference,proto3" json:"provider_reference,omitempty"`
+	FailureReason     string                 `protobuf:"bytes,14,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	PaidAt            *timestamp.Timestamp   `protobuf:"bytes,15,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`
+	CreatedAt         *timestamp.Timestamp   `protobuf:"bytes,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Payout) Reset() {
+	*x = Payout{}
+	mi := &file_proto_donation_proto_msgTypes[106]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DonationEvent) String() string {
+func (x *Payout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DonationEvent) ProtoMessage() {}
+func (*Payout) ProtoMessage() {}
 
-func (x *DonationEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[15]
+func (x *Payout) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[106]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,138 +8146,159 @@ func (x *DonationEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DonationEvent.ProtoReflect.Descriptor instead.
-func (*DonationEvent) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use Payout.ProtoReflect.Descriptor instead.
+func (*Payout) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{106}
 }
 
-func (x *DonationEvent) GetType() EventType {
+func (x *Payout) GetId() uint32 {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
-	return EventType_EVENT_TYPE_UNSPECIFIED
+	return 0
 }
 
-func (x *DonationEvent) GetDonation() *Donation {
+func (x *Payout) GetStreamerId() uint32 {
 	if x != nil {
-		return x.Donation
+		return x.StreamerId
 	}
-	return nil
+	return 0
 }
 
-func (x *DonationEvent) GetTimestamp() *timestamp.Timestamp {
+func (x *Payout) GetAccountId() uint32 {
 	if x != nil {
-		return x.Timestamp
+		return x.AccountId
 	}
-	return nil
+	return 0
 }
 
-func (x *DonationEvent) GetMetadata() map[string]string {
+func (x *Payout) GetMethod() string {
 	if x != nil {
-		return x.Metadata
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-type SendNotificationRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Type          NotificationType       `protobuf:"varint,2,opt,name=type,proto3,enum=donation.NotificationType" json:"type,omitempty"`
-	Title         string                 `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	Data          map[string]string      `protobuf:"bytes,5,rep,name=data,proto3" json:"data,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *Payout) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
 }
 
-func (x *SendNotificationRequest) Reset() {
-	*x = SendNotificationRequest{}
-	mi := &file_proto_donation_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *Payout) GetAccountNumber() string {
+	if x != nil {
+		return x.AccountNumber
+	}
+	return ""
 }
 
-func (x *SendNotificationRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Payout) GetAccountName() string {
+	if x != nil {
+		return x.AccountName
+	}
+	return ""
 }
 
-func (*SendNotificationRequest) ProtoMessage() {}
+func (x *Payout) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
 
-func (x *SendNotificationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[16]
+func (x *Payout) GetCurrency() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Currency
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SendNotificationRequest.ProtoReflect.Descriptor instead.
-func (*SendNotificationRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{16}
+func (x *Payout) GetStatus() PayoutStatus {
+	if x != nil {
+		return x.Status
+	}
+	return PayoutStatus_PAYOUT_STATUS_UNSPECIFIED
 }
 
-func (x *SendNotificationRequest) GetUserId() uint32 {
+func (x *Payout) GetReviewedBy() uint32 {
 	if x != nil {
-		return x.UserId
+		return x.ReviewedBy
 	}
 	return 0
 }
 
-func (x *SendNotificationRequest) GetType() NotificationType {
+func (x *Payout) GetReviewedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Type
+		return x.ReviewedAt
 	}
-	return NotificationType_NOTIFICATION_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *SendNotificationRequest) GetTitle() string {
+func (x *Payout) GetProviderReference() string {
 	if x != nil {
-		return x.Title
+		return x.ProviderReference
 	}
 	return ""
 }
 
-func (x *SendNotificationRequest) GetMessage() string {
+func (x *Payout) GetFailureReason() string {
 	if x != nil {
-		return x.Message
+		return x.FailureReason
 	}
 	return ""
 }
 
-func (x *SendNotificationRequest) GetData() map[string]string {
+func (x *Payout) GetPaidAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Data
+		return x.PaidAt
 	}
 	return nil
 }
 
-type SendNotificationResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	NotificationId string                 `protobuf:"bytes,2,opt,name=notification_id,json=notificationId,proto3" json:"notification_id,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+func (x *Payout) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
 }
 
-func (x *SendNotificationResponse) Reset() {
-	*x = SendNotificationResponse{}
-	mi := &file_proto_donation_proto_msgTypes[17]
+type RiskAssessment struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StreamerId      uint32                 `protobuf:"varint,2,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	DonatorId       uint32                 `protobuf:"varint,3,opt,name=donator_id,json=donatorId,proto3" json:"donator_id,omitempty"`
+	IpAddress       string                 `protobuf:"bytes,4,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	Country         string                 `protobuf:"bytes,5,opt,name=country,proto3" json:"country,omitempty"`
+	Amount          int64                  `protobuf:"varint,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency        string                 `protobuf:"bytes,7,opt,name=currency,proto3" json:"currency,omitempty"`
+	TinyAmount      bool                   `protobuf:"varint,8,opt,name=tiny_amount,json=tinyAmount,proto3" json:"tiny_amount,omitempty"`
+	PaymentProvider PaymentProvider        `protobuf:"varint,9,opt,name=payment_provider,json=paymentProvider,proto3,enum=donation.PaymentProvider" json:"payment_provider,omitempty"`
+	SplitDonationId uint32                 `protobuf:"varint,10,opt,name=split_donation_id,json=splitDonationId,proto3" json:"split_donation_id,omitempty"`
+	Score           int32                  `protobuf:"varint,11,opt,name=score,proto3" json:"score,omitempty"`
+	Decision        RiskDecision           `protobuf:"varint,12,opt,name=decision,proto3,enum=donation.RiskDecision" json:"decision,omitempty"`
+	Reasons         []*RiskReason          `protobuf:"bytes,13,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	DonationId      uint32                 `protobuf:"varint,14,opt,name=donation_id,json=donationId,proto3" json:"donation_id,omitempty"`
+	CreatedAt       *timestamp.Timestamp   `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RiskAssessment) Reset() {
+	*x = RiskAssessment{}
+	mi := &file_proto_donation_proto_msgTypes[107]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SendNotificationResponse) String() string {
+func (x *RiskAssessment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendNotificationResponse) ProtoMessage() {}
+func (*RiskAssessment) ProtoMessage() {}
 
-func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[17]
+func (x *RiskAssessment) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[107]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1332,101 +8309,140 @@ func (x *SendNotificationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendNotificationResponse.ProtoReflect.Descriptor instead.
-func (*SendNotificationResponse) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use RiskAssessment.ProtoReflect.Descriptor instead.
+func (*RiskAssessment) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{107}
 }
 
-func (x *SendNotificationResponse) GetSuccess() bool {
+func (x *RiskAssessment) GetId() uint32 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-func (x *SendNotificationResponse) GetNotificationId() string {
+func (x *RiskAssessment) GetStreamerId() uint32 {
 	if x != nil {
-		return x.NotificationId
+		return x.StreamerId
+	}
+	return 0
+}
+
+func (x *RiskAssessment) GetDonatorId() uint32 {
+	if x != nil {
+		return x.DonatorId
+	}
+	return 0
+}
+
+func (x *RiskAssessment) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
 	}
 	return ""
 }
 
-type SubscribeEventsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint32                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	EventTypes    []EventType            `protobuf:"varint,2,rep,packed,name=event_types,json=eventTypes,proto3,enum=donation.EventType" json:"event_types,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RiskAssessment) GetCountry() string {
+	if x != nil {
+		return x.Country
+	}
+	return ""
 }
 
-func (x *SubscribeEventsRequest) Reset() {
-	*x = SubscribeEventsRequest{}
-	mi := &file_proto_donation_proto_msgTypes[18]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RiskAssessment) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
 }
 
-func (x *SubscribeEventsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RiskAssessment) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
 }
 
-func (*SubscribeEventsRequest) ProtoMessage() {}
+func (x *RiskAssessment) GetTinyAmount() bool {
+	if x != nil {
+		return x.TinyAmount
+	}
+	return false
+}
 
-func (x *SubscribeEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[18]
+func (x *RiskAssessment) GetPaymentProvider() PaymentProvider {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.PaymentProvider
 	}
-	return mi.MessageOf(x)
+	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
 }
 
-// Deprecated: Use SubscribeEventsRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeEventsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{18}
+func (x *RiskAssessment) GetSplitDonationId() uint32 {
+	if x != nil {
+		return x.SplitDonationId
+	}
+	return 0
 }
 
-func (x *SubscribeEventsRequest) GetUserId() uint32 {
+func (x *RiskAssessment) GetScore() int32 {
 	if x != nil {
-		return x.UserId
+		return x.Score
 	}
 	return 0
 }
 
-func (x *SubscribeEventsRequest) GetEventTypes() []EventType {
+func (x *RiskAssessment) GetDecision() RiskDecision {
 	if x != nil {
-		return x.EventTypes
+		return x.Decision
+	}
+	return RiskDecision_RISK_DECISION_UNSPECIFIED
+}
+
+func (x *RiskAssessment) GetReasons() []*RiskReason {
+	if x != nil {
+		return x.Reasons
 	}
 	return nil
 }
 
-type GetDonationStatsRequest struct {
+func (x *RiskAssessment) GetDonationId() uint32 {
+	if x != nil {
+		return x.DonationId
+	}
+	return 0
+}
+
+func (x *RiskAssessment) GetCreatedAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+type RiskReason struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
-	StartDate     *timestamp.Timestamp   `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
-	EndDate       *timestamp.Timestamp   `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Rule          string                 `protobuf:"bytes,1,opt,name=rule,proto3" json:"rule,omitempty"`
+	Points        int32                  `protobuf:"varint,2,opt,name=points,proto3" json:"points,omitempty"`
+	Detail        string                 `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDonationStatsRequest) Reset() {
-	*x = GetDonationStatsRequest{}
-	mi := &file_proto_donation_proto_msgTypes[19]
+func (x *RiskReason) Reset() {
+	*x = RiskReason{}
+	mi := &file_proto_donation_proto_msgTypes[108]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDonationStatsRequest) String() string {
+func (x *RiskReason) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDonationStatsRequest) ProtoMessage() {}
+func (*RiskReason) ProtoMessage() {}
 
-func (x *GetDonationStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[19]
+func (x *RiskReason) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[108]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,57 +8453,68 @@ func (x *GetDonationStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDonationStatsRequest.ProtoReflect.Descriptor instead.
-func (*GetDonationStatsRequest) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RiskReason.ProtoReflect.Descriptor instead.
+func (*RiskReason) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{108}
 }
 
-func (x *GetDonationStatsRequest) GetStreamerId() uint32 {
+func (x *RiskReason) GetRule() string {
 	if x != nil {
-		return x.StreamerId
+		return x.Rule
 	}
-	return 0
+	return ""
 }
 
-func (x *GetDonationStatsRequest) GetStartDate() *timestamp.Timestamp {
+func (x *RiskReason) GetPoints() int32 {
 	if x != nil {
-		return x.StartDate
+		return x.Points
 	}
-	return nil
+	return 0
 }
 
-func (x *GetDonationStatsRequest) GetEndDate() *timestamp.Timestamp {
+func (x *RiskReason) GetDetail() string {
 	if x != nil {
-		return x.EndDate
+		return x.Detail
 	}
-	return nil
-}
-
-type GetDonationStatsResponse struct {
-	state          protoimpl.MessageState `protogen:"open.v1"`
-	TotalAmount    float64                `protobuf:"fixed64,1,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	TotalDonations int32                  `protobuf:"varint,2,opt,name=total_donations,json=totalDonations,proto3" json:"total_donations,omitempty"`
-	AverageAmount  float64                `protobuf:"fixed64,3,opt,name=average_amount,json=averageAmount,proto3" json:"average_amount,omitempty"`
-	DailyStats     []*DonationStat        `protobuf:"bytes,4,rep,name=daily_stats,json=dailyStats,proto3" json:"daily_stats,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	return ""
 }
 
-func (x *GetDonationStatsResponse) Reset() {
-	*x = GetDonationStatsResponse{}
-	mi := &file_proto_donation_proto_msgTypes[20]
+type MatchCampaign struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name             string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	SponsorName      string                 `protobuf:"bytes,3,opt,name=sponsor_name,json=sponsorName,proto3" json:"sponsor_name,omitempty"`
+	SponsorUserId    uint32                 `protobuf:"varint,4,opt,name=sponsor_user_id,json=sponsorUserId,proto3" json:"sponsor_user_id,omitempty"`
+	StreamerIds      []uint32               `protobuf:"varint,5,rep,packed,name=streamer_ids,json=streamerIds,proto3" json:"streamer_ids,omitempty"`
+	Currency         string                 `protobuf:"bytes,6,opt,name=currency,proto3" json:"currency,omitempty"`
+	RatioBasisPoints int64                  `protobuf:"varint,7,opt,name=ratio_basis_points,json=ratioBasisPoints,proto3" json:"ratio_basis_points,omitempty"`
+	MaxMatchAmount   int64                  `protobuf:"varint,8,opt,name=max_match_amount,json=maxMatchAmount,proto3" json:"max_match_amount,omitempty"`
+	Budget           int64                  `protobuf:"varint,9,opt,name=budget,proto3" json:"budget,omitempty"`
+	Spent            int64                  `protobuf:"varint,10,opt,name=spent,proto3" json:"spent,omitempty"`
+	MatchCount       int64                  `protobuf:"varint,11,opt,name=match_count,json=matchCount,proto3" json:"match_count,omitempty"`
+	StartsAt         *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt           *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	IsActive         bool                   `protobuf:"varint,14,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	CreatedAt        *timestamp.Timestamp   `protobuf:"bytes,15,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MatchCampaign) Reset() {
+	*x = MatchCampaign{}
+	mi := &file_proto_donation_proto_msgTypes[109]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDonationStatsResponse) String() string {
+func (x *MatchCampaign) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDonationStatsResponse) ProtoMessage() {}
+func (*MatchCampaign) ProtoMessage() {}
 
-func (x *GetDonationStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[20]
+func (x *MatchCampaign) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[109]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1495,138 +8522,145 @@ func (x *GetDonationStatsResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MatchCampaign.ProtoReflect.Descriptor instead.
+func (*MatchCampaign) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *MatchCampaign) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
 }
 
-// Deprecated: Use GetDonationStatsResponse.ProtoReflect.Descriptor instead.
-func (*GetDonationStatsResponse) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{20}
+func (x *MatchCampaign) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *GetDonationStatsResponse) GetTotalAmount() float64 {
+func (x *MatchCampaign) GetSponsorName() string {
 	if x != nil {
-		return x.TotalAmount
+		return x.SponsorName
 	}
-	return 0
+	return ""
 }
 
-func (x *GetDonationStatsResponse) GetTotalDonations() int32 {
+func (x *MatchCampaign) GetSponsorUserId() uint32 {
 	if x != nil {
-		return x.TotalDonations
+		return x.SponsorUserId
 	}
 	return 0
 }
 
-func (x *GetDonationStatsResponse) GetAverageAmount() float64 {
+func (x *MatchCampaign) GetStreamerIds() []uint32 {
 	if x != nil {
-		return x.AverageAmount
+		return x.StreamerIds
 	}
-	return 0
+	return nil
 }
 
-func (x *GetDonationStatsResponse) GetDailyStats() []*DonationStat {
+func (x *MatchCampaign) GetCurrency() string {
 	if x != nil {
-		return x.DailyStats
+		return x.Currency
 	}
-	return nil
+	return ""
 }
 
-type DonationStat struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Date          string                 `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
-	Amount        float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Count         int32                  `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *MatchCampaign) GetRatioBasisPoints() int64 {
+	if x != nil {
+		return x.RatioBasisPoints
+	}
+	return 0
 }
 
-func (x *DonationStat) Reset() {
-	*x = DonationStat{}
-	mi := &file_proto_donation_proto_msgTypes[21]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *MatchCampaign) GetMaxMatchAmount() int64 {
+	if x != nil {
+		return x.MaxMatchAmount
+	}
+	return 0
 }
 
-func (x *DonationStat) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MatchCampaign) GetBudget() int64 {
+	if x != nil {
+		return x.Budget
+	}
+	return 0
 }
 
-func (*DonationStat) ProtoMessage() {}
+func (x *MatchCampaign) GetSpent() int64 {
+	if x != nil {
+		return x.Spent
+	}
+	return 0
+}
 
-func (x *DonationStat) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[21]
+func (x *MatchCampaign) GetMatchCount() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MatchCount
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DonationStat.ProtoReflect.Descriptor instead.
-func (*DonationStat) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{21}
+func (x *MatchCampaign) GetStartsAt() *timestamp.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
 }
 
-func (x *DonationStat) GetDate() string {
+func (x *MatchCampaign) GetEndsAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Date
+		return x.EndsAt
 	}
-	return ""
+	return nil
 }
 
-func (x *DonationStat) GetAmount() float64 {
+func (x *MatchCampaign) GetIsActive() bool {
 	if x != nil {
-		return x.Amount
+		return x.IsActive
 	}
-	return 0
+	return false
 }
 
-func (x *DonationStat) GetCount() int32 {
+func (x *MatchCampaign) GetCreatedAt() *timestamp.Timestamp {
 	if x != nil {
-		return x.Count
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-// Data models
-type Donation struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Amount          float64                `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	Currency        string                 `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
-	Message         string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	StreamerId      uint32                 `protobuf:"varint,5,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
-	DonatorId       uint32                 `protobuf:"varint,6,opt,name=donator_id,json=donatorId,proto3" json:"donator_id,omitempty"`
-	DisplayName     string                 `protobuf:"bytes,7,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
-	IsAnonymous     bool                   `protobuf:"varint,8,opt,name=is_anonymous,json=isAnonymous,proto3" json:"is_anonymous,omitempty"`
-	Status          PaymentStatus          `protobuf:"varint,9,opt,name=status,proto3,enum=donation.PaymentStatus" json:"status,omitempty"`
-	PaymentProvider PaymentProvider        `protobuf:"varint,10,opt,name=payment_provider,json=paymentProvider,proto3,enum=donation.PaymentProvider" json:"payment_provider,omitempty"`
-	TransactionId   string                 `protobuf:"bytes,11,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
-	CreatedAt       *timestamp.Timestamp   `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt       *timestamp.Timestamp   `protobuf:"bytes,13,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	PaymentTime     *timestamp.Timestamp   `protobuf:"bytes,14,opt,name=payment_time,json=paymentTime,proto3" json:"payment_time,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type MatchCampaignReport struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Campaign       *MatchCampaign         `protobuf:"bytes,1,opt,name=campaign,proto3" json:"campaign,omitempty"`
+	Remaining      int64                  `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	DonationAmount int64                  `protobuf:"varint,3,opt,name=donation_amount,json=donationAmount,proto3" json:"donation_amount,omitempty"` // Donations matched, in the campaign's currency
+	MatchedAmount  int64                  `protobuf:"varint,4,opt,name=matched_amount,json=matchedAmount,proto3" json:"matched_amount,omitempty"`
+	Streamers      []*MatchStreamerTotal  `protobuf:"bytes,5,rep,name=streamers,proto3" json:"streamers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Donation) Reset() {
-	*x = Donation{}
-	mi := &file_proto_donation_proto_msgTypes[22]
+func (x *MatchCampaignReport) Reset() {
+	*x = MatchCampaignReport{}
+	mi := &file_proto_donation_proto_msgTypes[110]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Donation) String() string {
+func (x *MatchCampaignReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Donation) ProtoMessage() {}
+func (*MatchCampaignReport) ProtoMessage() {}
 
-func (x *Donation) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_donation_proto_msgTypes[22]
+func (x *MatchCampaignReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[110]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1637,116 +8671,121 @@ func (x *Donation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Donation.ProtoReflect.Descriptor instead.
-func (*Donation) Descriptor() ([]byte, []int) {
-	return file_proto_donation_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use MatchCampaignReport.ProtoReflect.Descriptor instead.
+func (*MatchCampaignReport) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{110}
 }
 
-func (x *Donation) GetId() uint32 {
+func (x *MatchCampaignReport) GetCampaign() *MatchCampaign {
 	if x != nil {
-		return x.Id
+		return x.Campaign
 	}
-	return 0
+	return nil
 }
 
-func (x *Donation) GetAmount() float64 {
+func (x *MatchCampaignReport) GetRemaining() int64 {
 	if x != nil {
-		return x.Amount
+		return x.Remaining
 	}
 	return 0
 }
 
-func (x *Donation) GetCurrency() string {
+func (x *MatchCampaignReport) GetDonationAmount() int64 {
 	if x != nil {
-		return x.Currency
+		return x.DonationAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *Donation) GetMessage() string {
+func (x *MatchCampaignReport) GetMatchedAmount() int64 {
 	if x != nil {
-		return x.Message
+		return x.MatchedAmount
 	}
-	return ""
+	return 0
 }
 
-func (x *Donation) GetStreamerId() uint32 {
+func (x *MatchCampaignReport) GetStreamers() []*MatchStreamerTotal {
 	if x != nil {
-		return x.StreamerId
+		return x.Streamers
 	}
-	return 0
+	return nil
 }
 
-func (x *Donation) GetDonatorId() uint32 {
-	if x != nil {
-		return x.DonatorId
-	}
-	return 0
+type MatchStreamerTotal struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	StreamerId     uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
+	MatchCount     int64                  `protobuf:"varint,2,opt,name=match_count,json=matchCount,proto3" json:"match_count,omitempty"`
+	DonationAmount int64                  `protobuf:"varint,3,opt,name=donation_amount,json=donationAmount,proto3" json:"donation_amount,omitempty"`
+	MatchedAmount  int64                  `protobuf:"varint,4,opt,name=matched_amount,json=matchedAmount,proto3" json:"matched_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *Donation) GetDisplayName() string {
-	if x != nil {
-		return x.DisplayName
-	}
-	return ""
+func (x *MatchStreamerTotal) Reset() {
+	*x = MatchStreamerTotal{}
+	mi := &file_proto_donation_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Donation) GetIsAnonymous() bool {
-	if x != nil {
-		return x.IsAnonymous
-	}
-	return false
+func (x *MatchStreamerTotal) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Donation) GetStatus() PaymentStatus {
+func (*MatchStreamerTotal) ProtoMessage() {}
+
+func (x *MatchStreamerTotal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_donation_proto_msgTypes[111]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return PaymentStatus_PAYMENT_STATUS_UNSPECIFIED
+	return mi.MessageOf(x)
 }
 
-func (x *Donation) GetPaymentProvider() PaymentProvider {
-	if x != nil {
-		return x.PaymentProvider
-	}
-	return PaymentProvider_PAYMENT_PROVIDER_UNSPECIFIED
+// Deprecated: Use MatchStreamerTotal.ProtoReflect.Descriptor instead.
+func (*MatchStreamerTotal) Descriptor() ([]byte, []int) {
+	return file_proto_donation_proto_rawDescGZIP(), []int{111}
 }
 
-func (x *Donation) GetTransactionId() string {
+func (x *MatchStreamerTotal) GetStreamerId() uint32 {
 	if x != nil {
-		return x.TransactionId
+		return x.StreamerId
 	}
-	return ""
+	return 0
 }
 
-func (x *Donation) GetCreatedAt() *timestamp.Timestamp {
+func (x *MatchStreamerTotal) GetMatchCount() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.MatchCount
 	}
-	return nil
+	return 0
 }
 
-func (x *Donation) GetUpdatedAt() *timestamp.Timestamp {
+func (x *MatchStreamerTotal) GetDonationAmount() int64 {
 	if x != nil {
-		return x.UpdatedAt
+		return x.DonationAmount
 	}
-	return nil
+	return 0
 }
 
-func (x *Donation) GetPaymentTime() *timestamp.Timestamp {
+func (x *MatchStreamerTotal) GetMatchedAmount() int64 {
 	if x != nil {
-		return x.PaymentTime
+		return x.MatchedAmount
 	}
-	return nil
+	return 0
 }
 
 var File_proto_donation_proto protoreflect.FileDescriptor
 
 const file_proto_donation_proto_rawDesc = "" +
 	"\n" +
-	"\x14proto/donation.proto\x12\bdonation\x1a\x1fgoogle/protobuf/timestamp.proto\"\x92\x02\n" +
+	"\x14proto/donation.proto\x12\bdonation\x1a\x1fgoogle/protobuf/timestamp.proto\"\xb1\x04\n" +
 	"\x15CreateDonationRequest\x12\x16\n" +
-	"\x06amount\x18\x01 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\x06amount\x18\v \x01(\x03R\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1f\n" +
 	"\vstreamer_id\x18\x04 \x01(\rR\n" +
@@ -1755,7 +8794,15 @@ const file_proto_donation_proto_rawDesc = "" +
 	"donator_id\x18\x05 \x01(\rR\tdonatorId\x12!\n" +
 	"\fdisplay_name\x18\x06 \x01(\tR\vdisplayName\x12!\n" +
 	"\fis_anonymous\x18\a \x01(\bR\visAnonymous\x12%\n" +
-	"\x0epayment_method\x18\b \x01(\tR\rpaymentMethod\"\xe2\x01\n" +
+	"\x0epayment_method\x18\b \x01(\tR\rpaymentMethod\x12'\n" +
+	"\x0fidempotency_key\x18\t \x01(\tR\x0eidempotencyKey\x12+\n" +
+	"\x11streamer_currency\x18\n" +
+	" \x01(\tR\x10streamerCurrency\x12*\n" +
+	"\x11split_donation_id\x18\f \x01(\rR\x0fsplitDonationId\x12\x1b\n" +
+	"\tclient_ip\x18\r \x01(\tR\bclientIp\x12%\n" +
+	"\x0eclient_country\x18\x0e \x01(\tR\rclientCountry\x12$\n" +
+	"\x0eclient_user_id\x18\x0f \x01(\rR\fclientUserId\x12+\n" +
+	"\x11streamer_language\x18\x10 \x01(\tR\x10streamerLanguageJ\x04\b\x01\x10\x02\"\x92\x02\n" +
 	"\x16CreateDonationResponse\x12\x1f\n" +
 	"\vdonation_id\x18\x01 \x01(\rR\n" +
 	"donationId\x12%\n" +
@@ -1764,37 +8811,106 @@ const file_proto_donation_proto_rawDesc = "" +
 	"paymentUrl\x12$\n" +
 	"\x0eqr_code_base64\x18\x04 \x01(\tR\fqrCodeBase64\x129\n" +
 	"\n" +
-	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"5\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x12.\n" +
+	"\bdonation\x18\x06 \x01(\v2\x12.donation.DonationR\bdonation\"S\n" +
+	"\x18CreateSplitSharesRequest\x127\n" +
+	"\x06shares\x18\x01 \x03(\v2\x1f.donation.CreateDonationRequestR\x06shares\"M\n" +
+	"\x19CreateSplitSharesResponse\x120\n" +
+	"\tdonations\x18\x01 \x03(\v2\x12.donation.DonationR\tdonations\"5\n" +
 	"\x12GetDonationRequest\x12\x1f\n" +
 	"\vdonation_id\x18\x01 \x01(\rR\n" +
 	"donationId\"E\n" +
 	"\x13GetDonationResponse\x12.\n" +
-	"\bdonation\x18\x01 \x01(\v2\x12.donation.DonationR\bdonation\"q\n" +
+	"\bdonation\x18\x01 \x01(\v2\x12.donation.DonationR\bdonation\"J\n" +
+	"!GetDonationByTransactionIDRequest\x12%\n" +
+	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\"q\n" +
 	"\x1dGetDonationsByStreamerRequest\x12\x1f\n" +
 	"\vstreamer_id\x18\x01 \x01(\rR\n" +
 	"streamerId\x12\x12\n" +
 	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xb1\x01\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"n\n" +
+	"\x1cGetDonationsByDonatorRequest\x12\x1d\n" +
+	"\n" +
+	"donator_id\x18\x01 \x01(\rR\tdonatorId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"F\n" +
+	"\x13GetDonationsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"1\n" +
+	"\x19GetLatestDonationsRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\x05R\x05limit\"\xb1\x01\n" +
 	"\x18GetDonationsListResponse\x120\n" +
 	"\tdonations\x18\x01 \x03(\v2\x12.donation.DonationR\tdonations\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12!\n" +
 	"\fcurrent_page\x18\x03 \x01(\x05R\vcurrentPage\x12\x1f\n" +
 	"\vtotal_pages\x18\x04 \x01(\x05R\n" +
-	"totalPages\"\x96\x01\n" +
+	"totalPages\"\xf0\x03\n" +
+	"\x0eDonationFilter\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x1d\n" +
+	"\n" +
+	"donator_id\x18\x02 \x01(\rR\tdonatorId\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1d\n" +
+	"\n" +
+	"min_amount\x18\v \x01(\x03R\tminAmount\x12\x1d\n" +
+	"\n" +
+	"max_amount\x18\f \x01(\x03R\tmaxAmount\x12/\n" +
+	"\x06status\x18\a \x01(\x0e2\x17.donation.PaymentStatusR\x06status\x12\x1a\n" +
+	"\bcurrency\x18\b \x01(\tR\bcurrency\x125\n" +
+	"\bprovider\x18\t \x01(\x0e2\x19.donation.PaymentProviderR\bprovider\x127\n" +
+	"\tanonymity\x18\n" +
+	" \x01(\x0e2\x19.donation.AnonymityFilterR\tanonymity\x12%\n" +
+	"\x0eparticipant_id\x18\r \x01(\rR\rparticipantIdJ\x04\b\x05\x10\x06J\x04\b\x06\x10\a\"\xd7\x01\n" +
+	"\x14ListDonationsRequest\x120\n" +
+	"\x06filter\x18\x01 \x01(\v2\x18.donation.DonationFilterR\x06filter\x124\n" +
+	"\asort_by\x18\x02 \x01(\x0e2\x1b.donation.DonationSortFieldR\x06sortBy\x12)\n" +
+	"\x05order\x18\x03 \x01(\x0e2\x13.donation.SortOrderR\x05order\x12\x16\n" +
+	"\x06cursor\x18\x04 \x01(\tR\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"\x85\x01\n" +
+	"\x15ListDonationsResponse\x120\n" +
+	"\tdonations\x18\x01 \x03(\v2\x12.donation.DonationR\tdonations\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"\xff\x01\n" +
 	"\x1bUpdateDonationStatusRequest\x12\x1f\n" +
 	"\vdonation_id\x18\x01 \x01(\rR\n" +
 	"donationId\x12/\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x17.donation.PaymentStatusR\x06status\x12%\n" +
-	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\"R\n" +
+	"\x0etransaction_id\x18\x03 \x01(\tR\rtransactionId\x124\n" +
+	"\x06source\x18\x04 \x01(\x0e2\x1c.donation.StatusChangeSourceR\x06source\x12\x19\n" +
+	"\bactor_id\x18\x05 \x01(\rR\aactorId\x12\x16\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"R\n" +
 	"\x1cUpdateDonationStatusResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x84\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"B\n" +
+	"\x1fGetDonationStatusHistoryRequest\x12\x1f\n" +
+	"\vdonation_id\x18\x01 \x01(\rR\n" +
+	"donationId\"\\\n" +
+	" GetDonationStatusHistoryResponse\x128\n" +
+	"\ahistory\x18\x01 \x03(\v2\x1e.donation.DonationStatusChangeR\ahistory\"\x9e\x01\n" +
+	"\x1dProcessDonationPaymentRequest\x12\x1f\n" +
+	"\vdonation_id\x18\x01 \x01(\rR\n" +
+	"donationId\x12%\n" +
+	"\x0etransaction_id\x18\x02 \x01(\tR\rtransactionId\x125\n" +
+	"\bprovider\x18\x03 \x01(\x0e2\x19.donation.PaymentProviderR\bprovider\"T\n" +
+	"\x1eProcessDonationPaymentResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"^\n" +
+	"\x1fGetStreamerDonationTotalRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\"E\n" +
+	" GetStreamerDonationTotalResponse\x12!\n" +
+	"\ftotal_amount\x18\x01 \x01(\x03R\vtotalAmount\"\xad\x02\n" +
 	"\x15ProcessPaymentRequest\x12\x1f\n" +
 	"\vdonation_id\x18\x01 \x01(\rR\n" +
 	"donationId\x125\n" +
 	"\bprovider\x18\x02 \x01(\x0e2\x19.donation.PaymentProviderR\bprovider\x12S\n" +
-	"\fpayment_data\x18\x03 \x03(\v20.donation.ProcessPaymentRequest.PaymentDataEntryR\vpaymentData\x1a>\n" +
+	"\fpayment_data\x18\x03 \x03(\v20.donation.ProcessPaymentRequest.PaymentDataEntryR\vpaymentData\x12'\n" +
+	"\x0fidempotency_key\x18\x04 \x01(\tR\x0eidempotencyKey\x1a>\n" +
 	"\x10PaymentDataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xaa\x01\n" +
@@ -1806,12 +8922,12 @@ const file_proto_donation_proto_rawDesc = "" +
 	"\x06status\x18\x04 \x01(\x0e2\x17.donation.PaymentStatusR\x06status\"t\n" +
 	"\x14VerifyPaymentRequest\x12%\n" +
 	"\x0etransaction_id\x18\x01 \x01(\tR\rtransactionId\x125\n" +
-	"\bprovider\x18\x02 \x01(\x0e2\x19.donation.PaymentProviderR\bprovider\"\x81\x01\n" +
+	"\bprovider\x18\x02 \x01(\x0e2\x19.donation.PaymentProviderR\bprovider\"\x87\x01\n" +
 	"\x15VerifyPaymentResponse\x12\x1f\n" +
 	"\vis_verified\x18\x01 \x01(\bR\n" +
 	"isVerified\x12/\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x17.donation.PaymentStatusR\x06status\x12\x16\n" +
-	"\x06amount\x18\x03 \x01(\x01R\x06amount\"\xea\x01\n" +
+	"\x06amount\x18\x04 \x01(\x03R\x06amountJ\x04\b\x03\x10\x04\"\xea\x01\n" +
 	"\x14HandleWebhookRequest\x125\n" +
 	"\bprovider\x18\x01 \x01(\x0e2\x19.donation.PaymentProviderR\bprovider\x12\x18\n" +
 	"\apayload\x18\x02 \x01(\fR\apayload\x12E\n" +
@@ -1849,26 +8965,265 @@ const file_proto_donation_proto_rawDesc = "" +
 	"\x16SubscribeEventsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\rR\x06userId\x124\n" +
 	"\vevent_types\x18\x02 \x03(\x0e2\x13.donation.EventTypeR\n" +
-	"eventTypes\"\xac\x01\n" +
+	"eventTypes\"\xfd\x01\n" +
 	"\x17GetDonationStatsRequest\x12\x1f\n" +
 	"\vstreamer_id\x18\x01 \x01(\rR\n" +
 	"streamerId\x129\n" +
 	"\n" +
 	"start_date\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
-	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\"\xc6\x01\n" +
+	"\bend_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x123\n" +
+	"\binterval\x18\x04 \x01(\x0e2\x17.donation.StatsIntervalR\binterval\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"\xd4\x03\n" +
 	"\x18GetDonationStatsResponse\x12!\n" +
-	"\ftotal_amount\x18\x01 \x01(\x01R\vtotalAmount\x12'\n" +
+	"\ftotal_amount\x18\n" +
+	" \x01(\x03R\vtotalAmount\x12'\n" +
 	"\x0ftotal_donations\x18\x02 \x01(\x05R\x0etotalDonations\x12%\n" +
-	"\x0eaverage_amount\x18\x03 \x01(\x01R\raverageAmount\x127\n" +
+	"\x0eaverage_amount\x18\v \x01(\x03R\raverageAmount\x127\n" +
 	"\vdaily_stats\x18\x04 \x03(\v2\x16.donation.DonationStatR\n" +
-	"dailyStats\"P\n" +
+	"dailyStats\x12=\n" +
+	"\x0ecurrency_stats\x18\x05 \x03(\v2\x16.donation.CurrencyStatR\rcurrencyStats\x123\n" +
+	"\binterval\x18\x06 \x01(\x0e2\x17.donation.StatsIntervalR\binterval\x129\n" +
+	"\n" +
+	"start_date\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrencyJ\x04\b\x01\x10\x02J\x04\b\x03\x10\x04\"\xd2\x01\n" +
 	"\fDonationStat\x12\x12\n" +
 	"\x04date\x18\x01 \x01(\tR\x04date\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x14\n" +
-	"\x05count\x18\x03 \x01(\x05R\x05count\"\xc1\x04\n" +
+	"\x06amount\x18\a \x01(\x03R\x06amount\x12\x14\n" +
+	"\x05count\x18\x03 \x01(\x05R\x05count\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12)\n" +
+	"\x10converted_amount\x18\b \x01(\x03R\x0fconvertedAmount\x12-\n" +
+	"\x12converted_currency\x18\x06 \x01(\tR\x11convertedCurrencyJ\x04\b\x02\x10\x03J\x04\b\x05\x10\x06\"\xda\x01\n" +
+	"\fCurrencyStat\x12\x1a\n" +
+	"\bcurrency\x18\x01 \x01(\tR\bcurrency\x12!\n" +
+	"\ftotal_amount\x18\x06 \x01(\x03R\vtotalAmount\x12'\n" +
+	"\x0ftotal_donations\x18\x03 \x01(\x05R\x0etotalDonations\x12%\n" +
+	"\x0eaverage_amount\x18\a \x01(\x03R\raverageAmount\x12)\n" +
+	"\x10converted_amount\x18\b \x01(\x03R\x0fconvertedAmountJ\x04\b\x02\x10\x03J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06\"\xe1\x01\n" +
+	"\x15RefundDonationRequest\x12\x1f\n" +
+	"\vdonation_id\x18\x01 \x01(\rR\n" +
+	"donationId\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12!\n" +
+	"\frequested_by\x18\x04 \x01(\rR\vrequestedBy\x12\x16\n" +
+	"\x06manual\x18\x05 \x01(\bR\x06manual\x12\x1e\n" +
+	"\n" +
+	"chargeback\x18\a \x01(\bR\n" +
+	"chargeback\x12\x16\n" +
+	"\x06source\x18\b \x01(\tR\x06sourceJ\x04\b\x02\x10\x03\"z\n" +
+	"\x16RefundDonationResponse\x120\n" +
+	"\x06refund\x18\x01 \x01(\v2\x18.donation.DonationRefundR\x06refund\x12.\n" +
+	"\bdonation\x18\x02 \x01(\v2\x12.donation.DonationR\bdonation\"=\n" +
+	"\x1aListDonationRefundsRequest\x12\x1f\n" +
+	"\vdonation_id\x18\x01 \x01(\rR\n" +
+	"donationId\"Q\n" +
+	"\x1bListDonationRefundsResponse\x122\n" +
+	"\arefunds\x18\x01 \x03(\v2\x18.donation.DonationRefundR\arefunds\"\xc2\x02\n" +
+	"\x1dGetDonationLeaderboardRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x123\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x1b.donation.LeaderboardPeriodR\x06period\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x05R\x05limit\x12'\n" +
+	"\x0fgroup_anonymous\x18\a \x01(\bR\x0egroupAnonymous\"\xba\x02\n" +
+	"\x1eGetDonationLeaderboardResponse\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x123\n" +
+	"\x06period\x18\x02 \x01(\x0e2\x1b.donation.LeaderboardPeriodR\x06period\x12\x1a\n" +
+	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x124\n" +
+	"\aentries\x18\x06 \x03(\v2\x1a.donation.LeaderboardEntryR\aentries\"\xe5\x02\n" +
+	"\x10LeaderboardEntry\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x1d\n" +
+	"\n" +
+	"donator_id\x18\x02 \x01(\rR\tdonatorId\x12!\n" +
+	"\fdisplay_name\x18\x03 \x01(\tR\vdisplayName\x12!\n" +
+	"\fis_anonymous\x18\x04 \x01(\bR\visAnonymous\x12!\n" +
+	"\ftotal_amount\x18\t \x01(\x03R\vtotalAmount\x12%\n" +
+	"\x0edonation_count\x18\x06 \x01(\x05R\rdonationCount\x12D\n" +
+	"\x10first_donated_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\x0efirstDonatedAt\x12B\n" +
+	"\x0flast_donated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\rlastDonatedAtJ\x04\b\x05\x10\x06\"G\n" +
+	"\x19CreateDonationGoalRequest\x12*\n" +
+	"\x04goal\x18\x01 \x01(\v2\x16.donation.DonationGoalR\x04goal\"G\n" +
+	"\x19UpdateDonationGoalRequest\x12*\n" +
+	"\x04goal\x18\x01 \x01(\v2\x16.donation.DonationGoalR\x04goal\"B\n" +
+	"\x14DonationGoalResponse\x12*\n" +
+	"\x04goal\x18\x01 \x01(\v2\x16.donation.DonationGoalR\x04goal\"U\n" +
+	"\x19DeleteDonationGoalRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x17\n" +
+	"\agoal_id\x18\x02 \x01(\rR\x06goalId\"6\n" +
+	"\x1aDeleteDonationGoalResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xf7\x01\n" +
+	"\x16ExportDonationsRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12.\n" +
+	"\x06format\x18\x02 \x01(\x0e2\x16.donation.ExportFormatR\x06format\x129\n" +
+	"\n" +
+	"start_date\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\"!\n" +
+	"\vExportChunk\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"X\n" +
+	"\x18GetDonationExportRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x1b\n" +
+	"\texport_id\x18\x02 \x01(\rR\bexportId\"J\n" +
+	"\x16DonationExportResponse\x120\n" +
+	"\x06export\x18\x01 \x01(\v2\x18.donation.DonationExportR\x06export\"1\n" +
+	"\x16GetDonationGoalRequest\x12\x17\n" +
+	"\agoal_id\x18\x01 \x01(\rR\x06goalId\"\\\n" +
+	"\x18ListDonationGoalsRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x1f\n" +
+	"\vactive_only\x18\x02 \x01(\bR\n" +
+	"activeOnly\"I\n" +
+	"\x19ListDonationGoalsResponse\x12,\n" +
+	"\x05goals\x18\x01 \x03(\v2\x16.donation.DonationGoalR\x05goals\"?\n" +
+	"\x1cGetModerationSettingsRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\"[\n" +
+	"\x1fUpdateModerationSettingsRequest\x128\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1c.donation.ModerationSettingsR\bsettings\"V\n" +
+	"\x1aModerationSettingsResponse\x128\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1c.donation.ModerationSettingsR\bsettings\"\xa4\x01\n" +
+	"\x19ListMessageReviewsRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x125\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1d.donation.MessageReviewStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x96\x01\n" +
+	"\x1aListMessageReviewsResponse\x121\n" +
+	"\areviews\x18\x01 \x03(\v2\x17.donation.MessageReviewR\areviews\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"[\n" +
+	"\x1bResolveMessageReviewRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x1b\n" +
+	"\treview_id\x18\x02 \x01(\rR\breviewId\"H\n" +
+	"\x15MessageReviewResponse\x12/\n" +
+	"\x06review\x18\x01 \x01(\v2\x17.donation.MessageReviewR\x06review\"\x19\n" +
+	"\x17ListBlockedTermsRequest\"G\n" +
+	"\x18ListBlockedTermsResponse\x12+\n" +
+	"\x05terms\x18\x01 \x03(\v2\x15.donation.BlockedTermR\x05terms\"J\n" +
+	"\x15AddBlockedTermRequest\x12\x12\n" +
+	"\x04term\x18\x01 \x01(\tR\x04term\x12\x1d\n" +
+	"\n" +
+	"is_pattern\x18\x02 \x01(\bR\tisPattern\"@\n" +
+	"\x13BlockedTermResponse\x12)\n" +
+	"\x04term\x18\x01 \x01(\v2\x15.donation.BlockedTermR\x04term\"*\n" +
+	"\x18RemoveBlockedTermRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\"5\n" +
+	"\x19RemoveBlockedTermResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"<\n" +
+	"\x19GetStreamerBalanceRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\"S\n" +
+	"\x1aGetStreamerBalanceResponse\x125\n" +
+	"\bbalances\x18\x01 \x03(\v2\x19.donation.StreamerBalanceR\bbalances\"l\n" +
+	"\x18ListLedgerEntriesRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"}\n" +
+	"\x19ListLedgerEntriesResponse\x12/\n" +
+	"\aentries\x18\x01 \x03(\v2\x15.donation.LedgerEntryR\aentries\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xa1\x01\n" +
+	"\x1aCreatePayoutAccountRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x18\n" +
+	"\achannel\x18\x02 \x01(\tR\achannel\x12%\n" +
+	"\x0eaccount_number\x18\x03 \x01(\tR\raccountNumber\x12!\n" +
+	"\faccount_name\x18\x04 \x01(\tR\vaccountName\"<\n" +
+	"\x19ListPayoutAccountsRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\"Q\n" +
+	"\x1aListPayoutAccountsResponse\x123\n" +
+	"\baccounts\x18\x01 \x03(\v2\x17.donation.PayoutAccountR\baccounts\"\\\n" +
+	"\x1aDeletePayoutAccountRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\rR\taccountId\"7\n" +
+	"\x1bDeletePayoutAccountResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x8a\x01\n" +
+	"\x14RequestPayoutRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x02 \x01(\rR\taccountId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\"/\n" +
+	"\x10GetPayoutRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\rR\bpayoutId\"\x96\x01\n" +
+	"\x12ListPayoutsRequest\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12.\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x16.donation.PayoutStatusR\x06status\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"r\n" +
+	"\x13ListPayoutsResponse\x12*\n" +
+	"\apayouts\x18\x01 \x03(\v2\x10.donation.PayoutR\apayouts\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x81\x01\n" +
+	"\x1aListRiskAssessmentsRequest\x122\n" +
+	"\bdecision\x18\x01 \x01(\x0e2\x16.donation.RiskDecisionR\bdecision\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x8a\x01\n" +
+	"\x1bListRiskAssessmentsResponse\x12:\n" +
+	"\vassessments\x18\x01 \x03(\v2\x18.donation.RiskAssessmentR\vassessments\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xaf\x03\n" +
+	"\x14MatchCampaignRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12!\n" +
+	"\fsponsor_name\x18\x02 \x01(\tR\vsponsorName\x12&\n" +
+	"\x0fsponsor_user_id\x18\x03 \x01(\rR\rsponsorUserId\x12!\n" +
+	"\fstreamer_ids\x18\x04 \x03(\rR\vstreamerIds\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12,\n" +
+	"\x12ratio_basis_points\x18\x06 \x01(\x03R\x10ratioBasisPoints\x12(\n" +
+	"\x10max_match_amount\x18\a \x01(\x03R\x0emaxMatchAmount\x12\x16\n" +
+	"\x06budget\x18\b \x01(\x03R\x06budget\x127\n" +
+	"\tstarts_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1b\n" +
+	"\tis_active\x18\v \x01(\bR\bisActive\"y\n" +
+	"\x1aUpdateMatchCampaignRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\rR\n" +
+	"campaignId\x12:\n" +
+	"\bcampaign\x18\x02 \x01(\v2\x1e.donation.MatchCampaignRequestR\bcampaign\":\n" +
+	"\x17GetMatchCampaignRequest\x12\x1f\n" +
+	"\vcampaign_id\x18\x01 \x01(\rR\n" +
+	"campaignId\"L\n" +
+	"\x19ListMatchCampaignsRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\x84\x01\n" +
+	"\x1aListMatchCampaignsResponse\x125\n" +
+	"\tcampaigns\x18\x01 \x03(\v2\x17.donation.MatchCampaignR\tcampaigns\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"N\n" +
+	"\x14ApprovePayoutRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\rR\bpayoutId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\rR\aadminId\"e\n" +
+	"\x13RejectPayoutRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\rR\bpayoutId\x12\x19\n" +
+	"\badmin_id\x18\x02 \x01(\rR\aadminId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"c\n" +
+	"\x15CompletePayoutRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\rR\bpayoutId\x12-\n" +
+	"\x12provider_reference\x18\x02 \x01(\tR\x11providerReference\"H\n" +
+	"\x11FailPayoutRequest\x12\x1b\n" +
+	"\tpayout_id\x18\x01 \x01(\rR\bpayoutId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"\xaa\t\n" +
 	"\bDonation\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x16\n" +
-	"\x06amount\x18\x02 \x01(\x01R\x06amount\x12\x1a\n" +
+	"\x06amount\x18\x16 \x01(\x03R\x06amount\x12\x1a\n" +
 	"\bcurrency\x18\x03 \x01(\tR\bcurrency\x12\x18\n" +
 	"\amessage\x18\x04 \x01(\tR\amessage\x12\x1f\n" +
 	"\vstreamer_id\x18\x05 \x01(\rR\n" +
@@ -1885,46 +9240,433 @@ const file_proto_donation_proto_rawDesc = "" +
 	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
 	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12=\n" +
-	"\fpayment_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vpaymentTime*\xbf\x01\n" +
+	"\fpayment_time\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\vpaymentTime\x12'\n" +
+	"\x0frefunded_amount\x18\x17 \x01(\x03R\x0erefundedAmount\x12>\n" +
+	"\x0emessage_status\x18\x10 \x01(\x0e2\x17.donation.MessageStatusR\rmessageStatus\x12#\n" +
+	"\rexchange_rate\x18\x11 \x01(\x01R\fexchangeRate\x12)\n" +
+	"\x10converted_amount\x18\x18 \x01(\x03R\x0fconvertedAmount\x12-\n" +
+	"\x12converted_currency\x18\x13 \x01(\tR\x11convertedCurrency\x12\x1f\n" +
+	"\vrate_source\x18\x14 \x01(\tR\n" +
+	"rateSource\x127\n" +
+	"\trate_time\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\brateTime\x12*\n" +
+	"\x11split_donation_id\x18\x19 \x01(\rR\x0fsplitDonationId\x12)\n" +
+	"\x10message_language\x18\x1a \x01(\tR\x0fmessageLanguage\x12-\n" +
+	"\x12translated_message\x18\x1b \x01(\tR\x11translatedMessage\x121\n" +
+	"\x14translation_language\x18\x1c \x01(\tR\x13translationLanguage\x12*\n" +
+	"\x11match_campaign_id\x18\x1d \x01(\rR\x0fmatchCampaignId\x12.\n" +
+	"\x13matched_donation_id\x18\x1e \x01(\rR\x11matchedDonationIdJ\x04\b\x02\x10\x03J\x04\b\x0f\x10\x10J\x04\b\x12\x10\x13\"\xdb\x02\n" +
+	"\x14DonationStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vdonation_id\x18\x02 \x01(\rR\n" +
+	"donationId\x128\n" +
+	"\vfrom_status\x18\x03 \x01(\x0e2\x17.donation.PaymentStatusR\n" +
+	"fromStatus\x124\n" +
+	"\tto_status\x18\x04 \x01(\x0e2\x17.donation.PaymentStatusR\btoStatus\x124\n" +
+	"\x06source\x18\x05 \x01(\x0e2\x1c.donation.StatusChangeSourceR\x06source\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\rR\aactorId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8c\x04\n" +
+	"\x0eDonationRefund\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vdonation_id\x18\x02 \x01(\rR\n" +
+	"donationId\x12\x16\n" +
+	"\x06amount\x18\r \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\x04 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12.\n" +
+	"\x06status\x18\x06 \x01(\x0e2\x16.donation.RefundStatusR\x06status\x125\n" +
+	"\bprovider\x18\a \x01(\x0e2\x19.donation.PaymentProviderR\bprovider\x12,\n" +
+	"\x12provider_refund_id\x18\b \x01(\tR\x10providerRefundId\x12!\n" +
+	"\frequested_by\x18\t \x01(\rR\vrequestedBy\x12%\n" +
+	"\x0efailure_reason\x18\n" +
+	" \x01(\tR\rfailureReason\x12=\n" +
+	"\fprocessed_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1e\n" +
+	"\n" +
+	"chargeback\x18\x0e \x01(\bR\n" +
+	"chargebackJ\x04\b\x03\x10\x04\"\xa0\x04\n" +
+	"\fDonationGoal\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vstreamer_id\x18\x02 \x01(\rR\n" +
+	"streamerId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12#\n" +
+	"\rtarget_amount\x18\x0e \x01(\x03R\ftargetAmount\x12%\n" +
+	"\x0ecurrent_amount\x18\x0f \x01(\x03R\rcurrentAmount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x126\n" +
+	"\bdeadline\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\bdeadline\x12\x1b\n" +
+	"\tis_active\x18\t \x01(\bR\bisActive\x12=\n" +
+	"\fcompleted_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x12)\n" +
+	"\x10progress_percent\x18\v \x01(\x01R\x0fprogressPercent\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
+	"\n" +
+	"updated_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtJ\x04\b\x05\x10\x06J\x04\b\x06\x10\a\"\xd9\x04\n" +
+	"\x0eDonationExport\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vstreamer_id\x18\x02 \x01(\rR\n" +
+	"streamerId\x12.\n" +
+	"\x06format\x18\x03 \x01(\x0e2\x16.donation.ExportFormatR\x06format\x129\n" +
+	"\n" +
+	"start_date\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tstartDate\x125\n" +
+	"\bend_date\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\aendDate\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x126\n" +
+	"\x06status\x18\a \x01(\x0e2\x1e.donation.DonationExportStatusR\x06status\x12\x1b\n" +
+	"\trow_count\x18\b \x01(\x05R\browCount\x12\x1b\n" +
+	"\tfile_size\x18\t \x01(\x03R\bfileSize\x12\x14\n" +
+	"\x05error\x18\n" +
+	" \x01(\tR\x05error\x12\x1b\n" +
+	"\tfile_name\x18\v \x01(\tR\bfileName\x129\n" +
+	"\n" +
+	"created_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12=\n" +
+	"\fcompleted_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\vcompletedAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"\xd9\x02\n" +
+	"\x12ModerationSettings\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\x12!\n" +
+	"\fbanned_words\x18\x03 \x03(\tR\vbannedWords\x12'\n" +
+	"\x0fbanned_patterns\x18\x04 \x03(\tR\x0ebannedPatterns\x12\x1f\n" +
+	"\vstrip_links\x18\x05 \x01(\bR\n" +
+	"stripLinks\x12,\n" +
+	"\x12max_message_length\x18\x06 \x01(\x05R\x10maxMessageLength\x122\n" +
+	"\x06action\x18\a \x01(\x0e2\x1a.donation.ModerationActionR\x06action\x129\n" +
+	"\n" +
+	"updated_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\xcc\x03\n" +
+	"\rMessageReview\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vdonation_id\x18\x02 \x01(\rR\n" +
+	"donationId\x12\x1f\n" +
+	"\vstreamer_id\x18\x03 \x01(\rR\n" +
+	"streamerId\x12)\n" +
+	"\x10original_message\x18\x04 \x01(\tR\x0foriginalMessage\x122\n" +
+	"\x15original_display_name\x18\x05 \x01(\tR\x13originalDisplayName\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\x12!\n" +
+	"\fdisplay_name\x18\a \x01(\tR\vdisplayName\x12\x1e\n" +
+	"\n" +
+	"violations\x18\b \x03(\tR\n" +
+	"violations\x125\n" +
+	"\x06status\x18\t \x01(\x0e2\x1d.donation.MessageReviewStatusR\x06status\x12;\n" +
+	"\vreviewed_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x129\n" +
+	"\n" +
+	"created_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x8b\x01\n" +
+	"\vBlockedTerm\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04term\x18\x02 \x01(\tR\x04term\x12\x1d\n" +
+	"\n" +
+	"is_pattern\x18\x03 \x01(\bR\tisPattern\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\x9e\x01\n" +
+	"\x0fStreamerBalance\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x1a\n" +
+	"\bcurrency\x18\x02 \x01(\tR\bcurrency\x12\x18\n" +
+	"\abalance\x18\x03 \x01(\x03R\abalance\x12\x1a\n" +
+	"\bcredited\x18\x04 \x01(\x03R\bcredited\x12\x18\n" +
+	"\adebited\x18\x05 \x01(\x03R\adebited\"\x8b\x03\n" +
+	"\vLedgerEntry\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12-\n" +
+	"\x04type\x18\x03 \x01(\x0e2\x19.donation.LedgerEntryTypeR\x04type\x12\x1f\n" +
+	"\vstreamer_id\x18\x04 \x01(\rR\n" +
+	"streamerId\x12\x1f\n" +
+	"\vdonation_id\x18\x05 \x01(\rR\n" +
+	"donationId\x12\x1b\n" +
+	"\trefund_id\x18\x06 \x01(\rR\brefundId\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12 \n" +
+	"\vdescription\x18\b \x01(\tR\vdescription\x12*\n" +
+	"\x05lines\x18\t \x03(\v2\x14.donation.LedgerLineR\x05lines\x129\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1b\n" +
+	"\tpayout_id\x18\v \x01(\rR\bpayoutId\"\xdb\x01\n" +
+	"\n" +
+	"LedgerLine\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x121\n" +
+	"\aaccount\x18\x02 \x01(\x0e2\x17.donation.LedgerAccountR\aaccount\x12\x1f\n" +
+	"\vstreamer_id\x18\x03 \x01(\rR\n" +
+	"streamerId\x125\n" +
+	"\bprovider\x18\x04 \x01(\x0e2\x19.donation.PaymentProviderR\bprovider\x12\x1a\n" +
+	"\bcurrency\x18\x05 \x01(\tR\bcurrency\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\"\xf7\x01\n" +
+	"\rPayoutAccount\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vstreamer_id\x18\x02 \x01(\rR\n" +
+	"streamerId\x12\x16\n" +
+	"\x06method\x18\x03 \x01(\tR\x06method\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12%\n" +
+	"\x0eaccount_number\x18\x05 \x01(\tR\raccountNumber\x12!\n" +
+	"\faccount_name\x18\x06 \x01(\tR\vaccountName\x129\n" +
+	"\n" +
+	"created_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xdc\x04\n" +
+	"\x06Payout\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vstreamer_id\x18\x02 \x01(\rR\n" +
+	"streamerId\x12\x1d\n" +
+	"\n" +
+	"account_id\x18\x03 \x01(\rR\taccountId\x12\x16\n" +
+	"\x06method\x18\x04 \x01(\tR\x06method\x12\x18\n" +
+	"\achannel\x18\x05 \x01(\tR\achannel\x12%\n" +
+	"\x0eaccount_number\x18\x06 \x01(\tR\raccountNumber\x12!\n" +
+	"\faccount_name\x18\a \x01(\tR\vaccountName\x12\x16\n" +
+	"\x06amount\x18\b \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\t \x01(\tR\bcurrency\x12.\n" +
+	"\x06status\x18\n" +
+	" \x01(\x0e2\x16.donation.PayoutStatusR\x06status\x12\x1f\n" +
+	"\vreviewed_by\x18\v \x01(\rR\n" +
+	"reviewedBy\x12;\n" +
+	"\vreviewed_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"reviewedAt\x12-\n" +
+	"\x12provider_reference\x18\r \x01(\tR\x11providerReference\x12%\n" +
+	"\x0efailure_reason\x18\x0e \x01(\tR\rfailureReason\x123\n" +
+	"\apaid_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\x06paidAt\x129\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xb6\x04\n" +
+	"\x0eRiskAssessment\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
+	"\vstreamer_id\x18\x02 \x01(\rR\n" +
+	"streamerId\x12\x1d\n" +
+	"\n" +
+	"donator_id\x18\x03 \x01(\rR\tdonatorId\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x04 \x01(\tR\tipAddress\x12\x18\n" +
+	"\acountry\x18\x05 \x01(\tR\acountry\x12\x16\n" +
+	"\x06amount\x18\x06 \x01(\x03R\x06amount\x12\x1a\n" +
+	"\bcurrency\x18\a \x01(\tR\bcurrency\x12\x1f\n" +
+	"\vtiny_amount\x18\b \x01(\bR\n" +
+	"tinyAmount\x12D\n" +
+	"\x10payment_provider\x18\t \x01(\x0e2\x19.donation.PaymentProviderR\x0fpaymentProvider\x12*\n" +
+	"\x11split_donation_id\x18\n" +
+	" \x01(\rR\x0fsplitDonationId\x12\x14\n" +
+	"\x05score\x18\v \x01(\x05R\x05score\x122\n" +
+	"\bdecision\x18\f \x01(\x0e2\x16.donation.RiskDecisionR\bdecision\x12.\n" +
+	"\areasons\x18\r \x03(\v2\x14.donation.RiskReasonR\areasons\x12\x1f\n" +
+	"\vdonation_id\x18\x0e \x01(\rR\n" +
+	"donationId\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"P\n" +
+	"\n" +
+	"RiskReason\x12\x12\n" +
+	"\x04rule\x18\x01 \x01(\tR\x04rule\x12\x16\n" +
+	"\x06points\x18\x02 \x01(\x05R\x06points\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\"\xaa\x04\n" +
+	"\rMatchCampaign\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\rR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12!\n" +
+	"\fsponsor_name\x18\x03 \x01(\tR\vsponsorName\x12&\n" +
+	"\x0fsponsor_user_id\x18\x04 \x01(\rR\rsponsorUserId\x12!\n" +
+	"\fstreamer_ids\x18\x05 \x03(\rR\vstreamerIds\x12\x1a\n" +
+	"\bcurrency\x18\x06 \x01(\tR\bcurrency\x12,\n" +
+	"\x12ratio_basis_points\x18\a \x01(\x03R\x10ratioBasisPoints\x12(\n" +
+	"\x10max_match_amount\x18\b \x01(\x03R\x0emaxMatchAmount\x12\x16\n" +
+	"\x06budget\x18\t \x01(\x03R\x06budget\x12\x14\n" +
+	"\x05spent\x18\n" +
+	" \x01(\x03R\x05spent\x12\x1f\n" +
+	"\vmatch_count\x18\v \x01(\x03R\n" +
+	"matchCount\x127\n" +
+	"\tstarts_at\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x1b\n" +
+	"\tis_active\x18\x0e \x01(\bR\bisActive\x129\n" +
+	"\n" +
+	"created_at\x18\x0f \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\"\xf4\x01\n" +
+	"\x13MatchCampaignReport\x123\n" +
+	"\bcampaign\x18\x01 \x01(\v2\x17.donation.MatchCampaignR\bcampaign\x12\x1c\n" +
+	"\tremaining\x18\x02 \x01(\x03R\tremaining\x12'\n" +
+	"\x0fdonation_amount\x18\x03 \x01(\x03R\x0edonationAmount\x12%\n" +
+	"\x0ematched_amount\x18\x04 \x01(\x03R\rmatchedAmount\x12:\n" +
+	"\tstreamers\x18\x05 \x03(\v2\x1c.donation.MatchStreamerTotalR\tstreamers\"\xa6\x01\n" +
+	"\x12MatchStreamerTotal\x12\x1f\n" +
+	"\vstreamer_id\x18\x01 \x01(\rR\n" +
+	"streamerId\x12\x1f\n" +
+	"\vmatch_count\x18\x02 \x01(\x03R\n" +
+	"matchCount\x12'\n" +
+	"\x0fdonation_amount\x18\x03 \x01(\x03R\x0edonationAmount\x12%\n" +
+	"\x0ematched_amount\x18\x04 \x01(\x03R\rmatchedAmount*\xbf\x01\n" +
 	"\rPaymentStatus\x12\x1e\n" +
 	"\x1aPAYMENT_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16PAYMENT_STATUS_PENDING\x10\x01\x12\x1c\n" +
 	"\x18PAYMENT_STATUS_COMPLETED\x10\x02\x12\x19\n" +
 	"\x15PAYMENT_STATUS_FAILED\x10\x03\x12\x1c\n" +
 	"\x18PAYMENT_STATUS_CANCELLED\x10\x04\x12\x1b\n" +
-	"\x17PAYMENT_STATUS_REFUNDED\x10\x05*\xc4\x01\n" +
+	"\x17PAYMENT_STATUS_REFUNDED\x10\x05*\xe2\x01\n" +
 	"\x0fPaymentProvider\x12 \n" +
 	"\x1cPAYMENT_PROVIDER_UNSPECIFIED\x10\x00\x12\x1d\n" +
 	"\x19PAYMENT_PROVIDER_MIDTRANS\x10\x01\x12\x1b\n" +
 	"\x17PAYMENT_PROVIDER_PAYPAL\x10\x02\x12\x1b\n" +
 	"\x17PAYMENT_PROVIDER_STRIPE\x10\x03\x12\x19\n" +
 	"\x15PAYMENT_PROVIDER_QRIS\x10\x04\x12\x1b\n" +
-	"\x17PAYMENT_PROVIDER_CRYPTO\x10\x05*\xac\x01\n" +
+	"\x17PAYMENT_PROVIDER_CRYPTO\x10\x05\x12\x1c\n" +
+	"\x18PAYMENT_PROVIDER_SPONSOR\x10\x06*\xbc\x01\n" +
+	"\x12StatusChangeSource\x12$\n" +
+	" STATUS_CHANGE_SOURCE_UNSPECIFIED\x10\x00\x12 \n" +
+	"\x1cSTATUS_CHANGE_SOURCE_WEBHOOK\x10\x01\x12\x1e\n" +
+	"\x1aSTATUS_CHANGE_SOURCE_ADMIN\x10\x02\x12\x1d\n" +
+	"\x19STATUS_CHANGE_SOURCE_USER\x10\x03\x12\x1f\n" +
+	"\x1bSTATUS_CHANGE_SOURCE_SYSTEM\x10\x04*\x7f\n" +
+	"\fRefundStatus\x12\x1d\n" +
+	"\x19REFUND_STATUS_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15REFUND_STATUS_PENDING\x10\x01\x12\x1b\n" +
+	"\x17REFUND_STATUS_SUCCEEDED\x10\x02\x12\x18\n" +
+	"\x14REFUND_STATUS_FAILED\x10\x03*\xcd\x01\n" +
 	"\tEventType\x12\x1a\n" +
 	"\x16EVENT_TYPE_UNSPECIFIED\x10\x00\x12\x1f\n" +
 	"\x1bEVENT_TYPE_DONATION_CREATED\x10\x01\x12!\n" +
 	"\x1dEVENT_TYPE_DONATION_COMPLETED\x10\x02\x12\x1e\n" +
 	"\x1aEVENT_TYPE_DONATION_FAILED\x10\x03\x12\x1f\n" +
-	"\x1bEVENT_TYPE_PAYMENT_VERIFIED\x10\x04*\xad\x01\n" +
+	"\x1bEVENT_TYPE_PAYMENT_VERIFIED\x10\x04\x12\x1f\n" +
+	"\x1bEVENT_TYPE_DONATION_UPDATED\x10\x05*z\n" +
+	"\rStatsInterval\x12\x1e\n" +
+	"\x1aSTATS_INTERVAL_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12STATS_INTERVAL_DAY\x10\x01\x12\x17\n" +
+	"\x13STATS_INTERVAL_WEEK\x10\x02\x12\x18\n" +
+	"\x14STATS_INTERVAL_MONTH\x10\x03*v\n" +
+	"\x11DonationSortField\x12#\n" +
+	"\x1fDONATION_SORT_FIELD_UNSPECIFIED\x10\x00\x12\x1c\n" +
+	"\x18DONATION_SORT_FIELD_TIME\x10\x01\x12\x1e\n" +
+	"\x1aDONATION_SORT_FIELD_AMOUNT\x10\x02*P\n" +
+	"\tSortOrder\x12\x1a\n" +
+	"\x16SORT_ORDER_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fSORT_ORDER_DESC\x10\x01\x12\x12\n" +
+	"\x0eSORT_ORDER_ASC\x10\x02*d\n" +
+	"\x0fAnonymityFilter\x12\x18\n" +
+	"\x14ANONYMITY_FILTER_ANY\x10\x00\x12\x19\n" +
+	"\x15ANONYMITY_FILTER_ONLY\x10\x01\x12\x1c\n" +
+	"\x18ANONYMITY_FILTER_EXCLUDE\x10\x02*\xb7\x01\n" +
+	"\x11LeaderboardPeriod\x12\"\n" +
+	"\x1eLEADERBOARD_PERIOD_UNSPECIFIED\x10\x00\x12\x1f\n" +
+	"\x1bLEADERBOARD_PERIOD_ALL_TIME\x10\x01\x12\x1e\n" +
+	"\x1aLEADERBOARD_PERIOD_MONTHLY\x10\x02\x12\x1d\n" +
+	"\x19LEADERBOARD_PERIOD_WEEKLY\x10\x03\x12\x1e\n" +
+	"\x1aLEADERBOARD_PERIOD_SESSION\x10\x04*\x81\x01\n" +
+	"\rMessageStatus\x12\x1e\n" +
+	"\x1aMESSAGE_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16MESSAGE_STATUS_VISIBLE\x10\x01\x12\x17\n" +
+	"\x13MESSAGE_STATUS_HELD\x10\x02\x12\x1b\n" +
+	"\x17MESSAGE_STATUS_REJECTED\x10\x03*\x8b\x01\n" +
+	"\x10ModerationAction\x12!\n" +
+	"\x1dMODERATION_ACTION_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16MODERATION_ACTION_MASK\x10\x01\x12\x1a\n" +
+	"\x16MODERATION_ACTION_HOLD\x10\x02\x12\x1c\n" +
+	"\x18MODERATION_ACTION_REJECT\x10\x03*\xa7\x01\n" +
+	"\x13MessageReviewStatus\x12%\n" +
+	"!MESSAGE_REVIEW_STATUS_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dMESSAGE_REVIEW_STATUS_PENDING\x10\x01\x12\"\n" +
+	"\x1eMESSAGE_REVIEW_STATUS_APPROVED\x10\x02\x12\"\n" +
+	"\x1eMESSAGE_REVIEW_STATUS_REJECTED\x10\x03*\\\n" +
+	"\fExportFormat\x12\x1d\n" +
+	"\x19EXPORT_FORMAT_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11EXPORT_FORMAT_CSV\x10\x01\x12\x16\n" +
+	"\x12EXPORT_FORMAT_XLSX\x10\x02*\xcf\x01\n" +
+	"\x14DonationExportStatus\x12&\n" +
+	"\"DONATION_EXPORT_STATUS_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1eDONATION_EXPORT_STATUS_PENDING\x10\x01\x12\"\n" +
+	"\x1eDONATION_EXPORT_STATUS_RUNNING\x10\x02\x12$\n" +
+	" DONATION_EXPORT_STATUS_COMPLETED\x10\x03\x12!\n" +
+	"\x1dDONATION_EXPORT_STATUS_FAILED\x10\x04*\xad\x01\n" +
 	"\x10NotificationType\x12!\n" +
 	"\x1dNOTIFICATION_TYPE_UNSPECIFIED\x10\x00\x12'\n" +
 	"#NOTIFICATION_TYPE_DONATION_RECEIVED\x10\x01\x12'\n" +
 	"#NOTIFICATION_TYPE_PAYMENT_COMPLETED\x10\x02\x12$\n" +
-	" NOTIFICATION_TYPE_PAYMENT_FAILED\x10\x032\xb5\x04\n" +
+	" NOTIFICATION_TYPE_PAYMENT_FAILED\x10\x03*\xd8\x01\n" +
+	"\x0fLedgerEntryType\x12!\n" +
+	"\x1dLEDGER_ENTRY_TYPE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19LEDGER_ENTRY_TYPE_PAYMENT\x10\x01\x12\x1c\n" +
+	"\x18LEDGER_ENTRY_TYPE_REFUND\x10\x02\x12 \n" +
+	"\x1cLEDGER_ENTRY_TYPE_CHARGEBACK\x10\x03\x12\x1c\n" +
+	"\x18LEDGER_ENTRY_TYPE_PAYOUT\x10\x04\x12%\n" +
+	"!LEDGER_ENTRY_TYPE_PAYOUT_REVERSAL\x10\x05*\xd8\x01\n" +
+	"\rLedgerAccount\x12\x1e\n" +
+	"\x1aLEDGER_ACCOUNT_UNSPECIFIED\x10\x00\x12$\n" +
+	" LEDGER_ACCOUNT_PROVIDER_CLEARING\x10\x01\x12\x1f\n" +
+	"\x1bLEDGER_ACCOUNT_PROVIDER_FEE\x10\x02\x12\x1f\n" +
+	"\x1bLEDGER_ACCOUNT_PLATFORM_FEE\x10\x03\x12#\n" +
+	"\x1fLEDGER_ACCOUNT_STREAMER_BALANCE\x10\x04\x12\x1a\n" +
+	"\x16LEDGER_ACCOUNT_PAYOUTS\x10\x05*\xb6\x01\n" +
+	"\fPayoutStatus\x12\x1d\n" +
+	"\x19PAYOUT_STATUS_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17PAYOUT_STATUS_REQUESTED\x10\x01\x12\x1c\n" +
+	"\x18PAYOUT_STATUS_PROCESSING\x10\x02\x12\x16\n" +
+	"\x12PAYOUT_STATUS_PAID\x10\x03\x12\x18\n" +
+	"\x14PAYOUT_STATUS_FAILED\x10\x04\x12\x1a\n" +
+	"\x16PAYOUT_STATUS_REJECTED\x10\x05*|\n" +
+	"\fRiskDecision\x12\x1d\n" +
+	"\x19RISK_DECISION_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13RISK_DECISION_ALLOW\x10\x01\x12\x1b\n" +
+	"\x17RISK_DECISION_CHALLENGE\x10\x02\x12\x17\n" +
+	"\x13RISK_DECISION_BLOCK\x10\x032\xbb\x10\n" +
 	"\x0fDonationService\x12S\n" +
-	"\x0eCreateDonation\x12\x1f.donation.CreateDonationRequest\x1a .donation.CreateDonationResponse\x12J\n" +
-	"\vGetDonation\x12\x1c.donation.GetDonationRequest\x1a\x1d.donation.GetDonationResponse\x12e\n" +
-	"\x16GetDonationsByStreamer\x12'.donation.GetDonationsByStreamerRequest\x1a\".donation.GetDonationsListResponse\x12e\n" +
-	"\x14UpdateDonationStatus\x12%.donation.UpdateDonationStatusRequest\x1a&.donation.UpdateDonationStatusResponse\x12X\n" +
+	"\x0eCreateDonation\x12\x1f.donation.CreateDonationRequest\x1a .donation.CreateDonationResponse\x12\\\n" +
+	"\x11CreateSplitShares\x12\".donation.CreateSplitSharesRequest\x1a#.donation.CreateSplitSharesResponse\x12J\n" +
+	"\vGetDonation\x12\x1c.donation.GetDonationRequest\x1a\x1d.donation.GetDonationResponse\x12h\n" +
+	"\x1aGetDonationByTransactionID\x12+.donation.GetDonationByTransactionIDRequest\x1a\x1d.donation.GetDonationResponse\x12e\n" +
+	"\x16GetDonationsByStreamer\x12'.donation.GetDonationsByStreamerRequest\x1a\".donation.GetDonationsListResponse\x12c\n" +
+	"\x15GetDonationsByDonator\x12&.donation.GetDonationsByDonatorRequest\x1a\".donation.GetDonationsListResponse\x12Q\n" +
+	"\fGetDonations\x12\x1d.donation.GetDonationsRequest\x1a\".donation.GetDonationsListResponse\x12]\n" +
+	"\x12GetLatestDonations\x12#.donation.GetLatestDonationsRequest\x1a\".donation.GetDonationsListResponse\x12P\n" +
+	"\rListDonations\x12\x1e.donation.ListDonationsRequest\x1a\x1f.donation.ListDonationsResponse\x12e\n" +
+	"\x14UpdateDonationStatus\x12%.donation.UpdateDonationStatusRequest\x1a&.donation.UpdateDonationStatusResponse\x12q\n" +
+	"\x18GetDonationStatusHistory\x12).donation.GetDonationStatusHistoryRequest\x1a*.donation.GetDonationStatusHistoryResponse\x12k\n" +
+	"\x16ProcessDonationPayment\x12'.donation.ProcessDonationPaymentRequest\x1a(.donation.ProcessDonationPaymentResponse\x12q\n" +
+	"\x18GetStreamerDonationTotal\x12).donation.GetStreamerDonationTotalRequest\x1a*.donation.GetStreamerDonationTotalResponse\x12X\n" +
 	"\x14StreamDonationEvents\x12%.donation.StreamDonationEventsRequest\x1a\x17.donation.DonationEvent0\x01\x12Y\n" +
-	"\x10GetDonationStats\x12!.donation.GetDonationStatsRequest\x1a\".donation.GetDonationStatsResponse2\x89\x02\n" +
+	"\x10GetDonationStats\x12!.donation.GetDonationStatsRequest\x1a\".donation.GetDonationStatsResponse\x12S\n" +
+	"\x0eRefundDonation\x12\x1f.donation.RefundDonationRequest\x1a .donation.RefundDonationResponse\x12b\n" +
+	"\x13ListDonationRefunds\x12$.donation.ListDonationRefundsRequest\x1a%.donation.ListDonationRefundsResponse\x12k\n" +
+	"\x16GetDonationLeaderboard\x12'.donation.GetDonationLeaderboardRequest\x1a(.donation.GetDonationLeaderboardResponse\x12L\n" +
+	"\x0fExportDonations\x12 .donation.ExportDonationsRequest\x1a\x15.donation.ExportChunk0\x01\x12Z\n" +
+	"\x14CreateDonationExport\x12 .donation.ExportDonationsRequest\x1a .donation.DonationExportResponse\x12Y\n" +
+	"\x11GetDonationExport\x12\".donation.GetDonationExportRequest\x1a .donation.DonationExportResponse\x12U\n" +
+	"\x16DownloadDonationExport\x12\".donation.GetDonationExportRequest\x1a\x15.donation.ExportChunk0\x012\x89\x02\n" +
 	"\x0ePaymentService\x12S\n" +
 	"\x0eProcessPayment\x12\x1f.donation.ProcessPaymentRequest\x1a .donation.ProcessPaymentResponse\x12P\n" +
 	"\rVerifyPayment\x12\x1e.donation.VerifyPaymentRequest\x1a\x1f.donation.VerifyPaymentResponse\x12P\n" +
 	"\rHandleWebhook\x12\x1e.donation.HandleWebhookRequest\x1a\x1f.donation.HandleWebhookResponse2\xd0\x01\n" +
 	"\x13NotificationService\x12a\n" +
 	"\x18SendDonationNotification\x12!.donation.SendNotificationRequest\x1a\".donation.SendNotificationResponse\x12V\n" +
-	"\x17SubscribeDonationEvents\x12 .donation.SubscribeEventsRequest\x1a\x17.donation.DonationEvent0\x01B\"Z github.com/rzfd/mediashar/pkg/pbb\x06proto3"
+	"\x17SubscribeDonationEvents\x12 .donation.SubscribeEventsRequest\x1a\x17.donation.DonationEvent0\x012\xdf\x03\n" +
+	"\x13DonationGoalService\x12Y\n" +
+	"\x12CreateDonationGoal\x12#.donation.CreateDonationGoalRequest\x1a\x1e.donation.DonationGoalResponse\x12Y\n" +
+	"\x12UpdateDonationGoal\x12#.donation.UpdateDonationGoalRequest\x1a\x1e.donation.DonationGoalResponse\x12_\n" +
+	"\x12DeleteDonationGoal\x12#.donation.DeleteDonationGoalRequest\x1a$.donation.DeleteDonationGoalResponse\x12S\n" +
+	"\x0fGetDonationGoal\x12 .donation.GetDonationGoalRequest\x1a\x1e.donation.DonationGoalResponse\x12\\\n" +
+	"\x11ListDonationGoals\x12\".donation.ListDonationGoalsRequest\x1a#.donation.ListDonationGoalsResponse2\x86\x06\n" +
+	"\x11ModerationService\x12e\n" +
+	"\x15GetModerationSettings\x12&.donation.GetModerationSettingsRequest\x1a$.donation.ModerationSettingsResponse\x12k\n" +
+	"\x18UpdateModerationSettings\x12).donation.UpdateModerationSettingsRequest\x1a$.donation.ModerationSettingsResponse\x12_\n" +
+	"\x12ListMessageReviews\x12#.donation.ListMessageReviewsRequest\x1a$.donation.ListMessageReviewsResponse\x12X\n" +
+	"\x0eApproveMessage\x12%.donation.ResolveMessageReviewRequest\x1a\x1f.donation.MessageReviewResponse\x12W\n" +
+	"\rRejectMessage\x12%.donation.ResolveMessageReviewRequest\x1a\x1f.donation.MessageReviewResponse\x12Y\n" +
+	"\x10ListBlockedTerms\x12!.donation.ListBlockedTermsRequest\x1a\".donation.ListBlockedTermsResponse\x12P\n" +
+	"\x0eAddBlockedTerm\x12\x1f.donation.AddBlockedTermRequest\x1a\x1d.donation.BlockedTermResponse\x12\\\n" +
+	"\x11RemoveBlockedTerm\x12\".donation.RemoveBlockedTermRequest\x1a#.donation.RemoveBlockedTermResponse2\xce\x01\n" +
+	"\rLedgerService\x12_\n" +
+	"\x12GetStreamerBalance\x12#.donation.GetStreamerBalanceRequest\x1a$.donation.GetStreamerBalanceResponse\x12\\\n" +
+	"\x11ListLedgerEntries\x12\".donation.ListLedgerEntriesRequest\x1a#.donation.ListLedgerEntriesResponse2\xfa\x05\n" +
+	"\rPayoutService\x12T\n" +
+	"\x13CreatePayoutAccount\x12$.donation.CreatePayoutAccountRequest\x1a\x17.donation.PayoutAccount\x12_\n" +
+	"\x12ListPayoutAccounts\x12#.donation.ListPayoutAccountsRequest\x1a$.donation.ListPayoutAccountsResponse\x12b\n" +
+	"\x13DeletePayoutAccount\x12$.donation.DeletePayoutAccountRequest\x1a%.donation.DeletePayoutAccountResponse\x12A\n" +
+	"\rRequestPayout\x12\x1e.donation.RequestPayoutRequest\x1a\x10.donation.Payout\x129\n" +
+	"\tGetPayout\x12\x1a.donation.GetPayoutRequest\x1a\x10.donation.Payout\x12J\n" +
+	"\vListPayouts\x12\x1c.donation.ListPayoutsRequest\x1a\x1d.donation.ListPayoutsResponse\x12A\n" +
+	"\rApprovePayout\x12\x1e.donation.ApprovePayoutRequest\x1a\x10.donation.Payout\x12?\n" +
+	"\fRejectPayout\x12\x1d.donation.RejectPayoutRequest\x1a\x10.donation.Payout\x12C\n" +
+	"\x0eCompletePayout\x12\x1f.donation.CompletePayoutRequest\x1a\x10.donation.Payout\x12;\n" +
+	"\n" +
+	"FailPayout\x12\x1b.donation.FailPayoutRequest\x1a\x10.donation.Payout2q\n" +
+	"\vRiskService\x12b\n" +
+	"\x13ListRiskAssessments\x12$.donation.ListRiskAssessmentsRequest\x1a%.donation.ListRiskAssessmentsResponse2\xc9\x03\n" +
+	"\x14MatchCampaignService\x12N\n" +
+	"\x13CreateMatchCampaign\x12\x1e.donation.MatchCampaignRequest\x1a\x17.donation.MatchCampaign\x12T\n" +
+	"\x13UpdateMatchCampaign\x12$.donation.UpdateMatchCampaignRequest\x1a\x17.donation.MatchCampaign\x12N\n" +
+	"\x10GetMatchCampaign\x12!.donation.GetMatchCampaignRequest\x1a\x17.donation.MatchCampaign\x12_\n" +
+	"\x12ListMatchCampaigns\x12#.donation.ListMatchCampaignsRequest\x1a$.donation.ListMatchCampaignsResponse\x12Z\n" +
+	"\x16GetMatchCampaignReport\x12!.donation.GetMatchCampaignRequest\x1a\x1d.donation.MatchCampaignReportB\"Z github.com/rzfd/mediashar/pkg/pbb\x06proto3"
 
 var (
 	file_proto_donation_proto_rawDescOnce sync.Once
@@ -1938,96 +9680,399 @@ func file_proto_donation_proto_rawDescGZIP() []byte {
 	return file_proto_donation_proto_rawDescData
 }
 
-var file_proto_donation_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_proto_donation_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_proto_donation_proto_enumTypes = make([]protoimpl.EnumInfo, 20)
+var file_proto_donation_proto_msgTypes = make([]protoimpl.MessageInfo, 116)
 var file_proto_donation_proto_goTypes = []any{
-	(PaymentStatus)(0),                    // 0: donation.PaymentStatus
-	(PaymentProvider)(0),                  // 1: donation.PaymentProvider
-	(EventType)(0),                        // 2: donation.EventType
-	(NotificationType)(0),                 // 3: donation.NotificationType
-	(*CreateDonationRequest)(nil),         // 4: donation.CreateDonationRequest
-	(*CreateDonationResponse)(nil),        // 5: donation.CreateDonationResponse
-	(*GetDonationRequest)(nil),            // 6: donation.GetDonationRequest
-	(*GetDonationResponse)(nil),           // 7: donation.GetDonationResponse
-	(*GetDonationsByStreamerRequest)(nil), // 8: donation.GetDonationsByStreamerRequest
-	(*GetDonationsListResponse)(nil),      // 9: donation.GetDonationsListResponse
-	(*UpdateDonationStatusRequest)(nil),   // 10: donation.UpdateDonationStatusRequest
-	(*UpdateDonationStatusResponse)(nil),  // 11: donation.UpdateDonationStatusResponse
-	(*ProcessPaymentRequest)(nil),         // 12: donation.ProcessPaymentRequest
-	(*ProcessPaymentResponse)(nil),        // 13: donation.ProcessPaymentResponse
-	(*VerifyPaymentRequest)(nil),          // 14: donation.VerifyPaymentRequest
-	(*VerifyPaymentResponse)(nil),         // 15: donation.VerifyPaymentResponse
-	(*HandleWebhookRequest)(nil),          // 16: donation.HandleWebhookRequest
-	(*HandleWebhookResponse)(nil),         // 17: donation.HandleWebhookResponse
-	(*StreamDonationEventsRequest)(nil),   // 18: donation.StreamDonationEventsRequest
-	(*DonationEvent)(nil),                 // 19: donation.DonationEvent
-	(*SendNotificationRequest)(nil),       // 20: donation.SendNotificationRequest
-	(*SendNotificationResponse)(nil),      // 21: donation.SendNotificationResponse
-	(*SubscribeEventsRequest)(nil),        // 22: donation.SubscribeEventsRequest
-	(*GetDonationStatsRequest)(nil),       // 23: donation.GetDonationStatsRequest
-	(*GetDonationStatsResponse)(nil),      // 24: donation.GetDonationStatsResponse
-	(*DonationStat)(nil),                  // 25: donation.DonationStat
-	(*Donation)(nil),                      // 26: donation.Donation
-	nil,                                   // 27: donation.ProcessPaymentRequest.PaymentDataEntry
-	nil,                                   // 28: donation.HandleWebhookRequest.HeadersEntry
-	nil,                                   // 29: donation.DonationEvent.MetadataEntry
-	nil,                                   // 30: donation.SendNotificationRequest.DataEntry
-	(*timestamp.Timestamp)(nil),           // 31: google.protobuf.Timestamp
+	(PaymentStatus)(0),                        // 0: donation.PaymentStatus
+	(PaymentProvider)(0),                      // 1: donation.PaymentProvider
+	(StatusChangeSource)(0),                   // 2: donation.StatusChangeSource
+	(RefundStatus)(0),                         // 3: donation.RefundStatus
+	(EventType)(0),                            // 4: donation.EventType
+	(StatsInterval)(0),                        // 5: donation.StatsInterval
+	(DonationSortField)(0),                    // 6: donation.DonationSortField
+	(SortOrder)(0),                            // 7: donation.SortOrder
+	(AnonymityFilter)(0),                      // 8: donation.AnonymityFilter
+	(LeaderboardPeriod)(0),                    // 9: donation.LeaderboardPeriod
+	(MessageStatus)(0),                        // 10: donation.MessageStatus
+	(ModerationAction)(0),                     // 11: donation.ModerationAction
+	(MessageReviewStatus)(0),                  // 12: donation.MessageReviewStatus
+	(ExportFormat)(0),                         // 13: donation.ExportFormat
+	(DonationExportStatus)(0),                 // 14: donation.DonationExportStatus
+	(NotificationType)(0),                     // 15: donation.NotificationType
+	(LedgerEntryType)(0),                      // 16: donation.LedgerEntryType
+	(LedgerAccount)(0),                        // 17: donation.LedgerAccount
+	(PayoutStatus)(0),                         // 18: donation.PayoutStatus
+	(RiskDecision)(0),                         // 19: donation.RiskDecision
+	(*CreateDonationRequest)(nil),             // 20: donation.CreateDonationRequest
+	(*CreateDonationResponse)(nil),            // 21: donation.CreateDonationResponse
+	(*CreateSplitSharesRequest)(nil),          // 22: donation.CreateSplitSharesRequest
+	(*CreateSplitSharesResponse)(nil),         // 23: donation.CreateSplitSharesResponse
+	(*GetDonationRequest)(nil),                // 24: donation.GetDonationRequest
+	(*GetDonationResponse)(nil),               // 25: donation.GetDonationResponse
+	(*GetDonationByTransactionIDRequest)(nil), // 26: donation.GetDonationByTransactionIDRequest
+	(*GetDonationsByStreamerRequest)(nil),     // 27: donation.GetDonationsByStreamerRequest
+	(*GetDonationsByDonatorRequest)(nil),      // 28: donation.GetDonationsByDonatorRequest
+	(*GetDonationsRequest)(nil),               // 29: donation.GetDonationsRequest
+	(*GetLatestDonationsRequest)(nil),         // 30: donation.GetLatestDonationsRequest
+	(*GetDonationsListResponse)(nil),          // 31: donation.GetDonationsListResponse
+	(*DonationFilter)(nil),                    // 32: donation.DonationFilter
+	(*ListDonationsRequest)(nil),              // 33: donation.ListDonationsRequest
+	(*ListDonationsResponse)(nil),             // 34: donation.ListDonationsResponse
+	(*UpdateDonationStatusRequest)(nil),       // 35: donation.UpdateDonationStatusRequest
+	(*UpdateDonationStatusResponse)(nil),      // 36: donation.UpdateDonationStatusResponse
+	(*GetDonationStatusHistoryRequest)(nil),   // 37: donation.GetDonationStatusHistoryRequest
+	(*GetDonationStatusHistoryResponse)(nil),  // 38: donation.GetDonationStatusHistoryResponse
+	(*ProcessDonationPaymentRequest)(nil),     // 39: donation.ProcessDonationPaymentRequest
+	(*ProcessDonationPaymentResponse)(nil),    // 40: donation.ProcessDonationPaymentResponse
+	(*GetStreamerDonationTotalRequest)(nil),   // 41: donation.GetStreamerDonationTotalRequest
+	(*GetStreamerDonationTotalResponse)(nil),  // 42: donation.GetStreamerDonationTotalResponse
+	(*ProcessPaymentRequest)(nil),             // 43: donation.ProcessPaymentRequest
+	(*ProcessPaymentResponse)(nil),            // 44: donation.ProcessPaymentResponse
+	(*VerifyPaymentRequest)(nil),              // 45: donation.VerifyPaymentRequest
+	(*VerifyPaymentResponse)(nil),             // 46: donation.VerifyPaymentResponse
+	(*HandleWebhookRequest)(nil),              // 47: donation.HandleWebhookRequest
+	(*HandleWebhookResponse)(nil),             // 48: donation.HandleWebhookResponse
+	(*StreamDonationEventsRequest)(nil),       // 49: donation.StreamDonationEventsRequest
+	(*DonationEvent)(nil),                     // 50: donation.DonationEvent
+	(*SendNotificationRequest)(nil),           // 51: donation.SendNotificationRequest
+	(*SendNotificationResponse)(nil),          // 52: donation.SendNotificationResponse
+	(*SubscribeEventsRequest)(nil),            // 53: donation.SubscribeEventsRequest
+	(*GetDonationStatsRequest)(nil),           // 54: donation.GetDonationStatsRequest
+	(*GetDonationStatsResponse)(nil),          // 55: donation.GetDonationStatsResponse
+	(*DonationStat)(nil),                      // 56: donation.DonationStat
+	(*CurrencyStat)(nil),                      // 57: donation.CurrencyStat
+	(*RefundDonationRequest)(nil),             // 58: donation.RefundDonationRequest
+	(*RefundDonationResponse)(nil),            // 59: donation.RefundDonationResponse
+	(*ListDonationRefundsRequest)(nil),        // 60: donation.ListDonationRefundsRequest
+	(*ListDonationRefundsResponse)(nil),       // 61: donation.ListDonationRefundsResponse
+	(*GetDonationLeaderboardRequest)(nil),     // 62: donation.GetDonationLeaderboardRequest
+	(*GetDonationLeaderboardResponse)(nil),    // 63: donation.GetDonationLeaderboardResponse
+	(*LeaderboardEntry)(nil),                  // 64: donation.LeaderboardEntry
+	(*CreateDonationGoalRequest)(nil),         // 65: donation.CreateDonationGoalRequest
+	(*UpdateDonationGoalRequest)(nil),         // 66: donation.UpdateDonationGoalRequest
+	(*DonationGoalResponse)(nil),              // 67: donation.DonationGoalResponse
+	(*DeleteDonationGoalRequest)(nil),         // 68: donation.DeleteDonationGoalRequest
+	(*DeleteDonationGoalResponse)(nil),        // 69: donation.DeleteDonationGoalResponse
+	(*ExportDonationsRequest)(nil),            // 70: donation.ExportDonationsRequest
+	(*ExportChunk)(nil),                       // 71: donation.ExportChunk
+	(*GetDonationExportRequest)(nil),          // 72: donation.GetDonationExportRequest
+	(*DonationExportResponse)(nil),            // 73: donation.DonationExportResponse
+	(*GetDonationGoalRequest)(nil),            // 74: donation.GetDonationGoalRequest
+	(*ListDonationGoalsRequest)(nil),          // 75: donation.ListDonationGoalsRequest
+	(*ListDonationGoalsResponse)(nil),         // 76: donation.ListDonationGoalsResponse
+	(*GetModerationSettingsRequest)(nil),      // 77: donation.GetModerationSettingsRequest
+	(*UpdateModerationSettingsRequest)(nil),   // 78: donation.UpdateModerationSettingsRequest
+	(*ModerationSettingsResponse)(nil),        // 79: donation.ModerationSettingsResponse
+	(*ListMessageReviewsRequest)(nil),         // 80: donation.ListMessageReviewsRequest
+	(*ListMessageReviewsResponse)(nil),        // 81: donation.ListMessageReviewsResponse
+	(*ResolveMessageReviewRequest)(nil),       // 82: donation.ResolveMessageReviewRequest
+	(*MessageReviewResponse)(nil),             // 83: donation.MessageReviewResponse
+	(*ListBlockedTermsRequest)(nil),           // 84: donation.ListBlockedTermsRequest
+	(*ListBlockedTermsResponse)(nil),          // 85: donation.ListBlockedTermsResponse
+	(*AddBlockedTermRequest)(nil),             // 86: donation.AddBlockedTermRequest
+	(*BlockedTermResponse)(nil),               // 87: donation.BlockedTermResponse
+	(*RemoveBlockedTermRequest)(nil),          // 88: donation.RemoveBlockedTermRequest
+	(*RemoveBlockedTermResponse)(nil),         // 89: donation.RemoveBlockedTermResponse
+	(*GetStreamerBalanceRequest)(nil),         // 90: donation.GetStreamerBalanceRequest
+	(*GetStreamerBalanceResponse)(nil),        // 91: donation.GetStreamerBalanceResponse
+	(*ListLedgerEntriesRequest)(nil),          // 92: donation.ListLedgerEntriesRequest
+	(*ListLedgerEntriesResponse)(nil),         // 93: donation.ListLedgerEntriesResponse
+	(*CreatePayoutAccountRequest)(nil),        // 94: donation.CreatePayoutAccountRequest
+	(*ListPayoutAccountsRequest)(nil),         // 95: donation.ListPayoutAccountsRequest
+	(*ListPayoutAccountsResponse)(nil),        // 96: donation.ListPayoutAccountsResponse
+	(*DeletePayoutAccountRequest)(nil),        // 97: donation.DeletePayoutAccountRequest
+	(*DeletePayoutAccountResponse)(nil),       // 98: donation.DeletePayoutAccountResponse
+	(*RequestPayoutRequest)(nil),              // 99: donation.RequestPayoutRequest
+	(*GetPayoutRequest)(nil),                  // 100: donation.GetPayoutRequest
+	(*ListPayoutsRequest)(nil),                // 101: donation.ListPayoutsRequest
+	(*ListPayoutsResponse)(nil),               // 102: donation.ListPayoutsResponse
+	(*ListRiskAssessmentsRequest)(nil),        // 103: donation.ListRiskAssessmentsRequest
+	(*ListRiskAssessmentsResponse)(nil),       // 104: donation.ListRiskAssessmentsResponse
+	(*MatchCampaignRequest)(nil),              // 105: donation.MatchCampaignRequest
+	(*UpdateMatchCampaignRequest)(nil),        // 106: donation.UpdateMatchCampaignRequest
+	(*GetMatchCampaignRequest)(nil),           // 107: donation.GetMatchCampaignRequest
+	(*ListMatchCampaignsRequest)(nil),         // 108: donation.ListMatchCampaignsRequest
+	(*ListMatchCampaignsResponse)(nil),        // 109: donation.ListMatchCampaignsResponse
+	(*ApprovePayoutRequest)(nil),              // 110: donation.ApprovePayoutRequest
+	(*RejectPayoutRequest)(nil),               // 111: donation.RejectPayoutRequest
+	(*CompletePayoutRequest)(nil),             // 112: donation.CompletePayoutRequest
+	(*FailPayoutRequest)(nil),                 // 113: donation.FailPayoutRequest
+	(*Donation)(nil),                          // 114: donation.Donation
+	(*DonationStatusChange)(nil),              // 115: donation.DonationStatusChange
+	(*DonationRefund)(nil),                    // 116: donation.DonationRefund
+	(*DonationGoal)(nil),                      // 117: donation.DonationGoal
+	(*DonationExport)(nil),                    // 118: donation.DonationExport
+	(*ModerationSettings)(nil),                // 119: donation.ModerationSettings
+	(*MessageReview)(nil),                     // 120: donation.MessageReview
+	(*BlockedTerm)(nil),                       // 121: donation.BlockedTerm
+	(*StreamerBalance)(nil),                   // 122: donation.StreamerBalance
+	(*LedgerEntry)(nil),                       // 123: donation.LedgerEntry
+	(*LedgerLine)(nil),                        // 124: donation.LedgerLine
+	(*PayoutAccount)(nil),                     // 125: donation.PayoutAccount
+	(*Payout)(nil),                            // 126: donation.Payout
+	(*RiskAssessment)(nil),                    // 127: donation.RiskAssessment
+	(*RiskReason)(nil),                        // 128: donation.RiskReason
+	(*MatchCampaign)(nil),                     // 129: donation.MatchCampaign
+	(*MatchCampaignReport)(nil),               // 130: donation.MatchCampaignReport
+	(*MatchStreamerTotal)(nil),                // 131: donation.MatchStreamerTotal
+	nil,                                       // 132: donation.ProcessPaymentRequest.PaymentDataEntry
+	nil,                                       // 133: donation.HandleWebhookRequest.HeadersEntry
+	nil,                                       // 134: donation.DonationEvent.MetadataEntry
+	nil,                                       // 135: donation.SendNotificationRequest.DataEntry
+	(*timestamp.Timestamp)(nil),               // 136: google.protobuf.Timestamp
 }
 var file_proto_donation_proto_depIdxs = []int32{
-	31, // 0: donation.CreateDonationResponse.expires_at:type_name -> google.protobuf.Timestamp
-	26, // 1: donation.GetDonationResponse.donation:type_name -> donation.Donation
-	26, // 2: donation.GetDonationsListResponse.donations:type_name -> donation.Donation
-	0,  // 3: donation.UpdateDonationStatusRequest.status:type_name -> donation.PaymentStatus
-	1,  // 4: donation.ProcessPaymentRequest.provider:type_name -> donation.PaymentProvider
-	27, // 5: donation.ProcessPaymentRequest.payment_data:type_name -> donation.ProcessPaymentRequest.PaymentDataEntry
-	0,  // 6: donation.ProcessPaymentResponse.status:type_name -> donation.PaymentStatus
-	1,  // 7: donation.VerifyPaymentRequest.provider:type_name -> donation.PaymentProvider
-	0,  // 8: donation.VerifyPaymentResponse.status:type_name -> donation.PaymentStatus
-	1,  // 9: donation.HandleWebhookRequest.provider:type_name -> donation.PaymentProvider
-	28, // 10: donation.HandleWebhookRequest.headers:type_name -> donation.HandleWebhookRequest.HeadersEntry
-	2,  // 11: donation.DonationEvent.type:type_name -> donation.EventType
-	26, // 12: donation.DonationEvent.donation:type_name -> donation.Donation
-	31, // 13: donation.DonationEvent.timestamp:type_name -> google.protobuf.Timestamp
-	29, // 14: donation.DonationEvent.metadata:type_name -> donation.DonationEvent.MetadataEntry
-	3,  // 15: donation.SendNotificationRequest.type:type_name -> donation.NotificationType
-	30, // 16: donation.SendNotificationRequest.data:type_name -> donation.SendNotificationRequest.DataEntry
-	2,  // 17: donation.SubscribeEventsRequest.event_types:type_name -> donation.EventType
-	31, // 18: donation.GetDonationStatsRequest.start_date:type_name -> google.protobuf.Timestamp
-	31, // 19: donation.GetDonationStatsRequest.end_date:type_name -> google.protobuf.Timestamp
-	25, // 20: donation.GetDonationStatsResponse.daily_stats:type_name -> donation.DonationStat
-	0,  // 21: donation.Donation.status:type_name -> donation.PaymentStatus
-	1,  // 22: donation.Donation.payment_provider:type_name -> donation.PaymentProvider
-	31, // 23: donation.Donation.created_at:type_name -> google.protobuf.Timestamp
-	31, // 24: donation.Donation.updated_at:type_name -> google.protobuf.Timestamp
-	31, // 25: donation.Donation.payment_time:type_name -> google.protobuf.Timestamp
-	4,  // 26: donation.DonationService.CreateDonation:input_type -> donation.CreateDonationRequest
-	6,  // 27: donation.DonationService.GetDonation:input_type -> donation.GetDonationRequest
-	8,  // 28: donation.DonationService.GetDonationsByStreamer:input_type -> donation.GetDonationsByStreamerRequest
-	10, // 29: donation.DonationService.UpdateDonationStatus:input_type -> donation.UpdateDonationStatusRequest
-	18, // 30: donation.DonationService.StreamDonationEvents:input_type -> donation.StreamDonationEventsRequest
-	23, // 31: donation.DonationService.GetDonationStats:input_type -> donation.GetDonationStatsRequest
-	12, // 32: donation.PaymentService.ProcessPayment:input_type -> donation.ProcessPaymentRequest
-	14, // 33: donation.PaymentService.VerifyPayment:input_type -> donation.VerifyPaymentRequest
-	16, // 34: donation.PaymentService.HandleWebhook:input_type -> donation.HandleWebhookRequest
-	20, // 35: donation.NotificationService.SendDonationNotification:input_type -> donation.SendNotificationRequest
-	22, // 36: donation.NotificationService.SubscribeDonationEvents:input_type -> donation.SubscribeEventsRequest
-	5,  // 37: donation.DonationService.CreateDonation:output_type -> donation.CreateDonationResponse
-	7,  // 38: donation.DonationService.GetDonation:output_type -> donation.GetDonationResponse
-	9,  // 39: donation.DonationService.GetDonationsByStreamer:output_type -> donation.GetDonationsListResponse
-	11, // 40: donation.DonationService.UpdateDonationStatus:output_type -> donation.UpdateDonationStatusResponse
-	19, // 41: donation.DonationService.StreamDonationEvents:output_type -> donation.DonationEvent
-	24, // 42: donation.DonationService.GetDonationStats:output_type -> donation.GetDonationStatsResponse
-	13, // 43: donation.PaymentService.ProcessPayment:output_type -> donation.ProcessPaymentResponse
-	15, // 44: donation.PaymentService.VerifyPayment:output_type -> donation.VerifyPaymentResponse
-	17, // 45: donation.PaymentService.HandleWebhook:output_type -> donation.HandleWebhookResponse
-	21, // 46: donation.NotificationService.SendDonationNotification:output_type -> donation.SendNotificationResponse
-	19, // 47: donation.NotificationService.SubscribeDonationEvents:output_type -> donation.DonationEvent
-	37, // [37:48] is the sub-list for method output_type
-	26, // [26:37] is the sub-list for method input_type
-	26, // [26:26] is the sub-list for extension type_name
-	26, // [26:26] is the sub-list for extension extendee
-	0,  // [0:26] is the sub-list for field type_name
+	136, // 0: donation.CreateDonationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	114, // 1: donation.CreateDonationResponse.donation:type_name -> donation.Donation
+	20,  // 2: donation.CreateSplitSharesRequest.shares:type_name -> donation.CreateDonationRequest
+	114, // 3: donation.CreateSplitSharesResponse.donations:type_name -> donation.Donation
+	114, // 4: donation.GetDonationResponse.donation:type_name -> donation.Donation
+	114, // 5: donation.GetDonationsListResponse.donations:type_name -> donation.Donation
+	136, // 6: donation.DonationFilter.start_date:type_name -> google.protobuf.Timestamp
+	136, // 7: donation.DonationFilter.end_date:type_name -> google.protobuf.Timestamp
+	0,   // 8: donation.DonationFilter.status:type_name -> donation.PaymentStatus
+	1,   // 9: donation.DonationFilter.provider:type_name -> donation.PaymentProvider
+	8,   // 10: donation.DonationFilter.anonymity:type_name -> donation.AnonymityFilter
+	32,  // 11: donation.ListDonationsRequest.filter:type_name -> donation.DonationFilter
+	6,   // 12: donation.ListDonationsRequest.sort_by:type_name -> donation.DonationSortField
+	7,   // 13: donation.ListDonationsRequest.order:type_name -> donation.SortOrder
+	114, // 14: donation.ListDonationsResponse.donations:type_name -> donation.Donation
+	0,   // 15: donation.UpdateDonationStatusRequest.status:type_name -> donation.PaymentStatus
+	2,   // 16: donation.UpdateDonationStatusRequest.source:type_name -> donation.StatusChangeSource
+	115, // 17: donation.GetDonationStatusHistoryResponse.history:type_name -> donation.DonationStatusChange
+	1,   // 18: donation.ProcessDonationPaymentRequest.provider:type_name -> donation.PaymentProvider
+	1,   // 19: donation.ProcessPaymentRequest.provider:type_name -> donation.PaymentProvider
+	132, // 20: donation.ProcessPaymentRequest.payment_data:type_name -> donation.ProcessPaymentRequest.PaymentDataEntry
+	0,   // 21: donation.ProcessPaymentResponse.status:type_name -> donation.PaymentStatus
+	1,   // 22: donation.VerifyPaymentRequest.provider:type_name -> donation.PaymentProvider
+	0,   // 23: donation.VerifyPaymentResponse.status:type_name -> donation.PaymentStatus
+	1,   // 24: donation.HandleWebhookRequest.provider:type_name -> donation.PaymentProvider
+	133, // 25: donation.HandleWebhookRequest.headers:type_name -> donation.HandleWebhookRequest.HeadersEntry
+	4,   // 26: donation.DonationEvent.type:type_name -> donation.EventType
+	114, // 27: donation.DonationEvent.donation:type_name -> donation.Donation
+	136, // 28: donation.DonationEvent.timestamp:type_name -> google.protobuf.Timestamp
+	134, // 29: donation.DonationEvent.metadata:type_name -> donation.DonationEvent.MetadataEntry
+	15,  // 30: donation.SendNotificationRequest.type:type_name -> donation.NotificationType
+	135, // 31: donation.SendNotificationRequest.data:type_name -> donation.SendNotificationRequest.DataEntry
+	4,   // 32: donation.SubscribeEventsRequest.event_types:type_name -> donation.EventType
+	136, // 33: donation.GetDonationStatsRequest.start_date:type_name -> google.protobuf.Timestamp
+	136, // 34: donation.GetDonationStatsRequest.end_date:type_name -> google.protobuf.Timestamp
+	5,   // 35: donation.GetDonationStatsRequest.interval:type_name -> donation.StatsInterval
+	56,  // 36: donation.GetDonationStatsResponse.daily_stats:type_name -> donation.DonationStat
+	57,  // 37: donation.GetDonationStatsResponse.currency_stats:type_name -> donation.CurrencyStat
+	5,   // 38: donation.GetDonationStatsResponse.interval:type_name -> donation.StatsInterval
+	136, // 39: donation.GetDonationStatsResponse.start_date:type_name -> google.protobuf.Timestamp
+	136, // 40: donation.GetDonationStatsResponse.end_date:type_name -> google.protobuf.Timestamp
+	116, // 41: donation.RefundDonationResponse.refund:type_name -> donation.DonationRefund
+	114, // 42: donation.RefundDonationResponse.donation:type_name -> donation.Donation
+	116, // 43: donation.ListDonationRefundsResponse.refunds:type_name -> donation.DonationRefund
+	9,   // 44: donation.GetDonationLeaderboardRequest.period:type_name -> donation.LeaderboardPeriod
+	136, // 45: donation.GetDonationLeaderboardRequest.start_date:type_name -> google.protobuf.Timestamp
+	136, // 46: donation.GetDonationLeaderboardRequest.end_date:type_name -> google.protobuf.Timestamp
+	9,   // 47: donation.GetDonationLeaderboardResponse.period:type_name -> donation.LeaderboardPeriod
+	136, // 48: donation.GetDonationLeaderboardResponse.start_date:type_name -> google.protobuf.Timestamp
+	136, // 49: donation.GetDonationLeaderboardResponse.end_date:type_name -> google.protobuf.Timestamp
+	64,  // 50: donation.GetDonationLeaderboardResponse.entries:type_name -> donation.LeaderboardEntry
+	136, // 51: donation.LeaderboardEntry.first_donated_at:type_name -> google.protobuf.Timestamp
+	136, // 52: donation.LeaderboardEntry.last_donated_at:type_name -> google.protobuf.Timestamp
+	117, // 53: donation.CreateDonationGoalRequest.goal:type_name -> donation.DonationGoal
+	117, // 54: donation.UpdateDonationGoalRequest.goal:type_name -> donation.DonationGoal
+	117, // 55: donation.DonationGoalResponse.goal:type_name -> donation.DonationGoal
+	13,  // 56: donation.ExportDonationsRequest.format:type_name -> donation.ExportFormat
+	136, // 57: donation.ExportDonationsRequest.start_date:type_name -> google.protobuf.Timestamp
+	136, // 58: donation.ExportDonationsRequest.end_date:type_name -> google.protobuf.Timestamp
+	118, // 59: donation.DonationExportResponse.export:type_name -> donation.DonationExport
+	117, // 60: donation.ListDonationGoalsResponse.goals:type_name -> donation.DonationGoal
+	119, // 61: donation.UpdateModerationSettingsRequest.settings:type_name -> donation.ModerationSettings
+	119, // 62: donation.ModerationSettingsResponse.settings:type_name -> donation.ModerationSettings
+	12,  // 63: donation.ListMessageReviewsRequest.status:type_name -> donation.MessageReviewStatus
+	120, // 64: donation.ListMessageReviewsResponse.reviews:type_name -> donation.MessageReview
+	120, // 65: donation.MessageReviewResponse.review:type_name -> donation.MessageReview
+	121, // 66: donation.ListBlockedTermsResponse.terms:type_name -> donation.BlockedTerm
+	121, // 67: donation.BlockedTermResponse.term:type_name -> donation.BlockedTerm
+	122, // 68: donation.GetStreamerBalanceResponse.balances:type_name -> donation.StreamerBalance
+	123, // 69: donation.ListLedgerEntriesResponse.entries:type_name -> donation.LedgerEntry
+	125, // 70: donation.ListPayoutAccountsResponse.accounts:type_name -> donation.PayoutAccount
+	18,  // 71: donation.ListPayoutsRequest.status:type_name -> donation.PayoutStatus
+	126, // 72: donation.ListPayoutsResponse.payouts:type_name -> donation.Payout
+	19,  // 73: donation.ListRiskAssessmentsRequest.decision:type_name -> donation.RiskDecision
+	127, // 74: donation.ListRiskAssessmentsResponse.assessments:type_name -> donation.RiskAssessment
+	136, // 75: donation.MatchCampaignRequest.starts_at:type_name -> google.protobuf.Timestamp
+	136, // 76: donation.MatchCampaignRequest.ends_at:type_name -> google.protobuf.Timestamp
+	105, // 77: donation.UpdateMatchCampaignRequest.campaign:type_name -> donation.MatchCampaignRequest
+	129, // 78: donation.ListMatchCampaignsResponse.campaigns:type_name -> donation.MatchCampaign
+	0,   // 79: donation.Donation.status:type_name -> donation.PaymentStatus
+	1,   // 80: donation.Donation.payment_provider:type_name -> donation.PaymentProvider
+	136, // 81: donation.Donation.created_at:type_name -> google.protobuf.Timestamp
+	136, // 82: donation.Donation.updated_at:type_name -> google.protobuf.Timestamp
+	136, // 83: donation.Donation.payment_time:type_name -> google.protobuf.Timestamp
+	10,  // 84: donation.Donation.message_status:type_name -> donation.MessageStatus
+	136, // 85: donation.Donation.rate_time:type_name -> google.protobuf.Timestamp
+	0,   // 86: donation.DonationStatusChange.from_status:type_name -> donation.PaymentStatus
+	0,   // 87: donation.DonationStatusChange.to_status:type_name -> donation.PaymentStatus
+	2,   // 88: donation.DonationStatusChange.source:type_name -> donation.StatusChangeSource
+	136, // 89: donation.DonationStatusChange.created_at:type_name -> google.protobuf.Timestamp
+	3,   // 90: donation.DonationRefund.status:type_name -> donation.RefundStatus
+	1,   // 91: donation.DonationRefund.provider:type_name -> donation.PaymentProvider
+	136, // 92: donation.DonationRefund.processed_at:type_name -> google.protobuf.Timestamp
+	136, // 93: donation.DonationRefund.created_at:type_name -> google.protobuf.Timestamp
+	136, // 94: donation.DonationGoal.deadline:type_name -> google.protobuf.Timestamp
+	136, // 95: donation.DonationGoal.completed_at:type_name -> google.protobuf.Timestamp
+	136, // 96: donation.DonationGoal.created_at:type_name -> google.protobuf.Timestamp
+	136, // 97: donation.DonationGoal.updated_at:type_name -> google.protobuf.Timestamp
+	13,  // 98: donation.DonationExport.format:type_name -> donation.ExportFormat
+	136, // 99: donation.DonationExport.start_date:type_name -> google.protobuf.Timestamp
+	136, // 100: donation.DonationExport.end_date:type_name -> google.protobuf.Timestamp
+	14,  // 101: donation.DonationExport.status:type_name -> donation.DonationExportStatus
+	136, // 102: donation.DonationExport.created_at:type_name -> google.protobuf.Timestamp
+	136, // 103: donation.DonationExport.completed_at:type_name -> google.protobuf.Timestamp
+	136, // 104: donation.DonationExport.expires_at:type_name -> google.protobuf.Timestamp
+	11,  // 105: donation.ModerationSettings.action:type_name -> donation.ModerationAction
+	136, // 106: donation.ModerationSettings.updated_at:type_name -> google.protobuf.Timestamp
+	12,  // 107: donation.MessageReview.status:type_name -> donation.MessageReviewStatus
+	136, // 108: donation.MessageReview.reviewed_at:type_name -> google.protobuf.Timestamp
+	136, // 109: donation.MessageReview.created_at:type_name -> google.protobuf.Timestamp
+	136, // 110: donation.BlockedTerm.created_at:type_name -> google.protobuf.Timestamp
+	16,  // 111: donation.LedgerEntry.type:type_name -> donation.LedgerEntryType
+	124, // 112: donation.LedgerEntry.lines:type_name -> donation.LedgerLine
+	136, // 113: donation.LedgerEntry.created_at:type_name -> google.protobuf.Timestamp
+	17,  // 114: donation.LedgerLine.account:type_name -> donation.LedgerAccount
+	1,   // 115: donation.LedgerLine.provider:type_name -> donation.PaymentProvider
+	136, // 116: donation.PayoutAccount.created_at:type_name -> google.protobuf.Timestamp
+	18,  // 117: donation.Payout.status:type_name -> donation.PayoutStatus
+	136, // 118: donation.Payout.reviewed_at:type_name -> google.protobuf.Timestamp
+	136, // 119: donation.Payout.paid_at:type_name -> google.protobuf.Timestamp
+	136, // 120: donation.Payout.created_at:type_name -> google.protobuf.Timestamp
+	1,   // 121: donation.RiskAssessment.payment_provider:type_name -> donation.PaymentProvider
+	19,  // 122: donation.RiskAssessment.decision:type_name -> donation.RiskDecision
+	128, // 123: donation.RiskAssessment.reasons:type_name -> donation.RiskReason
+	136, // 124: donation.RiskAssessment.created_at:type_name -> google.protobuf.Timestamp
+	136, // 125: donation.MatchCampaign.starts_at:type_name -> google.protobuf.Timestamp
+	136, // 126: donation.MatchCampaign.ends_at:type_name -> google.protobuf.Timestamp
+	136, // 127: donation.MatchCampaign.created_at:type_name -> google.protobuf.Timestamp
+	129, // 128: donation.MatchCampaignReport.campaign:type_name -> donation.MatchCampaign
+	131, // 129: donation.MatchCampaignReport.streamers:type_name -> donation.MatchStreamerTotal
+	20,  // 130: donation.DonationService.CreateDonation:input_type -> donation.CreateDonationRequest
+	22,  // 131: donation.DonationService.CreateSplitShares:input_type -> donation.CreateSplitSharesRequest
+	24,  // 132: donation.DonationService.GetDonation:input_type -> donation.GetDonationRequest
+	26,  // 133: donation.DonationService.GetDonationByTransactionID:input_type -> donation.GetDonationByTransactionIDRequest
+	27,  // 134: donation.DonationService.GetDonationsByStreamer:input_type -> donation.GetDonationsByStreamerRequest
+	28,  // 135: donation.DonationService.GetDonationsByDonator:input_type -> donation.GetDonationsByDonatorRequest
+	29,  // 136: donation.DonationService.GetDonations:input_type -> donation.GetDonationsRequest
+	30,  // 137: donation.DonationService.GetLatestDonations:input_type -> donation.GetLatestDonationsRequest
+	33,  // 138: donation.DonationService.ListDonations:input_type -> donation.ListDonationsRequest
+	35,  // 139: donation.DonationService.UpdateDonationStatus:input_type -> donation.UpdateDonationStatusRequest
+	37,  // 140: donation.DonationService.GetDonationStatusHistory:input_type -> donation.GetDonationStatusHistoryRequest
+	39,  // 141: donation.DonationService.ProcessDonationPayment:input_type -> donation.ProcessDonationPaymentRequest
+	41,  // 142: donation.DonationService.GetStreamerDonationTotal:input_type -> donation.GetStreamerDonationTotalRequest
+	49,  // 143: donation.DonationService.StreamDonationEvents:input_type -> donation.StreamDonationEventsRequest
+	54,  // 144: donation.DonationService.GetDonationStats:input_type -> donation.GetDonationStatsRequest
+	58,  // 145: donation.DonationService.RefundDonation:input_type -> donation.RefundDonationRequest
+	60,  // 146: donation.DonationService.ListDonationRefunds:input_type -> donation.ListDonationRefundsRequest
+	62,  // 147: donation.DonationService.GetDonationLeaderboard:input_type -> donation.GetDonationLeaderboardRequest
+	70,  // 148: donation.DonationService.ExportDonations:input_type -> donation.ExportDonationsRequest
+	70,  // 149: donation.DonationService.CreateDonationExport:input_type -> donation.ExportDonationsRequest
+	72,  // 150: donation.DonationService.GetDonationExport:input_type -> donation.GetDonationExportRequest
+	72,  // 151: donation.DonationService.DownloadDonationExport:input_type -> donation.GetDonationExportRequest
+	43,  // 152: donation.PaymentService.ProcessPayment:input_type -> donation.ProcessPaymentRequest
+	45,  // 153: donation.PaymentService.VerifyPayment:input_type -> donation.VerifyPaymentRequest
+	47,  // 154: donation.PaymentService.HandleWebhook:input_type -> donation.HandleWebhookRequest
+	51,  // 155: donation.NotificationService.SendDonationNotification:input_type -> donation.SendNotificationRequest
+	53,  // 156: donation.NotificationService.SubscribeDonationEvents:input_type -> donation.SubscribeEventsRequest
+	65,  // 157: donation.DonationGoalService.CreateDonationGoal:input_type -> donation.CreateDonationGoalRequest
+	66,  // 158: donation.DonationGoalService.UpdateDonationGoal:input_type -> donation.UpdateDonationGoalRequest
+	68,  // 159: donation.DonationGoalService.DeleteDonationGoal:input_type -> donation.DeleteDonationGoalRequest
+	74,  // 160: donation.DonationGoalService.GetDonationGoal:input_type -> donation.GetDonationGoalRequest
+	75,  // 161: donation.DonationGoalService.ListDonationGoals:input_type -> donation.ListDonationGoalsRequest
+	77,  // 162: donation.ModerationService.GetModerationSettings:input_type -> donation.GetModerationSettingsRequest
+	78,  // 163: donation.ModerationService.UpdateModerationSettings:input_type -> donation.UpdateModerationSettingsRequest
+	80,  // 164: donation.ModerationService.ListMessageReviews:input_type -> donation.ListMessageReviewsRequest
+	82,  // 165: donation.ModerationService.ApproveMessage:input_type -> donation.ResolveMessageReviewRequest
+	82,  // 166: donation.ModerationService.RejectMessage:input_type -> donation.ResolveMessageReviewRequest
+	84,  // 167: donation.ModerationService.ListBlockedTerms:input_type -> donation.ListBlockedTermsRequest
+	86,  // 168: donation.ModerationService.AddBlockedTerm:input_type -> donation.AddBlockedTermRequest
+	88,  // 169: donation.ModerationService.RemoveBlockedTerm:input_type -> donation.RemoveBlockedTermRequest
+	90,  // 170: donation.LedgerService.GetStreamerBalance:input_type -> donation.GetStreamerBalanceRequest
+	92,  // 171: donation.LedgerService.ListLedgerEntries:input_type -> donation.ListLedgerEntriesRequest
+	94,  // 172: donation.PayoutService.CreatePayoutAccount:input_type -> donation.CreatePayoutAccountRequest
+	95,  // 173: donation.PayoutService.ListPayoutAccounts:input_type -> donation.ListPayoutAccountsRequest
+	97,  // 174: donation.PayoutService.DeletePayoutAccount:input_type -> donation.DeletePayoutAccountRequest
+	99,  // 175: donation.PayoutService.RequestPayout:input_type -> donation.RequestPayoutRequest
+	100, // 176: donation.PayoutService.GetPayout:input_type -> donation.GetPayoutRequest
+	101, // 177: donation.PayoutService.ListPayouts:input_type -> donation.ListPayoutsRequest
+	110, // 178: donation.PayoutService.ApprovePayout:input_type -> donation.ApprovePayoutRequest
+	111, // 179: donation.PayoutService.RejectPayout:input_type -> donation.RejectPayoutRequest
+	112, // 180: donation.PayoutService.CompletePayout:input_type -> donation.CompletePayoutRequest
+	113, // 181: donation.PayoutService.FailPayout:input_type -> donation.FailPayoutRequest
+	103, // 182: donation.RiskService.ListRiskAssessments:input_type -> donation.ListRiskAssessmentsRequest
+	105, // 183: donation.MatchCampaignService.CreateMatchCampaign:input_type -> donation.MatchCampaignRequest
+	106, // 184: donation.MatchCampaignService.UpdateMatchCampaign:input_type -> donation.UpdateMatchCampaignRequest
+	107, // 185: donation.MatchCampaignService.GetMatchCampaign:input_type -> donation.GetMatchCampaignRequest
+	108, // 186: donation.MatchCampaignService.ListMatchCampaigns:input_type -> donation.ListMatchCampaignsRequest
+	107, // 187: donation.MatchCampaignService.GetMatchCampaignReport:input_type -> donation.GetMatchCampaignRequest
+	21,  // 188: donation.DonationService.CreateDonation:output_type -> donation.CreateDonationResponse
+	23,  // 189: donation.DonationService.CreateSplitShares:output_type -> donation.CreateSplitSharesResponse
+	25,  // 190: donation.DonationService.GetDonation:output_type -> donation.GetDonationResponse
+	25,  // 191: donation.DonationService.GetDonationByTransactionID:output_type -> donation.GetDonationResponse
+	31,  // 192: donation.DonationService.GetDonationsByStreamer:output_type -> donation.GetDonationsListResponse
+	31,  // 193: donation.DonationService.GetDonationsByDonator:output_type -> donation.GetDonationsListResponse
+	31,  // 194: donation.DonationService.GetDonations:output_type -> donation.GetDonationsListResponse
+	31,  // 195: donation.DonationService.GetLatestDonations:output_type -> donation.GetDonationsListResponse
+	34,  // 196: donation.DonationService.ListDonations:output_type -> donation.ListDonationsResponse
+	36,  // 197: donation.DonationService.UpdateDonationStatus:output_type -> donation.UpdateDonationStatusResponse
+	38,  // 198: donation.DonationService.GetDonationStatusHistory:output_type -> donation.GetDonationStatusHistoryResponse
+	40,  // 199: donation.DonationService.ProcessDonationPayment:output_type -> donation.ProcessDonationPaymentResponse
+	42,  // 200: donation.DonationService.GetStreamerDonationTotal:output_type -> donation.GetStreamerDonationTotalResponse
+	50,  // 201: donation.DonationService.StreamDonationEvents:output_type -> donation.DonationEvent
+	55,  // 202: donation.DonationService.GetDonationStats:output_type -> donation.GetDonationStatsResponse
+	59,  // 203: donation.DonationService.RefundDonation:output_type -> donation.RefundDonationResponse
+	61,  // 204: donation.DonationService.ListDonationRefunds:output_type -> donation.ListDonationRefundsResponse
+	63,  // 205: donation.DonationService.GetDonationLeaderboard:output_type -> donation.GetDonationLeaderboardResponse
+	71,  // 206: donation.DonationService.ExportDonations:output_type -> donation.ExportChunk
+	73,  // 207: donation.DonationService.CreateDonationExport:output_type -> donation.DonationExportResponse
+	73,  // 208: donation.DonationService.GetDonationExport:output_type -> donation.DonationExportResponse
+	71,  // 209: donation.DonationService.DownloadDonationExport:output_type -> donation.ExportChunk
+	44,  // 210: donation.PaymentService.ProcessPayment:output_type -> donation.ProcessPaymentResponse
+	46,  // 211: donation.PaymentService.VerifyPayment:output_type -> donation.VerifyPaymentResponse
+	48,  // 212: donation.PaymentService.HandleWebhook:output_type -> donation.HandleWebhookResponse
+	52,  // 213: donation.NotificationService.SendDonationNotification:output_type -> donation.SendNotificationResponse
+	50,  // 214: donation.NotificationService.SubscribeDonationEvents:output_type -> donation.DonationEvent
+	67,  // 215: donation.DonationGoalService.CreateDonationGoal:output_type -> donation.DonationGoalResponse
+	67,  // 216: donation.DonationGoalService.UpdateDonationGoal:output_type -> donation.DonationGoalResponse
+	69,  // 217: donation.DonationGoalService.DeleteDonationGoal:output_type -> donation.DeleteDonationGoalResponse
+	67,  // 218: donation.DonationGoalService.GetDonationGoal:output_type -> donation.DonationGoalResponse
+	76,  // 219: donation.DonationGoalService.ListDonationGoals:output_type -> donation.ListDonationGoalsResponse
+	79,  // 220: donation.ModerationService.GetModerationSettings:output_type -> donation.ModerationSettingsResponse
+	79,  // 221: donation.ModerationService.UpdateModerationSettings:output_type -> donation.ModerationSettingsResponse
+	81,  // 222: donation.ModerationService.ListMessageReviews:output_type -> donation.ListMessageReviewsResponse
+	83,  // 223: donation.ModerationService.ApproveMessage:output_type -> donation.MessageReviewResponse
+	83,  // 224: donation.ModerationService.RejectMessage:output_type -> donation.MessageReviewResponse
+	85,  // 225: donation.ModerationService.ListBlockedTerms:output_type -> donation.ListBlockedTermsResponse
+	87,  // 226: donation.ModerationService.AddBlockedTerm:output_type -> donation.BlockedTermResponse
+	89,  // 227: donation.ModerationService.RemoveBlockedTerm:output_type -> donation.RemoveBlockedTermResponse
+	91,  // 228: donation.LedgerService.GetStreamerBalance:output_type -> donation.GetStreamerBalanceResponse
+	93,  // 229: donation.LedgerService.ListLedgerEntries:output_type -> donation.ListLedgerEntriesResponse
+	125, // 230: donation.PayoutService.CreatePayoutAccount:output_type -> donation.PayoutAccount
+	96,  // 231: donation.PayoutService.ListPayoutAccounts:output_type -> donation.ListPayoutAccountsResponse
+	98,  // 232: donation.PayoutService.DeletePayoutAccount:output_type -> donation.DeletePayoutAccountResponse
+	126, // 233: donation.PayoutService.RequestPayout:output_type -> donation.Payout
+	126, // 234: donation.PayoutService.GetPayout:output_type -> donation.Payout
+	102, // 235: donation.PayoutService.ListPayouts:output_type -> donation.ListPayoutsResponse
+	126, // 236: donation.PayoutService.ApprovePayout:output_type -> donation.Payout
+	126, // 237: donation.PayoutService.RejectPayout:output_type -> donation.Payout
+	126, // 238: donation.PayoutService.CompletePayout:output_type -> donation.Payout
+	126, // 239: donation.PayoutService.FailPayout:output_type -> donation.Payout
+	104, // 240: donation.RiskService.ListRiskAssessments:output_type -> donation.ListRiskAssessmentsResponse
+	129, // 241: donation.MatchCampaignService.CreateMatchCampaign:output_type -> donation.MatchCampaign
+	129, // 242: donation.MatchCampaignService.UpdateMatchCampaign:output_type -> donation.MatchCampaign
+	129, // 243: donation.MatchCampaignService.GetMatchCampaign:output_type -> donation.MatchCampaign
+	109, // 244: donation.MatchCampaignService.ListMatchCampaigns:output_type -> donation.ListMatchCampaignsResponse
+	130, // 245: donation.MatchCampaignService.GetMatchCampaignReport:output_type -> donation.MatchCampaignReport
+	188, // [188:246] is the sub-list for method output_type
+	130, // [130:188] is the sub-list for method input_type
+	130, // [130:130] is the sub-list for extension type_name
+	130, // [130:130] is the sub-list for extension extendee
+	0,   // [0:130] is the sub-list for field type_name
 }
 
 func init() { file_proto_donation_proto_init() }
@@ -2040,10 +10085,10 @@ func file_proto_donation_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_donation_proto_rawDesc), len(file_proto_donation_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   27,
+			NumEnums:      20,
+			NumMessages:   116,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   9,
 		},
 		GoTypes:           file_proto_donation_proto_goTypes,
 		DependencyIndexes: file_proto_donation_proto_depIdxs,
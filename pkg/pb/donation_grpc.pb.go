@@ -19,12 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	DonationService_CreateDonation_FullMethodName         = "/donation.DonationService/CreateDonation"
-	DonationService_GetDonation_FullMethodName            = "/donation.DonationService/GetDonation"
-	DonationService_GetDonationsByStreamer_FullMethodName = "/donation.DonationService/GetDonationsByStreamer"
-	DonationService_UpdateDonationStatus_FullMethodName   = "/donation.DonationService/UpdateDonationStatus"
-	DonationService_StreamDonationEvents_FullMethodName   = "/donation.DonationService/StreamDonationEvents"
-	DonationService_GetDonationStats_FullMethodName       = "/donation.DonationService/GetDonationStats"
+	DonationService_CreateDonation_FullMethodName             = "/donation.DonationService/CreateDonation"
+	DonationService_CreateSplitShares_FullMethodName          = "/donation.DonationService/CreateSplitShares"
+	DonationService_GetDonation_FullMethodName                = "/donation.DonationService/GetDonation"
+	DonationService_GetDonationByTransactionID_FullMethodName = "/donation.DonationService/GetDonationByTransactionID"
+	DonationService_GetDonationsByStreamer_FullMethodName     = "/donation.DonationService/GetDonationsByStreamer"
+	DonationService_GetDonationsByDonator_FullMethodName      = "/donation.DonationService/GetDonationsByDonator"
+	DonationService_GetDonations_FullMethodName               = "/donation.DonationService/GetDonations"
+	DonationService_GetLatestDonations_FullMethodName         = "/donation.DonationService/GetLatestDonations"
+	DonationService_ListDonations_FullMethodName              = "/donation.DonationService/ListDonations"
+	DonationService_UpdateDonationStatus_FullMethodName       = "/donation.DonationService/UpdateDonationStatus"
+	DonationService_GetDonationStatusHistory_FullMethodName   = "/donation.DonationService/GetDonationStatusHistory"
+	DonationService_ProcessDonationPayment_FullMethodName     = "/donation.DonationService/ProcessDonationPayment"
+	DonationService_GetStreamerDonationTotal_FullMethodName   = "/donation.DonationService/GetStreamerDonationTotal"
+	DonationService_StreamDonationEvents_FullMethodName       = "/donation.DonationService/StreamDonationEvents"
+	DonationService_GetDonationStats_FullMethodName           = "/donation.DonationService/GetDonationStats"
+	DonationService_RefundDonation_FullMethodName             = "/donation.DonationService/RefundDonation"
+	DonationService_ListDonationRefunds_FullMethodName        = "/donation.DonationService/ListDonationRefunds"
+	DonationService_GetDonationLeaderboard_FullMethodName     = "/donation.DonationService/GetDonationLeaderboard"
+	DonationService_ExportDonations_FullMethodName            = "/donation.DonationService/ExportDonations"
+	DonationService_CreateDonationExport_FullMethodName       = "/donation.DonationService/CreateDonationExport"
+	DonationService_GetDonationExport_FullMethodName          = "/donation.DonationService/GetDonationExport"
+	DonationService_DownloadDonationExport_FullMethodName     = "/donation.DonationService/DownloadDonationExport"
 )
 
 // DonationServiceClient is the client API for DonationService service.
@@ -35,16 +51,47 @@ const (
 type DonationServiceClient interface {
 	// Create a new donation
 	CreateDonation(ctx context.Context, in *CreateDonationRequest, opts ...grpc.CallOption) (*CreateDonationResponse, error)
+	// Create the donations of a split donation's shares, screened once for the whole split
+	CreateSplitShares(ctx context.Context, in *CreateSplitSharesRequest, opts ...grpc.CallOption) (*CreateSplitSharesResponse, error)
 	// Get donation by ID
 	GetDonation(ctx context.Context, in *GetDonationRequest, opts ...grpc.CallOption) (*GetDonationResponse, error)
+	// Get donation by payment transaction ID
+	GetDonationByTransactionID(ctx context.Context, in *GetDonationByTransactionIDRequest, opts ...grpc.CallOption) (*GetDonationResponse, error)
 	// Get donations by streamer
 	GetDonationsByStreamer(ctx context.Context, in *GetDonationsByStreamerRequest, opts ...grpc.CallOption) (*GetDonationsListResponse, error)
+	// Get donations made by a donator
+	GetDonationsByDonator(ctx context.Context, in *GetDonationsByDonatorRequest, opts ...grpc.CallOption) (*GetDonationsListResponse, error)
+	// Page through all donations by page number
+	GetDonations(ctx context.Context, in *GetDonationsRequest, opts ...grpc.CallOption) (*GetDonationsListResponse, error)
+	// Get the most recent donations for display
+	GetLatestDonations(ctx context.Context, in *GetLatestDonationsRequest, opts ...grpc.CallOption) (*GetDonationsListResponse, error)
+	// List donations with filters, sorting and cursor pagination
+	ListDonations(ctx context.Context, in *ListDonationsRequest, opts ...grpc.CallOption) (*ListDonationsResponse, error)
 	// Update donation status
 	UpdateDonationStatus(ctx context.Context, in *UpdateDonationStatusRequest, opts ...grpc.CallOption) (*UpdateDonationStatusResponse, error)
+	// Get the status transition history of a donation
+	GetDonationStatusHistory(ctx context.Context, in *GetDonationStatusHistoryRequest, opts ...grpc.CallOption) (*GetDonationStatusHistoryResponse, error)
+	// Record the payment of a pending donation and complete it
+	ProcessDonationPayment(ctx context.Context, in *ProcessDonationPaymentRequest, opts ...grpc.CallOption) (*ProcessDonationPaymentResponse, error)
+	// Get a streamer's completed donation total, net of refunds
+	GetStreamerDonationTotal(ctx context.Context, in *GetStreamerDonationTotalRequest, opts ...grpc.CallOption) (*GetStreamerDonationTotalResponse, error)
 	// Stream donation events (real-time notifications)
 	StreamDonationEvents(ctx context.Context, in *StreamDonationEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DonationEvent], error)
 	// Get donation statistics
 	GetDonationStats(ctx context.Context, in *GetDonationStatsRequest, opts ...grpc.CallOption) (*GetDonationStatsResponse, error)
+	// Refund a completed donation, fully or partially
+	RefundDonation(ctx context.Context, in *RefundDonationRequest, opts ...grpc.CallOption) (*RefundDonationResponse, error)
+	// List refunds recorded for a donation
+	ListDonationRefunds(ctx context.Context, in *ListDonationRefundsRequest, opts ...grpc.CallOption) (*ListDonationRefundsResponse, error)
+	// Rank a streamer's top donors over a period
+	GetDonationLeaderboard(ctx context.Context, in *GetDonationLeaderboardRequest, opts ...grpc.CallOption) (*GetDonationLeaderboardResponse, error)
+	// Stream a CSV or XLSX file of a streamer's donations
+	ExportDonations(ctx context.Context, in *ExportDonationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
+	// Queue a background export for ranges too large to stream directly
+	CreateDonationExport(ctx context.Context, in *ExportDonationsRequest, opts ...grpc.CallOption) (*DonationExportResponse, error)
+	GetDonationExport(ctx context.Context, in *GetDonationExportRequest, opts ...grpc.CallOption) (*DonationExportResponse, error)
+	// Stream the file of a completed background export
+	DownloadDonationExport(ctx context.Context, in *GetDonationExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error)
 }
 
 type donationServiceClient struct {
@@ -65,6 +112,16 @@ func (c *donationServiceClient) CreateDonation(ctx context.Context, in *CreateDo
 	return out, nil
 }
 
+func (c *donationServiceClient) CreateSplitShares(ctx context.Context, in *CreateSplitSharesRequest, opts ...grpc.CallOption) (*CreateSplitSharesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateSplitSharesResponse)
+	err := c.cc.Invoke(ctx, DonationService_CreateSplitShares_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *donationServiceClient) GetDonation(ctx context.Context, in *GetDonationRequest, opts ...grpc.CallOption) (*GetDonationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDonationResponse)
@@ -75,6 +132,16 @@ func (c *donationServiceClient) GetDonation(ctx context.Context, in *GetDonation
 	return out, nil
 }
 
+func (c *donationServiceClient) GetDonationByTransactionID(ctx context.Context, in *GetDonationByTransactionIDRequest, opts ...grpc.CallOption) (*GetDonationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDonationResponse)
+	err := c.cc.Invoke(ctx, DonationService_GetDonationByTransactionID_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *donationServiceClient) GetDonationsByStreamer(ctx context.Context, in *GetDonationsByStreamerRequest, opts ...grpc.CallOption) (*GetDonationsListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetDonationsListResponse)
@@ -85,6 +152,46 @@ func (c *donationServiceClient) GetDonationsByStreamer(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *donationServiceClient) GetDonationsByDonator(ctx context.Context, in *GetDonationsByDonatorRequest, opts ...grpc.CallOption) (*GetDonationsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDonationsListResponse)
+	err := c.cc.Invoke(ctx, DonationService_GetDonationsByDonator_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) GetDonations(ctx context.Context, in *GetDonationsRequest, opts ...grpc.CallOption) (*GetDonationsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDonationsListResponse)
+	err := c.cc.Invoke(ctx, DonationService_GetDonations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) GetLatestDonations(ctx context.Context, in *GetLatestDonationsRequest, opts ...grpc.CallOption) (*GetDonationsListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDonationsListResponse)
+	err := c.cc.Invoke(ctx, DonationService_GetLatestDonations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) ListDonations(ctx context.Context, in *ListDonationsRequest, opts ...grpc.CallOption) (*ListDonationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDonationsResponse)
+	err := c.cc.Invoke(ctx, DonationService_ListDonations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *donationServiceClient) UpdateDonationStatus(ctx context.Context, in *UpdateDonationStatusRequest, opts ...grpc.CallOption) (*UpdateDonationStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateDonationStatusResponse)
@@ -95,6 +202,36 @@ func (c *donationServiceClient) UpdateDonationStatus(ctx context.Context, in *Up
 	return out, nil
 }
 
+func (c *donationServiceClient) GetDonationStatusHistory(ctx context.Context, in *GetDonationStatusHistoryRequest, opts ...grpc.CallOption) (*GetDonationStatusHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDonationStatusHistoryResponse)
+	err := c.cc.Invoke(ctx, DonationService_GetDonationStatusHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) ProcessDonationPayment(ctx context.Context, in *ProcessDonationPaymentRequest, opts ...grpc.CallOption) (*ProcessDonationPaymentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProcessDonationPaymentResponse)
+	err := c.cc.Invoke(ctx, DonationService_ProcessDonationPayment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) GetStreamerDonationTotal(ctx context.Context, in *GetStreamerDonationTotalRequest, opts ...grpc.CallOption) (*GetStreamerDonationTotalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStreamerDonationTotalResponse)
+	err := c.cc.Invoke(ctx, DonationService_GetStreamerDonationTotal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *donationServiceClient) StreamDonationEvents(ctx context.Context, in *StreamDonationEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[DonationEvent], error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	stream, err := c.cc.NewStream(ctx, &DonationService_ServiceDesc.Streams[0], DonationService_StreamDonationEvents_FullMethodName, cOpts...)
@@ -124,6 +261,94 @@ func (c *donationServiceClient) GetDonationStats(ctx context.Context, in *GetDon
 	return out, nil
 }
 
+func (c *donationServiceClient) RefundDonation(ctx context.Context, in *RefundDonationRequest, opts ...grpc.CallOption) (*RefundDonationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RefundDonationResponse)
+	err := c.cc.Invoke(ctx, DonationService_RefundDonation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) ListDonationRefunds(ctx context.Context, in *ListDonationRefundsRequest, opts ...grpc.CallOption) (*ListDonationRefundsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDonationRefundsResponse)
+	err := c.cc.Invoke(ctx, DonationService_ListDonationRefunds_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) GetDonationLeaderboard(ctx context.Context, in *GetDonationLeaderboardRequest, opts ...grpc.CallOption) (*GetDonationLeaderboardResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDonationLeaderboardResponse)
+	err := c.cc.Invoke(ctx, DonationService_GetDonationLeaderboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) ExportDonations(ctx context.Context, in *ExportDonationsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DonationService_ServiceDesc.Streams[1], DonationService_ExportDonations_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportDonationsRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DonationService_ExportDonationsClient = grpc.ServerStreamingClient[ExportChunk]
+
+func (c *donationServiceClient) CreateDonationExport(ctx context.Context, in *ExportDonationsRequest, opts ...grpc.CallOption) (*DonationExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DonationExportResponse)
+	err := c.cc.Invoke(ctx, DonationService_CreateDonationExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) GetDonationExport(ctx context.Context, in *GetDonationExportRequest, opts ...grpc.CallOption) (*DonationExportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DonationExportResponse)
+	err := c.cc.Invoke(ctx, DonationService_GetDonationExport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationServiceClient) DownloadDonationExport(ctx context.Context, in *GetDonationExportRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportChunk], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DonationService_ServiceDesc.Streams[2], DonationService_DownloadDonationExport_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[GetDonationExportRequest, ExportChunk]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DonationService_DownloadDonationExportClient = grpc.ServerStreamingClient[ExportChunk]
+
 // DonationServiceServer is the server API for DonationService service.
 // All implementations must embed UnimplementedDonationServiceServer
 // for forward compatibility.
@@ -132,16 +357,47 @@ func (c *donationServiceClient) GetDonationStats(ctx context.Context, in *GetDon
 type DonationServiceServer interface {
 	// Create a new donation
 	CreateDonation(context.Context, *CreateDonationRequest) (*CreateDonationResponse, error)
+	// Create the donations of a split donation's shares, screened once for the whole split
+	CreateSplitShares(context.Context, *CreateSplitSharesRequest) (*CreateSplitSharesResponse, error)
 	// Get donation by ID
 	GetDonation(context.Context, *GetDonationRequest) (*GetDonationResponse, error)
+	// Get donation by payment transaction ID
+	GetDonationByTransactionID(context.Context, *GetDonationByTransactionIDRequest) (*GetDonationResponse, error)
 	// Get donations by streamer
 	GetDonationsByStreamer(context.Context, *GetDonationsByStreamerRequest) (*GetDonationsListResponse, error)
+	// Get donations made by a donator
+	GetDonationsByDonator(context.Context, *GetDonationsByDonatorRequest) (*GetDonationsListResponse, error)
+	// Page through all donations by page number
+	GetDonations(context.Context, *GetDonationsRequest) (*GetDonationsListResponse, error)
+	// Get the most recent donations for display
+	GetLatestDonations(context.Context, *GetLatestDonationsRequest) (*GetDonationsListResponse, error)
+	// List donations with filters, sorting and cursor pagination
+	ListDonations(context.Context, *ListDonationsRequest) (*ListDonationsResponse, error)
 	// Update donation status
 	UpdateDonationStatus(context.Context, *UpdateDonationStatusRequest) (*UpdateDonationStatusResponse, error)
+	// Get the status transition history of a donation
+	GetDonationStatusHistory(context.Context, *GetDonationStatusHistoryRequest) (*GetDonationStatusHistoryResponse, error)
+	// Record the payment of a pending donation and complete it
+	ProcessDonationPayment(context.Context, *ProcessDonationPaymentRequest) (*ProcessDonationPaymentResponse, error)
+	// Get a streamer's completed donation total, net of refunds
+	GetStreamerDonationTotal(context.Context, *GetStreamerDonationTotalRequest) (*GetStreamerDonationTotalResponse, error)
 	// Stream donation events (real-time notifications)
 	StreamDonationEvents(*StreamDonationEventsRequest, grpc.ServerStreamingServer[DonationEvent]) error
 	// Get donation statistics
 	GetDonationStats(context.Context, *GetDonationStatsRequest) (*GetDonationStatsResponse, error)
+	// Refund a completed donation, fully or partially
+	RefundDonation(context.Context, *RefundDonationRequest) (*RefundDonationResponse, error)
+	// List refunds recorded for a donation
+	ListDonationRefunds(context.Context, *ListDonationRefundsRequest) (*ListDonationRefundsResponse, error)
+	// Rank a streamer's top donors over a period
+	GetDonationLeaderboard(context.Context, *GetDonationLeaderboardRequest) (*GetDonationLeaderboardResponse, error)
+	// Stream a CSV or XLSX file of a streamer's donations
+	ExportDonations(*ExportDonationsRequest, grpc.ServerStreamingServer[ExportChunk]) error
+	// Queue a background export for ranges too large to stream directly
+	CreateDonationExport(context.Context, *ExportDonationsRequest) (*DonationExportResponse, error)
+	GetDonationExport(context.Context, *GetDonationExportRequest) (*DonationExportResponse, error)
+	// Stream the file of a completed background export
+	DownloadDonationExport(*GetDonationExportRequest, grpc.ServerStreamingServer[ExportChunk]) error
 	mustEmbedUnimplementedDonationServiceServer()
 }
 
@@ -155,21 +411,69 @@ type UnimplementedDonationServiceServer struct{}
 func (UnimplementedDonationServiceServer) CreateDonation(context.Context, *CreateDonationRequest) (*CreateDonationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateDonation not implemented")
 }
+func (UnimplementedDonationServiceServer) CreateSplitShares(context.Context, *CreateSplitSharesRequest) (*CreateSplitSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSplitShares not implemented")
+}
 func (UnimplementedDonationServiceServer) GetDonation(context.Context, *GetDonationRequest) (*GetDonationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDonation not implemented")
 }
+func (UnimplementedDonationServiceServer) GetDonationByTransactionID(context.Context, *GetDonationByTransactionIDRequest) (*GetDonationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDonationByTransactionID not implemented")
+}
 func (UnimplementedDonationServiceServer) GetDonationsByStreamer(context.Context, *GetDonationsByStreamerRequest) (*GetDonationsListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDonationsByStreamer not implemented")
 }
+func (UnimplementedDonationServiceServer) GetDonationsByDonator(context.Context, *GetDonationsByDonatorRequest) (*GetDonationsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDonationsByDonator not implemented")
+}
+func (UnimplementedDonationServiceServer) GetDonations(context.Context, *GetDonationsRequest) (*GetDonationsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDonations not implemented")
+}
+func (UnimplementedDonationServiceServer) GetLatestDonations(context.Context, *GetLatestDonationsRequest) (*GetDonationsListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLatestDonations not implemented")
+}
+func (UnimplementedDonationServiceServer) ListDonations(context.Context, *ListDonationsRequest) (*ListDonationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDonations not implemented")
+}
 func (UnimplementedDonationServiceServer) UpdateDonationStatus(context.Context, *UpdateDonationStatusRequest) (*UpdateDonationStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDonationStatus not implemented")
 }
+func (UnimplementedDonationServiceServer) GetDonationStatusHistory(context.Context, *GetDonationStatusHistoryRequest) (*GetDonationStatusHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDonationStatusHistory not implemented")
+}
+func (UnimplementedDonationServiceServer) ProcessDonationPayment(context.Context, *ProcessDonationPaymentRequest) (*ProcessDonationPaymentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProcessDonationPayment not implemented")
+}
+func (UnimplementedDonationServiceServer) GetStreamerDonationTotal(context.Context, *GetStreamerDonationTotalRequest) (*GetStreamerDonationTotalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStreamerDonationTotal not implemented")
+}
 func (UnimplementedDonationServiceServer) StreamDonationEvents(*StreamDonationEventsRequest, grpc.ServerStreamingServer[DonationEvent]) error {
 	return status.Errorf(codes.Unimplemented, "method StreamDonationEvents not implemented")
 }
 func (UnimplementedDonationServiceServer) GetDonationStats(context.Context, *GetDonationStatsRequest) (*GetDonationStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDonationStats not implemented")
 }
+func (UnimplementedDonationServiceServer) RefundDonation(context.Context, *RefundDonationRequest) (*RefundDonationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefundDonation not implemented")
+}
+func (UnimplementedDonationServiceServer) ListDonationRefunds(context.Context, *ListDonationRefundsRequest) (*ListDonationRefundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDonationRefunds not implemented")
+}
+func (UnimplementedDonationServiceServer) GetDonationLeaderboard(context.Context, *GetDonationLeaderboardRequest) (*GetDonationLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDonationLeaderboard not implemented")
+}
+func (UnimplementedDonationServiceServer) ExportDonations(*ExportDonationsRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDonations not implemented")
+}
+func (UnimplementedDonationServiceServer) CreateDonationExport(context.Context, *ExportDonationsRequest) (*DonationExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDonationExport not implemented")
+}
+func (UnimplementedDonationServiceServer) GetDonationExport(context.Context, *GetDonationExportRequest) (*DonationExportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDonationExport not implemented")
+}
+func (UnimplementedDonationServiceServer) DownloadDonationExport(*GetDonationExportRequest, grpc.ServerStreamingServer[ExportChunk]) error {
+	return status.Errorf(codes.Unimplemented, "method DownloadDonationExport not implemented")
+}
 func (UnimplementedDonationServiceServer) mustEmbedUnimplementedDonationServiceServer() {}
 func (UnimplementedDonationServiceServer) testEmbeddedByValue()                         {}
 
@@ -209,6 +513,24 @@ func _DonationService_CreateDonation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DonationService_CreateSplitShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSplitSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).CreateSplitShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_CreateSplitShares_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).CreateSplitShares(ctx, req.(*CreateSplitSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DonationService_GetDonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDonationRequest)
 	if err := dec(in); err != nil {
@@ -227,6 +549,24 @@ func _DonationService_GetDonation_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DonationService_GetDonationByTransactionID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationByTransactionIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).GetDonationByTransactionID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_GetDonationByTransactionID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).GetDonationByTransactionID(ctx, req.(*GetDonationByTransactionIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DonationService_GetDonationsByStreamer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetDonationsByStreamerRequest)
 	if err := dec(in); err != nil {
@@ -245,6 +585,78 @@ func _DonationService_GetDonationsByStreamer_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DonationService_GetDonationsByDonator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationsByDonatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).GetDonationsByDonator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_GetDonationsByDonator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).GetDonationsByDonator(ctx, req.(*GetDonationsByDonatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_GetDonations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).GetDonations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_GetDonations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).GetDonations(ctx, req.(*GetDonationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_GetLatestDonations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLatestDonationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).GetLatestDonations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_GetLatestDonations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).GetLatestDonations(ctx, req.(*GetLatestDonationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_ListDonations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDonationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).ListDonations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_ListDonations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).ListDonations(ctx, req.(*ListDonationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DonationService_UpdateDonationStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateDonationStatusRequest)
 	if err := dec(in); err != nil {
@@ -263,62 +675,284 @@ func _DonationService_UpdateDonationStatus_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
-func _DonationService_StreamDonationEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StreamDonationEventsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _DonationService_GetDonationStatusHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationStatusHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(DonationServiceServer).StreamDonationEvents(m, &grpc.GenericServerStream[StreamDonationEventsRequest, DonationEvent]{ServerStream: stream})
+	if interceptor == nil {
+		return srv.(DonationServiceServer).GetDonationStatusHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_GetDonationStatusHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).GetDonationStatusHistory(ctx, req.(*GetDonationStatusHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
-type DonationService_StreamDonationEventsServer = grpc.ServerStreamingServer[DonationEvent]
-
-func _DonationService_GetDonationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDonationStatsRequest)
+func _DonationService_ProcessDonationPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProcessDonationPaymentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(DonationServiceServer).GetDonationStats(ctx, in)
+		return srv.(DonationServiceServer).ProcessDonationPayment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: DonationService_GetDonationStats_FullMethodName,
+		FullMethod: DonationService_ProcessDonationPayment_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DonationServiceServer).GetDonationStats(ctx, req.(*GetDonationStatsRequest))
+		return srv.(DonationServiceServer).ProcessDonationPayment(ctx, req.(*ProcessDonationPaymentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// DonationService_ServiceDesc is the grpc.ServiceDesc for DonationService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var DonationService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "donation.DonationService",
-	HandlerType: (*DonationServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
+func _DonationService_GetStreamerDonationTotal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStreamerDonationTotalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).GetStreamerDonationTotal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_GetStreamerDonationTotal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).GetStreamerDonationTotal(ctx, req.(*GetStreamerDonationTotalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_StreamDonationEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamDonationEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DonationServiceServer).StreamDonationEvents(m, &grpc.GenericServerStream[StreamDonationEventsRequest, DonationEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DonationService_StreamDonationEventsServer = grpc.ServerStreamingServer[DonationEvent]
+
+func _DonationService_GetDonationStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).GetDonationStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_GetDonationStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).GetDonationStats(ctx, req.(*GetDonationStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_RefundDonation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundDonationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).RefundDonation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_RefundDonation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).RefundDonation(ctx, req.(*RefundDonationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_ListDonationRefunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDonationRefundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).ListDonationRefunds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_ListDonationRefunds_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).ListDonationRefunds(ctx, req.(*ListDonationRefundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_GetDonationLeaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).GetDonationLeaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_GetDonationLeaderboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).GetDonationLeaderboard(ctx, req.(*GetDonationLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_ExportDonations_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDonationsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DonationServiceServer).ExportDonations(m, &grpc.GenericServerStream[ExportDonationsRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DonationService_ExportDonationsServer = grpc.ServerStreamingServer[ExportChunk]
+
+func _DonationService_CreateDonationExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDonationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).CreateDonationExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_CreateDonationExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).CreateDonationExport(ctx, req.(*ExportDonationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_GetDonationExport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationExportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationServiceServer).GetDonationExport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationService_GetDonationExport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationServiceServer).GetDonationExport(ctx, req.(*GetDonationExportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationService_DownloadDonationExport_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetDonationExportRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DonationServiceServer).DownloadDonationExport(m, &grpc.GenericServerStream[GetDonationExportRequest, ExportChunk]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DonationService_DownloadDonationExportServer = grpc.ServerStreamingServer[ExportChunk]
+
+// DonationService_ServiceDesc is the grpc.ServiceDesc for DonationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DonationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "donation.DonationService",
+	HandlerType: (*DonationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
 		{
 			MethodName: "CreateDonation",
 			Handler:    _DonationService_CreateDonation_Handler,
 		},
+		{
+			MethodName: "CreateSplitShares",
+			Handler:    _DonationService_CreateSplitShares_Handler,
+		},
 		{
 			MethodName: "GetDonation",
 			Handler:    _DonationService_GetDonation_Handler,
 		},
+		{
+			MethodName: "GetDonationByTransactionID",
+			Handler:    _DonationService_GetDonationByTransactionID_Handler,
+		},
 		{
 			MethodName: "GetDonationsByStreamer",
 			Handler:    _DonationService_GetDonationsByStreamer_Handler,
 		},
+		{
+			MethodName: "GetDonationsByDonator",
+			Handler:    _DonationService_GetDonationsByDonator_Handler,
+		},
+		{
+			MethodName: "GetDonations",
+			Handler:    _DonationService_GetDonations_Handler,
+		},
+		{
+			MethodName: "GetLatestDonations",
+			Handler:    _DonationService_GetLatestDonations_Handler,
+		},
+		{
+			MethodName: "ListDonations",
+			Handler:    _DonationService_ListDonations_Handler,
+		},
 		{
 			MethodName: "UpdateDonationStatus",
 			Handler:    _DonationService_UpdateDonationStatus_Handler,
 		},
+		{
+			MethodName: "GetDonationStatusHistory",
+			Handler:    _DonationService_GetDonationStatusHistory_Handler,
+		},
+		{
+			MethodName: "ProcessDonationPayment",
+			Handler:    _DonationService_ProcessDonationPayment_Handler,
+		},
+		{
+			MethodName: "GetStreamerDonationTotal",
+			Handler:    _DonationService_GetStreamerDonationTotal_Handler,
+		},
 		{
 			MethodName: "GetDonationStats",
 			Handler:    _DonationService_GetDonationStats_Handler,
 		},
+		{
+			MethodName: "RefundDonation",
+			Handler:    _DonationService_RefundDonation_Handler,
+		},
+		{
+			MethodName: "ListDonationRefunds",
+			Handler:    _DonationService_ListDonationRefunds_Handler,
+		},
+		{
+			MethodName: "GetDonationLeaderboard",
+			Handler:    _DonationService_GetDonationLeaderboard_Handler,
+		},
+		{
+			MethodName: "CreateDonationExport",
+			Handler:    _DonationService_CreateDonationExport_Handler,
+		},
+		{
+			MethodName: "GetDonationExport",
+			Handler:    _DonationService_GetDonationExport_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -326,6 +960,16 @@ var DonationService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DonationService_StreamDonationEvents_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportDonations",
+			Handler:       _DonationService_ExportDonations_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DownloadDonationExport",
+			Handler:       _DonationService_DownloadDonationExport_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "proto/donation.proto",
 }
@@ -669,3 +1313,1589 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 	},
 	Metadata: "proto/donation.proto",
 }
+
+const (
+	DonationGoalService_CreateDonationGoal_FullMethodName = "/donation.DonationGoalService/CreateDonationGoal"
+	DonationGoalService_UpdateDonationGoal_FullMethodName = "/donation.DonationGoalService/UpdateDonationGoal"
+	DonationGoalService_DeleteDonationGoal_FullMethodName = "/donation.DonationGoalService/DeleteDonationGoal"
+	DonationGoalService_GetDonationGoal_FullMethodName    = "/donation.DonationGoalService/GetDonationGoal"
+	DonationGoalService_ListDonationGoals_FullMethodName  = "/donation.DonationGoalService/ListDonationGoals"
+)
+
+// DonationGoalServiceClient is the client API for DonationGoalService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Donation goal service for streamer progress bars
+type DonationGoalServiceClient interface {
+	CreateDonationGoal(ctx context.Context, in *CreateDonationGoalRequest, opts ...grpc.CallOption) (*DonationGoalResponse, error)
+	UpdateDonationGoal(ctx context.Context, in *UpdateDonationGoalRequest, opts ...grpc.CallOption) (*DonationGoalResponse, error)
+	DeleteDonationGoal(ctx context.Context, in *DeleteDonationGoalRequest, opts ...grpc.CallOption) (*DeleteDonationGoalResponse, error)
+	GetDonationGoal(ctx context.Context, in *GetDonationGoalRequest, opts ...grpc.CallOption) (*DonationGoalResponse, error)
+	ListDonationGoals(ctx context.Context, in *ListDonationGoalsRequest, opts ...grpc.CallOption) (*ListDonationGoalsResponse, error)
+}
+
+type donationGoalServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDonationGoalServiceClient(cc grpc.ClientConnInterface) DonationGoalServiceClient {
+	return &donationGoalServiceClient{cc}
+}
+
+func (c *donationGoalServiceClient) CreateDonationGoal(ctx context.Context, in *CreateDonationGoalRequest, opts ...grpc.CallOption) (*DonationGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DonationGoalResponse)
+	err := c.cc.Invoke(ctx, DonationGoalService_CreateDonationGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationGoalServiceClient) UpdateDonationGoal(ctx context.Context, in *UpdateDonationGoalRequest, opts ...grpc.CallOption) (*DonationGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DonationGoalResponse)
+	err := c.cc.Invoke(ctx, DonationGoalService_UpdateDonationGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationGoalServiceClient) DeleteDonationGoal(ctx context.Context, in *DeleteDonationGoalRequest, opts ...grpc.CallOption) (*DeleteDonationGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteDonationGoalResponse)
+	err := c.cc.Invoke(ctx, DonationGoalService_DeleteDonationGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationGoalServiceClient) GetDonationGoal(ctx context.Context, in *GetDonationGoalRequest, opts ...grpc.CallOption) (*DonationGoalResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DonationGoalResponse)
+	err := c.cc.Invoke(ctx, DonationGoalService_GetDonationGoal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *donationGoalServiceClient) ListDonationGoals(ctx context.Context, in *ListDonationGoalsRequest, opts ...grpc.CallOption) (*ListDonationGoalsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDonationGoalsResponse)
+	err := c.cc.Invoke(ctx, DonationGoalService_ListDonationGoals_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DonationGoalServiceServer is the server API for DonationGoalService service.
+// All implementations must embed UnimplementedDonationGoalServiceServer
+// for forward compatibility.
+//
+// Donation goal service for streamer progress bars
+type DonationGoalServiceServer interface {
+	CreateDonationGoal(context.Context, *CreateDonationGoalRequest) (*DonationGoalResponse, error)
+	UpdateDonationGoal(context.Context, *UpdateDonationGoalRequest) (*DonationGoalResponse, error)
+	DeleteDonationGoal(context.Context, *DeleteDonationGoalRequest) (*DeleteDonationGoalResponse, error)
+	GetDonationGoal(context.Context, *GetDonationGoalRequest) (*DonationGoalResponse, error)
+	ListDonationGoals(context.Context, *ListDonationGoalsRequest) (*ListDonationGoalsResponse, error)
+	mustEmbedUnimplementedDonationGoalServiceServer()
+}
+
+// UnimplementedDonationGoalServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDonationGoalServiceServer struct{}
+
+func (UnimplementedDonationGoalServiceServer) CreateDonationGoal(context.Context, *CreateDonationGoalRequest) (*DonationGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDonationGoal not implemented")
+}
+func (UnimplementedDonationGoalServiceServer) UpdateDonationGoal(context.Context, *UpdateDonationGoalRequest) (*DonationGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDonationGoal not implemented")
+}
+func (UnimplementedDonationGoalServiceServer) DeleteDonationGoal(context.Context, *DeleteDonationGoalRequest) (*DeleteDonationGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDonationGoal not implemented")
+}
+func (UnimplementedDonationGoalServiceServer) GetDonationGoal(context.Context, *GetDonationGoalRequest) (*DonationGoalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDonationGoal not implemented")
+}
+func (UnimplementedDonationGoalServiceServer) ListDonationGoals(context.Context, *ListDonationGoalsRequest) (*ListDonationGoalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDonationGoals not implemented")
+}
+func (UnimplementedDonationGoalServiceServer) mustEmbedUnimplementedDonationGoalServiceServer() {}
+func (UnimplementedDonationGoalServiceServer) testEmbeddedByValue()                             {}
+
+// UnsafeDonationGoalServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DonationGoalServiceServer will
+// result in compilation errors.
+type UnsafeDonationGoalServiceServer interface {
+	mustEmbedUnimplementedDonationGoalServiceServer()
+}
+
+func RegisterDonationGoalServiceServer(s grpc.ServiceRegistrar, srv DonationGoalServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDonationGoalServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DonationGoalService_ServiceDesc, srv)
+}
+
+func _DonationGoalService_CreateDonationGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDonationGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationGoalServiceServer).CreateDonationGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationGoalService_CreateDonationGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationGoalServiceServer).CreateDonationGoal(ctx, req.(*CreateDonationGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationGoalService_UpdateDonationGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDonationGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationGoalServiceServer).UpdateDonationGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationGoalService_UpdateDonationGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationGoalServiceServer).UpdateDonationGoal(ctx, req.(*UpdateDonationGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationGoalService_DeleteDonationGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDonationGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationGoalServiceServer).DeleteDonationGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationGoalService_DeleteDonationGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationGoalServiceServer).DeleteDonationGoal(ctx, req.(*DeleteDonationGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationGoalService_GetDonationGoal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDonationGoalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationGoalServiceServer).GetDonationGoal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationGoalService_GetDonationGoal_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationGoalServiceServer).GetDonationGoal(ctx, req.(*GetDonationGoalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DonationGoalService_ListDonationGoals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDonationGoalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DonationGoalServiceServer).ListDonationGoals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DonationGoalService_ListDonationGoals_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DonationGoalServiceServer).ListDonationGoals(ctx, req.(*ListDonationGoalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DonationGoalService_ServiceDesc is the grpc.ServiceDesc for DonationGoalService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DonationGoalService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "donation.DonationGoalService",
+	HandlerType: (*DonationGoalServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDonationGoal",
+			Handler:    _DonationGoalService_CreateDonationGoal_Handler,
+		},
+		{
+			MethodName: "UpdateDonationGoal",
+			Handler:    _DonationGoalService_UpdateDonationGoal_Handler,
+		},
+		{
+			MethodName: "DeleteDonationGoal",
+			Handler:    _DonationGoalService_DeleteDonationGoal_Handler,
+		},
+		{
+			MethodName: "GetDonationGoal",
+			Handler:    _DonationGoalService_GetDonationGoal_Handler,
+		},
+		{
+			MethodName: "ListDonationGoals",
+			Handler:    _DonationGoalService_ListDonationGoals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/donation.proto",
+}
+
+const (
+	ModerationService_GetModerationSettings_FullMethodName    = "/donation.ModerationService/GetModerationSettings"
+	ModerationService_UpdateModerationSettings_FullMethodName = "/donation.ModerationService/UpdateModerationSettings"
+	ModerationService_ListMessageReviews_FullMethodName       = "/donation.ModerationService/ListMessageReviews"
+	ModerationService_ApproveMessage_FullMethodName           = "/donation.ModerationService/ApproveMessage"
+	ModerationService_RejectMessage_FullMethodName            = "/donation.ModerationService/RejectMessage"
+	ModerationService_ListBlockedTerms_FullMethodName         = "/donation.ModerationService/ListBlockedTerms"
+	ModerationService_AddBlockedTerm_FullMethodName           = "/donation.ModerationService/AddBlockedTerm"
+	ModerationService_RemoveBlockedTerm_FullMethodName        = "/donation.ModerationService/RemoveBlockedTerm"
+)
+
+// ModerationServiceClient is the client API for ModerationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Moderation service for streamer message rules, the review queue and the global blocklist
+type ModerationServiceClient interface {
+	GetModerationSettings(ctx context.Context, in *GetModerationSettingsRequest, opts ...grpc.CallOption) (*ModerationSettingsResponse, error)
+	UpdateModerationSettings(ctx context.Context, in *UpdateModerationSettingsRequest, opts ...grpc.CallOption) (*ModerationSettingsResponse, error)
+	ListMessageReviews(ctx context.Context, in *ListMessageReviewsRequest, opts ...grpc.CallOption) (*ListMessageReviewsResponse, error)
+	ApproveMessage(ctx context.Context, in *ResolveMessageReviewRequest, opts ...grpc.CallOption) (*MessageReviewResponse, error)
+	RejectMessage(ctx context.Context, in *ResolveMessageReviewRequest, opts ...grpc.CallOption) (*MessageReviewResponse, error)
+	ListBlockedTerms(ctx context.Context, in *ListBlockedTermsRequest, opts ...grpc.CallOption) (*ListBlockedTermsResponse, error)
+	AddBlockedTerm(ctx context.Context, in *AddBlockedTermRequest, opts ...grpc.CallOption) (*BlockedTermResponse, error)
+	RemoveBlockedTerm(ctx context.Context, in *RemoveBlockedTermRequest, opts ...grpc.CallOption) (*RemoveBlockedTermResponse, error)
+}
+
+type moderationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewModerationServiceClient(cc grpc.ClientConnInterface) ModerationServiceClient {
+	return &moderationServiceClient{cc}
+}
+
+func (c *moderationServiceClient) GetModerationSettings(ctx context.Context, in *GetModerationSettingsRequest, opts ...grpc.CallOption) (*ModerationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationSettingsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_GetModerationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) UpdateModerationSettings(ctx context.Context, in *UpdateModerationSettingsRequest, opts ...grpc.CallOption) (*ModerationSettingsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ModerationSettingsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_UpdateModerationSettings_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ListMessageReviews(ctx context.Context, in *ListMessageReviewsRequest, opts ...grpc.CallOption) (*ListMessageReviewsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMessageReviewsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListMessageReviews_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ApproveMessage(ctx context.Context, in *ResolveMessageReviewRequest, opts ...grpc.CallOption) (*MessageReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageReviewResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ApproveMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) RejectMessage(ctx context.Context, in *ResolveMessageReviewRequest, opts ...grpc.CallOption) (*MessageReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MessageReviewResponse)
+	err := c.cc.Invoke(ctx, ModerationService_RejectMessage_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) ListBlockedTerms(ctx context.Context, in *ListBlockedTermsRequest, opts ...grpc.CallOption) (*ListBlockedTermsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedTermsResponse)
+	err := c.cc.Invoke(ctx, ModerationService_ListBlockedTerms_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) AddBlockedTerm(ctx context.Context, in *AddBlockedTermRequest, opts ...grpc.CallOption) (*BlockedTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockedTermResponse)
+	err := c.cc.Invoke(ctx, ModerationService_AddBlockedTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *moderationServiceClient) RemoveBlockedTerm(ctx context.Context, in *RemoveBlockedTermRequest, opts ...grpc.CallOption) (*RemoveBlockedTermResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RemoveBlockedTermResponse)
+	err := c.cc.Invoke(ctx, ModerationService_RemoveBlockedTerm_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ModerationServiceServer is the server API for ModerationService service.
+// All implementations must embed UnimplementedModerationServiceServer
+// for forward compatibility.
+//
+// Moderation service for streamer message rules, the review queue and the global blocklist
+type ModerationServiceServer interface {
+	GetModerationSettings(context.Context, *GetModerationSettingsRequest) (*ModerationSettingsResponse, error)
+	UpdateModerationSettings(context.Context, *UpdateModerationSettingsRequest) (*ModerationSettingsResponse, error)
+	ListMessageReviews(context.Context, *ListMessageReviewsRequest) (*ListMessageReviewsResponse, error)
+	ApproveMessage(context.Context, *ResolveMessageReviewRequest) (*MessageReviewResponse, error)
+	RejectMessage(context.Context, *ResolveMessageReviewRequest) (*MessageReviewResponse, error)
+	ListBlockedTerms(context.Context, *ListBlockedTermsRequest) (*ListBlockedTermsResponse, error)
+	AddBlockedTerm(context.Context, *AddBlockedTermRequest) (*BlockedTermResponse, error)
+	RemoveBlockedTerm(context.Context, *RemoveBlockedTermRequest) (*RemoveBlockedTermResponse, error)
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+// UnimplementedModerationServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedModerationServiceServer struct{}
+
+func (UnimplementedModerationServiceServer) GetModerationSettings(context.Context, *GetModerationSettingsRequest) (*ModerationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetModerationSettings not implemented")
+}
+func (UnimplementedModerationServiceServer) UpdateModerationSettings(context.Context, *UpdateModerationSettingsRequest) (*ModerationSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateModerationSettings not implemented")
+}
+func (UnimplementedModerationServiceServer) ListMessageReviews(context.Context, *ListMessageReviewsRequest) (*ListMessageReviewsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMessageReviews not implemented")
+}
+func (UnimplementedModerationServiceServer) ApproveMessage(context.Context, *ResolveMessageReviewRequest) (*MessageReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveMessage not implemented")
+}
+func (UnimplementedModerationServiceServer) RejectMessage(context.Context, *ResolveMessageReviewRequest) (*MessageReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectMessage not implemented")
+}
+func (UnimplementedModerationServiceServer) ListBlockedTerms(context.Context, *ListBlockedTermsRequest) (*ListBlockedTermsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBlockedTerms not implemented")
+}
+func (UnimplementedModerationServiceServer) AddBlockedTerm(context.Context, *AddBlockedTermRequest) (*BlockedTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddBlockedTerm not implemented")
+}
+func (UnimplementedModerationServiceServer) RemoveBlockedTerm(context.Context, *RemoveBlockedTermRequest) (*RemoveBlockedTermResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveBlockedTerm not implemented")
+}
+func (UnimplementedModerationServiceServer) mustEmbedUnimplementedModerationServiceServer() {}
+func (UnimplementedModerationServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeModerationServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ModerationServiceServer will
+// result in compilation errors.
+type UnsafeModerationServiceServer interface {
+	mustEmbedUnimplementedModerationServiceServer()
+}
+
+func RegisterModerationServiceServer(s grpc.ServiceRegistrar, srv ModerationServiceServer) {
+	// If the following call pancis, it indicates UnimplementedModerationServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ModerationService_ServiceDesc, srv)
+}
+
+func _ModerationService_GetModerationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetModerationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).GetModerationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_GetModerationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).GetModerationSettings(ctx, req.(*GetModerationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_UpdateModerationSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateModerationSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).UpdateModerationSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_UpdateModerationSettings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).UpdateModerationSettings(ctx, req.(*UpdateModerationSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ListMessageReviews_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMessageReviewsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListMessageReviews(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListMessageReviews_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListMessageReviews(ctx, req.(*ListMessageReviewsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ApproveMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveMessageReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ApproveMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ApproveMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ApproveMessage(ctx, req.(*ResolveMessageReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_RejectMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveMessageReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).RejectMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_RejectMessage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).RejectMessage(ctx, req.(*ResolveMessageReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_ListBlockedTerms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedTermsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).ListBlockedTerms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_ListBlockedTerms_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).ListBlockedTerms(ctx, req.(*ListBlockedTermsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_AddBlockedTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddBlockedTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).AddBlockedTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_AddBlockedTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).AddBlockedTerm(ctx, req.(*AddBlockedTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ModerationService_RemoveBlockedTerm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveBlockedTermRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ModerationServiceServer).RemoveBlockedTerm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ModerationService_RemoveBlockedTerm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ModerationServiceServer).RemoveBlockedTerm(ctx, req.(*RemoveBlockedTermRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ModerationService_ServiceDesc is the grpc.ServiceDesc for ModerationService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ModerationService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "donation.ModerationService",
+	HandlerType: (*ModerationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetModerationSettings",
+			Handler:    _ModerationService_GetModerationSettings_Handler,
+		},
+		{
+			MethodName: "UpdateModerationSettings",
+			Handler:    _ModerationService_UpdateModerationSettings_Handler,
+		},
+		{
+			MethodName: "ListMessageReviews",
+			Handler:    _ModerationService_ListMessageReviews_Handler,
+		},
+		{
+			MethodName: "ApproveMessage",
+			Handler:    _ModerationService_ApproveMessage_Handler,
+		},
+		{
+			MethodName: "RejectMessage",
+			Handler:    _ModerationService_RejectMessage_Handler,
+		},
+		{
+			MethodName: "ListBlockedTerms",
+			Handler:    _ModerationService_ListBlockedTerms_Handler,
+		},
+		{
+			MethodName: "AddBlockedTerm",
+			Handler:    _ModerationService_AddBlockedTerm_Handler,
+		},
+		{
+			MethodName: "RemoveBlockedTerm",
+			Handler:    _ModerationService_RemoveBlockedTerm_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/donation.proto",
+}
+
+const (
+	LedgerService_GetStreamerBalance_FullMethodName = "/donation.LedgerService/GetStreamerBalance"
+	LedgerService_ListLedgerEntries_FullMethodName  = "/donation.LedgerService/ListLedgerEntries"
+)
+
+// LedgerServiceClient is the client API for LedgerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Ledger service for what the platform owes each streamer
+type LedgerServiceClient interface {
+	GetStreamerBalance(ctx context.Context, in *GetStreamerBalanceRequest, opts ...grpc.CallOption) (*GetStreamerBalanceResponse, error)
+	ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error)
+}
+
+type ledgerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLedgerServiceClient(cc grpc.ClientConnInterface) LedgerServiceClient {
+	return &ledgerServiceClient{cc}
+}
+
+func (c *ledgerServiceClient) GetStreamerBalance(ctx context.Context, in *GetStreamerBalanceRequest, opts ...grpc.CallOption) (*GetStreamerBalanceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetStreamerBalanceResponse)
+	err := c.cc.Invoke(ctx, LedgerService_GetStreamerBalance_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *ledgerServiceClient) ListLedgerEntries(ctx context.Context, in *ListLedgerEntriesRequest, opts ...grpc.CallOption) (*ListLedgerEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLedgerEntriesResponse)
+	err := c.cc.Invoke(ctx, LedgerService_ListLedgerEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LedgerServiceServer is the server API for LedgerService service.
+// All implementations must embed UnimplementedLedgerServiceServer
+// for forward compatibility.
+//
+// Ledger service for what the platform owes each streamer
+type LedgerServiceServer interface {
+	GetStreamerBalance(context.Context, *GetStreamerBalanceRequest) (*GetStreamerBalanceResponse, error)
+	ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error)
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+// UnimplementedLedgerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedLedgerServiceServer struct{}
+
+func (UnimplementedLedgerServiceServer) GetStreamerBalance(context.Context, *GetStreamerBalanceRequest) (*GetStreamerBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStreamerBalance not implemented")
+}
+func (UnimplementedLedgerServiceServer) ListLedgerEntries(context.Context, *ListLedgerEntriesRequest) (*ListLedgerEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLedgerEntries not implemented")
+}
+func (UnimplementedLedgerServiceServer) mustEmbedUnimplementedLedgerServiceServer() {}
+func (UnimplementedLedgerServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafeLedgerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LedgerServiceServer will
+// result in compilation errors.
+type UnsafeLedgerServiceServer interface {
+	mustEmbedUnimplementedLedgerServiceServer()
+}
+
+func RegisterLedgerServiceServer(s grpc.ServiceRegistrar, srv LedgerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedLedgerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&LedgerService_ServiceDesc, srv)
+}
+
+func _LedgerService_GetStreamerBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStreamerBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).GetStreamerBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_GetStreamerBalance_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).GetStreamerBalance(ctx, req.(*GetStreamerBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LedgerService_ListLedgerEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLedgerEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LedgerServiceServer).ListLedgerEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LedgerService_ListLedgerEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LedgerServiceServer).ListLedgerEntries(ctx, req.(*ListLedgerEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// LedgerService_ServiceDesc is the grpc.ServiceDesc for LedgerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var LedgerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "donation.LedgerService",
+	HandlerType: (*LedgerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetStreamerBalance",
+			Handler:    _LedgerService_GetStreamerBalance_Handler,
+		},
+		{
+			MethodName: "ListLedgerEntries",
+			Handler:    _LedgerService_ListLedgerEntries_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/donation.proto",
+}
+
+const (
+	PayoutService_CreatePayoutAccount_FullMethodName = "/donation.PayoutService/CreatePayoutAccount"
+	PayoutService_ListPayoutAccounts_FullMethodName  = "/donation.PayoutService/ListPayoutAccounts"
+	PayoutService_DeletePayoutAccount_FullMethodName = "/donation.PayoutService/DeletePayoutAccount"
+	PayoutService_RequestPayout_FullMethodName       = "/donation.PayoutService/RequestPayout"
+	PayoutService_GetPayout_FullMethodName           = "/donation.PayoutService/GetPayout"
+	PayoutService_ListPayouts_FullMethodName         = "/donation.PayoutService/ListPayouts"
+	PayoutService_ApprovePayout_FullMethodName       = "/donation.PayoutService/ApprovePayout"
+	PayoutService_RejectPayout_FullMethodName        = "/donation.PayoutService/RejectPayout"
+	PayoutService_CompletePayout_FullMethodName      = "/donation.PayoutService/CompletePayout"
+	PayoutService_FailPayout_FullMethodName          = "/donation.PayoutService/FailPayout"
+)
+
+// PayoutServiceClient is the client API for PayoutService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Payout service: streamers withdraw their balance, admins approve
+type PayoutServiceClient interface {
+	CreatePayoutAccount(ctx context.Context, in *CreatePayoutAccountRequest, opts ...grpc.CallOption) (*PayoutAccount, error)
+	ListPayoutAccounts(ctx context.Context, in *ListPayoutAccountsRequest, opts ...grpc.CallOption) (*ListPayoutAccountsResponse, error)
+	DeletePayoutAccount(ctx context.Context, in *DeletePayoutAccountRequest, opts ...grpc.CallOption) (*DeletePayoutAccountResponse, error)
+	RequestPayout(ctx context.Context, in *RequestPayoutRequest, opts ...grpc.CallOption) (*Payout, error)
+	GetPayout(ctx context.Context, in *GetPayoutRequest, opts ...grpc.CallOption) (*Payout, error)
+	ListPayouts(ctx context.Context, in *ListPayoutsRequest, opts ...grpc.CallOption) (*ListPayoutsResponse, error)
+	ApprovePayout(ctx context.Context, in *ApprovePayoutRequest, opts ...grpc.CallOption) (*Payout, error)
+	RejectPayout(ctx context.Context, in *RejectPayoutRequest, opts ...grpc.CallOption) (*Payout, error)
+	CompletePayout(ctx context.Context, in *CompletePayoutRequest, opts ...grpc.CallOption) (*Payout, error)
+	FailPayout(ctx context.Context, in *FailPayoutRequest, opts ...grpc.CallOption) (*Payout, error)
+}
+
+type payoutServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPayoutServiceClient(cc grpc.ClientConnInterface) PayoutServiceClient {
+	return &payoutServiceClient{cc}
+}
+
+func (c *payoutServiceClient) CreatePayoutAccount(ctx context.Context, in *CreatePayoutAccountRequest, opts ...grpc.CallOption) (*PayoutAccount, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PayoutAccount)
+	err := c.cc.Invoke(ctx, PayoutService_CreatePayoutAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) ListPayoutAccounts(ctx context.Context, in *ListPayoutAccountsRequest, opts ...grpc.CallOption) (*ListPayoutAccountsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPayoutAccountsResponse)
+	err := c.cc.Invoke(ctx, PayoutService_ListPayoutAccounts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) DeletePayoutAccount(ctx context.Context, in *DeletePayoutAccountRequest, opts ...grpc.CallOption) (*DeletePayoutAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeletePayoutAccountResponse)
+	err := c.cc.Invoke(ctx, PayoutService_DeletePayoutAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) RequestPayout(ctx context.Context, in *RequestPayoutRequest, opts ...grpc.CallOption) (*Payout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payout)
+	err := c.cc.Invoke(ctx, PayoutService_RequestPayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) GetPayout(ctx context.Context, in *GetPayoutRequest, opts ...grpc.CallOption) (*Payout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payout)
+	err := c.cc.Invoke(ctx, PayoutService_GetPayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) ListPayouts(ctx context.Context, in *ListPayoutsRequest, opts ...grpc.CallOption) (*ListPayoutsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListPayoutsResponse)
+	err := c.cc.Invoke(ctx, PayoutService_ListPayouts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) ApprovePayout(ctx context.Context, in *ApprovePayoutRequest, opts ...grpc.CallOption) (*Payout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payout)
+	err := c.cc.Invoke(ctx, PayoutService_ApprovePayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) RejectPayout(ctx context.Context, in *RejectPayoutRequest, opts ...grpc.CallOption) (*Payout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payout)
+	err := c.cc.Invoke(ctx, PayoutService_RejectPayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) CompletePayout(ctx context.Context, in *CompletePayoutRequest, opts ...grpc.CallOption) (*Payout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payout)
+	err := c.cc.Invoke(ctx, PayoutService_CompletePayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *payoutServiceClient) FailPayout(ctx context.Context, in *FailPayoutRequest, opts ...grpc.CallOption) (*Payout, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Payout)
+	err := c.cc.Invoke(ctx, PayoutService_FailPayout_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PayoutServiceServer is the server API for PayoutService service.
+// All implementations must embed UnimplementedPayoutServiceServer
+// for forward compatibility.
+//
+// Payout service: streamers withdraw their balance, admins approve
+type PayoutServiceServer interface {
+	CreatePayoutAccount(context.Context, *CreatePayoutAccountRequest) (*PayoutAccount, error)
+	ListPayoutAccounts(context.Context, *ListPayoutAccountsRequest) (*ListPayoutAccountsResponse, error)
+	DeletePayoutAccount(context.Context, *DeletePayoutAccountRequest) (*DeletePayoutAccountResponse, error)
+	RequestPayout(context.Context, *RequestPayoutRequest) (*Payout, error)
+	GetPayout(context.Context, *GetPayoutRequest) (*Payout, error)
+	ListPayouts(context.Context, *ListPayoutsRequest) (*ListPayoutsResponse, error)
+	ApprovePayout(context.Context, *ApprovePayoutRequest) (*Payout, error)
+	RejectPayout(context.Context, *RejectPayoutRequest) (*Payout, error)
+	CompletePayout(context.Context, *CompletePayoutRequest) (*Payout, error)
+	FailPayout(context.Context, *FailPayoutRequest) (*Payout, error)
+	mustEmbedUnimplementedPayoutServiceServer()
+}
+
+// UnimplementedPayoutServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPayoutServiceServer struct{}
+
+func (UnimplementedPayoutServiceServer) CreatePayoutAccount(context.Context, *CreatePayoutAccountRequest) (*PayoutAccount, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePayoutAccount not implemented")
+}
+func (UnimplementedPayoutServiceServer) ListPayoutAccounts(context.Context, *ListPayoutAccountsRequest) (*ListPayoutAccountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayoutAccounts not implemented")
+}
+func (UnimplementedPayoutServiceServer) DeletePayoutAccount(context.Context, *DeletePayoutAccountRequest) (*DeletePayoutAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePayoutAccount not implemented")
+}
+func (UnimplementedPayoutServiceServer) RequestPayout(context.Context, *RequestPayoutRequest) (*Payout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestPayout not implemented")
+}
+func (UnimplementedPayoutServiceServer) GetPayout(context.Context, *GetPayoutRequest) (*Payout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPayout not implemented")
+}
+func (UnimplementedPayoutServiceServer) ListPayouts(context.Context, *ListPayoutsRequest) (*ListPayoutsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPayouts not implemented")
+}
+func (UnimplementedPayoutServiceServer) ApprovePayout(context.Context, *ApprovePayoutRequest) (*Payout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApprovePayout not implemented")
+}
+func (UnimplementedPayoutServiceServer) RejectPayout(context.Context, *RejectPayoutRequest) (*Payout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectPayout not implemented")
+}
+func (UnimplementedPayoutServiceServer) CompletePayout(context.Context, *CompletePayoutRequest) (*Payout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompletePayout not implemented")
+}
+func (UnimplementedPayoutServiceServer) FailPayout(context.Context, *FailPayoutRequest) (*Payout, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FailPayout not implemented")
+}
+func (UnimplementedPayoutServiceServer) mustEmbedUnimplementedPayoutServiceServer() {}
+func (UnimplementedPayoutServiceServer) testEmbeddedByValue()                       {}
+
+// UnsafePayoutServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PayoutServiceServer will
+// result in compilation errors.
+type UnsafePayoutServiceServer interface {
+	mustEmbedUnimplementedPayoutServiceServer()
+}
+
+func RegisterPayoutServiceServer(s grpc.ServiceRegistrar, srv PayoutServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPayoutServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PayoutService_ServiceDesc, srv)
+}
+
+func _PayoutService_CreatePayoutAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePayoutAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).CreatePayoutAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_CreatePayoutAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).CreatePayoutAccount(ctx, req.(*CreatePayoutAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_ListPayoutAccounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayoutAccountsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).ListPayoutAccounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_ListPayoutAccounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).ListPayoutAccounts(ctx, req.(*ListPayoutAccountsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_DeletePayoutAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePayoutAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).DeletePayoutAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_DeletePayoutAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).DeletePayoutAccount(ctx, req.(*DeletePayoutAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_RequestPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).RequestPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_RequestPayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).RequestPayout(ctx, req.(*RequestPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_GetPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).GetPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_GetPayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).GetPayout(ctx, req.(*GetPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_ListPayouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPayoutsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).ListPayouts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_ListPayouts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).ListPayouts(ctx, req.(*ListPayoutsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_ApprovePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApprovePayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).ApprovePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_ApprovePayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).ApprovePayout(ctx, req.(*ApprovePayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_RejectPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RejectPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).RejectPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_RejectPayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).RejectPayout(ctx, req.(*RejectPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_CompletePayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompletePayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).CompletePayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_CompletePayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).CompletePayout(ctx, req.(*CompletePayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PayoutService_FailPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FailPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PayoutServiceServer).FailPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PayoutService_FailPayout_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PayoutServiceServer).FailPayout(ctx, req.(*FailPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PayoutService_ServiceDesc is the grpc.ServiceDesc for PayoutService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PayoutService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "donation.PayoutService",
+	HandlerType: (*PayoutServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePayoutAccount",
+			Handler:    _PayoutService_CreatePayoutAccount_Handler,
+		},
+		{
+			MethodName: "ListPayoutAccounts",
+			Handler:    _PayoutService_ListPayoutAccounts_Handler,
+		},
+		{
+			MethodName: "DeletePayoutAccount",
+			Handler:    _PayoutService_DeletePayoutAccount_Handler,
+		},
+		{
+			MethodName: "RequestPayout",
+			Handler:    _PayoutService_RequestPayout_Handler,
+		},
+		{
+			MethodName: "GetPayout",
+			Handler:    _PayoutService_GetPayout_Handler,
+		},
+		{
+			MethodName: "ListPayouts",
+			Handler:    _PayoutService_ListPayouts_Handler,
+		},
+		{
+			MethodName: "ApprovePayout",
+			Handler:    _PayoutService_ApprovePayout_Handler,
+		},
+		{
+			MethodName: "RejectPayout",
+			Handler:    _PayoutService_RejectPayout_Handler,
+		},
+		{
+			MethodName: "CompletePayout",
+			Handler:    _PayoutService_CompletePayout_Handler,
+		},
+		{
+			MethodName: "FailPayout",
+			Handler:    _PayoutService_FailPayout_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/donation.proto",
+}
+
+const (
+	RiskService_ListRiskAssessments_FullMethodName = "/donation.RiskService/ListRiskAssessments"
+)
+
+// RiskServiceClient is the client API for RiskService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Risk service: admins review donation requests screened for fraud
+type RiskServiceClient interface {
+	ListRiskAssessments(ctx context.Context, in *ListRiskAssessmentsRequest, opts ...grpc.CallOption) (*ListRiskAssessmentsResponse, error)
+}
+
+type riskServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewRiskServiceClient(cc grpc.ClientConnInterface) RiskServiceClient {
+	return &riskServiceClient{cc}
+}
+
+func (c *riskServiceClient) ListRiskAssessments(ctx context.Context, in *ListRiskAssessmentsRequest, opts ...grpc.CallOption) (*ListRiskAssessmentsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListRiskAssessmentsResponse)
+	err := c.cc.Invoke(ctx, RiskService_ListRiskAssessments_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RiskServiceServer is the server API for RiskService service.
+// All implementations must embed UnimplementedRiskServiceServer
+// for forward compatibility.
+//
+// Risk service: admins review donation requests screened for fraud
+type RiskServiceServer interface {
+	ListRiskAssessments(context.Context, *ListRiskAssessmentsRequest) (*ListRiskAssessmentsResponse, error)
+	mustEmbedUnimplementedRiskServiceServer()
+}
+
+// UnimplementedRiskServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedRiskServiceServer struct{}
+
+func (UnimplementedRiskServiceServer) ListRiskAssessments(context.Context, *ListRiskAssessmentsRequest) (*ListRiskAssessmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRiskAssessments not implemented")
+}
+func (UnimplementedRiskServiceServer) mustEmbedUnimplementedRiskServiceServer() {}
+func (UnimplementedRiskServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeRiskServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to RiskServiceServer will
+// result in compilation errors.
+type UnsafeRiskServiceServer interface {
+	mustEmbedUnimplementedRiskServiceServer()
+}
+
+func RegisterRiskServiceServer(s grpc.ServiceRegistrar, srv RiskServiceServer) {
+	// If the following call pancis, it indicates UnimplementedRiskServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&RiskService_ServiceDesc, srv)
+}
+
+func _RiskService_ListRiskAssessments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRiskAssessmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RiskServiceServer).ListRiskAssessments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RiskService_ListRiskAssessments_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RiskServiceServer).ListRiskAssessments(ctx, req.(*ListRiskAssessmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// RiskService_ServiceDesc is the grpc.ServiceDesc for RiskService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var RiskService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "donation.RiskService",
+	HandlerType: (*RiskServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListRiskAssessments",
+			Handler:    _RiskService_ListRiskAssessments_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/donation.proto",
+}
+
+const (
+	MatchCampaignService_CreateMatchCampaign_FullMethodName    = "/donation.MatchCampaignService/CreateMatchCampaign"
+	MatchCampaignService_UpdateMatchCampaign_FullMethodName    = "/donation.MatchCampaignService/UpdateMatchCampaign"
+	MatchCampaignService_GetMatchCampaign_FullMethodName       = "/donation.MatchCampaignService/GetMatchCampaign"
+	MatchCampaignService_ListMatchCampaigns_FullMethodName     = "/donation.MatchCampaignService/ListMatchCampaigns"
+	MatchCampaignService_GetMatchCampaignReport_FullMethodName = "/donation.MatchCampaignService/GetMatchCampaignReport"
+)
+
+// MatchCampaignServiceClient is the client API for MatchCampaignService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Match campaign service: sponsors match donations to streamers, admins set the terms
+type MatchCampaignServiceClient interface {
+	CreateMatchCampaign(ctx context.Context, in *MatchCampaignRequest, opts ...grpc.CallOption) (*MatchCampaign, error)
+	UpdateMatchCampaign(ctx context.Context, in *UpdateMatchCampaignRequest, opts ...grpc.CallOption) (*MatchCampaign, error)
+	GetMatchCampaign(ctx context.Context, in *GetMatchCampaignRequest, opts ...grpc.CallOption) (*MatchCampaign, error)
+	ListMatchCampaigns(ctx context.Context, in *ListMatchCampaignsRequest, opts ...grpc.CallOption) (*ListMatchCampaignsResponse, error)
+	GetMatchCampaignReport(ctx context.Context, in *GetMatchCampaignRequest, opts ...grpc.CallOption) (*MatchCampaignReport, error)
+}
+
+type matchCampaignServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMatchCampaignServiceClient(cc grpc.ClientConnInterface) MatchCampaignServiceClient {
+	return &matchCampaignServiceClient{cc}
+}
+
+func (c *matchCampaignServiceClient) CreateMatchCampaign(ctx context.Context, in *MatchCampaignRequest, opts ...grpc.CallOption) (*MatchCampaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchCampaign)
+	err := c.cc.Invoke(ctx, MatchCampaignService_CreateMatchCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchCampaignServiceClient) UpdateMatchCampaign(ctx context.Context, in *UpdateMatchCampaignRequest, opts ...grpc.CallOption) (*MatchCampaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchCampaign)
+	err := c.cc.Invoke(ctx, MatchCampaignService_UpdateMatchCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchCampaignServiceClient) GetMatchCampaign(ctx context.Context, in *GetMatchCampaignRequest, opts ...grpc.CallOption) (*MatchCampaign, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchCampaign)
+	err := c.cc.Invoke(ctx, MatchCampaignService_GetMatchCampaign_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchCampaignServiceClient) ListMatchCampaigns(ctx context.Context, in *ListMatchCampaignsRequest, opts ...grpc.CallOption) (*ListMatchCampaignsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListMatchCampaignsResponse)
+	err := c.cc.Invoke(ctx, MatchCampaignService_ListMatchCampaigns_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *matchCampaignServiceClient) GetMatchCampaignReport(ctx context.Context, in *GetMatchCampaignRequest, opts ...grpc.CallOption) (*MatchCampaignReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MatchCampaignReport)
+	err := c.cc.Invoke(ctx, MatchCampaignService_GetMatchCampaignReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MatchCampaignServiceServer is the server API for MatchCampaignService service.
+// All implementations must embed UnimplementedMatchCampaignServiceServer
+// for forward compatibility.
+//
+// Match campaign service: sponsors match donations to streamers, admins set the terms
+type MatchCampaignServiceServer interface {
+	CreateMatchCampaign(context.Context, *MatchCampaignRequest) (*MatchCampaign, error)
+	UpdateMatchCampaign(context.Context, *UpdateMatchCampaignRequest) (*MatchCampaign, error)
+	GetMatchCampaign(context.Context, *GetMatchCampaignRequest) (*MatchCampaign, error)
+	ListMatchCampaigns(context.Context, *ListMatchCampaignsRequest) (*ListMatchCampaignsResponse, error)
+	GetMatchCampaignReport(context.Context, *GetMatchCampaignRequest) (*MatchCampaignReport, error)
+	mustEmbedUnimplementedMatchCampaignServiceServer()
+}
+
+// UnimplementedMatchCampaignServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedMatchCampaignServiceServer struct{}
+
+func (UnimplementedMatchCampaignServiceServer) CreateMatchCampaign(context.Context, *MatchCampaignRequest) (*MatchCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMatchCampaign not implemented")
+}
+func (UnimplementedMatchCampaignServiceServer) UpdateMatchCampaign(context.Context, *UpdateMatchCampaignRequest) (*MatchCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMatchCampaign not implemented")
+}
+func (UnimplementedMatchCampaignServiceServer) GetMatchCampaign(context.Context, *GetMatchCampaignRequest) (*MatchCampaign, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchCampaign not implemented")
+}
+func (UnimplementedMatchCampaignServiceServer) ListMatchCampaigns(context.Context, *ListMatchCampaignsRequest) (*ListMatchCampaignsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMatchCampaigns not implemented")
+}
+func (UnimplementedMatchCampaignServiceServer) GetMatchCampaignReport(context.Context, *GetMatchCampaignRequest) (*MatchCampaignReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMatchCampaignReport not implemented")
+}
+func (UnimplementedMatchCampaignServiceServer) mustEmbedUnimplementedMatchCampaignServiceServer() {}
+func (UnimplementedMatchCampaignServiceServer) testEmbeddedByValue()                              {}
+
+// UnsafeMatchCampaignServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MatchCampaignServiceServer will
+// result in compilation errors.
+type UnsafeMatchCampaignServiceServer interface {
+	mustEmbedUnimplementedMatchCampaignServiceServer()
+}
+
+func RegisterMatchCampaignServiceServer(s grpc.ServiceRegistrar, srv MatchCampaignServiceServer) {
+	// If the following call pancis, it indicates UnimplementedMatchCampaignServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&MatchCampaignService_ServiceDesc, srv)
+}
+
+func _MatchCampaignService_CreateMatchCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MatchCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchCampaignServiceServer).CreateMatchCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchCampaignService_CreateMatchCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchCampaignServiceServer).CreateMatchCampaign(ctx, req.(*MatchCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchCampaignService_UpdateMatchCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMatchCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchCampaignServiceServer).UpdateMatchCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchCampaignService_UpdateMatchCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchCampaignServiceServer).UpdateMatchCampaign(ctx, req.(*UpdateMatchCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchCampaignService_GetMatchCampaign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchCampaignServiceServer).GetMatchCampaign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchCampaignService_GetMatchCampaign_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchCampaignServiceServer).GetMatchCampaign(ctx, req.(*GetMatchCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchCampaignService_ListMatchCampaigns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMatchCampaignsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchCampaignServiceServer).ListMatchCampaigns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchCampaignService_ListMatchCampaigns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchCampaignServiceServer).ListMatchCampaigns(ctx, req.(*ListMatchCampaignsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MatchCampaignService_GetMatchCampaignReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMatchCampaignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MatchCampaignServiceServer).GetMatchCampaignReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MatchCampaignService_GetMatchCampaignReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MatchCampaignServiceServer).GetMatchCampaignReport(ctx, req.(*GetMatchCampaignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// MatchCampaignService_ServiceDesc is the grpc.ServiceDesc for MatchCampaignService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var MatchCampaignService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "donation.MatchCampaignService",
+	HandlerType: (*MatchCampaignServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateMatchCampaign",
+			Handler:    _MatchCampaignService_CreateMatchCampaign_Handler,
+		},
+		{
+			MethodName: "UpdateMatchCampaign",
+			Handler:    _MatchCampaignService_UpdateMatchCampaign_Handler,
+		},
+		{
+			MethodName: "GetMatchCampaign",
+			Handler:    _MatchCampaignService_GetMatchCampaign_Handler,
+		},
+		{
+			MethodName: "ListMatchCampaigns",
+			Handler:    _MatchCampaignService_ListMatchCampaigns_Handler,
+		},
+		{
+			MethodName: "GetMatchCampaignReport",
+			Handler:    _MatchCampaignService_GetMatchCampaignReport_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/donation.proto",
+}
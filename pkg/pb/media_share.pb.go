@@ -128,6 +128,7 @@ func (MediaStatus) EnumDescriptor() ([]byte, []int) {
 }
 
 // Settings Messages
+// Money amounts are int64 minor units of their currency (cents for USD, rupiah for IDR)
 type GetSettingsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	StreamerId    uint32                 `protobuf:"varint,1,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
@@ -333,7 +334,7 @@ type MediaShareSettings struct {
 	Id                 uint32                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	StreamerId         uint32                 `protobuf:"varint,2,opt,name=streamer_id,json=streamerId,proto3" json:"streamer_id,omitempty"`
 	Enabled            bool                   `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
-	MinDonationAmount  float64                `protobuf:"fixed64,4,opt,name=min_donation_amount,json=minDonationAmount,proto3" json:"min_donation_amount,omitempty"`
+	MinDonationAmount  int64                  `protobuf:"varint,12,opt,name=min_donation_amount,json=minDonationAmount,proto3" json:"min_donation_amount,omitempty"`
 	YoutubeEnabled     bool                   `protobuf:"varint,5,opt,name=youtube_enabled,json=youtubeEnabled,proto3" json:"youtube_enabled,omitempty"`
 	TiktokEnabled      bool                   `protobuf:"varint,6,opt,name=tiktok_enabled,json=tiktokEnabled,proto3" json:"tiktok_enabled,omitempty"`
 	AutoApprove        bool                   `protobuf:"varint,7,opt,name=auto_approve,json=autoApprove,proto3" json:"auto_approve,omitempty"`
@@ -396,7 +397,7 @@ func (x *MediaShareSettings) GetEnabled() bool {
 	return false
 }
 
-func (x *MediaShareSettings) GetMinDonationAmount() float64 {
+func (x *MediaShareSettings) GetMinDonationAmount() int64 {
 	if x != nil {
 		return x.MinDonationAmount
 	}
@@ -806,11 +807,14 @@ type MediaShareItem struct {
 	StartTime         uint32                 `protobuf:"varint,12,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
 	EndTime           uint32                 `protobuf:"varint,13,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	Status            MediaStatus            `protobuf:"varint,14,opt,name=status,proto3,enum=mediashar.media_share.MediaStatus" json:"status,omitempty"`
-	DonationAmount    float64                `protobuf:"fixed64,15,opt,name=donation_amount,json=donationAmount,proto3" json:"donation_amount,omitempty"`
+	DonationAmount    int64                  `protobuf:"varint,18,opt,name=donation_amount,json=donationAmount,proto3" json:"donation_amount,omitempty"`
 	SubmittedAt       *timestamp.Timestamp   `protobuf:"bytes,16,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
 	ProcessedAt       *timestamp.Timestamp   `protobuf:"bytes,17,opt,name=processed_at,json=processedAt,proto3" json:"processed_at,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// custom_description in the streamer's language, empty when it needed no translation
+	TranslatedDescription string `protobuf:"bytes,19,opt,name=translated_description,json=translatedDescription,proto3" json:"translated_description,omitempty"`
+	TranslationLanguage   string `protobuf:"bytes,20,opt,name=translation_language,json=translationLanguage,proto3" json:"translation_language,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
 func (x *MediaShareItem) Reset() {
@@ -941,7 +945,7 @@ func (x *MediaShareItem) GetStatus() MediaStatus {
 	return MediaStatus_MEDIA_STATUS_UNSPECIFIED
 }
 
-func (x *MediaShareItem) GetDonationAmount() float64 {
+func (x *MediaShareItem) GetDonationAmount() int64 {
 	if x != nil {
 		return x.DonationAmount
 	}
@@ -962,6 +966,20 @@ func (x *MediaShareItem) GetProcessedAt() *timestamp.Timestamp {
 	return nil
 }
 
+func (x *MediaShareItem) GetTranslatedDescription() string {
+	if x != nil {
+		return x.TranslatedDescription
+	}
+	return ""
+}
+
+func (x *MediaShareItem) GetTranslationLanguage() string {
+	if x != nil {
+		return x.TranslationLanguage
+	}
+	return ""
+}
+
 // Stats Messages
 type GetMediaStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -1014,7 +1032,7 @@ type GetMediaStatsResponse struct {
 	ApprovedCount       uint32                 `protobuf:"varint,3,opt,name=approved_count,json=approvedCount,proto3" json:"approved_count,omitempty"`
 	RejectedCount       uint32                 `protobuf:"varint,4,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"`
 	PlayedCount         uint32                 `protobuf:"varint,5,opt,name=played_count,json=playedCount,proto3" json:"played_count,omitempty"`
-	TotalDonationAmount float64                `protobuf:"fixed64,6,opt,name=total_donation_amount,json=totalDonationAmount,proto3" json:"total_donation_amount,omitempty"`
+	TotalDonationAmount int64                  `protobuf:"varint,9,opt,name=total_donation_amount,json=totalDonationAmount,proto3" json:"total_donation_amount,omitempty"`
 	YoutubeCount        uint32                 `protobuf:"varint,7,opt,name=youtube_count,json=youtubeCount,proto3" json:"youtube_count,omitempty"`
 	TiktokCount         uint32                 `protobuf:"varint,8,opt,name=tiktok_count,json=tiktokCount,proto3" json:"tiktok_count,omitempty"`
 	unknownFields       protoimpl.UnknownFields
@@ -1086,7 +1104,7 @@ func (x *GetMediaStatsResponse) GetPlayedCount() uint32 {
 	return 0
 }
 
-func (x *GetMediaStatsResponse) GetTotalDonationAmount() float64 {
+func (x *GetMediaStatsResponse) GetTotalDonationAmount() int64 {
 	if x != nil {
 		return x.TotalDonationAmount
 	}
@@ -1371,7 +1389,7 @@ func (x *StreamMediaQueueRequest) GetStreamerId() uint32 {
 
 type MediaQueueUpdate struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // "new_submission", "approved", "rejected", "played"
+	EventType     string                 `protobuf:"bytes,1,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"` // "new_submission", "approved", "rejected", "played", "translated"
 	MediaItem     *MediaShareItem        `protobuf:"bytes,2,opt,name=media_item,json=mediaItem,proto3" json:"media_item,omitempty"`
 	QueuePosition uint32                 `protobuf:"varint,3,opt,name=queue_position,json=queuePosition,proto3" json:"queue_position,omitempty"`
 	Timestamp     *timestamp.Timestamp   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
@@ -1454,13 +1472,13 @@ const file_proto_media_share_proto_rawDesc = "" +
 	"\x16UpdateSettingsResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\x12E\n" +
-	"\bsettings\x18\x03 \x01(\v2).mediashar.media_share.MediaShareSettingsR\bsettings\"\xd3\x03\n" +
+	"\bsettings\x18\x03 \x01(\v2).mediashar.media_share.MediaShareSettingsR\bsettings\"\xd9\x03\n" +
 	"\x12MediaShareSettings\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
 	"\vstreamer_id\x18\x02 \x01(\rR\n" +
 	"streamerId\x12\x18\n" +
 	"\aenabled\x18\x03 \x01(\bR\aenabled\x12.\n" +
-	"\x13min_donation_amount\x18\x04 \x01(\x01R\x11minDonationAmount\x12'\n" +
+	"\x13min_donation_amount\x18\f \x01(\x03R\x11minDonationAmount\x12'\n" +
 	"\x0fyoutube_enabled\x18\x05 \x01(\bR\x0eyoutubeEnabled\x12%\n" +
 	"\x0etiktok_enabled\x18\x06 \x01(\bR\rtiktokEnabled\x12!\n" +
 	"\fauto_approve\x18\a \x01(\bR\vautoApprove\x120\n" +
@@ -1470,7 +1488,7 @@ const file_proto_media_share_proto_rawDesc = "" +
 	"created_at\x18\n" +
 	" \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x129\n" +
 	"\n" +
-	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"\x87\x03\n" +
+	"updated_at\x18\v \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAtJ\x04\b\x04\x10\x05\"\x87\x03\n" +
 	"\x17SubmitMediaShareRequest\x12\x1f\n" +
 	"\vdonation_id\x18\x01 \x01(\rR\n" +
 	"donationId\x12\x1f\n" +
@@ -1506,7 +1524,7 @@ const file_proto_media_share_proto_rawDesc = "" +
 	"\x04page\x18\x03 \x01(\rR\x04page\x12\x1b\n" +
 	"\tpage_size\x18\x04 \x01(\rR\bpageSize\x12\x1f\n" +
 	"\vtotal_pages\x18\x05 \x01(\rR\n" +
-	"totalPages\"\xc1\x05\n" +
+	"totalPages\"\xb1\x06\n" +
 	"\x0eMediaShareItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\rR\x02id\x12\x1f\n" +
 	"\vdonation_id\x18\x02 \x01(\rR\n" +
@@ -1528,21 +1546,23 @@ const file_proto_media_share_proto_rawDesc = "" +
 	"start_time\x18\f \x01(\rR\tstartTime\x12\x19\n" +
 	"\bend_time\x18\r \x01(\rR\aendTime\x12:\n" +
 	"\x06status\x18\x0e \x01(\x0e2\".mediashar.media_share.MediaStatusR\x06status\x12'\n" +
-	"\x0fdonation_amount\x18\x0f \x01(\x01R\x0edonationAmount\x12=\n" +
+	"\x0fdonation_amount\x18\x12 \x01(\x03R\x0edonationAmount\x12=\n" +
 	"\fsubmitted_at\x18\x10 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\x12=\n" +
-	"\fprocessed_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\"7\n" +
+	"\fprocessed_at\x18\x11 \x01(\v2\x1a.google.protobuf.TimestampR\vprocessedAt\x125\n" +
+	"\x16translated_description\x18\x13 \x01(\tR\x15translatedDescription\x121\n" +
+	"\x14translation_language\x18\x14 \x01(\tR\x13translationLanguageJ\x04\b\x0f\x10\x10\"7\n" +
 	"\x14GetMediaStatsRequest\x12\x1f\n" +
 	"\vstreamer_id\x18\x01 \x01(\rR\n" +
-	"streamerId\"\xd6\x02\n" +
+	"streamerId\"\xdc\x02\n" +
 	"\x15GetMediaStatsResponse\x12+\n" +
 	"\x11total_submissions\x18\x01 \x01(\rR\x10totalSubmissions\x12#\n" +
 	"\rpending_count\x18\x02 \x01(\rR\fpendingCount\x12%\n" +
 	"\x0eapproved_count\x18\x03 \x01(\rR\rapprovedCount\x12%\n" +
 	"\x0erejected_count\x18\x04 \x01(\rR\rrejectedCount\x12!\n" +
 	"\fplayed_count\x18\x05 \x01(\rR\vplayedCount\x122\n" +
-	"\x15total_donation_amount\x18\x06 \x01(\x01R\x13totalDonationAmount\x12#\n" +
+	"\x15total_donation_amount\x18\t \x01(\x03R\x13totalDonationAmount\x12#\n" +
 	"\ryoutube_count\x18\a \x01(\rR\fyoutubeCount\x12!\n" +
-	"\ftiktok_count\x18\b \x01(\rR\vtiktokCount\"Q\n" +
+	"\ftiktok_count\x18\b \x01(\rR\vtiktokCountJ\x04\b\x06\x10\a\"Q\n" +
 	"\x13ApproveMediaRequest\x12\x19\n" +
 	"\bmedia_id\x18\x01 \x01(\rR\amediaId\x12\x1f\n" +
 	"\vstreamer_id\x18\x02 \x01(\rR\n" +
@@ -0,0 +1,214 @@
+// Package pdf writes simple PDF documents of text, lines and shaded boxes on A4
+// pages. Fonts are not embedded: Latin text uses the standard Helvetica fonts and
+// any other text, such as Chinese, the Adobe STSong-Light font that PDF readers
+// provide themselves.
+package pdf
+
+import (
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// A4 page size in points
+const (
+	PageWidth  = 595.28
+	PageHeight = 841.89
+)
+
+// Font picks the weight of a text run
+type Font int
+
+const (
+	Regular Font = iota
+	Bold
+)
+
+// Font resource names; the CJK font has a single weight
+const (
+	fontRegular = "F1"
+	fontBold    = "F2"
+	fontCJK     = "F3"
+)
+
+// fontObjects are objects 3 to 7, after the catalog and the page tree
+var fontObjects = []string{
+	`<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>`,
+	`<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica-Bold /Encoding /WinAnsiEncoding >>`,
+	`<< /Type /Font /Subtype /Type0 /BaseFont /STSong-Light /Encoding /UniGB-UCS2-H /DescendantFonts [6 0 R] >>`,
+	`<< /Type /Font /Subtype /CIDFontType0 /BaseFont /STSong-Light /CIDSystemInfo << /Registry (Adobe) /Ordering (GB1) /Supplement 2 >> /FontDescriptor 7 0 R /DW 1000 /W [1 95 500] >>`,
+	`<< /Type /FontDescriptor /FontName /STSong-Light /Flags 6 /FontBBox [-25 -254 1000 880] /ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 93 >>`,
+}
+
+const firstPageObject = 3 + 5 // After the catalog, the page tree and the font objects
+
+// Document is a PDF being built page by page
+type Document struct {
+	pages []*Page
+}
+
+// Page is one A4 page. Coordinates are in points from the bottom-left corner.
+type Page struct {
+	content bytes.Buffer
+}
+
+// New creates an empty document
+func New() *Document {
+	return &Document{}
+}
+
+// AddPage appends a blank page and returns it
+func (d *Document) AddPage() *Page {
+	page := &Page{}
+	d.pages = append(d.pages, page)
+	return page
+}
+
+// Text draws a line of text with its baseline starting at x, y. gray sets the
+// text's color, from 0 (black) to 1 (white).
+func (p *Page) Text(x, y, size float64, font Font, gray float64, text string) {
+	resource, encoded := encodeText(text, font)
+	fmt.Fprintf(&p.content, "BT %s g /%s %s Tf %s %s Td <%s> Tj ET\n",
+		number(gray), resource, number(size), number(x), number(y), encoded)
+}
+
+// Line draws a line from x1, y1 to x2, y2
+func (p *Page) Line(x1, y1, x2, y2, width, gray float64) {
+	fmt.Fprintf(&p.content, "%s G %s w %s %s m %s %s l S\n",
+		number(gray), number(width), number(x1), number(y1), number(x2), number(y2))
+}
+
+// FillRect fills a box with its bottom-left corner at x, y
+func (p *Page) FillRect(x, y, width, height, gray float64) {
+	fmt.Fprintf(&p.content, "%s g %s %s %s %s re f\n",
+		number(gray), number(x), number(y), number(width), number(height))
+}
+
+// WriteTo writes the document as a PDF file
+func (d *Document) WriteTo(w io.Writer) (int64, error) {
+	out := &countingWriter{w: w}
+	pageCount := max(len(d.pages), 1)
+
+	var offsets []int64
+	object := func(body string) {
+		offsets = append(offsets, out.n)
+		fmt.Fprintf(out, "%d 0 obj\n%s\nendobj\n", len(offsets), body)
+	}
+
+	io.WriteString(out, "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// Each page is a page object followed by its content stream
+	kids := make([]string, pageCount)
+	for i := range kids {
+		kids[i] = fmt.Sprintf("%d 0 R", firstPageObject+2*i)
+	}
+	object("<< /Type /Catalog /Pages 2 0 R >>")
+	object(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), pageCount))
+	for _, font := range fontObjects {
+		object(font)
+	}
+
+	resources := fmt.Sprintf("<< /Font << /%s 3 0 R /%s 4 0 R /%s 5 0 R >> >>", fontRegular, fontBold, fontCJK)
+	for i := 0; i < pageCount; i++ {
+		var content []byte
+		if i < len(d.pages) {
+			content = d.pages[i].content.Bytes()
+		}
+
+		var compressed bytes.Buffer
+		zw := zlib.NewWriter(&compressed)
+		zw.Write(content)
+		zw.Close()
+
+		object(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources %s /Contents %d 0 R >>",
+			number(PageWidth), number(PageHeight), resources, len(offsets)+2))
+		object(fmt.Sprintf("<< /Length %d /Filter /FlateDecode >>\nstream\n%s\nendstream", compressed.Len(), compressed.Bytes()))
+	}
+
+	xref := out.n
+	fmt.Fprintf(out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+
+	return out.n, out.err
+}
+
+// encodeText picks the font for a text run and encodes it as a hex string: Helvetica
+// when WinAnsiEncoding has every character, otherwise the CJK font in UCS-2
+func encodeText(text string, font Font) (string, string) {
+	if encoded, ok := encodeWinAnsi(text); ok {
+		if font == Bold {
+			return fontBold, encoded
+		}
+		return fontRegular, encoded
+	}
+
+	var b strings.Builder
+	for _, r := range text {
+		if r > 0xFFFF {
+			r = '?' // UCS-2 has no room for characters outside the basic plane
+		}
+		fmt.Fprintf(&b, "%04X", r)
+	}
+	return fontCJK, b.String()
+}
+
+// winAnsiSpecials maps the characters WinAnsiEncoding places in 0x80-0x9F
+var winAnsiSpecials = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87,
+	'ˆ': 0x88, '‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E, '‘': 0x91,
+	'’': 0x92, '“': 0x93, '”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '˜': 0x98,
+	'™': 0x99, 'š': 0x9A, '›': 0x9B, 'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+func encodeWinAnsi(text string) (string, bool) {
+	var b strings.Builder
+	for _, r := range text {
+		var c byte
+		switch {
+		case r >= 0x20 && r <= 0x7E, r >= 0xA0 && r <= 0xFF:
+			c = byte(r)
+		case r == '\t' || r == '\n' || r == '\r':
+			c = ' '
+		default:
+			special, ok := winAnsiSpecials[r]
+			if !ok {
+				return "", false
+			}
+			c = special
+		}
+		fmt.Fprintf(&b, "%02X", c)
+	}
+	return b.String(), true
+}
+
+// number formats a coordinate to two decimals, without trailing zeros
+func number(f float64) string {
+	s := strconv.FormatFloat(f, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	if s == "" || s == "-" {
+		return "0"
+	}
+	return s
+}
+
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+	return n, err
+}
@@ -0,0 +1,77 @@
+// Package spreadsheet writes tabular data as CSV or XLSX one row at a time, so
+// exports of any size are produced without holding them in memory.
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RowWriter writes rows of cells. Cells may be strings, numbers, times or nil for
+// an empty cell. Close must be called to finish the file; it does not close the
+// underlying writer.
+type RowWriter interface {
+	WriteRow(cells ...interface{}) error
+	Close() error
+}
+
+// csvWriter writes RFC 4180 CSV
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// NewCSVWriter creates a RowWriter producing CSV
+func NewCSVWriter(w io.Writer) RowWriter {
+	return &csvWriter{w: csv.NewWriter(w)}
+}
+
+func (c *csvWriter) WriteRow(cells ...interface{}) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		value := formatCell(cell)
+		if _, isString := cell.(string); isString {
+			value = escapeFormula(value)
+		}
+		record[i] = value
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// escapeFormula stops spreadsheet apps from running text that looks like a formula
+func escapeFormula(value string) string {
+	if value != "" && strings.ContainsRune("=+-@\t\r", rune(value[0])) {
+		return "'" + value
+	}
+	return value
+}
+
+// formatCell renders a cell value as text
+func formatCell(cell interface{}) string {
+	switch v := cell.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case uint:
+		return strconv.FormatUint(uint64(v), 10)
+	case time.Time:
+		return v.Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}